@@ -19,6 +19,7 @@
 // ui/assets/js/vendor/d3.v4.min.js
 // ui/assets/js/vendor/jquery-3.1.0.min.js
 // ui/views/index.html
+// ui/views/kube_resource_revisions.html
 // ui/views/kube_resources.html
 // ui/views/layouts/layout.html
 // ui/views/login.html
@@ -92,7 +93,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _configProvidersAwsMaster_userdataTxt = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xc4\x59\x6f\x73\xdb\x36\xd2\x7f\xcf\x4f\xb1\x65\x34\x8f\xec\xc6\x14\x25\xc7\x4d\x9e\x51\xca\xcc\x29\xb6\x92\x6a\x2a\x4b\x3e\x49\x6e\xda\x26\x1d\x0e\x44\x82\x12\xc6\x24\xc0\x02\xa0\x6c\xc5\x55\x3f\xfb\x0d\x00\x52\xa2\xfe\x50\x4e\xae\x9d\xeb\x2b\x89\xc4\xee\x6f\x7f\x58\xec\x02\x8b\xe5\xb3\x6f\xc0\x9d\x12\xea\x4e\x91\x98\x5b\xc9\x5d\x48\x38\x38\x29\xb8\x0b\xc4\xdd\x00\x05\x73\xec\xde\x65\x53\xcc\x29\x96\x58\x38\x84\x0a\x89\xe2\xd8\x0a\xc2\x27\x04\x38\x46\x21\xa3\xf1\x12\xc6\x9d\xfe\xc4\xbf\xee\x8c\x27\xdd\x91\x57\x7f\x7c\x84\x46\xe7\xc3\xf8\x92\xd1\x88\xcc\x1a\xd7\x48\x48\xcc\x6f\x38\x59\x20\x89\x7b\x37\xb0\x5a\xd5\x37\x7a\xbd\xc1\x78\xd2\x19\x5c\x76\xfd\x9b\x51\xf7\x5d\xef\x67\xa3\x3b\x40\x09\xde\x16\x1b\x0c\xaf\xba\xfe\x31\x59\x27\x21\x94\x30\x5a\x52\xb9\xec\xdf\x2a\x36\x7e\xef\xc6\x1f\x75\x06\xef\xbb\x5e\xbd\xd5\x6c\x9c\x5f\x5c\x34\x9a\x8d\xa6\xdb\x7a\x59\x92\xec\xf4\xfb\xc3\xcb\xce\xa4\xeb\x6b\x2b\x97\xbd\xab\xd1\xd8\xab\x4b\x9e\xe1\x92\xcc\xb8\x3b\xfa\xa9\x3b\xf2\xdf\xf6\x06\x9d\xd1\x2f\xfe\xa4\x33\xf2\x6f\x47\x7d\xaf\x3e\x97\x32\x15\x6d\xd7\x15\x2f\x1a\x28\x41\x9f\x19\x45\xf7\xa2\x11\xb0\xa4\xec\xab\x96\xd3\x72\x5e\x39\x88\x4b\x12\xa1\x40\x0a\x37\xc4\x0b\x1c\x97\x05\x04\xe6\x0b\xcc\x9d\x98\xd0\xec\xc1\x41\x49\xf8\xf2\xa2\x21\x11\x6f\xcc\x3e\xd7\x77\xfc\xfb\x37\x9b\x45\xb1\xdc\x37\xf4\xeb\x70\xd0\xdd\x5d\xc1\xce\x02\x91\x18\x4d\x49\x4c\xe4\xf2\x57\x46\x77\xd6\xe6\xc7\xdb\xb7\x5d\xff\x76\x5c\x2c\xfc\xad\xc0\x9c\xee\x2d\xa0\x16\xba\xe9\x8c\xc7\x1f\x86\xa3\x2b\x23\x78\x83\x84\xb8\x67\x3c\xdc\x16\x54\x8e\xee\x5d\x76\xfd\x83\xcb\xd7\xdc\x5f\xbc\xee\xa0\xf3\xb6\xbf\x11\xbf\x1e\x0e\x7a\x93\xe1\xa8\x37\x78\xef\xd5\x09\x8d\xe2\xec\x21\x9c\x56\x4b\xf7\x87\xef\xdf\x6b\xd1\x08\xc5\x02\xef\xcb\xe9\x80\xa8\x14\xca\x07\xfc\xab\xee\x78\xd2\x1b\x74\x26\xbd\xe1\xc0\xab\xe3\x18\x09\x49\x02\x81\x11\x0f\xe6\x65\xc4\x7e\x67\x3c\xe9\x5d\x8e\xbb\x9d\xd1\xe5\x0f\x05\xa6\x3f\xea\xde\xf4\x7b\x97\x9d\xb1\x57\x6f\x55\xb3\xbc\x1a\xec\xc7\xe3\x8e\xc8\x6d\x6f\x4f\xe2\x6a\x30\xae\x80\x57\x23\x79\x3c\xf7\x6e\xd6\x7e\x6d\x35\x77\x44\xae\x86\xd7\x9d\xde\xc0\xab\x07\x71\xa6\x12\xb8\x11\xb3\x00\xc5\xe5\xb4\xb9\xba\xee\x8d\xc7\xbd\xe1\xc0\xbf\x1c\x0e\x26\xa3\x61\xdf\xab\xab\x64\x14\x29\x0a\x70\x9f\x44\x38\x58\x06\x31\x3e\xeb\x93\x84\xc8\x11\xa2\x33\xcc\xcf\xc6\x38\xc8\x38\x91\xcb\x4b\x46\x25\x7e\x90\x57\x98\x2e\xcf\xc6\x98\x2f\x48\x80\x3b\x41\xc0\x32\x2a\xcf\x46\x58\xb0\x8c\x07\xf8\xdf\x19\x93\xa8\x64\xcc\x6c\x2d\xbb\xc9\xfc\x52\xc7\xc3\xf9\xc5\x4e\xa0\xf5\xbb\x13\x7f\x32\xfc\xb1\x3b\xf0\x6a\x27\x61\x08\x24\xf2\x54\xf4\xbb\x19\x47\x34\x64\x09\x4c\x85\xd7\x3a\xff\x7f\xd0\x06\xbd\x16\x9c\xbf\xd1\xa3\x34\x8b\x63\xf8\x03\xa6\x48\xe0\x97\x17\xf0\x07\x48\x0e\x4e\x08\xb6\xf7\xdc\xb5\xe1\x0f\x08\x43\xa5\xf6\xe2\xfc\x90\xd6\xe9\x6e\x98\x8f\x86\x3f\xff\xf2\x0f\x11\xb8\x1a\x5e\xfe\xd8\x1d\xf9\xe3\xc9\x70\xd4\x51\x6e\x42\x59\x24\xf6\xdd\xd8\xfd\x79\x32\xea\xf8\xe3\x8e\x0a\xac\xde\x4d\xbb\x88\x80\xb3\xab\xc1\xb8\xbd\xd9\x21\x76\x1e\x1b\x21\x8e\x50\x16\xcb\x8a\xd7\x0d\xb1\x08\x8e\x0c\x35\xb6\xc2\x68\x47\xd0\x49\xf4\x19\x51\xde\xf0\x6f\xaf\xfd\xeb\xde\xa0\x37\x1c\x98\xe0\xb5\x2c\x0b\xa5\xd2\x99\x61\x09\x59\x1a\x22\x89\xd7\x8f\xf9\x51\x04\x8e\xb3\xc4\x02\x82\x8c\xc7\x96\x15\xb2\x7b\x1a\x33\x14\x3a\x8c\x3b\xd3\x4c\xc8\x93\x53\x78\xb4\x00\xb4\x69\x70\x38\x64\x3c\xf6\xec\x5a\xcb\x2e\xbf\x8b\x48\x8c\x3d\xbb\xf6\x98\xf1\xf8\xd9\xb3\x6f\xdd\x95\x1a\xe4\x09\x38\x11\xd8\x35\x35\xa6\x9e\x33\x2a\x49\x0c\x1f\x3f\x82\x83\xc1\xae\x3d\xb6\x8c\x20\xfc\xf6\xdb\x6b\x08\x99\x05\x00\x80\x83\x39\x03\xfb\x2a\xb7\x4f\xe8\x4c\xe3\xc2\x49\xad\x75\x6a\x6b\x01\x45\x10\x1c\x87\xa4\x8b\x0b\x70\xfa\xac\x00\x07\xc7\x09\x18\xa5\x38\x90\x8e\x24\x09\x66\x99\x84\xf3\x26\x38\x0e\xc7\x92\x2f\xe1\x65\xf1\xcf\x09\x71\x8c\x96\xd0\x6a\x42\x4e\x1f\x20\x09\xbf\x13\x59\x52\x22\x19\x32\x8a\xad\x95\xf2\x58\xee\x1a\xbd\xd3\x6f\xb9\x40\xbd\xf0\x13\x16\x62\xe3\x05\x0b\x80\x44\x10\xa6\x77\x33\x70\x84\x1e\xcc\x8f\x53\xf8\xbf\x4d\x90\xbd\x06\x39\xc7\xb4\x34\x49\xcf\x83\x31\x8a\xe5\x58\xa2\xe0\x0e\x50\xac\x96\x6e\x59\xac\x06\x0e\xcf\x40\xdc\x91\x34\x55\x1e\x28\x56\x48\x48\x9c\x82\xe7\x19\xda\x1c\xcb\x8c\x2b\xb8\x88\x28\xfb\x6b\xc8\x11\x8e\x38\x16\x73\xa5\x97\xa2\xe0\x0e\xcd\x30\x84\x48\x22\x95\x15\xb9\xae\x59\x84\xed\x68\xd8\xf1\xbf\xe7\xed\x8c\x43\x84\x88\x26\xa5\xbd\xa8\xc0\x0b\x1e\x5a\x41\xc4\x18\xa7\xf0\x5d\xe1\x3c\x0b\xe0\x50\x81\xa4\x1d\x53\x54\x3e\x00\xdb\xc5\xd1\xd6\xa0\x05\x70\xd5\x7d\x3b\xf6\x4e\xb4\x89\x98\x4c\x3f\x27\xbf\xbf\xf0\x5f\x34\xce\x1b\x2f\x9e\x87\x91\x98\x39\xad\x3f\xa7\x29\x7b\xd5\xfc\x33\x14\xf2\x79\xcb\x37\x67\x7e\x88\xa7\x5a\x3c\x5d\xca\x39\xa3\xce\xe7\xe4\x77\xbf\xf5\xa2\xd1\x6a\x34\x8f\x4a\x6b\xbb\x01\x4b\x12\x46\xfd\xf3\x66\xeb\xa2\xd1\x6a\xb4\x5e\x3c\x0f\x45\xa1\xa4\x14\xe2\x38\x17\x3f\x35\x2b\xfd\xf1\xa3\x8a\xde\x75\x10\xac\x6c\xf0\x3c\xb0\x4d\x0e\x9a\x68\x5e\x2f\xb5\x9a\xc6\x73\xef\x24\x0f\x0a\x2d\xf1\x84\x19\x6d\x24\x22\xd6\xae\xae\x0e\xa8\x2f\xd1\xbd\x1d\xf5\xfd\xb7\x9d\x71\xd7\xb3\xd7\x65\x8e\x64\x1c\xcd\x70\x63\xc6\xd8\x2c\xc6\x28\x25\x7b\xc5\x0e\xc7\x31\x46\xc2\xac\x82\x8e\xe7\x88\x71\x50\x80\x84\xaa\xa9\x2a\x26\x1f\xff\xf5\xdb\xca\x5e\xc7\x89\xf2\x02\x7c\x93\xe7\x71\x88\xa7\x2a\x8b\x4b\xd3\x56\x81\xb0\xbd\x85\x28\xb9\x82\xd9\xca\xcd\x75\xb4\xac\x9e\x6b\x11\x37\x4f\xd9\x5d\xc7\x67\xcf\x84\x8a\x0a\x45\x03\x76\x06\x64\x46\x19\xc7\x10\xe2\x14\xd3\x10\xd3\x60\x09\x01\x4b\xd2\x18\x11\x2a\x05\x9c\xdc\x93\x38\x86\x88\x3c\x40\x8c\x24\xe6\xa7\xeb\x00\x36\x69\xeb\xa8\x6c\x73\x04\x4a\xb0\xb3\xc0\x5c\xa8\xdc\x75\x9c\x88\xf1\x00\x3b\x06\x4f\x80\x43\xd6\x73\x2d\x11\x7e\x06\x93\x39\x11\xa0\xd1\x8b\x44\x45\x74\x09\x2c\x52\xde\x80\x8c\x26\x58\x6e\x28\x11\x2c\x20\xe2\x2c\x01\x34\x65\x0b\xdc\xb0\x0e\x4f\xe8\x80\xd2\xa1\xd4\x5d\xef\xdc\x11\x38\xcb\xea\x0c\x2e\xc4\xbe\x36\x85\x9f\x41\x9f\xcd\x00\x81\xda\x4f\x85\x44\x49\x5a\x66\xfb\x8e\x50\x22\xe6\x38\x2c\xd0\x15\x9e\xda\xcd\x34\xa8\xde\x3c\x8d\xe8\x07\x44\xa4\xde\xc5\x19\x07\x13\xfe\x90\x86\x20\x19\x4c\x31\x20\x29\x55\xea\x87\xb6\x85\xa4\xc4\x49\x2a\xbd\xa6\x75\x3f\x57\xdb\xbd\x2a\xc5\xf2\x09\x69\x98\x8e\x19\x07\xbb\x76\x72\x52\xcb\x85\x9f\xb7\x4e\x4f\x6d\x85\x14\xcc\x71\x70\xa7\x0d\xe8\xbd\xf6\x61\x11\x4e\xd7\x69\xea\xe0\xcd\xcb\xed\xc4\x34\xf4\xde\xb1\x8c\x86\x1b\x11\xe3\x8f\x29\xc7\xe8\xae\x48\xc2\x82\xda\x8e\x65\x0b\x72\x8f\xb5\x2c\xe3\x2f\x83\x77\xad\x8a\x0b\x35\x5f\x33\x57\x27\x0d\xed\xd2\x5d\x31\xa1\xd2\x5d\x0f\x58\xc9\x5d\x24\xc0\x91\x80\x1f\xe4\x45\x89\xba\x01\xda\xb0\xde\x51\x03\x23\x0f\x94\x21\xb5\x30\x00\x4d\x68\xda\xf0\xe6\x0d\xb8\x58\x06\x6e\x24\x24\x9a\x5a\x89\x62\xb1\x6b\xae\xe0\x91\xc0\xab\x66\x73\x9f\x8e\xde\x8a\xb1\x0c\xc2\x2a\xbe\xae\xe0\x8b\xd2\x96\x71\x54\x4c\xef\x58\x6c\x81\x79\x8c\x96\xc7\x05\xc5\x3c\xe5\xec\x61\x69\x59\x31\x55\x67\xa7\x13\x55\xd1\x82\x0d\xc1\x2a\xd1\x6d\x82\xb0\x4b\xf8\x98\x5a\xc1\x03\xb6\x59\x1d\x55\x29\xcd\x11\xf6\x67\x6d\x91\x08\xbe\x01\x12\x82\x26\x7f\xa8\x0e\xc8\x04\xe6\x28\x0c\x95\x05\x57\x4c\x09\x75\x29\x8b\xd9\x8c\x50\x55\xb5\x6e\xa6\xad\x67\x1c\x11\x2b\x98\xb3\x7b\x0a\xce\xc8\xe0\x55\xad\x5d\x30\x9f\xf1\xf4\x49\x29\xcb\x2a\x2d\xca\x2e\x73\x37\x25\x71\x8c\xb8\x15\x20\x09\xdf\x7f\xdf\x1d\xbe\x83\x37\x55\x32\x6e\x5e\x93\x3a\x29\xe2\x28\x11\x0d\x11\x0b\x53\x33\xd1\x00\xfb\x29\xc7\x11\x79\x68\x43\xbd\x76\x62\x42\xba\xb6\xd3\x7f\x50\x55\xb9\xc0\xa1\x3e\x43\x84\x5b\x77\xeb\x75\x77\x66\x9f\xd6\x2d\xca\x42\xec\x1f\x81\x39\xd4\xcb\xa8\xc2\xca\x09\xfa\x01\x09\x79\x19\x63\xf7\x76\x5c\xa5\x8f\x62\x55\xf0\x49\xec\x6b\x52\x0a\x45\x94\x61\x0e\x74\x3e\xaa\x90\x84\xb9\xa7\xf9\x05\x23\x92\xfa\x5c\xdd\xea\xca\x70\x55\x77\xf7\x2a\x4c\x4c\xd1\x34\xde\x40\x26\x8c\x12\xc9\x38\xa1\xb3\x32\x68\xe5\x05\xff\x0b\x51\x63\x36\x9b\x1d\x87\xcc\x2f\xe3\x5f\x88\x97\x91\x23\x50\xb7\xbd\x27\x50\xf4\x3a\x54\x53\x2a\x37\x1c\xaa\x90\x72\x6d\x3f\xc4\x42\x12\x8a\x24\x61\xb4\x8c\x74\xa0\x2b\x51\xc9\xa9\xdc\xab\xf0\x39\x4e\x63\x12\xa0\xad\x00\x39\xde\xb5\xf8\x42\x8f\x85\x54\x1c\x71\xd9\xd5\xa0\x12\x27\xa4\xe2\x20\xab\x72\x6b\xe3\x98\xae\x69\xac\xed\x6a\xae\x5b\x1f\xc7\x54\x43\x96\x20\x42\x77\x55\x4d\x4b\xa4\x32\xd9\xc2\x84\x08\x55\x7a\xf9\x01\xa3\x92\xb3\x78\x2b\xd5\x76\xbb\x25\x95\xdb\x47\x96\xe8\x28\x11\x6d\x58\x2b\x3f\x96\xae\xc3\x2b\xfb\xd4\xea\x0e\xdf\x59\x9b\xbb\xf2\xdb\xce\xb8\x77\xe9\x77\x6e\x27\x3f\xf8\xef\x7a\xfd\xae\x67\xef\xef\x77\xea\x41\x9f\x25\x8e\xaa\x9e\xb5\x5b\xdc\x29\x12\x24\xf0\x51\x26\xe7\x8d\x40\x2c\x6c\x6b\xab\x24\xde\xc1\xdc\x2a\x8f\x8f\xec\xbd\x07\xec\x58\x00\x27\x59\x82\xc4\x1d\x34\x5f\xbd\x7a\x5d\xaa\x5d\x6a\x8f\x5b\x4d\xc1\xd5\x59\xfe\xe2\x76\xdc\x1d\xad\xce\x94\x33\xa9\x0d\x6f\x0e\x92\x39\x55\x27\x8a\xa5\xec\xc4\x58\xfa\x92\xdd\x61\xea\xd5\xb6\x3a\x3f\x7a\xd0\xd7\xa7\x60\x79\xbc\xdc\x9b\x39\x7a\x88\x1c\x9a\xc8\xa6\xcb\x33\x18\x7e\x18\x18\x90\xf1\x57\xb9\xfc\x8e\xb2\x7b\x6a\xf8\x08\xe3\xf4\xdc\x35\x99\xc7\xef\xcf\x66\xcc\x83\xd7\x85\x73\xb6\x26\x77\x96\x3f\x15\xbf\xca\x2f\xb5\x3d\x16\xf0\xda\x2a\x29\x97\x27\x7f\xb6\x79\x51\xfa\xab\xcb\xae\x7d\x98\xd3\x2f\x73\x4c\x8c\xe5\x7a\x05\x54\x10\xf9\xa6\x81\x72\x5c\xa3\x54\xcf\x68\xa5\x6a\x07\x3c\x7e\xb2\xdf\x62\xc4\x31\x9f\xa8\x09\x7c\xb2\xdb\xf0\x69\xc7\x29\x9f\xec\x33\xf8\x64\xf7\xa8\xc0\x41\xc6\xb1\x92\x50\x65\x37\xac\xb4\x73\xf6\x98\x7d\xe1\xac\x1c\x53\x38\x95\x1c\xa8\xfe\x06\xba\x0f\xfe\xf4\x0c\x8d\xb6\xbb\x51\xb1\x75\x19\xa2\x83\xb8\x1a\x72\x65\x9b\x42\xc5\x42\x29\xf9\xc9\x5c\xde\xda\xb0\x68\x59\x77\x84\x86\x6d\x30\x3d\x78\x4b\x55\x5b\xa2\x6d\x39\x40\x51\x82\xdb\x50\xe2\x6a\x2a\xb1\xb6\xce\x2c\xed\x98\x36\x6c\x59\xd3\xef\x56\x45\x29\x51\xc2\xd0\xbd\x20\x0b\x20\x1f\x31\x08\xea\x42\xa4\x1d\x6a\x6e\x94\x32\x16\xea\x42\x49\xa2\xa5\x71\xaf\x15\x98\xde\xad\x86\xc9\xff\x1b\xc5\x02\x65\x0d\x9b\xf3\xda\xa6\x6a\x2c\xe7\xc5\x84\x83\x4c\xd7\xd7\xc9\x81\xac\x20\xe3\x1c\x6f\x9e\xab\x05\xf5\x06\xf8\xb5\x51\xfa\x35\x2b\x59\xc4\xea\xc1\x65\x3c\x00\xf6\xdf\xae\xa1\xe2\x57\xb9\x80\xeb\x50\xff\x87\x57\xcf\x90\xfc\x3b\x97\xae\xa8\x26\xf3\x41\xe1\x9d\xd8\x62\x29\x24\x4e\xda\x42\xdd\xa8\xb3\x18\x73\x1b\x8a\x57\xf9\x69\x1a\xab\x12\x11\x51\x34\x2b\x8f\xe5\xc5\xd0\xe6\xc5\xa6\x88\xdc\xbc\x0b\xa9\x50\x47\x06\xe3\x90\xdb\xcb\xdb\x33\xbb\x2c\xca\xad\x9a\xfc\xd0\xf8\xdf\xb4\xef\x37\x47\xa2\x59\xee\xb3\xda\x63\xce\xa9\xfc\x57\xef\xd7\xea\xd8\xdc\xdd\xb1\x57\x76\x7e\x89\x5f\xb7\x2d\xca\x0d\xe8\x29\xa1\x88\x2f\x21\x6f\x91\x81\x44\x1c\x4e\x6a\x07\xbf\x67\x9e\xda\x7b\x9d\xf3\xbc\xaa\xdf\x13\xb5\xbf\xdc\x52\xe9\xcb\x65\x85\x81\x92\xc4\x1a\xf7\x96\xa6\x28\xb8\x5b\xb7\x65\x24\xc7\xd8\xb6\x78\x02\x0e\x8f\xa0\x74\x29\x56\x36\x1e\x3e\x47\xca\x2d\x65\x98\xbc\x83\x9f\x63\x8d\x32\x4a\x15\x52\x41\x6c\xdd\x90\x0e\x38\x49\xa5\x6d\x89\x2c\x64\x25\x50\xbd\x0b\xa8\x75\x74\x73\xc1\x86\x98\x6b\x03\x87\x1c\x51\x58\x2a\xed\x46\x58\x06\x66\x23\x31\x3d\xcf\x46\x98\xd3\x30\x37\xd9\x36\xd4\x4a\x1f\xcb\xd5\x61\xb5\xaf\x90\x5f\x7a\x1b\x6a\x83\xb1\xb6\x6e\xb2\xfb\xa2\x33\x8e\x88\xaa\x26\x94\xa8\xf9\xaf\x32\x9a\xb3\x18\x0b\x93\xda\x0e\xec\x7d\x68\xd1\xdb\x06\xcb\xc2\x36\xa0\x7b\xa1\x1e\xa6\xdc\x31\x57\x4c\xbb\xf6\xb8\xf3\xa9\x6d\x65\x9b\x94\xcd\x1b\x52\xa6\xaf\x69\xbe\x32\x0d\x6f\x26\xe3\xd5\x56\xc3\xb8\x44\xf5\x29\xae\x00\x21\x0b\xee\x30\xf7\x59\x2a\xb7\xab\xfb\x0d\x78\x55\x8d\xac\x08\xa9\x0a\xf0\x00\xa7\xd1\x70\x38\xf9\xeb\x9c\x38\x63\xf2\x00\x27\x05\xfe\x35\x9c\x8a\x92\xf4\x2f\x92\x2a\xce\x82\x5d\x56\x65\xf8\xaf\xa1\xb5\xf7\x15\xf0\x2f\x70\xcb\xbf\x09\xe0\x07\xc9\x91\x2f\xd0\xf6\x55\x6f\xcf\xd0\x53\x2c\x0f\x25\x91\x49\x85\xb0\x22\x0d\xf2\x51\x17\x65\x92\xa9\xb3\x06\xa7\xd2\x50\x53\x2f\x7c\xf3\xa2\x0d\x13\x75\xf8\xe9\x30\x3e\x8e\xc2\x31\x0a\x24\xcb\x13\x2f\x7f\x50\x59\xe4\x40\xbd\xe4\x04\xf7\x5b\x57\x48\xc4\x65\xbd\x48\x30\x5d\x48\xe4\xe2\xee\x9c\xcc\xe6\x42\x22\x89\x1d\x8a\xef\x75\x4f\xc9\xe4\x4f\xe9\x63\x5c\xee\xb5\x62\x7f\x62\x29\xa6\xfa\x4b\x8c\x21\xba\x69\x87\x96\xc8\xe5\xa2\x7b\xb3\x3a\x2c\x5c\x1c\xb0\xe5\xef\x36\xa0\x39\xef\x8c\x98\xcf\x7c\x66\xe4\x3f\x01\x00\x00\xff\xff\x11\xf4\x83\xca\x22\x24\x00\x00")
+var _configProvidersAwsMaster_userdataTxt = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xc5\x5a\xff\x77\xda\xb6\x16\xff\xdd\x7f\x85\x46\x73\x1e\xc9\x8a\x31\x24\x69\xbb\xa5\xa3\x67\x34\xa1\x1d\xa7\x04\xf2\x80\xac\xdb\xda\x1e\x1f\x61\x0b\xf0\x8b\xbf\x4d\x92\x93\xd0\x3c\xf6\xb7\xbf\x7b\x25\x1b\x8c\xc1\xa4\xdd\x76\xde\xda\x1f\x02\xd2\xd5\xd5\x47\x57\xf7\xbb\x78\xf2\x0d\xb1\x26\x5e\x68\x4d\xa8\x98\x1b\xc1\x8d\xeb\x71\x62\xc6\xc4\xba\xa5\xdc\x72\xa8\x33\x67\xd6\x4d\x32\x61\x3c\x64\x92\x09\xd3\x0b\x85\xa4\xbe\x6f\x38\xee\x23\x04\x9c\x51\x37\x0a\xfd\x05\x19\xb5\x7b\x63\xfb\xb2\x3d\x1a\x77\x86\xad\xea\xc3\x03\xa9\xb7\xdf\x8f\xce\xa3\x70\xea\xcd\xea\x97\x54\x48\xc6\xaf\xb8\x77\x4b\x25\xeb\x5e\x91\xe5\xb2\xba\x5e\xd7\xed\x8f\xc6\xed\xfe\x79\xc7\xbe\x1a\x76\xde\x74\x7f\xd1\x6b\xfb\x34\x60\x9b\x64\xfd\xc1\x45\xc7\xde\x47\x6b\x06\x5e\xe8\x45\x61\x6e\xc9\x79\xef\x1a\xd1\xd8\xdd\x2b\x7b\xd8\xee\xbf\xed\xb4\xaa\xcd\x46\xfd\xf8\xf4\xb4\xde\xa8\x37\xac\xe6\xf3\x1c\x65\xbb\xd7\x1b\x9c\xb7\xc7\x1d\x5b\xed\x72\xde\xbd\x18\x8e\x5a\x55\xc9\x13\x96\xa3\x19\x75\x86\x3f\x03\xb3\xd7\xdd\x7e\x7b\xf8\xab\x3d\x6e\x0f\xed\xeb\x61\xaf\x55\x9d\x4b\x19\x8b\x33\xcb\x12\x27\x75\x1a\xd0\xcf\x51\x48\xef\x44\xdd\x89\x82\xbc\xac\x9a\xf0\xff\x85\x49\xb9\xf4\xa6\xd4\x91\xc2\x72\xd9\x2d\xf3\xf3\x04\x82\xf1\x5b\xc6\x4d\xdf\x0b\x93\x7b\x93\x06\xee\xf3\xd3\xba\xa4\xbc\x3e\xfb\x5c\x2d\xc8\xf7\x6f\xde\x96\xfa\x72\x7b\xa3\xdf\x06\xfd\x4e\xf1\x06\xdb\xb7\xd4\xf3\xe9\xc4\xf3\x3d\xb9\xf8\x2d\x0a\x0b\x77\xf3\xee\xfa\x75\xc7\xbe\x1e\x65\x17\x7f\x0d\xc7\x09\xb7\x2e\x50\x11\x5d\xb5\x47\xa3\xf7\x83\xe1\x85\x26\xbc\xa2\x42\xdc\x45\xdc\xdd\x24\x44\x41\x77\xe1\x8e\x77\x5e\x5f\x63\xfb\xf2\x3a\xfd\xf6\xeb\xde\x9a\xfc\x72\xd0\xef\x8e\x07\xc3\x6e\xff\x6d\xab\xea\x85\x53\x3f\xb9\x77\x27\xe5\xd4\xbd\xc1\xdb\xb7\x8a\x74\x4a\x7d\xc1\xb6\xe9\x94\x42\x94\x12\xa5\x13\xf6\x45\x67\x34\x06\xbd\x18\x77\x07\xfd\x56\x95\xf9\xa0\xee\x9e\x23\x18\xe5\xce\x3c\xcf\xb1\x07\xc6\xd1\x3d\x1f\x75\xda\xc3\xf3\x9f\x32\x9e\xf6\xb0\x73\xd5\xeb\x9e\xb7\x41\xdf\x9a\xe5\x28\x2f\xfa\xdb\xfa\x58\x20\xb9\xee\x6e\x51\xc0\xaa\x12\xf6\x38\x93\xea\x73\xf7\x6a\x25\xd7\x66\xa3\x40\x72\x31\xb8\x6c\x77\xe1\x44\x8e\x9f\xa0\x01\xd7\xfd\xc8\xa1\x7e\xde\x6c\x2e\x2e\xbb\xa3\x11\x1c\xda\x3e\x1f\xf4\xc7\xc3\x01\xe8\x25\x1a\xa3\x88\xa9\xc3\x7a\xde\x94\x39\x0b\xc7\x67\xb5\x9e\x17\x78\x72\x48\xc3\x19\xe3\xb5\x11\x73\x12\x0e\x3a\x04\x6a\x25\xd9\xbd\xbc\x60\xe1\x02\xc6\xf8\xad\xe7\xb0\xb6\xe3\x44\x49\x28\x6b\x43\x26\xa2\x84\x3b\xec\xdf\x49\x24\x69\x6e\x33\xed\x5a\x8a\xc6\xfc\x5c\xe9\xc3\xf1\x69\x41\xd1\x7a\x1d\xb0\x95\xc1\xbb\x4e\xbf\x75\x70\xe8\xba\xc4\x9b\xb6\x50\xfb\xad\x84\xd3\xd0\x8d\x02\x32\x11\xad\xe6\xf1\x77\x44\x6d\xd8\x6a\x92\xe3\x57\x6a\x36\x4c\x7c\x9f\xfc\x97\x80\x7b\x64\xcf\x4f\xe1\x83\x04\xff\xe8\x92\x4a\xeb\xa9\x55\x81\x6f\xc0\x06\x96\x9d\x1c\xef\x5a\x75\x54\x54\xf3\xe1\xe0\x97\x5f\xff\x21\x00\x17\x83\xf3\x77\x20\xa7\x11\xd8\x40\x1b\xc5\x44\x93\xa9\xd8\x16\x63\xe7\x97\xf1\xb0\x6d\x8f\xda\xa8\x58\xdd\xab\xb3\x4c\x03\x6a\x70\xed\x67\x6b\x0f\x51\xf8\x5a\x77\xd9\x94\x26\xbe\x2c\x19\xae\x8b\x5b\x67\xcf\x54\x7d\x43\x8d\x0a\x84\x66\xa0\x62\x44\xde\xe1\x5f\x5f\xda\x97\xdd\x3e\x68\x97\x56\x5e\xf8\x47\x63\x69\xce\x98\x24\x49\xec\x42\x20\x59\x7d\x4d\x43\x11\x31\xcd\x05\x13\x04\x14\xcc\x37\x0c\x37\xba\x0b\xfd\x88\xba\x66\xc4\xcd\x09\x6c\x7b\x78\x44\x1e\x0c\x42\xd4\xd6\xc4\xe4\x04\x88\x5a\x95\x83\x66\x25\x3f\x36\xf5\x7c\x06\x83\x0f\x30\xf7\xe4\xc9\xb7\xd6\x12\x27\x79\x40\xcc\x29\xa9\x1c\xe0\x1c\x7e\x07\xc9\x7b\x3e\xf9\xf0\x81\x98\x0c\x46\x1f\x9a\x9a\x90\x7c\xfa\xf4\x92\xb8\x11\xcc\x13\xc2\x9c\x79\x44\x2a\x17\xe9\xfe\x5e\x38\x53\x7c\xc9\xe1\x41\xf3\xa8\xa2\x08\x10\x20\x60\xf5\xe2\xdb\x53\x62\xf6\xa2\x8c\x39\x0c\x39\x51\x18\x32\x47\x9a\xd2\x0b\x58\x94\x48\x72\xdc\x80\x41\xce\x24\x5f\x90\xe7\xd9\x27\xd3\x05\xff\xb2\x20\xcd\x06\x49\xe1\x13\x12\xb8\xcf\x44\x12\xe4\x40\x82\x00\x99\xb1\x44\x89\xa5\xa2\x51\x9e\x7e\x43\x04\x38\x60\x07\x91\xcb\xb4\x14\x60\xdc\x9b\x12\x37\xbe\x99\x11\x53\xa8\xc9\x34\x9c\x92\x7f\xad\x95\xec\x25\x91\x73\x16\xe6\x0e\xd9\x6a\x91\x11\x90\x8e\x24\x75\x6e\x08\xf5\xf1\xea\x16\xd9\x6d\x30\xb7\x46\xc4\x8d\x17\xc7\x28\x81\xec\x86\xe0\x8a\x63\xd2\x6a\x69\xd8\x70\x9c\x84\x23\xbb\xa9\x87\xfb\xaf\x58\x0e\xd9\x94\x33\x31\xc7\x75\xe0\x48\x6e\xe8\x8c\x11\xb8\x6e\x8a\x56\x91\xae\xd5\x97\xb0\xa9\x0d\x05\xf9\x03\x9f\xcd\x79\x32\x85\x08\x86\xa0\x94\x14\x91\x79\x86\x43\x2d\x10\x3e\x03\x68\xcf\x32\xe1\xc1\xdf\x5d\x09\x92\x12\x4c\x96\xf9\xc0\x4d\xba\xa5\x93\x30\x7b\xd1\x79\x3d\x6a\x1d\xaa\x2d\x7c\x6f\xf2\x39\xf8\xfd\xc4\x3e\xa9\x1f\xd7\x4f\x9e\xba\x53\x31\x33\x9b\x7f\x4c\xe2\xe8\x45\xe3\x0f\x57\xc8\xa7\x4d\x5b\xc7\x7c\x97\x4d\x14\x79\xbc\x90\xf3\x28\x34\x61\x89\xdd\x3c\xa9\x37\xeb\x8d\xbd\xd4\x6a\x5f\x08\xfd\x41\x14\xda\xc7\x8d\xe6\x29\x2c\x68\xc2\x26\x22\x5b\x84\x0b\x7c\x3f\x25\x3f\xd2\x37\x0d\x0a\x0c\xda\xbb\x52\x02\xd0\x60\x10\x58\x45\xdb\xa0\xd6\xe6\xd5\x55\xe3\x31\x9e\xb6\x0e\x53\xa5\x50\x14\x8f\x6c\xa3\x36\x81\x4b\x2d\xae\x55\x0a\xf5\x25\x6b\x21\xc3\xb1\x5f\xb7\x47\x9d\x56\x65\x95\xe6\xc8\x88\x83\x22\xd4\x67\x51\x34\xf3\x19\x8d\xbd\xad\x64\x87\x33\x18\x17\xfa\x16\x94\x3e\x4f\x23\x4e\x90\xa1\x17\xe2\x51\x11\xc9\x87\x1f\x3f\x2d\x2b\x2b\x3d\x41\x29\x90\x6f\x52\x3b\x06\x42\xb4\xe2\xdc\xb1\x51\x11\x36\x5d\x08\xd2\x65\xc8\x96\x56\xba\x46\xd1\xaa\xb3\x66\x7a\xf3\xd8\xbe\x2b\xfd\xec\x6a\x55\x41\x55\xd4\xcc\x6a\xc4\x9b\x85\x11\x07\x75\x67\x31\x0b\x5d\x16\x3a\x0b\xf0\xf5\x41\xec\x53\x2f\x94\x82\x1c\xde\x79\x60\x42\x53\xef\x9e\xf8\xa0\xcf\xfc\x68\xa5\xc0\xda\x6c\x4d\xb4\x36\x30\xf4\x80\x99\x90\x4b\x0a\xb4\x5d\xd3\x04\x30\x0e\x33\x35\x3f\x41\x4c\x6f\x75\xd6\x1c\xe0\x27\x64\x3c\xf7\x04\x51\xdc\x33\x43\xa5\xe1\x82\x44\x53\x94\x06\x18\x5b\x00\x46\xb4\x82\xe4\x81\x8b\x9d\x72\x08\x61\x74\x12\xdd\xb2\xba\xb1\xfb\x40\x3b\x16\xed\x32\xdd\x95\xe7\x9e\x12\x73\x51\x6e\xc1\x19\xd9\xd7\x9a\xf0\x13\xd2\x8b\x66\x84\x12\xf4\xa7\xc0\x21\x88\xf3\x68\xdf\x80\x3a\x8a\x39\x73\x33\xee\xc8\x0f\xbd\x99\x62\xaa\x9c\xa7\x26\x7d\x4f\x3d\xa9\xbc\x38\xdc\xab\x56\x7f\x12\xbb\x44\x46\x64\xc2\x08\x95\x12\x4d\xdf\xad\x18\xf0\x89\x05\xb1\x6c\x35\x8c\xbb\x39\xba\x7b\x4c\xc5\xd2\x03\x29\x36\x6d\x3d\x0f\xf2\x3f\x3c\x3c\x48\x89\x9f\x36\x8f\x8e\x2a\xc8\x09\x58\x80\x03\xc5\x0d\x94\xaf\xbd\xbf\x75\x27\x2b\x33\x05\xfd\x5c\x0d\x6e\x1a\xa6\x86\xf7\x06\x92\x01\x77\x4d\xa2\xe5\x31\x01\x57\x7c\x93\x19\x61\x06\xad\xb0\x33\xcc\x68\x89\x35\x0d\x2d\x2f\xcd\xef\x12\x93\x0b\x3c\xaf\x3e\xab\x19\xc3\xe1\xd6\xae\x30\x08\xa5\xb5\x9a\x80\xf1\x29\x28\x95\x24\x90\xc9\x9d\xe6\xa0\x6b\x46\x6b\xd4\x85\x65\x44\xd3\x93\x30\xa2\x78\x31\x84\x34\x48\xa3\x42\x5e\xbd\x22\x16\x93\x8e\x35\x85\xcb\x98\x18\x01\xa2\x28\x6e\x97\xe1\x08\xc8\x8b\x46\x63\x1b\x8e\x72\xc5\xc0\xc2\x2d\xc3\x6b\x09\x7e\x9b\x73\x19\x7b\xc9\x94\xc7\x02\x05\xe7\x10\x6c\xf7\x13\x8a\x79\xcc\xa3\xfb\x85\x61\xf8\x21\xc6\x4e\x50\xe5\x12\x58\x64\x0d\xb0\x8c\x74\x13\x20\x29\x02\xde\xb7\x2c\xc3\x41\x36\x51\xed\x5d\x92\x3b\x23\xd9\x3e\xb5\x81\x8e\x81\x11\x87\x61\x01\xe9\x39\x18\x43\x53\x97\xd0\xbe\xea\x12\x5d\xb2\x12\x70\x1c\x02\xbc\x16\x98\xd1\x64\xa1\xe6\xce\xdb\x19\xd5\x3b\x00\x5e\x23\x60\x0e\xce\x1c\x58\x8d\x92\x98\xf1\x99\x47\xe1\x5a\x61\x1d\x30\x84\xf3\x79\x92\xd0\x19\x45\xfb\xab\x29\xed\xc7\x55\x71\x32\xf1\x3d\x87\x74\xaf\x32\x36\x1a\x73\x5d\xdb\xa6\x1b\x31\x11\x56\x25\xf0\x9b\xb1\x90\x71\xc4\xe4\x81\x6b\x04\x4f\x0d\xd9\x86\x17\x3a\x2c\xc5\x55\x47\xd4\xa0\x67\x9e\x90\xa2\xbe\x95\xfc\x5e\x5d\xbf\x86\xa2\x08\x4b\x9f\x83\x43\x9d\x8d\x09\x82\x91\x06\x02\x4d\xf3\xf9\xf7\xf5\xe3\x67\x10\xa0\xf4\x5f\x0b\x5d\xad\x00\xc1\x31\x49\x4d\xcc\x42\x2c\x8d\x4f\x65\x6f\x47\x06\x08\x85\xfc\xf0\x43\xb5\x33\x78\x53\x25\xaf\x0a\xb7\x05\x59\x41\xdd\xe1\xd2\xc0\x42\xf7\xbc\x7d\x8e\x78\x96\x4b\x03\x48\x8d\xc3\x04\xce\x74\x43\x1a\x2f\x5e\xbc\x7c\x94\xc3\x0d\x5b\xa4\x1c\xde\xb1\x45\xc6\x20\xdb\x18\x3e\x6f\x2f\x8a\xc0\xe7\x0a\xe1\xd7\x9d\x70\x6a\x7c\xe0\xec\xf7\x4f\x70\xfc\xdf\x6d\x30\x39\x18\x86\xa0\x00\x8e\x98\xdc\x9e\xd8\x30\x66\xb8\x20\x1d\xb0\xf4\x44\xb9\x41\x5b\x55\xec\x2d\x82\xc4\xdb\x13\x8a\xd3\x8e\xf1\x4f\xc6\x07\xcd\xec\x93\x01\xf9\x99\xe7\x40\x5d\x27\x24\xd7\xf1\xaa\x05\xba\x70\xf6\xa6\xdd\x1b\x75\x0c\x38\xc5\xb5\xc0\x44\xae\x05\x56\x1f\x0e\x59\x9c\xb8\x1e\x18\x7f\x04\xb7\xe6\x7a\x33\x0f\xdc\xef\x08\x94\x88\x42\x3e\x08\x1a\x03\xc4\x1d\x08\x19\xf1\x9c\xf1\x80\x85\xd2\x10\xc9\xe4\x3f\x90\x15\xb7\x7d\xd9\xd7\x10\xa1\x5e\x39\x78\x28\x5e\xe5\xb2\xa6\x86\x73\x5d\xa8\x65\x6d\x45\xb5\xae\x76\xb4\x00\x53\x19\xa1\x12\x71\x41\x89\x89\xc9\x76\x51\x8e\xa9\x22\x01\x1a\xc8\xc3\x4f\xbf\x5b\xad\x81\xc3\x12\x33\x64\x77\xc4\xc4\xa9\x3d\xab\xf6\x71\x75\x04\xf8\x13\x3c\x18\xf8\xc9\xf3\x7e\x6b\xab\x0e\x82\x6a\xc0\x51\x9d\x17\xb2\xef\x7a\x33\x48\xf7\xcf\x1a\xdf\x43\x01\x83\xc0\x20\xeb\xd8\xb7\x25\x58\xe7\x6e\x35\xc5\xa9\x5d\xe7\xd1\x2a\x88\xb3\x0e\x58\x11\x8c\x80\xed\x62\xb5\xb4\xef\x6c\xa8\xea\x60\x2c\x0b\x41\x4e\x9e\x3f\x03\x47\x9d\xd3\x3d\xad\x2c\x6a\x48\x55\x45\xfb\x4e\x07\xa5\x57\x89\x41\x18\x10\x1a\xbf\x21\x9e\x4b\x94\x5b\xdd\x55\xa1\x24\x80\x85\x42\xc1\x6c\xa2\x13\xc5\x9e\x67\x18\xf9\xd1\x0c\x84\x63\xe6\xdc\xb0\x5a\x6e\x40\x8c\x84\x78\x75\x07\x53\x43\xcd\xaf\x2c\xaa\x38\xf3\x19\x8f\x1f\xa5\x32\x8c\x5c\xb8\x28\xfa\x54\x2b\x86\x24\x8b\xf2\x2d\xf3\xdd\x41\x63\xa5\xd5\xb2\x19\x53\x4e\x03\x51\x17\xbe\xd0\xd5\x1c\x38\x39\x3b\xe6\x0c\xf2\xc0\x33\x52\x3d\x38\xd4\xc1\xf6\xa0\xd0\x19\xc5\x7e\x81\x00\xa7\x8c\xd9\xad\xb0\xaa\x56\xb5\x6a\xcd\x2a\x47\x55\x23\x84\x64\xdf\xde\xc3\x66\x57\x97\xb5\x8c\x57\x0a\xd0\x76\x3c\x97\xe7\x79\x14\xfb\x76\x65\xeb\x21\xe7\x8a\x30\xaa\xd8\x0a\x14\x72\x11\x79\x36\x3b\x7a\xb2\x65\x9c\x84\xee\x20\xd9\x19\x22\x2f\xb6\x39\xf6\x9b\xf2\xec\xca\xba\x8a\x65\x3c\x59\x48\x27\xfe\x9a\x25\x14\x57\x1e\xd4\x20\xe0\xfd\xf2\x4c\x4b\x5b\x8f\x5f\xc8\x15\x74\x72\xb6\x9f\x65\xda\x26\xfc\x42\x7e\x89\xb7\x87\xd5\x75\xf7\x11\x2e\xea\x1e\xca\x21\xe5\x5b\xa1\x65\x9c\xd2\xd5\xb6\xcb\x30\x52\x28\x17\x9f\xe7\xb4\xa3\x5f\x5a\x8a\x29\xdf\x45\x05\xa7\x11\x43\xd4\xa5\x1b\x0a\xb2\xbf\x9f\xfa\x85\x12\x73\x43\xb1\x47\x64\x17\xfd\x52\x3e\xb0\x70\x27\xaa\x7c\xd3\x75\xdf\x5a\xed\x2c\x8b\x2b\x57\x4d\xd9\x7d\x4b\xdd\x28\x80\x08\x5b\x5c\xaa\x9b\xb5\xa5\xc6\xe6\x06\x9e\x40\x1f\x6c\x43\x5c\x91\x3c\xf2\x37\x4c\xad\xd8\xc7\x2d\x75\x1f\x49\xa0\xb4\x04\x4e\xbc\x5a\xfc\x90\x6b\xd4\x2d\x2b\x47\x2a\xbe\xae\x33\x2e\x28\x96\x21\x3c\xb7\xaf\xc7\x3f\xd9\x6f\xba\x3d\xa8\xe8\xb7\xfd\x1d\x7e\x51\x4e\xde\xc4\xba\x5e\x89\xc5\x52\xc9\x84\x4d\x13\x39\x87\xb8\x75\x5b\x31\x36\x8a\xf5\x02\xcf\x8d\xc2\x7d\x8f\xef\xdd\xb1\x0f\x2c\xc8\x27\x63\xeb\xaa\xea\xe0\x61\xe3\xb9\x02\x53\x89\xd5\x23\xc7\xb2\x86\xc2\x0c\xa1\x64\xd9\x09\xe6\x08\x23\x8a\x81\xfb\xf8\x4c\xda\x32\xba\x61\x61\xeb\x60\xa3\x27\xad\x26\x6d\x95\x9f\xe7\xe7\xf3\x5d\xe3\xbd\x41\x64\xd7\x41\xd6\xfd\xe7\xfe\xe0\x7d\x5f\x33\x19\x7d\x95\xc8\x6f\x42\x08\x82\x1a\x8f\xd0\x42\x4f\x45\x93\xb4\xf8\x5d\x6d\x16\xb5\xc8\xcb\x4c\x38\x1b\x87\xab\xa5\xdf\xb2\xbf\x28\x97\x83\x2d\x14\xe4\xa5\x91\x5b\x9c\x3f\x7c\x6d\x3d\x90\xfb\xa8\x0a\xc2\x6d\x36\x47\x5f\x26\x18\x80\xb1\xba\x01\x54\x22\x5b\xb7\x76\xf7\xaf\xc8\x25\x1a\x6a\x51\xb9\x00\x1e\x3e\x56\x5e\x83\x5f\x62\x7c\x8c\x07\xf8\x58\x39\x23\x1f\x0b\x42\xf9\x58\xa9\xc1\x58\x37\x14\xf8\xde\xc1\x90\x02\x1b\x02\x64\xa9\x84\xb3\x85\xec\x0b\x4f\x65\xea\x92\x2e\x27\x40\xfc\xa8\xf3\xc4\xc7\x4f\xa8\x57\x5b\xeb\x25\x15\x95\x86\x28\x25\x2e\x67\x09\x88\x55\xa2\x62\x80\xa2\xfc\xac\xdb\x4a\x67\xe4\xb6\x69\xdc\x78\xa1\x7b\x46\xf4\xeb\xa0\x81\xd9\x96\x38\x33\x4c\x82\x25\xc1\x19\xc9\x61\xd5\x99\xd8\x99\xb2\x2c\x25\x18\x70\x1c\x0f\x45\x0d\x58\x66\xa9\x44\x8e\x87\xea\x52\x63\x8b\x55\xcf\x68\x0e\xd8\xaa\x51\x02\xd5\xbd\x2e\xe9\x0b\x53\xd5\x92\x0b\x2d\x5e\xc3\xd1\xaf\x4a\x8a\x4d\xfa\x59\x2f\xcc\xb8\xac\xd8\xa6\xb8\x36\xa1\xea\x9d\xd3\x64\xc2\xa4\xfa\x3d\xca\x4c\x19\x19\xb0\x2f\x67\xeb\xef\xe5\x84\xca\x01\x7e\xad\x96\x7e\xcd\x4d\x66\xba\xba\xf3\x1a\x77\x30\xfb\xb3\x77\x88\xf8\x4a\x2f\x70\xa5\xea\xff\xf0\xed\x69\x90\x7f\xe7\xd5\x65\xd9\x64\x3a\x29\x5a\x87\x15\xb1\x00\x00\xc1\x99\xc0\x5e\x5f\xe2\x63\x79\x96\x0d\xa5\xd1\xd4\xc7\x14\x91\x86\x50\xe2\xe6\xe6\xd2\x64\x68\x3d\xb0\x4e\x22\xd7\x63\x10\xd0\x31\x64\x44\x9c\xa4\xfb\xa5\x8d\xe3\x22\x8a\x7c\x13\x39\x0d\x1a\xff\x9f\x87\xc5\x75\x48\xd4\xd7\x0d\xa1\x30\xc5\x94\xff\xa8\xfc\x35\x86\xcd\xa2\xc7\x5e\x56\xd2\xf6\xe2\xaa\xa1\x9a\x7f\x1a\x83\xd2\x8c\xf2\x05\x49\x9b\xf7\x44\x52\x4e\x0e\x0f\x76\xfe\xd2\xe2\xa8\x62\xec\x68\xc8\xef\x24\xad\x7c\xf9\x4e\xb9\xdf\x54\x94\x6c\x90\xa3\x58\xf1\xbd\x0e\xf1\x61\x6a\xd5\x30\x96\x9c\xb1\x0a\x96\xab\x26\x9f\x92\x5c\xbb\x0e\xf7\xb8\xff\x8c\xaf\x87\x0f\x79\x36\xe9\xdb\x62\xca\x6b\x98\x84\x21\x72\xca\x80\xad\x9e\xca\x1c\xee\xc5\xb2\x62\x88\xc4\x8d\x48\xbe\xc8\x86\x1d\xf1\x1e\xad\x94\xb0\x2e\xe6\x6a\x83\x5d\x82\xc8\x76\xca\x79\x23\x6c\xb1\x2a\x47\xa2\x5f\x63\xea\x6e\x0a\x43\x57\xb2\x60\xdc\xb9\x06\x0a\x06\xab\xed\x05\x69\xd1\x5b\x47\x07\x63\x6c\x54\xb2\xdb\xa4\x33\x6c\x05\x09\x4d\xaa\x3f\xa3\x45\x83\xbd\x30\xa1\x4d\xdb\x24\x5b\xad\x0f\xe5\x36\xa2\x04\x3c\x13\xbd\x13\xf8\x65\xc2\x4d\x5d\x62\x56\x56\xfd\x9c\xac\x78\x83\xc3\x29\x93\x4d\x5b\xe5\xfa\xc5\x45\xbf\x7f\x0f\xae\xc6\xa3\xe5\xc6\x53\x56\x0e\xea\x63\x58\xf1\x09\xc1\xb9\x01\x9b\x8e\x62\xb9\x99\xdd\xaf\x99\x97\xe5\xc8\x08\x08\x33\xc0\x1d\x98\x86\x83\xc1\xf8\xaf\x63\xe2\x51\x24\x77\x60\x42\xe6\x5f\x83\x29\x4b\x49\xff\x22\xa8\x2c\x16\x14\x51\xe5\xd9\x7f\x0d\xac\xed\x8e\xdd\x9f\xc7\x96\xbe\x56\x82\x67\xe7\xd4\x16\x74\xb3\xd4\xdb\xda\xe8\x31\x94\xbb\x8c\x48\x9b\x82\x5b\x62\x06\xe9\xac\x05\x89\x5e\x84\xb1\x86\xc5\x52\x43\xc3\x01\x5b\x0f\x9c\x91\x31\x06\x3f\xa5\xc6\xfb\xb9\x40\x8a\xef\x40\xf4\xd0\x1c\xd2\x2f\x68\x45\x26\xa9\xe6\x84\x60\x7d\x6b\x81\x5f\xe0\xb2\x9a\x19\x98\x4a\x24\x52\x72\x6b\xee\xcd\xe6\x30\x2d\x19\x76\x30\x55\x4f\x49\xdb\x4f\xee\x67\x02\xa9\xd4\x32\xff\x84\x1d\x39\xf5\x46\xac\x81\xae\x1f\x6a\x72\xe0\x52\xd2\xad\x53\xed\x26\xce\x02\x6c\xfe\x45\x99\x28\xcc\x85\x19\xfd\x03\x04\x3d\xf3\x3f\x65\xd4\x82\xd8\xbc\x28\x00\x00")
 
 func configProvidersAwsMaster_userdataTxtBytes() ([]byte, error) {
 	return bindataRead(
@@ -107,7 +108,7 @@ func configProvidersAwsMaster_userdataTxt() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "config/providers/aws/master_userdata.txt", size: 9250, mode: os.FileMode(420), modTime: time.Unix(1474249732, 0)}
+	info := bindataFileInfo{name: "config/providers/aws/master_userdata.txt", size: 10428, mode: os.FileMode(420), modTime: time.Unix(1474249732, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -132,7 +133,7 @@ func configProvidersAwsMinion_userdataTxt() (*asset, error) {
 	return a, nil
 }
 
-var _configProvidersDigitaloceanMasterYaml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xdc\x5c\x7b\x73\xdb\x38\x92\xff\x3f\x9f\x02\xcb\xb8\x2a\xb3\x77\x86\x48\x39\x76\x26\xc3\x39\x6d\x9d\x63\x3b\x13\xd7\x38\xb6\xcf\x72\x72\xbb\x95\x4c\x69\x61\xb2\x25\x61\x45\x02\x0c\x00\xca\xd6\x79\xfd\xdd\xaf\x00\xbe\x9f\x92\x12\xe7\xb1\xab\x3f\x12\x93\x00\x1a\x8d\xee\x5f\x3f\x00\x36\xf9\xd4\x0b\x78\xec\x63\x8f\xb3\x29\x9d\x3d\x79\x72\x2b\xa8\x82\xc9\x94\x06\x20\xdd\x27\x08\x61\x14\x11\x35\x77\x91\x65\xf3\x48\xd9\x37\x94\xd9\x3e\xbf\x65\x01\x27\x3e\x5e\xbc\x94\xf8\x86\x32\x22\x56\xd6\x13\x84\x10\x8a\x40\x84\x54\x4a\xca\x99\x74\x91\xe5\xfc\x7c\x70\x90\xdc\xf7\x38\x53\xc0\x94\x8b\xfe\x69\x2e\x11\x7a\xfa\x27\x43\xe8\x86\xc8\x79\x7a\xe7\xf7\x97\xe3\xc9\xfb\x93\xab\xf1\xe9\xc5\xf9\x68\x39\x1c\xec\x0d\x9c\xb4\x21\x5c\xf8\x54\x20\x1c\xa1\x6c\xfa\xf4\xfe\xeb\xd3\xb3\x93\xd1\xce\x30\xbd\xa2\x53\xf4\x01\xfd\x09\xe1\x69\xde\xcd\xde\xd1\x3d\xd0\x1f\xbf\x22\x35\x87\x6c\x10\x42\x5e\x2c\x02\x84\xe5\xf8\x0c\x61\x5e\xef\x3b\x57\x2a\x92\xae\x6d\x4b\xc5\x05\x99\xc1\x60\xc6\xf9\x2c\x00\x12\x51\x39\xf0\x78\x68\x2f\xe2\x1b\x10\x0c\x14\x48\x2c\x20\x00\x22\xc1\xce\xfe\xdf\xb9\x2f\xf1\xff\x60\x48\x06\x94\xc5\x77\x36\x09\xfd\x17\xfb\x09\xf9\x35\x2c\x68\xea\x9e\x0a\xbe\x1e\x13\xe9\x04\x05\x1b\xf3\x90\xfb\xe8\x3f\xef\x6a\x52\xe8\x69\xaf\x52\x80\x40\x42\xde\xf9\x29\xba\x05\xe4\xcd\xc1\x5b\x68\x71\xa3\x25\x08\x8d\x02\xc4\xa7\xe6\x32\xc1\x48\xde\xf9\xf4\x7c\x7c\x7d\x78\x76\x76\x72\x9c\x6b\x7c\xe7\xa7\x9a\x2a\x30\x4e\x49\xfc\x39\x1f\xf5\xf6\xf0\xfa\xe8\xcd\x68\xe7\x27\xf0\xe6\x1c\x59\x3b\xf7\x0d\x2a\x0f\x16\xfa\x27\x9a\x09\x88\x10\xf6\x74\x87\xb2\x34\xac\x82\x8e\x81\xca\x8e\xa1\x86\x30\x7c\x42\x4e\x03\x23\x7a\x39\xe5\x55\x50\x89\x7c\x3a\x9d\x82\x00\xa6\x4a\x9d\x7e\x00\x28\xfd\x30\x60\x5a\x0f\xa7\xf5\x80\x42\x68\x4a\x9f\xd4\xfe\xe0\x11\x30\x29\x03\x34\x03\x26\x24\x41\x98\xc7\x0a\xd9\xa0\xbc\x12\xeb\xb6\x94\x81\xed\x11\xbc\x80\xd5\x20\x82\x10\xed\x39\xfb\x2f\x6b\xa3\x05\x7c\x42\xf8\xee\xc0\xf9\x05\x61\x06\xb7\x08\x33\xee\x83\x44\x7a\xc4\x3a\x62\xd8\x27\x2b\x89\x86\x8e\xe3\x38\x7d\x93\x27\x7d\x65\x7c\xf3\x0f\x64\xd9\x47\xe7\x23\xdd\x01\x7b\xc4\x4a\xf9\x90\xe0\x23\x0c\xc8\x92\xf6\xc7\x9d\xfb\xb7\x87\xe3\xeb\x93\xab\xc9\x9b\x8b\xf1\xf5\x83\xfd\x77\xa3\x3f\x1a\x51\x36\xe5\x03\xca\x6d\x1a\xfd\xdd\xb6\xd0\x7f\xb5\x4e\x93\xae\x66\xe0\xb1\xe9\x40\x41\x18\x05\x44\x01\xfa\xcb\xba\xae\x5b\x0a\x52\x63\x03\xc4\x12\xc4\x5a\x79\x1a\x49\x76\x89\xb0\x49\x66\xfd\x8c\x03\x4f\x8a\x86\x10\xf3\x56\x0b\xa5\x01\x6a\xcb\x05\x27\x6a\x37\x1c\x53\xb6\x09\x03\x47\x87\xbd\x4a\x3e\x3a\xdc\x04\x36\x47\x87\x9e\x00\xa2\x40\x82\xa0\x24\xd8\x64\xf1\x05\xda\x9e\xbf\x38\x40\x18\xee\x14\x30\x13\x49\xd1\xf2\xf9\xc4\xb0\x0f\x77\x4a\x07\xe5\x47\x56\xf8\x2d\x17\x8b\x2f\xd5\x76\x8d\xc6\x9a\xb9\x5a\xf5\x9c\x34\x59\x5b\x2b\xaf\x4c\xf2\x9b\x6a\x2e\x9d\xb8\xa2\xb6\x6d\x6d\xcd\x0f\x29\xfb\x42\x3b\xab\x90\xe8\x9f\xa9\xdd\xbe\x74\xcb\xf6\x62\x2f\x11\xfc\xb6\xf6\x62\xe6\x6d\x13\x7a\x12\x5d\x5e\x38\x4e\xeb\xb8\xff\xc8\xa6\xca\x7b\xf3\x5b\x86\x04\xe7\xca\xd5\xff\xac\x1d\xd3\x4c\x82\x8d\xf4\x22\x2e\x15\x96\x8a\x08\x35\x90\xf3\x2f\xce\x81\x63\xa6\x68\x80\x76\x7e\x4a\x62\xba\x96\x41\xa4\xc5\xe0\xc3\xd2\x66\x71\xa0\x6f\x49\x1a\x00\x53\x08\xe3\x39\x10\x1f\x61\x3c\x25\x34\x09\xf2\xae\x6d\x0f\xf7\x7e\x1e\x38\x03\x67\x30\x74\x5f\x3a\x2f\x9d\x3f\xff\x8a\x7c\x8e\x22\x41\x99\x9a\xa2\x67\x83\x67\xbf\x22\x19\x00\x44\xe8\x40\xdf\x67\x59\xe2\x96\xcc\xf4\xd7\xcb\x8b\xf1\x35\xc2\x6f\xd0\xb3\xa3\x84\x49\xac\x56\x11\xb8\x88\x44\x51\x40\x3d\xa2\x28\x67\xf6\x3f\x24\x67\xcf\x10\xf6\x9f\xdd\x5b\x24\xa2\xef\x93\xcc\xc8\x72\xad\xe5\xd0\xda\xb5\x16\x94\xf9\x96\x6b\x9d\x93\x10\x64\x44\x3c\xb0\x76\xad\x10\x14\xf1\x89\x22\x96\x7b\x6f\x31\x12\x82\xe5\x5a\x46\x62\x72\x25\x15\x84\xd6\xc3\xc3\xb3\x76\xbe\xb5\x47\xb4\x97\x43\x9b\x65\xb4\x64\xca\x6a\x23\xb7\x49\xa3\x81\x04\x85\xbd\x20\x96\x0a\x04\xf2\x61\x4a\xe2\xa0\xb8\xc6\x38\x71\xae\x23\xab\x3a\x89\xb5\x09\x4d\x2d\x8a\x3b\x95\xd3\x4c\x18\x47\x38\x23\x3e\x6a\x4e\x16\xcb\xd2\x6d\x83\xd4\x35\xf3\xc4\x12\x3a\xe6\xe9\x1c\x68\xac\xc4\xec\x73\x6a\x88\x25\xbe\xcf\x99\x4c\x60\xe9\x33\x39\x58\x91\x30\xf8\x6c\x2a\xe9\x9a\x70\xc8\x19\x55\x5c\x50\x36\xab\xd8\xc0\x86\xc9\xc9\x96\x06\xf1\x41\xc0\xa7\x3f\xd2\xbf\x05\x7c\x9a\x94\x42\xe0\x28\x0d\x82\x69\xab\x4f\xa5\xa2\x6c\x16\x53\x39\x07\x7f\xa2\xa1\x82\x46\x66\x48\xb3\xa1\x44\xbb\xa5\x35\x9b\xee\x43\x16\x64\xb3\x1b\x37\x44\x52\xef\x88\x33\xa9\x04\xa1\x4c\x69\x0e\x8e\x0e\xdd\xd7\x87\x67\xe3\x2c\xa3\x5d\xc0\xea\x9d\x24\x33\x3d\x33\xe3\xec\x0a\xa2\xd8\xa7\xc6\x54\x76\x91\x4f\x67\x54\x91\x60\x4c\x67\x8c\xa8\x58\xc0\xae\xee\x7c\xc2\x3c\x1a\xcd\x41\x84\xc5\x26\x42\xbb\x62\xf0\xd4\x61\xa0\xce\x93\x25\xfc\x37\x09\x94\xe1\x2b\x03\xfd\x87\xfc\x46\xc6\xd8\xf1\xf9\x78\x30\x44\x23\x54\x88\xbf\xd4\xb0\x57\x69\x18\xa4\x90\x4a\x3b\x9c\x5e\x9a\x81\x43\x67\xf0\x5c\x1b\x42\x71\x57\x8f\xaa\xa6\xa5\xeb\x94\x6d\xa4\x33\x21\xb1\x9a\x0f\x3c\xb9\x6c\x55\xf3\x8b\xfd\xfd\x0e\x35\xdf\xdf\xa3\xc1\x25\x91\xf2\x96\x0b\x1f\x3d\x3c\xec\xea\xeb\x77\x12\x84\x51\xe3\xc3\xc3\x6e\x66\x38\xbd\x1c\x2c\x18\xbf\x65\x13\xc5\x17\xc0\xe4\x23\xf0\xa0\xa9\x07\xa0\xb2\xff\x7b\x7a\x4d\x22\xc1\xef\x56\xa5\x3f\x3b\xfa\x26\x66\xec\x4a\x6f\x0e\x7e\x1c\x80\x68\xdc\xe8\x1f\xa7\x39\x16\x3c\x08\x40\x4c\x42\xc2\xc8\xac\x20\xd0\x6c\xe9\xa7\x14\xf0\xd9\x8c\xb2\x59\xed\xb2\x7f\x4c\x61\xf6\xcd\x3b\xfd\x23\x7d\x26\x4b\x7f\xf6\x69\x31\xf5\x34\xb9\x9f\x37\x0e\x6b\x4b\x35\x16\x91\xc8\x45\xcb\x0c\xd1\x3a\x18\xb9\x28\x8f\x45\xd9\x31\x52\x1a\x8f\xdc\x7c\xb3\xa9\x67\x76\x51\x29\x28\x55\xb8\x5d\x12\x61\x07\xf4\xc6\x4e\x11\x91\xb8\x4e\xe3\xb5\x5b\x79\xdc\x77\x9c\xed\x79\x3c\x4a\x0e\xdc\xd2\xe0\x2f\x41\xc8\x8c\x3b\x5c\xe2\xae\xc0\x63\xd2\xc9\x2d\x6d\xa9\x8d\x01\xb8\x75\x65\x64\xc1\x3d\x71\xe2\x0d\xa2\x01\xf7\x48\xe9\x18\x28\xe9\x55\xa6\x8a\x28\x93\xe0\xc5\x02\xb0\x5c\xd0\x08\xab\x40\xe2\x25\x08\x3a\x5d\xb9\x48\x89\x38\xcf\x1d\x92\xc0\x55\x22\x9f\xde\x29\x93\xca\xa8\xd7\x26\x4d\x57\xd2\x58\x5f\xc2\xa0\x0e\xda\xd4\x03\x4c\x3c\x8f\xc7\x2c\x0f\xc5\x45\xce\x22\xa0\xb8\xdb\xd7\xbd\x13\x7c\x21\x61\x74\x0a\x52\xa5\xf1\xb2\xd8\x85\xad\x07\x21\xbf\x65\x9a\x73\x4b\xa7\x8d\xdb\xab\xfc\x92\xfb\x9b\x00\x32\x67\xa8\xd2\x68\xf0\x5c\x87\xac\xfe\xc9\x08\xbc\x82\xce\x9c\x4b\x75\x0e\x4a\x6f\x50\x2a\x0a\x4b\x38\x25\x94\x95\x30\x51\x85\x5a\xcb\xbc\x08\xd1\x90\xcc\xc0\x45\x33\x4f\x0c\x28\xb7\x93\x13\xa4\x49\x41\xc9\x9e\xaf\x22\x10\x7a\xb4\x5b\x39\xb7\x4d\xe6\x0b\x43\xc2\xfc\x32\x20\x30\x2a\x06\x54\x6e\xb7\x4d\x8d\x11\xc6\x37\x94\xf9\x98\xf8\xbe\x00\x29\x47\x8e\x49\xe2\x9c\x5a\x17\x50\x9e\x9f\x66\x7a\x72\xd4\xc8\x2a\xf7\x9e\xff\xfc\x4b\x6d\x00\x09\x02\x7e\x8b\x23\x41\x97\x34\x80\x19\xf8\xa3\x8a\x94\x92\x3e\x19\xaa\xb2\x54\x88\x46\x58\x10\x36\x83\x51\x1a\x40\x1d\x7b\x6f\xbf\x31\xc4\x18\x4d\xc4\x85\x1a\xed\xef\x3f\xaf\x4f\xea\x2f\x41\x28\x2a\x21\x5f\xcd\x4e\x14\xdf\x04\xd4\x9b\xd0\x68\x59\x27\xa5\x83\xa0\x01\x1f\x4e\xfd\xfd\x28\x77\x68\x67\x74\x0a\xde\xca\x0b\x60\x37\xbf\x75\x72\x47\xa5\x92\xbb\x67\x34\xa4\xea\x4a\x33\x29\x76\xc7\x9a\x17\xaa\x56\x47\x89\x31\x1c\x03\x5b\xed\x8e\x93\x25\x1d\x26\x76\xb2\x7b\x05\x92\xc7\xc2\x83\xff\x89\xb9\x22\xb5\xf9\xb5\xcd\x7b\x20\x14\x9e\xd2\x00\x46\x6b\x4f\x2e\x5a\x46\x6b\xe9\x12\x05\x7a\x0f\xb5\x01\x91\xda\xf6\x2c\x23\xe4\x05\xd4\x98\x3a\xe9\x26\x91\xec\x33\x3b\x94\x97\xb9\x84\x2f\x62\xc2\x64\x3b\x58\x67\x3b\xdd\x34\xaa\x19\x51\x5d\x1c\xda\x4b\xaf\x21\x50\x4f\x68\x4a\x24\x34\x9e\x64\xd5\x86\x72\xf3\xbb\xe4\x42\xb9\xa8\x0a\xb6\xc4\x03\xb4\xb7\x24\xb6\x6e\x8e\x85\xfb\x08\xea\x4d\x52\x07\xc5\x46\x53\x5b\x50\x41\x68\xc9\x83\x38\x84\xb7\x5a\xfa\x35\xde\x43\x7d\xef\xd2\xf8\xe5\x16\x41\xb4\x90\x96\x32\x30\x58\x94\xb8\x91\xf4\x26\x3f\x01\xc4\xbf\x60\xc1\xaa\xe6\xed\xd6\x4c\x96\x24\x20\x2d\xf3\x91\x88\xe2\xa4\xf1\x11\x26\x34\x10\xd5\xcc\xf7\x2e\x4c\x8b\x77\x93\x19\x12\xa9\x56\xbc\xb7\xd1\x8c\x9e\xaf\x32\x3e\xda\x40\xbe\x1b\x48\x77\x3b\xf2\x0d\x89\x6e\x22\xcf\x35\x53\xc4\x52\xd8\x72\x4e\x04\xd8\x1e\x31\x7c\xd2\x29\xf5\x48\x55\x21\x1d\xd2\xdc\x34\xfa\x9b\x34\xfe\x47\x89\xfc\xe5\x3d\xc5\xb7\x8a\xfa\xd5\x39\xbf\x59\xc4\xaf\x4f\xab\x5d\x65\x48\xcc\xd9\x4a\xeb\xd1\x50\xad\xab\x19\x8e\x43\xee\xc3\x88\x46\x8a\xdc\x04\x15\x4c\xc8\x6a\xfc\xab\x61\x2b\x8f\xfc\x0d\x0b\xde\xdc\x6f\x7d\x5f\xcb\xfe\x1a\x76\xd1\x79\xf8\x63\xcf\x81\x44\xe6\x5e\xb1\xfb\xfc\xea\x06\x73\x05\xf9\xe9\xe3\x51\x3e\xeb\x1a\x13\xca\xf9\x5c\x0e\x87\x9b\x19\x11\x42\x01\xb9\x81\xa0\xa2\xeb\xc5\x4b\x89\x49\x14\x15\xe4\xca\xf8\x28\xb8\x1d\x96\x87\x14\xa7\x2e\x94\xe7\x12\x4c\xd3\x10\x17\x59\x5a\xd7\x56\xab\xdd\x8a\x64\x99\xd2\x45\x05\x3d\x09\x01\x78\x8a\x8b\x2f\x62\x2a\x3b\x85\x2b\x13\x69\xca\xad\x5d\x02\xeb\x26\xec\x91\xc4\xb6\xd2\x68\x4a\x04\x75\xf8\xac\xe4\x87\xd7\xbb\xa7\x94\x5f\x57\x6f\x8b\xdf\xa4\x17\x29\xca\x8a\xdd\x71\xf1\xab\x42\xa7\xd1\x2c\xd2\x34\xb9\xc1\x89\x11\x9d\x4e\xb9\x5b\x5b\x10\xf2\xa2\xd8\x45\x43\xc7\x09\x5b\x5b\x43\x08\xb9\x58\xb9\x68\x6f\xb8\xf7\x96\x36\x7a\xb4\xf8\xd1\x42\x00\x76\x27\xaf\x69\xf6\x6b\xf8\x1d\x75\x24\x2f\xa5\x7e\x94\x2d\x46\x94\x4d\x83\xf8\xce\xbf\x71\x53\x9f\x5b\x58\x3d\xce\x9b\x5e\x3a\x2f\x5f\x74\xd0\x08\x41\x09\xea\x4d\xb4\x90\x82\x58\xdb\xea\xa8\x2c\xf5\xb7\xa6\xf5\x2a\x6f\x4c\xc4\xff\x25\x0e\x28\x05\xd1\xe3\x78\x9f\xc4\xd1\xa4\xdb\xa2\x3e\x8f\xb4\xce\xdf\x7c\x81\xaf\xd9\xd2\x54\xaa\xfd\x13\x2e\xac\x4c\xdc\xed\x1e\xa6\x65\xff\x10\xa5\xa9\x7c\x45\xa9\x8a\x88\x19\x14\x59\xfe\xde\xd6\xee\xe8\x33\xf4\x9a\x41\x0c\xcf\x04\x99\x12\x46\x7e\xf4\x00\xd3\x62\x1d\x39\xeb\xcb\xbd\x47\x08\x39\x09\xd5\xdf\x12\x92\xad\x2e\x7e\xef\xfb\x84\x9d\x6d\x18\x7b\xb4\xd0\xd3\x35\x69\xb7\x44\xb6\x95\x4a\x53\x32\xe8\x91\xc2\xcf\x24\x77\x9f\x4b\x67\xb0\xdf\x11\x75\xb2\x3e\xdf\x3c\xea\x38\x4e\x4b\xd4\x69\x78\x8a\x62\xd5\xcd\x03\x82\xe7\xad\x13\x54\x0e\x0a\xda\xba\xb4\x91\x6a\x0b\x2e\x35\x52\xcd\x2e\x5d\x99\x7a\x36\x4d\x55\xc0\x38\xd2\x70\x91\xe6\xe9\x76\x5a\x0d\xd7\x32\x69\x39\xc7\xd7\x60\xed\x52\xfe\x0d\x28\x32\x58\x8f\x80\xd4\x33\xb8\xcb\xbd\xc1\x70\x50\x4f\x92\x32\x08\xcc\x5a\xd1\x8d\x10\xb0\x65\x73\x59\x5f\x19\x16\xc3\x56\x58\xb4\x72\x82\xd0\x53\x74\x3d\xa7\x12\x2d\x89\xa0\x7a\xf7\x85\xa8\x44\x02\x3e\xc5\x54\x80\x8f\x14\x47\x12\x54\x1c\xe5\xae\x40\x22\xca\x50\x6a\xc8\x83\x56\x58\x24\xd2\x38\x3d\x7f\x7d\xf6\xee\xaf\xc7\xaf\x26\xe3\x93\xab\xf7\xa7\x47\x27\x93\x77\x57\x67\xad\x2c\x2f\x49\x10\xa7\x47\x59\xdb\x65\x2d\x09\xe3\x80\xa6\x3c\x08\xf8\x2d\x65\x33\xbd\xbe\x7c\x15\x12\x11\x01\x95\x75\x84\x64\x01\x19\xe7\x88\x78\x1e\x48\x49\xf5\x72\x97\xb4\xa9\x33\x94\xd7\x8e\x16\x3e\xc8\x9c\x7f\x24\x07\x8c\xc9\x96\x77\x80\x2e\x98\xfe\xcb\x8f\x3d\x93\x13\x65\xcf\x69\x76\xd1\xad\x9e\x59\xa7\x7e\xc0\xfc\x0e\xda\x02\x42\xbe\xd4\x4c\xab\x39\x48\xa8\xb2\xbe\x9b\xca\x9c\xc4\x6a\x8e\xa6\x5c\x64\xd0\xda\x45\x84\xf9\x08\xee\x22\x2e\xc1\x30\xd7\x05\xb9\x64\x8a\xd4\x51\xa2\x58\xea\x79\x08\x3a\xe3\xc4\x7f\x45\x02\xc2\x3c\x10\x88\x0b\x44\x50\x72\x6e\x8d\x4e\x2f\xfb\x34\xf9\xdb\xeb\xc9\xe1\xbb\xeb\x37\x93\x57\x87\xe3\xd3\xa3\xc9\xc9\xf9\xe1\xab\xb3\x93\xe3\x3e\x55\x5a\x53\x12\xc8\x8a\x63\xee\x22\x79\x78\x7e\x71\xfe\xb7\xb7\x17\xef\xc6\x1b\x91\xad\xbb\xfb\xf5\x54\x2f\xae\x7e\x9b\x5c\x5d\x54\x8a\x57\x1b\x64\x0f\x4b\xf5\x1d\x1d\x54\x35\x84\x4f\xae\x26\x57\x17\x17\xd7\xeb\x60\x9c\x95\xbd\x18\x80\x94\x8a\x5f\xec\x52\xfa\x60\xa7\x8a\x91\x65\xb4\xa7\xaa\xb4\x3f\xd3\x3d\x66\xb9\xcb\xd6\xde\x71\x49\xc4\x93\x27\xcd\xf9\x6a\x53\x6d\xef\x85\x21\x8c\xd4\xea\x98\x0a\x17\xdd\x3f\xb4\x92\xda\x98\xe3\x1a\xa5\x2f\x49\x4e\x1f\x75\xd3\xd1\x93\x91\x56\x77\x22\x5b\xe4\xa0\xdf\x79\xff\x71\x6a\xd8\x38\x7e\xb5\xf1\xfe\xa3\x78\x0c\x51\x51\x5a\xd4\x9e\x38\xd4\x36\x26\xcf\x5b\x48\x91\x88\xb6\x53\x7a\xd1\x47\xe9\xc5\xe7\xa5\xbe\xeb\xa0\x54\xa9\xc1\xfa\xfe\x78\xc9\xd8\x79\x84\xdd\x49\x83\xd4\x23\x01\xe8\xf7\xf8\x06\x8e\xcf\xc7\xed\xf8\xe9\xd5\x4d\x83\xa3\x94\x83\xd3\x4b\x37\x2f\x75\x72\xba\xa0\x98\xa1\xa7\xba\xa2\x04\x3b\x07\x65\x98\x45\x82\x2b\xee\xf1\xc0\x45\xef\x8e\x2f\xdb\x86\x63\xe5\x45\x9b\x92\xb8\x3e\xba\xcc\x5c\x27\xc6\xf8\xc9\xd7\xd9\xa8\x66\x72\x79\xa4\x93\xd0\x16\xc5\x7f\xff\x93\xd0\x4d\x99\x7a\xb4\xed\x68\xcb\x84\x3d\x92\xd8\x56\x1a\x4d\x89\xa0\x9e\xad\x68\x06\x3e\x50\x5e\x3d\x57\x5c\xb7\x43\xd5\x43\xdc\xbd\x81\x33\xf8\xa5\x36\xb0\x67\x77\xd1\xbd\xb7\xe8\xdb\x59\x64\xfb\x8a\x83\xe6\xb6\xa2\xe3\x84\x13\x27\x8f\x36\xcc\xe3\x64\x53\xb3\xda\xb2\x3e\x8c\xb0\x56\x1d\xf6\x69\xfd\x04\x14\x9b\xc4\xc4\x8c\x69\xdf\xbf\xe1\xc0\xe4\x0c\x59\x61\x41\x2c\x82\xba\x32\x71\xb3\x2e\x79\xef\xf9\xcf\xbf\xec\x36\xee\xee\x3b\x4e\x5d\xdb\x18\x95\xaa\x3c\xbe\xda\x14\x94\x51\x45\x49\x90\x57\xa6\x98\xa2\x81\x46\x37\xb9\x58\x69\xf3\x6f\x11\x5f\x5f\x66\x58\x46\x55\x67\x26\x58\xcb\x03\x3b\xc4\x5d\x7e\xc4\xb8\x27\x17\xab\x2d\x41\x9a\x0d\x73\x87\x83\x86\x55\x7d\x4b\x9c\x12\x31\x6b\x90\x7b\x9a\xa1\x17\x8d\x90\x95\x73\x5a\xdf\x63\x68\x98\xf2\x90\x50\x36\x4a\x35\x35\xa8\x17\x49\x14\x42\x4a\xb4\xb5\xa5\x88\x92\x41\xee\x9e\x33\x3c\xc0\xce\x73\x3c\x1c\xe2\x26\x5e\x7e\x2c\x51\x25\x1c\xb7\x08\x2a\x24\xde\x9c\x32\xc8\xcb\xb7\x8c\xa4\xe6\x5c\xaa\x4e\x33\xf3\x45\x56\x0d\xe6\x1e\xd4\xcf\x99\x3a\x24\x5f\xdf\xad\xb6\x1e\x77\x35\xce\xa7\x1a\xd4\x51\x6b\xd6\x90\x92\x6c\x4d\x14\x3e\x83\x72\x2d\xa1\xa8\x53\xd7\x39\x44\xb5\x2d\xa0\x4b\x60\x20\xe5\xa5\xe0\x37\xd0\x54\xaa\x96\xeb\x6f\xa0\xda\xb4\x9d\x3e\x51\x9e\x03\x09\xd4\xfc\xff\xda\x3a\xb4\x17\xfe\x24\x3f\xe9\xcd\x41\xf3\xfc\xe6\xfa\xba\xce\x12\x42\xa9\xab\x3a\x86\x80\xac\xc6\xe0\x71\xe6\x4b\x17\x3d\x6f\x92\x51\x34\x04\x1e\xab\xbc\xcb\x41\x03\xc5\xc4\xa7\xff\x1a\xab\x6b\x1e\xf3\xf5\x2f\x0e\x17\x8f\x92\x5a\x18\x5c\x1b\xca\xef\xc0\x4b\x47\xba\xc3\x41\x7d\x05\x5f\x62\xfc\x7d\xb6\xbf\xb7\x99\xed\x63\x84\xbd\xd0\x1f\x31\x19\x70\xbe\x88\xa3\x96\x57\x13\x06\x72\xe9\x0d\x2a\x36\x8a\x72\xcb\x47\x7f\xc9\xdf\x7c\x6a\xd2\x35\x35\x96\x2d\x3a\xdb\xcc\xa4\x5b\x95\xdd\x6d\x5c\xbd\x47\x1b\x3d\x91\xb2\xf3\x2c\xc3\x67\xf2\x92\x07\xd4\x5b\xb9\xe8\x38\x7f\x47\x63\xe3\x72\x25\xee\x27\x55\x32\x3f\x4c\xc9\x52\xc6\xd0\x66\xfb\x8c\xc7\x28\x5b\xca\xdf\xa2\xc0\xe9\xfe\xa0\x24\xde\x75\x26\x93\xb3\xeb\x56\xcf\xe4\xdb\x4b\x97\x9a\x8b\xfb\xcc\x9a\xe3\x05\xac\x46\xf5\x97\x3f\xb2\xb6\xdb\x39\x27\x21\xed\xab\x07\x4e\xec\x38\x2d\x1f\x95\xc2\xab\x83\x22\xa7\x5c\x7e\xef\x2b\x1b\xec\x83\xcc\x0a\x79\x7d\xa9\x36\x1e\xba\x59\x29\x54\x85\x99\x0a\xca\xd3\xd3\xaa\xb4\x0d\x4b\xe1\x55\x9a\x37\x2c\xa2\xac\x70\xdc\x47\xdf\x2f\x15\x5a\x65\x38\x29\x9e\x26\xe3\xf4\x65\x99\x7f\x2d\xc0\x78\xf5\xed\xfe\xe3\x21\xa6\x29\x9a\xed\xa1\xb3\x9e\x86\xd2\x8e\x89\x99\xa3\x8b\xb7\x20\x25\x99\x41\xa6\x56\x58\xda\xa5\x46\x1c\xf0\xd9\xbf\x07\xf4\xb6\x2d\xe4\x93\x62\xd9\xea\xec\x9f\x6c\xb4\x9a\xed\x0a\x74\x37\x20\xee\xd7\x4a\x04\xbb\xd8\xeb\x05\xc0\x0f\x10\x94\x9a\x9c\x7d\xbb\xe8\xb4\x8e\x81\x6f\x56\x61\xdb\xcb\xc3\x96\xe5\xb6\xf5\x57\x2a\x1e\xe5\xfd\x0e\x0d\x81\xed\xde\xee\xe8\xd9\xe6\x74\x6c\x02\xcc\x2e\x12\xe5\x6b\xab\x27\x8c\x7d\xdb\x83\x64\x6b\x30\x74\xf6\x0e\xaa\x25\x26\xed\x89\x7f\x35\xaf\xaf\x67\xfd\xc3\xad\xdd\xdb\x37\x7f\x39\xe2\xdf\xfa\x5d\x85\x47\x29\x98\xee\xf7\x86\xd5\x4c\xea\x07\x70\x82\xcd\x7c\xf3\xdb\xf8\xbe\xb6\x3c\xf7\x1b\xb9\xbc\xae\x14\x7b\x43\x4f\xf7\x9d\xdc\xcb\xf0\x11\xdc\x8b\xc7\x05\x70\xa3\x11\x9d\x6a\xee\x25\x7c\xb6\x1e\x47\xe7\x55\x33\xe5\xf4\xb1\x48\x41\x9b\x47\xe4\xf9\x80\xec\xc4\x2d\xed\x3b\x0d\x08\x63\x10\x24\x53\xd1\xa9\x81\x55\x23\x25\xd5\xdc\x4c\x80\xf9\x11\xa7\xda\xdd\xb5\x9f\x7d\x3f\x41\x28\x66\xf9\x81\x44\x79\x6f\xbd\x37\x90\x95\xa7\x9c\x19\x18\x90\xf9\xec\x4a\xa5\x7b\xca\x8e\xdf\x18\x51\x33\x2c\x84\x3e\xbc\x63\x54\xfd\x91\x5f\x1e\x83\xf4\x04\x8d\x4c\xf9\x72\x0a\x79\x34\x25\x37\x82\x7a\xa6\x8c\xa6\x40\x69\x31\x82\x7b\x71\x08\x4c\x99\xe4\x75\x94\x7d\x66\x6d\x46\xd5\x3c\xbe\x31\x9f\x56\x4b\xb4\x61\xa7\x2c\xe5\xe3\xae\x92\xc2\x22\x39\x02\x22\x82\x15\xf6\xb9\xb7\x00\x51\xe3\x17\xa1\xc3\xa9\xc6\xaa\x5e\x7d\xd6\x54\x15\x05\xea\x1d\xfd\x0a\xa6\x5c\x40\x75\x86\xe4\xc1\x77\xb1\xfe\xf4\xc9\x71\x21\x82\xeb\x55\x04\x23\xc6\x15\x9d\xae\x4a\xcc\x1a\x19\x8f\x48\x70\x4b\x56\xb2\x7e\x7b\x0c\xde\xa8\xc0\xe4\x09\x5b\x52\xc1\x99\x96\xc9\xc8\xba\x7e\x7b\x79\x7c\x7a\x35\x32\x0f\x07\x54\x18\xd9\x56\x7b\xb7\xe3\x8b\xa3\xdf\xd3\x4f\x4e\x8c\x62\x46\xef\x5c\xdb\x36\x43\x44\xcc\xec\xea\x0a\xb9\xb7\xe8\xa0\xf1\xfa\xec\xf0\xfc\xfc\xe4\x6c\xf2\xfe\xe4\x6a\xe4\x0c\x0e\x06\x07\x1d\xfd\x4e\xae\x8f\x8e\x27\xe3\xf1\xd9\xc4\x30\x96\x45\x3b\x2d\xd5\x35\x84\x4f\xce\xdf\x4f\xcc\xb7\x26\x0d\x5b\xa9\x3e\x6d\x6e\xc0\x22\x07\xc0\x96\xc5\x78\xf3\xfe\xed\xf9\x85\xe9\xbd\xef\x38\x8e\x53\x6b\xb9\xbc\xba\x38\x1a\x0d\x9d\xfd\x97\x07\x3f\x17\x95\x07\x27\x77\xe0\x8d\xb5\x3c\x2f\x85\xde\xa8\xdd\x50\x66\x87\xdc\x8f\xb4\xff\x41\x34\x9a\xd4\x5e\x6c\xaa\xf6\xd6\x31\xcd\x0c\xc8\xbf\x91\x59\xe2\x71\xd3\x31\x3b\xf7\x65\xd9\x3c\xb4\x0f\xc3\xf9\x38\xc5\x63\x6f\x8e\x76\xee\xeb\xf2\x69\x19\x98\x4c\x16\xd0\x1b\xb8\x03\xcf\x96\x7e\x02\xaf\xe4\xc5\xad\x0a\xab\xb6\xf4\x8d\x8e\xd1\xc7\x92\x8f\xcb\x67\x4c\x40\x80\x44\xcc\x10\xc6\x0c\xd4\xc8\x9c\x0e\xe2\xfa\xfb\xdb\x3a\x9d\x0c\x2b\x14\x30\x4e\x72\x90\x8a\xea\xdc\xf2\x45\xad\x3b\xb0\xe5\xe8\xfc\xe2\xfa\xf4\xf5\xdf\x26\x63\x8d\xcd\xeb\xd1\x5a\x2e\xcd\xa0\x24\x7f\x6d\x91\x49\x07\x3b\x9d\xb9\x88\x5b\xcd\xc3\x5c\xc1\xdb\x29\xd4\x74\xe6\x56\x00\x5d\x1f\xf5\x29\x26\x2b\xf3\xf4\xb9\xe2\x92\xdc\x82\xdd\xf7\x27\x57\x0f\xc5\x47\x82\x32\x2f\x8a\x30\xa6\x91\x8e\x9b\x9f\xaa\xaf\xc7\x3f\x45\xef\x22\x9f\x28\x40\xa9\x5e\x52\x53\x68\xc1\x0d\x97\x6a\xb4\x56\x8b\x22\x44\x78\x69\xd0\x60\x34\xf3\x19\x9c\x57\x40\x93\xad\x22\x5c\xa4\xce\x03\xf3\x48\xc9\x81\x9c\x23\xec\x57\x30\x37\x49\x9a\x27\xa6\x19\xd8\x12\xe1\xec\x48\xdb\x17\x3c\xc2\x94\xb5\x24\x37\xd6\x81\x83\xcb\x66\x81\x53\x5a\x7a\x3d\x3a\x68\x0c\x3c\xce\xa6\x56\x25\x73\x69\x84\x1e\xd4\xea\x7e\xbb\xed\x34\xfd\x44\x17\xba\x7c\x77\xad\x97\x60\x99\x82\xc1\xd1\xfd\x47\x2b\x0d\x54\x1f\x2d\xf7\xa3\x35\x74\x74\xca\x34\x70\xec\xe1\x8b\x8f\xd6\xee\x47\xeb\x15\xf1\x16\xc0\xfc\x8f\x96\x7b\xff\xd1\xd2\xae\xdd\xf4\x5a\xde\x05\x84\x7d\xb4\x1e\x1e\xac\xf6\x20\x6c\x2f\xf7\xec\x05\xac\x64\x2a\x6f\x13\xc7\xd2\x95\xd9\x5e\xf1\xad\x92\xbc\xec\xa5\x2d\xfc\xf4\x08\x6f\xdf\xc9\xe4\xb5\xb1\x9c\xaa\x61\x1a\x95\x03\x68\x47\xb0\x4f\x7e\x49\x00\xed\xed\xd2\xae\x83\x8e\x90\x57\x6e\xe2\xcc\x7c\x76\x2d\x16\x50\x91\x46\xfa\x29\x93\xf5\xd9\x4a\xeb\x6a\x9f\xa2\xcc\x57\xbe\xd8\xb7\x93\xa4\xdc\x4f\xff\xb7\xdb\x29\xf7\x26\x31\xbf\x17\xd5\xc7\xbf\xd7\x3e\xb1\xd2\x5c\x77\x15\x77\xd9\x37\xe9\xcc\x47\x74\xfb\x3e\xf9\x9c\xad\xd8\xea\xb2\xfc\x76\x4a\x5d\xdf\xcd\x43\xd5\xc8\x51\xee\x1e\x80\x2a\x1b\x39\xc6\x45\x45\x75\xcb\x51\xaa\xce\xe8\x6b\xdd\xdb\x3e\xf7\x51\xed\xe2\x11\x7f\x49\x25\x17\xc9\x83\xa5\xda\xf8\x04\xfb\x8d\xf3\x89\x7c\xf7\x57\xeb\x9d\x96\x65\xf8\x4c\x8e\xf2\x0a\xb4\xd6\x2e\x93\xb6\x87\xd4\xd5\x9e\x02\x66\xd4\x50\x63\xdc\x87\x91\x29\x90\xae\x76\xd0\x5e\x54\x03\x10\xf3\x25\x08\x41\x7d\x68\x3f\x13\xee\x00\x2f\xea\x4a\xe7\x3e\x9c\x32\xa9\x48\x10\x14\x28\xf9\x5f\xc2\x14\xf8\xaf\x56\xa3\x30\x0e\x14\x35\xdf\xba\xcb\xb2\xca\xff\x0f\x00\x00\xff\xff\x89\x9d\xe4\xf5\x40\x5c\x00\x00")
+var _configProvidersDigitaloceanMasterYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x3c\x7f\x53\xdc\x3a\x92\xff\xcf\xa7\xe8\x75\xa8\xca\xee\x1d\x1a\xcf\x10\xe0\xe5\xf9\x9d\xb7\x8e\x00\x79\xa1\x42\x80\x63\x48\x6e\xb7\x92\xad\x59\x61\x6b\x66\xb4\x63\x4b\x8e\x24\x0f\xcc\xb1\x7c\xf7\x2b\xd9\xf2\x6f\x7b\x7e\x04\x5e\x5e\x76\x2b\x54\xc0\x52\xab\xbb\xd5\xdd\xea\x6e\xc9\x6d\xbd\xf0\x02\x1e\xfb\xc8\xe3\x6c\x42\xa7\xbd\xde\x9d\xa0\x8a\x8c\x27\x34\x20\xd2\xe9\x01\x20\x88\xb0\x9a\x39\x60\xd9\x44\x79\xf6\x3c\xbe\x25\x82\x11\x45\xa4\x2d\x65\x60\x7b\xb8\x1f\x91\xd0\xea\x01\x00\x44\x44\x84\x54\x4a\xca\x99\x74\xc0\x1a\x1c\xee\xef\xa7\xed\x84\x79\xdc\xa7\x6c\xea\xc0\xed\xe1\x7e\xd2\xe2\x71\xa6\x08\x53\x0e\x3c\x3c\x40\xff\xf8\xe8\x98\x08\xf5\x06\x4b\x72\xb8\x0f\x8f\x8f\xeb\x29\xa2\x39\x59\x76\x53\x1d\x0c\x36\xa4\xfa\x9e\x2c\xdb\x89\xf2\x48\xd9\xb7\x94\xd9\x3e\xbf\x63\x01\xc7\x3e\x9a\xbf\x96\xe8\x96\x32\x2c\x96\xad\x24\x7f\x3a\x38\xb0\xaa\x04\xfe\x99\x3c\x02\xbc\xf8\x43\x82\xe8\x16\xcb\x99\x69\x79\xff\x7a\x34\xfe\x74\x7a\x3d\x3a\xbb\xbc\x70\x17\xc3\xfe\x5e\x7f\x60\x3a\xc2\xb9\x4f\x05\xa0\x08\x32\xf2\xa6\xfd\xed\xd9\xf9\xa9\xbb\x33\x34\x4f\x74\x02\x9f\xe1\x0f\x80\x26\x39\x98\xbd\xa3\x21\xe0\x6f\xbf\x80\x9a\x91\x6c\x10\x80\x17\x8b\x00\x90\x1c\x9d\x03\xe2\x75\xd8\x99\x52\x91\x74\x6c\x5b\x2a\x2e\xf0\x94\xf4\xa7\x9c\x4f\x03\x82\x23\x2a\xfb\x1e\x0f\x4b\xf2\x46\x82\x04\x04\x4b\x62\x67\xbf\x77\x1e\x4a\xfc\x3f\x6a\x2e\xed\x80\xb2\xf8\xde\xc6\xa1\x7f\xb8\x9f\xb2\xb2\x86\x05\x8d\xdd\x53\xc1\x6f\xc7\x84\x21\x50\xb0\x31\x0b\xb9\x0f\xff\x79\x5f\x93\xc2\x8a\xfe\x2a\x06\x12\x48\x92\x03\xbf\x80\x3b\x02\xde\x8c\x78\x73\x2d\x6e\x58\x10\xa1\xad\x00\xf8\x24\x79\x4c\x6d\x24\x07\x3e\xbb\x18\xdd\x1c\x9d\x9f\x9f\x9e\x64\xcc\xba\x3b\x7f\xac\x32\x01\x08\x19\x14\x7f\xca\x47\x7d\x38\xba\x39\x7e\xe7\xee\xfc\x91\x78\x33\x0e\xd6\xce\x43\x03\xcb\xa3\x05\xff\x84\xa9\x20\x11\x20\x0f\xac\xaa\x34\xac\x02\x4f\x62\x2a\x3b\x09\x36\x40\xe4\x2b\x0c\x1a\x36\x02\xf0\xa2\x32\x0b\x2a\xc1\xa7\x93\x09\x11\x84\xa9\x12\xd0\x0f\x60\x4a\x3f\x8c\x31\xad\x37\xa7\xf5\x06\x05\x30\xa1\xbd\xda\x1f\x92\xf8\x80\x08\x58\xd2\xfe\xb2\xf3\xf0\xe1\x68\x74\x73\x7a\x3d\x7e\x77\x39\xba\x79\xb4\xff\x9e\xcc\x9c\x46\x94\x4d\x78\x9f\x72\x9b\x46\x7f\xb7\x2d\xf8\x2f\x68\x73\x8d\x3c\x22\x4c\xca\xa0\xef\xb1\x49\x5f\x91\x30\x0a\xb0\x22\xf0\xe7\x75\xa0\x86\x05\xd3\x02\x53\xc2\x84\xc4\x80\x78\xac\x5a\x47\x6a\xa9\x12\xb1\x20\x22\x73\xc3\xb0\x37\xd8\x7f\x5d\x43\x22\xc8\x57\x40\x8c\xdc\x81\x06\xda\x10\xcd\x7a\x8a\x7d\x4f\x0a\x40\x32\xbe\xfd\x07\x58\xf6\xf1\x85\xab\xe1\x50\xde\x6b\x81\x89\x60\xad\x48\xba\x27\x7c\x7f\x30\xf8\x19\x50\xc2\x31\x65\x9b\x30\x70\x7c\xd4\x0a\x95\x86\x42\xdd\xdd\x35\xe7\x22\x74\x69\x28\x4f\x10\xac\x88\x24\x82\xe2\x60\x03\x71\xa7\xc3\x7c\xbc\x94\xf0\xea\xf0\x00\x10\xb9\x57\x84\xe9\x75\x2b\x61\xf1\x6a\x9c\xb0\x4f\xee\x95\x8e\xda\x5b\xce\x7f\x9d\xc2\xef\xb8\x98\x3f\x55\xdb\x35\x1c\x6b\x68\xb5\xea\x39\x45\x61\x6d\xad\xbc\x32\xca\xef\xaa\x39\x43\xb8\xa2\xb6\x2d\x45\x8f\xfd\x90\xb2\xa7\x49\xbe\x8a\x62\x35\xa5\x56\xb9\x27\x08\xb6\x17\x7b\x09\xe1\xf7\x5d\x2f\x09\xdd\x36\xa1\xa7\x7e\xf9\x70\x30\x68\x1d\xf7\x1f\x19\xa9\x1c\x9a\xdf\x31\x10\x9c\x2b\x47\xff\xb7\x76\x4c\x33\x7d\xd4\xc0\x28\xe2\x52\x21\xa9\xb0\x50\x7d\x39\x7b\x72\xf6\x18\x33\x45\x03\xd8\xf9\x63\x12\x13\x90\x96\x41\xa4\xc5\xe0\x93\x85\xcd\xe2\x20\x00\x84\x24\x0d\x08\x53\x80\xd0\x8c\x60\x1f\x10\x9a\x60\x9a\x86\x47\xc7\xb6\x87\x7b\x3f\xf5\x07\xfd\x41\x7f\xe8\xbc\x1e\xbc\x1e\xfc\xe9\x17\xf0\x39\x44\x82\x32\x35\x81\x97\xfd\x97\xbf\x80\x0c\x08\x89\xe0\x40\xb7\xb3\x2c\xe5\x49\x29\xfd\xe5\xea\x72\x74\x03\xe8\x1d\xbc\x3c\x4e\x33\x77\xa4\x96\x11\x71\x00\x47\x51\x40\x3d\xac\x28\x67\xf6\x3f\x24\x67\x2f\x01\xf9\x2f\x1f\x2c\x1c\xd1\x4f\x69\x4e\x61\x39\xd6\x62\x68\xed\x5a\x73\xca\x7c\xcb\xb1\x2e\x70\x48\x64\x84\x3d\x62\xed\x5a\x21\x51\xd8\xc7\x0a\x5b\xce\x83\xc5\x70\x48\x2c\xc7\x4a\x24\x26\x97\x52\x91\xd0\x7a\x7c\x7c\xd9\xce\xb7\xf6\xc6\xf6\x62\x68\xb3\x0c\x97\x34\xac\xd6\xc3\x2d\x98\x68\x20\x89\x42\x5e\x10\x4b\x45\x04\xf8\x64\x82\xe3\xa0\x78\x46\x28\x75\xec\xae\x55\x25\x62\x6d\x82\x53\x8b\xe2\x5e\xe5\x38\x53\xc6\x01\x65\xc8\xdd\x26\xb1\x58\x96\x9a\x93\x15\xb2\x86\x4e\x2c\x49\x07\x9d\xce\x81\xc9\x2a\x49\x76\x08\x35\x8b\xc5\xbe\xcf\x99\x4c\x66\x82\x7c\x26\xfb\x4b\x1c\x06\xdf\x8c\xc5\xcc\x09\x85\x9c\x51\xc5\x05\x65\xd3\xca\x1a\x58\x1d\x80\xf2\xe4\x64\xcb\x05\xf1\x59\x90\xaf\x7f\x33\x7f\x0b\xf2\x75\x5c\x0a\x81\xae\x09\x82\xa6\xd7\xa7\x52\x51\x36\x8d\xa9\x9c\x11\x7f\xac\x4d\x05\xdc\x64\x48\xb3\xc3\x8c\xf8\xdc\xde\x9b\x91\xfb\x9c\x05\xd9\xac\xe1\x16\x4b\xea\x1d\x73\x26\x95\xc0\x94\x29\x09\x2e\x1c\x1f\x39\x6f\x8f\xce\x47\x59\x2e\x38\x27\xcb\x8f\x12\x4f\x09\xb8\xc0\x38\xbb\x26\x51\xec\xd3\x64\xa9\xec\x82\x4f\xa7\x54\xe1\x60\x44\xa7\x0c\xab\x58\x90\x5d\x98\x93\xe5\x29\xf3\x68\x34\x23\x22\x2c\xd2\x6f\xed\x8a\x89\xa7\x8e\x02\xa5\x57\x0e\xb8\xf0\xdf\x38\x50\x09\x5f\x99\xd1\x7f\xce\x1b\x32\xc6\x4e\x2e\x46\xfd\x21\xb8\x50\x88\xbf\xd4\xb1\x57\xe9\xe8\x1b\x93\x32\x00\x67\x57\xc9\xc0\xe1\xa0\xff\x4a\x7b\x89\xa2\x55\x8f\xaa\xa6\xa5\xeb\x94\x9d\x48\x67\x8c\x63\x35\xeb\x7b\x72\xb1\xfa\x78\xa0\xa1\x66\x7d\x28\x70\x85\xa5\xbc\xe3\xc2\x87\xc7\xc7\x5d\xfd\xfc\x51\x12\xa1\xa7\xa9\x9f\xb3\x85\xb3\x92\x83\x39\xe3\x77\x6c\xac\xf8\x9c\x30\xf9\x0c\x3c\x68\xec\x01\x51\xd9\xef\x15\x50\xe3\x48\xf0\xfb\x65\xe9\xcf\x0e\xd8\xd4\x5d\x38\xd2\x9b\x11\x3f\x0e\x88\x68\x34\xac\x1e\xa7\x39\x16\x3c\x08\x88\x18\x87\x98\xe1\x29\x11\xdd\x3d\xab\x31\x05\x7c\x3a\xa5\x6c\x5a\x7b\x5c\x3d\xa6\x58\xf6\xcd\x96\xd5\x23\x7d\x26\x4b\x7f\xae\xd2\xa2\xf1\x34\xb9\x9f\x4f\x1c\xd6\x96\x6a\x2c\x22\x91\x03\x8b\xcc\xa2\x75\x30\x72\x20\x8f\x45\xa6\x35\x8b\x47\x8e\x79\x06\xd0\x94\x1d\x28\x05\xa5\x0a\xb7\x0b\x2c\xec\x80\xde\xda\xc6\x22\x92\xdf\xa9\xd7\x6e\xe5\x71\x7f\x30\xd8\x9e\xc7\xe3\x04\x9f\x69\xd2\xc1\x43\x66\xdc\xa1\x12\x77\x85\x3d\xa6\x40\xc5\x0c\x00\x92\x05\xe0\xd4\x95\x61\x00\x8c\x13\x6f\x20\x0d\xb8\x87\x8b\xdd\xaa\x81\x2a\x63\x05\xca\x24\xf1\x62\x41\x90\x9c\xd3\x08\xa9\x40\xea\x73\x0c\x3a\x59\x3a\xa0\x44\x9c\xe7\x0e\x69\x80\x2c\xa1\x37\xa1\xac\x8c\x2a\xc3\x5e\x23\x6a\x66\xd2\x98\x5f\x3a\x6b\x1d\xb4\xa9\x47\x10\xf6\x3c\x1e\xb3\x3c\x14\x1b\x28\x2f\x16\x82\x14\xad\xab\xc0\x3b\x8d\x2f\xc4\x8c\x4e\x88\x54\x26\x5e\x16\xbb\xb0\xf5\x46\xc8\xef\x98\xe6\xdc\x12\x9c\xab\xed\x55\x7e\xc5\xfd\x4d\x0c\x32\x67\xa8\xd2\x99\xd8\x73\xdd\x64\xf5\x3f\x19\x11\xaf\xc0\x33\xe3\x52\x5d\x10\xa5\x37\x28\x15\x85\xa5\x9c\x62\xca\x4a\x36\x51\x35\xb5\x16\xba\x00\x34\xc4\x53\xe2\xc0\xd4\x13\xfa\x80\x22\x3d\x7b\x19\x17\x98\xec\xd9\x32\x22\x42\x8f\x76\x2a\x27\x9e\xfa\xc7\xe3\x61\x88\x99\x5f\x10\xd3\xe4\x8a\x01\x95\xe6\x36\xd2\x08\x90\x3e\x99\xf5\x11\xf6\x7d\x41\xa4\x74\x75\x0a\x37\xa8\x90\xd0\x20\x44\x79\xbe\xc9\xf4\xa4\xdb\xc8\x2a\xf7\x5e\xfd\xf4\x73\x6d\x00\x0e\x02\x7e\x87\x22\x41\x17\x34\x20\x53\xe2\xbb\x15\x29\xa5\x74\x33\xab\x32\x16\x8c\x68\x84\x04\x66\x53\xe2\x9a\x00\x3a\xb0\xf7\xf6\x1b\x43\x92\x45\x13\x71\xa1\xdc\xfd\xfd\x57\xb5\x5e\xec\x2f\x88\x50\x54\x92\x7c\x36\x3b\x51\x7c\x1b\x50\x6f\x4c\xa3\x45\x1d\x95\x0e\x82\xc9\x59\x3b\x32\xfe\xde\xcd\x1d\xda\x39\x9d\x10\x6f\xe9\x05\x64\x37\x6f\x3a\xbd\xa7\x52\xc9\xdd\x73\x1a\x52\x75\xad\x99\x14\xbb\x23\xbd\x80\xa9\x5a\x26\x19\xfd\xbd\x3a\x21\x6c\xb9\x3b\x4a\xa7\x74\x94\x2e\xab\xdd\x6b\x22\x79\x2c\x3c\xf2\x3f\x31\x57\xb8\x46\x5f\xaf\x79\x8f\x08\x85\xf4\x89\x83\xbb\xf6\xe4\xa2\x65\xb4\x96\x2e\x56\x44\xef\xa1\x36\x40\x52\xdb\x9e\x65\x88\xbc\x80\x26\x4b\x1d\x77\xa3\xf0\x70\xcb\xb8\xba\x4b\x78\x12\x13\x49\xb6\x83\x74\xb6\xd3\x8d\xa3\x9a\x11\xd5\x10\x24\x5e\x7a\x0d\x82\x7a\x42\x53\x42\xa1\xed\xa9\xb4\x60\x33\x4f\x9b\x2c\xbf\x2b\x2e\x94\x03\x55\x63\x4b\x3d\x40\x7b\x4f\xba\xd6\xf5\x1a\x91\xab\x10\xea\x4d\x52\x07\xc6\x46\x57\x5b\x50\x01\x58\xf0\x20\x0e\xc9\x07\x6d\x68\x35\xde\x43\xdd\x76\x95\x84\xd9\x16\x41\xb4\xa0\x96\x32\x48\x6c\x51\xa2\x02\xb4\x02\x26\x08\xf6\x2f\x59\x50\x0d\x4f\x6b\x89\xa5\x09\x48\x05\x51\x3a\x15\x1c\x51\xbd\x44\x39\x7b\x0e\x82\xda\x38\x12\xe6\x57\x4e\x4c\x2b\x6c\x13\x0a\xa9\x54\x4b\x02\x45\xa9\x66\x34\xbd\xbc\x4d\xff\x44\x1b\xc8\x77\x03\xe9\x6e\x87\xbe\x21\xd1\x4d\xe4\xb9\x86\x44\x2c\x85\x2d\x67\x58\x10\x7d\x5c\xa4\xf9\xa4\x13\xea\x61\x45\x9a\x64\x1a\xd2\xdc\x34\xfa\x27\x69\xfc\x8f\x12\xf9\xcb\x7b\x8a\xef\x15\xf5\xab\x34\xbf\x5b\xc4\xaf\x93\xd5\x0e\x3f\xc4\xc9\xd9\x4a\xeb\xd1\x50\x0d\x34\x19\x8e\x42\xee\x13\x97\x46\x0a\xdf\x06\x15\x9b\x90\xd5\xf8\x57\x66\x08\xa0\x88\xfc\x35\x51\x6d\xe3\xb7\x7e\xdf\x95\xfd\x5b\xac\x8b\xce\xc3\x1f\x7b\x46\x70\x94\xb4\x15\xbb\xcf\xdf\x7c\xc1\x5c\x93\xfc\xf4\xf1\x38\xa7\xba\x66\x09\xe5\x7c\x2e\x86\xc3\xcd\x16\x11\x40\x80\x6f\x49\x50\xd1\xb5\x2e\x08\xc0\x51\x54\xa0\x2b\xf5\x99\xb7\xa9\x0e\x94\x29\x40\xf9\xd4\x85\xf2\x5c\x82\x26\x0d\x71\xc0\xd2\x56\x66\xb5\xae\x5b\x91\x4e\x53\x3a\x50\xe0\x93\x24\x20\x9e\xe2\xe2\x49\x4c\x65\xa7\x70\x65\x24\x4d\xb9\xb5\x4b\x60\x1d\xc1\x15\x92\xd8\x56\x1a\x4d\x89\x74\xf9\xac\x6c\x5d\xac\x75\x4f\x86\x5f\x47\x6f\x8b\xdf\x99\x07\x63\x65\xc5\xee\x18\xa0\xdd\x74\x1a\xdd\xc2\xa4\xc9\x0d\x4e\xf4\x4f\xa0\x53\xee\xd6\x1e\x00\x2f\x8a\x1d\x18\x0e\x06\x61\xaf\xd6\x61\x54\x11\x72\xb1\x74\x60\x6f\xb8\xf7\x81\xf6\xea\x00\x2d\x7e\x34\xfb\xa7\xfd\x69\x17\xaf\x26\xfb\x4d\xf8\x75\x0b\x2d\x74\xc1\x51\x36\x77\x29\x9b\x04\xf1\xbd\x7f\xeb\x18\x9f\x5b\xac\x7a\x94\x77\xbd\x1e\xbc\x3e\xec\xc0\x11\x12\x25\xa8\x37\xd6\x42\x0a\x62\x7d\xfc\xe9\x96\xa5\xfe\x21\xe9\xbd\xce\x3b\xd7\x14\x00\x6d\xe0\x80\x8c\x11\x3d\x8f\xf7\x49\xf7\xe4\x66\x5b\xb4\xca\x23\xad\xf3\x37\x4f\xf0\x35\x5b\x2e\x95\x42\x5a\xda\xf8\x53\x2e\xac\x4c\xdc\x56\xaf\x6d\x3d\xb5\xec\x1f\x22\x93\xca\x97\x1a\x01\x14\x16\x53\x52\x64\xf9\x7b\x5b\xbb\xa3\x6f\xd0\x6b\x66\x62\x68\x2a\xf0\x04\x33\xfc\xa3\x07\x98\x96\xd5\x91\xb3\xbe\xd8\x7b\x82\x19\x64\xe2\x4c\x05\xf2\x6b\x2a\x8d\x5e\x9b\xb7\xdd\xeb\xb4\x86\xd5\xd6\xf3\xc4\xb0\xb3\x0d\x63\xcf\x16\x7a\xba\x88\x76\x4b\x64\x5b\xa9\x34\x25\xf3\x5c\xe1\x67\x9c\x19\x88\xb3\x18\xf4\xcb\xe7\x3b\x65\x7b\xca\x60\xbe\x7b\xd4\x19\x0c\x5a\xa2\x4e\xc3\x53\xac\x3a\x20\xa8\x1e\x2c\xb4\x1e\x14\xbc\xda\x10\xd5\xe1\x7a\x54\x4d\x90\xae\x4c\x3d\x23\x53\x15\x30\x8a\xf4\x02\x92\xda\xff\x23\x53\x47\xd6\x18\x03\x95\x1c\x5f\x1b\x6b\x97\xf2\x6f\x89\xc2\xfd\xf5\x16\x60\x3c\x83\xb3\xd8\xeb\x0f\xfb\xc3\x1a\xb2\xcc\x04\x0c\x50\xa3\x97\xb0\x85\xf3\xbd\xcd\x62\xd8\x6a\x16\xad\x9c\xe8\xf2\xc2\x9b\x19\x95\xb0\xc0\x82\xea\xdd\x17\x50\xa9\xdf\xc1\xc6\x54\x10\x1f\x14\x07\x49\x54\x1c\xe5\xae\x40\x02\x65\x60\x16\x72\xbf\x05\x59\xa6\xaf\xb3\x8b\xb7\xe7\x1f\xff\x72\xf2\x66\x3c\x3a\xbd\xfe\x74\x76\x7c\x3a\xfe\x78\x7d\xde\x02\x0e\xb0\xc0\x41\x6c\x8e\xb2\xb6\xcb\x5a\x52\xc6\x09\x4c\xb8\x3e\x05\xa6\x6c\x0a\x84\x2d\xf2\x59\x48\xc0\x82\x54\xe6\x11\xe2\x39\xc9\x38\x07\xec\x79\x44\x4a\xaa\xa7\xbb\xa0\xb8\x03\xbb\xae\xba\x2c\x7c\x90\x0e\x41\xe6\x74\x1a\x92\x3d\x6b\x1f\x2e\x99\xfe\xcb\x8f\xbd\x24\x27\x32\x0e\x4a\xee\xea\xea\x53\x41\x3c\x1e\x86\x84\xf9\xbd\x16\xd4\xf0\x02\x04\x09\xf9\x42\x33\xad\x66\x44\x92\x2a\xeb\xbb\x46\xe6\xfa\xa4\x11\x26\x5c\x64\xa6\xb5\x0b\x98\xf9\x40\xee\x23\x2e\x49\x52\x12\xda\x65\x72\xfa\xdf\x8b\xec\x7d\x0a\xc4\x52\xd3\xc1\x70\xce\xb1\xff\x06\x07\x98\x79\x44\x00\x17\x80\x21\x3d\xb7\xd6\xef\x8e\x57\x68\xf2\xd7\xb7\xe3\xa3\x8f\x37\xef\xc6\x6f\x8e\x46\x67\xc7\xe3\xd3\x8b\xa3\x37\xe7\xa7\x27\xab\x54\x69\x4d\x70\x20\x2b\x8e\xb9\x0b\xe5\xd1\xc5\xe5\xc5\x5f\x3f\x5c\x7e\x1c\x6d\x84\xb6\xee\xee\xd7\x63\xbd\xbc\xfe\x75\x7c\x7d\x79\x7e\xda\x32\x28\x47\x7b\x54\xaa\xef\xe8\xc0\xaa\x4d\xf8\xf4\x7a\x7c\x7d\x79\x79\xb3\xce\x8c\xb3\xb2\x97\xc4\x40\x4a\xc5\x2f\x76\x29\x7d\xb0\x8d\x62\x64\xd9\xda\x8d\x2a\xed\x6f\x74\x8f\x66\xf8\xf6\xde\x71\x81\x45\xaf\x02\xd2\x38\xc0\xf8\x36\x2f\x4c\xc2\x48\x2d\x4f\xa8\x70\xe0\xe1\xb1\x15\xd5\xc6\x1c\xd7\x30\x3d\x25\x39\x7d\xd6\x4d\xc7\x8a\x8c\xb4\xba\x13\xd9\x22\x07\x7d\x42\xe2\xb9\x65\xae\xd4\xba\xff\x38\x4b\xd8\x38\x79\x63\xf5\xda\x12\xaa\x96\xfd\x87\xd9\x3d\x29\x15\x95\x9a\x53\xc8\x96\xc4\xa1\xb6\x31\x79\xd5\x82\x0a\x47\xb4\x1d\xd3\xe1\x2a\x4c\x87\xdf\x96\xfa\xae\x33\xa5\x4a\x0d\xd6\xef\x6f\x2f\x19\x3b\x4f\x30\x92\x4c\x12\x0d\x54\xcf\x64\x40\xef\xe3\x5b\x72\x72\x31\x6a\xb7\x9f\x95\xba\x69\x70\x64\x38\x38\xbb\xd2\xe9\x4e\xf2\xa6\x76\x38\xe8\x32\xc5\xcc\x7a\xaa\x33\xd2\x40\x0e\x1c\x94\xcd\x2c\x12\x5c\x71\x8f\x07\x0e\x7c\x3c\xb9\x6a\x1b\x8e\x94\x17\x6d\x8a\xe2\xe6\xf8\x2a\x73\x9d\x08\xa1\xde\x7a\x35\x7f\xcb\x46\x35\x93\x0b\x2a\x9f\xce\x3d\xab\xe2\x3b\xce\xff\xb6\xb1\x87\x27\x6e\x49\x37\x65\xea\xd9\xb6\xa3\x2d\x04\x57\x48\x62\x5b\x69\x34\x25\xb2\x6a\x2b\x9a\x19\x9f\x2e\x7e\xa8\x74\xac\x7f\x7f\xa3\x87\x38\x7b\xfd\x41\xff\xe7\xde\xc6\xbb\x8b\xee\xbd\xc5\xaa\x9d\x45\xb6\xaf\x38\x68\x6e\x2b\x3a\x4e\x38\x51\xfa\x6a\x23\x79\x9d\x9c\xd4\xac\xb6\xcc\x0f\x01\xd2\xaa\x43\x3e\x15\xbd\x4a\x87\xae\x2e\xd1\x85\x5b\x7a\x4c\xfb\xfe\x0d\x05\x49\xce\x90\x15\x16\xc4\x22\xa8\x2b\x13\x35\xeb\x92\x75\x05\xc9\x6e\xa3\x75\x7f\x30\xa8\x6b\x1b\x41\xa9\xca\xe3\x37\x23\x41\x19\x55\x14\x07\x79\x65\x4a\x52\x34\xd0\x00\x93\xf3\xa5\xcf\x24\x6a\x11\xdf\xaa\xcc\xb0\x6c\x55\x1d\x79\x55\x23\x0f\xec\x10\x77\x86\x4a\x2f\x81\x3d\x39\x5f\x6e\x69\xa4\xd9\x30\x67\xd8\x1f\x0e\x7f\x47\x3b\xc5\x62\xda\x40\xf7\x22\xb3\x5e\x70\xc1\xca\x39\xb5\x9a\xaa\xf2\x79\x88\x29\x73\x8d\xa6\xfa\xf5\x22\x89\x42\x48\xa9\xb6\xb6\x14\x51\x3a\xc8\xd9\x1b\x0c\x0f\xd0\xe0\x15\x1a\x0e\xd1\x60\xf0\x63\x8b\x2a\xe5\xb8\x45\x50\x21\xf6\x66\x94\x91\xbc\x7c\x2b\x91\x94\x3e\x00\xea\x58\x03\xba\x84\x2a\xab\x06\x73\x0e\x5e\x35\x01\xda\x24\x5f\xdf\xad\x36\x12\xd3\xd6\xf3\xa9\x06\xf6\x2c\xc4\x36\x15\xd6\x95\x28\x7c\x03\xe6\x5a\x42\x51\xc7\xae\x73\x88\xba\x36\x17\x84\x11\x29\xaf\x04\xbf\xad\x44\x3a\x73\x98\xa6\x54\xf4\x2b\x51\xcd\x8e\xfc\x8d\xf2\x8c\xe0\x40\xcd\xfe\xaf\x0d\x20\xcb\x97\xab\xef\x0b\x4c\xc4\xf2\x66\x44\xf3\xfc\xee\xe6\xa6\xce\x12\x80\x71\x55\x27\x24\xc0\xcb\x11\xf1\x38\xf3\xa5\x03\xaf\x9a\x68\x14\x0d\x09\x8f\x55\x0e\x72\xd0\xab\x74\x27\x2f\xcd\xe9\xbf\xc6\xec\x86\x5b\x4e\x2e\xf3\x00\xed\x0c\xae\x73\x01\xe4\x9e\x78\x66\xa4\x33\xec\x0f\x9e\x71\xf1\xaf\x5a\xfb\x7b\x9b\xad\x7d\x04\xc8\x0b\x7d\x97\xc9\x80\xf3\x79\x1c\xb5\x7c\x9a\xd0\x97\x0b\xaf\x5f\x59\xa3\x90\xaf\x7c\xf8\x73\xfe\xe5\x53\x13\xaf\x56\x9a\xdb\xa2\xb3\xcd\x96\x74\xab\xb2\xbb\x17\xd7\xca\xa3\x8d\x15\x91\xb2\xf3\x2c\xc3\x67\xf2\x8a\x07\xd4\x5b\x3a\x70\x92\x7f\xa3\xb1\x71\xb9\x12\xf7\xd3\x2a\x99\x1f\xa6\x64\x29\x63\x68\xb3\x7d\xc6\x73\x94\x2d\xe5\x5f\x51\x20\xb3\x3f\xe8\x6d\xbe\x64\x72\x76\x9d\xea\x99\xbc\x89\x53\x05\x31\x4d\xae\x80\x7e\x6a\xcd\xf1\x9c\x2c\xdd\xfa\xc7\x1f\x59\xdf\xdd\x8c\xe3\x90\xae\xaa\x07\x4e\x83\xb8\x29\x1f\x95\xc2\xab\x1b\x45\x8e\xb9\xfc\xdd\x57\x36\xd8\x27\x32\x2b\xe4\xf5\xa5\xda\x78\xe8\x66\xa5\x50\x15\x66\x7a\xcd\x58\x96\xf5\x21\x29\xbc\x4d\x0a\xa1\xea\xf8\x2b\x1c\xaf\xc2\xef\x4b\xd5\xb0\x93\xe2\x6d\x32\x32\x1f\xcb\xfc\x6b\x19\x4c\xc1\xff\xb3\x5b\x4c\x53\x34\xdb\x9b\xce\x7a\x1c\x4a\x3b\x26\x96\x1c\x5d\x7c\x20\x52\x7f\x2f\x97\xa9\x95\x2c\xec\x52\x27\x0a\xf8\xf4\xdf\xc3\xf4\xb6\x2d\xe4\x93\x62\xd1\xea\xec\x7b\x9d\xd4\xca\xb3\x59\x83\xbc\x2b\x92\x74\x23\xf7\x6b\x25\x82\x5d\xec\xad\x34\x80\x1f\x20\x28\x35\x39\xfb\x7e\xd1\x69\x1d\x03\xdf\xad\xc2\x76\x25\x0f\x5b\x96\xdb\xd6\x3f\xa9\x78\x96\xef\x3b\xb4\x09\x6c\xf7\x75\xc7\x8a\x6d\x4e\xc7\x26\x40\xeb\xd0\x81\x7c\x6e\xb5\x5e\xb3\x50\xda\xb3\x6f\x9d\x4d\xea\x8d\xf0\xde\xc1\x5e\x6f\x7d\xe2\x7f\xd0\x5b\x95\xf5\x0f\xb7\x76\x6f\x4d\x71\x94\x40\x9b\xd5\xbd\x05\xe8\xb7\xf8\xba\x7f\xfb\x6f\x15\x9e\xa5\x60\x7a\xb5\x37\xac\x66\x52\x3f\x80\x13\xcc\x19\xfa\xce\xbe\xaf\x49\xf7\xbb\xb9\xbc\x36\xd2\x5b\x78\xba\xdf\xc9\xbd\x0c\x9f\xc1\xbd\x78\x5c\x10\x9e\x2c\x37\xbd\x25\xdd\xd3\x7f\x00\xb4\x1e\x47\xe7\x55\x33\xe5\xf4\xb1\x48\x41\x9b\x47\xe4\xf9\x80\xec\xc4\xcd\xc0\x4e\x02\xcc\x18\x09\x52\x52\x74\x92\xbc\x58\x6a\xa4\xa4\x9a\x9b\x31\x61\x7e\xc4\xf5\x45\x0a\x0e\x34\x54\x60\x90\xc5\x2c\x3f\x90\xc8\x8c\x49\x0f\xdd\xeb\x9b\xc8\xd3\xab\x1a\x03\x24\xd7\xae\x54\xc0\x0d\x3b\x7e\x63\x44\x6d\x61\x01\x7c\xfe\xc8\xa8\xca\xae\x54\x00\x38\x21\xd2\x13\x34\xd2\x59\xaa\x6b\x4c\x1e\x26\xf8\x56\x50\x2f\x29\xa3\x29\xac\xb4\x18\xc1\xbd\x58\xdf\xe4\x90\x24\xaf\x6e\x76\x41\xd9\x94\xaa\x59\x7c\x9b\x5c\x4a\x96\x6a\xc3\x36\x2c\xe5\xe3\xae\xd3\xc2\x22\xe9\x12\x2c\x82\x25\xf2\xb9\xa7\x2f\x44\xaa\xf2\x0b\x70\x34\xd1\x1f\xc1\xe8\xd9\x67\x5d\x55\x51\xc0\xca\xd1\x6f\xc8\x84\x0b\x52\xa5\x90\xbe\xf8\xce\x41\x3e\x9b\x37\xc7\x85\x08\x6e\x96\x11\x71\x19\x57\x74\x52\x1c\xd4\x5f\x93\x44\xc6\x2e\x0e\xee\xf0\x52\xd6\x9b\x47\xc4\x73\x0b\x9b\x3c\x65\x0b\x2a\x38\xd3\x32\x71\xad\x9b\x0f\x57\x27\x67\xd7\x6e\xf2\x72\x40\x85\x91\x6d\xb5\x83\x9d\x5c\x1e\xbf\x37\x57\x4e\xb8\x31\xa3\xf7\x8e\x6d\x27\x43\x44\xcc\xec\xea\x0c\xb9\x37\xef\xc0\xf1\xf6\xfc\xe8\xe2\xe2\xf4\x5c\xdf\xc1\xe7\x0e\xfa\x07\xfd\x83\x0e\xb8\xd3\x9b\xe3\x93\xf1\x68\x74\x3e\x4e\x18\xcb\xa2\x9d\x96\xea\x1a\xc4\xa7\x17\x9f\xc6\xfa\xb6\x3a\x37\x61\xcb\xe8\x53\xdf\xa8\xa2\x0f\x5b\xfa\x84\x99\xab\x27\xf4\x4f\xf2\xfd\xed\xc5\x65\x02\xbd\x3f\x18\x0c\x0a\xc7\x92\xf6\x5c\x5d\x5f\x1e\xbb\xc3\xc1\xfe\xeb\x83\x9f\x8a\xca\x83\xd3\x7b\xe2\x8d\xb4\x98\xaf\x84\xde\xda\xeb\x57\x5e\x21\xf7\x23\x7d\xce\x09\x34\x1a\xd7\x3e\x6c\xaa\x42\xeb\x98\x96\x0c\xc8\x6f\x97\x2c\xf1\xb8\xe9\x98\x9d\x87\xb2\x6c\x1e\xdb\x87\xa1\x7c\x9c\xe2\xb1\x37\x83\x9d\x87\xba\x7c\x5a\x06\xa6\x0c\x06\xf4\x56\x9f\x52\xda\xd2\x4f\xcd\x0b\x25\x35\x4e\x15\x56\x6d\xe9\x27\x3a\x86\x2f\x39\x12\x80\x9c\x62\x6a\x04\x20\x62\x06\x08\x31\xa2\x5c\xed\x72\x01\xd5\xbf\xdf\xd6\xe9\x64\x58\xc1\x80\x50\x9a\x83\x54\x54\xe7\x94\x1f\x6a\xe0\x84\x2d\xdc\x8b\xcb\x9b\xb3\xb7\x7f\x1d\x8f\xb4\x6d\xde\xb8\x6b\xb9\x44\x88\xb0\x45\xba\x43\x6e\x91\x49\x07\x3b\x9d\xb9\x88\x53\xcd\xc3\x1c\xc1\xdb\x31\xd4\x74\x56\x0c\xd3\x06\x5d\x1f\xf5\x35\xc6\x4b\x1d\x73\xab\x2e\xc9\x29\xd8\xfd\x74\x7a\xfd\x58\x5c\x12\x64\xfa\xf5\xf5\x52\x34\x42\x21\x96\x5f\xab\x9f\xc7\xbf\x80\x8f\x91\xaf\xaf\x0f\x32\x7a\x31\x4b\xa1\xa9\xfe\x2b\x2e\x95\xbb\x56\x8b\x22\x04\xb4\x48\xac\x21\xd1\xcc\x37\x70\x5e\x1e\x91\xcf\x22\x9c\x1b\xe7\x81\x78\xa4\x64\x5f\xce\x00\xf9\x15\x9b\x1b\xa7\xdd\xe3\xa4\x5b\x97\x51\xa2\xec\x48\xdb\x17\x3c\x42\x94\xb5\x24\x37\xd6\xc1\x00\x15\xd3\x13\x04\x19\x5c\x7a\x3e\xfa\xc3\xcb\xbe\xc7\xd9\xa4\xf0\x07\xad\xa1\xa7\xdd\xfd\x76\xaf\x53\x73\x45\x17\x5c\x7d\xbc\xd1\x53\xb0\x92\xf2\x43\xf7\xe1\x8b\x65\x02\xd5\x17\xcb\xf9\x62\x0d\x07\x3a\x65\xea\x0f\xec\xe1\xe1\x17\x6b\xf7\x8b\xf5\x06\x7b\x73\xc2\xfc\x2f\x96\xf3\xf0\xc5\xd2\xae\x3d\x81\x5a\xdc\x07\x98\x7d\xb1\x1e\x1f\xad\xf6\x20\x6c\x2f\xf6\xec\x39\x59\x4a\x23\xef\x24\x8e\x99\x99\xd9\xe6\xf6\xe0\xb2\x34\x5a\xc3\xcf\x0a\xe1\xed\x0f\x32\x79\x6d\x2c\xa7\x6a\x98\xae\x04\xd0\x8e\x60\x5f\x0e\xa0\x2b\x41\xda\x75\xd0\x11\xf2\xca\x5d\x9c\x25\xd7\xae\xc5\x82\xf4\x5a\xae\x6a\x59\x9f\xad\xb4\xce\xf6\x85\x29\x7b\xa0\xb7\x87\xfb\x76\x5a\x96\xe3\x9b\xdf\x76\x3b\xe6\x95\x49\xcc\xfb\x7c\x8f\x02\xef\x6b\x57\xac\x34\xe7\x5d\xb5\xbb\xec\x4e\xba\xe4\xfa\xd9\x7c\x39\xb5\x5c\x96\x9c\xcd\xd8\x6a\x41\x94\xac\xfc\x76\x4c\x5d\xf7\xe6\x55\x10\xb8\x15\xf0\x80\xa8\xb2\x5b\x40\xa8\xa8\xa8\x6e\x39\x4a\xd5\x19\x7d\x0d\xbc\xed\xba\x8f\x2a\x88\x87\xfd\x05\x95\x5c\x20\xbd\xe5\x77\x6b\xe3\xb5\xb5\xd2\x69\xe3\x7c\x22\xdf\xfd\xd5\xa0\x4d\x59\x86\xcf\x64\x76\x57\xc8\xb0\x8e\x30\x05\x19\xb7\xbd\xa4\xae\x42\x0a\x32\xd5\xd9\xb8\x40\x4c\x7f\xe7\x9c\x14\x48\x57\x01\x74\x2c\xd4\x06\x88\xf8\x82\x08\x41\x7d\xd2\x7e\x26\xdc\x61\xbc\x9d\xe9\xdc\xe7\x33\x26\x15\x0e\x82\xc2\x4a\xfe\x17\x33\x45\xfc\x37\x4b\x37\x8c\x03\x45\x91\xbe\xbf\xa7\xaf\xb0\x98\x12\xd5\xfb\xff\x01\x00\xcb\x83\x67\xb6\x61\x5c\x00\x00")
 
 func configProvidersDigitaloceanMasterYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -147,7 +148,7 @@ func configProvidersDigitaloceanMasterYaml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "config/providers/digitalocean/master.yaml", size: 23616, mode: os.FileMode(420), modTime: time.Unix(1475100938, 0)}
+	info := bindataFileInfo{name: "config/providers/digitalocean/master.yaml", size: 23649, mode: os.FileMode(420), modTime: time.Unix(1792365112, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -332,7 +333,7 @@ func uiAssetsFontsGlyphiconsHalflingsRegularWoff2() (*asset, error) {
 	return a, nil
 }
 
-var _uiAssetsJsIndexJs = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xcc\x7b\x6d\x73\xdb\x36\xb6\xf0\x77\xfd\x8a\x53\x24\x4f\x48\xae\x65\xca\x4e\xfb\xdc\xce\xd8\xa6\x32\x49\x9c\xb6\xde\x49\x53\xdf\xc4\xbb\xfb\xc1\xf1\x7a\x20\x12\x92\x50\x43\x00\x17\x00\x65\xe9\x76\xfc\xdf\xef\xe0\x85\x24\x48\x51\x8e\xd3\x76\xef\xbd\xc9\x8c\x2d\x01\x07\x07\x07\xe7\xfd\x1c\xc0\x93\x09\x5c\x2d\xa9\x82\x39\x65\x04\x72\xc1\x35\xa6\x5c\x01\x66\x0c\xf4\x92\xc0\x8a\x28\x05\x73\x21\x41\x12\x5e\x10\x49\xf9\x02\x18\x55\x1a\xd6\x94\xdc\xab\xd1\xe8\x79\x3c\xaf\x78\xae\xa9\xe0\x71\x02\xbf\x8d\x46\x00\x6b\x2c\x41\xe3\x19\x23\x90\xc1\xf3\x18\xd9\x8f\xcf\xa8\x26\xab\x5b\xb3\x0e\x25\xe3\x11\xd8\x7f\x15\x7d\x83\x15\xb9\xc4\x7a\x09\x99\x5b\x90\x16\x58\xe3\x18\x55\xf4\x70\x86\x15\x39\x2c\xb1\x5e\xb6\xe0\xb8\xdc\x03\x1f\xe1\x32\x58\x10\x35\x0b\xe6\x94\xb0\x42\xf5\x60\xdd\xe0\xe1\xaf\x4a\xf0\x16\x52\x2f\x09\x2e\x2c\xb5\xd1\x99\xfd\x3c\x0d\xe6\x66\xa2\xd8\xd6\x73\xe6\x73\x30\x57\xe2\x05\xe5\x58\x0b\xe9\xe6\x2b\x96\xfa\x11\x6a\xb0\x9f\x8e\x0c\x37\x0c\x73\x2d\x33\x0c\x5e\x22\x95\x67\x90\xfb\x76\x25\x7f\xd2\x2b\x06\x19\x44\x67\x5a\x4e\xa3\xd3\x11\x74\x27\x0e\x32\x40\x67\x7a\x39\x3d\x9b\xe8\xe5\x14\x9d\x1a\x64\x46\x12\xf9\x92\xe4\x77\x33\xb1\x19\x00\x37\x07\x98\x5e\x9c\xdb\x05\xd1\xa9\x21\xe0\x79\x4a\x70\xbe\x8c\xdd\xc1\xc7\xd0\x48\x6b\xbe\x19\x3b\x16\x19\xb1\x99\xd3\x0c\xa2\x8a\xe0\xc0\x41\xa5\x9a\x6a\x46\xe0\x00\x22\x87\x7b\x04\xf0\x90\xd8\x0d\x06\xd7\x7d\xd2\x58\x57\xaa\x26\x63\x08\x68\xe2\x4e\x3c\xf2\xec\x4f\x71\x59\x12\x5e\xc4\xcf\xe3\x10\x34\x49\xcc\xbc\x95\x9f\x9f\xb7\xc0\xc9\xe9\xce\xb0\x11\x8d\x65\xf9\xa8\xd6\xc1\xbc\x92\x92\x70\x7d\x89\x17\x46\x13\x8f\x4f\xfd\x70\xb9\xc2\x3a\x37\x2a\x74\x4f\x79\x21\xee\x53\x26\x72\x2b\xb0\x54\x11\x2c\xf3\x65\x6a\xa7\xe3\x49\x99\xc5\x9f\x8b\x83\x64\x62\xb7\xa2\x73\x88\xdd\xba\x9a\x59\x5d\xe4\x25\x96\x8a\x5c\x70\xed\x81\xae\x8f\x6f\xec\xb2\x87\x9a\x14\x46\x57\x54\x43\x06\x2f\xff\x7f\xad\x3a\x62\x3e\x57\xc4\x0c\xc5\x21\xa6\x43\x38\x4e\xe0\x2f\x0e\xfc\xb4\x5e\x3c\xa7\x4c\x13\xf9\x86\x6a\xf5\x45\xa2\x1d\xe8\xe7\xf4\xfa\x9f\xd9\xcd\x41\x76\xfd\xcf\x17\x37\x07\x93\x45\xd2\x60\xaa\xa4\xd1\xb5\xd0\x90\x0e\x00\xbd\xb2\xbb\x65\x08\x0e\x3c\x99\x07\x80\x5e\x38\xf2\xec\xa0\xfb\x58\x73\xa1\x25\xa6\xe6\x84\x41\x6a\xf4\xf4\x05\xb2\xaa\x52\x4f\xa7\xbf\x0a\xca\xe3\xe8\x45\xd4\xe5\x84\xa3\xf7\x0d\xf6\x26\x43\x79\x59\xe9\x67\x6e\x70\x86\x65\x6b\x5a\x6e\xe8\x22\x17\xdc\x01\x7a\x18\x9a\x87\x76\xeb\x76\x3b\xa7\x6b\x07\x53\xd0\xf5\x33\x37\xa4\x22\x77\xe8\x66\xb7\x74\x2e\xf2\x4a\x51\xde\x75\x56\xdd\x8d\xd2\x5c\xa9\x18\xe5\x82\x09\x89\xc6\x80\x66\x0c\xe7\x77\xc8\x51\x6f\x7f\xf6\x90\x89\x4a\x7f\x05\xb6\x67\xb3\xd9\xac\x45\x56\x7b\x86\x8f\xd6\xa5\x5a\x37\x5b\x08\x71\x58\xe0\x42\x99\x99\x1a\xad\x77\xb9\x3f\xd8\x33\x5d\x52\xc6\xe2\x3b\xb2\x1d\xc3\x1a\xb3\x7a\xbf\x56\x3f\xcc\xb4\x77\x53\xaa\xc4\x1c\x72\x86\x95\xca\x90\x23\xe8\xd0\xc1\xa0\xe9\x99\xd2\x52\xf0\x85\x35\xeb\x3b\xb2\x35\xe6\x7c\x72\x36\xf1\x83\x60\x46\xd7\x98\x59\x23\x0f\x91\x2c\xd8\xb6\xb4\xac\x87\xe6\xd3\xa1\x24\x2b\xb1\x26\x68\x7a\x36\x31\x90\xf5\x2f\x27\xed\x40\x32\xb5\x7d\xb6\x44\xb6\xfa\xd0\x1c\xb3\xc4\xc5\xa7\x9a\xb9\x3d\x56\x1a\x76\x5b\x4e\x96\xb8\x28\x28\x5f\x1c\x32\x32\xd7\x68\x1c\x6c\x70\x4f\x0b\xbd\x8c\x13\x38\x80\xe3\xa3\xa4\x46\x3d\xac\xaa\x2d\xca\x52\x8a\x32\x46\x25\xc3\x39\x59\x0a\x56\x10\x2b\x23\xe4\x94\x06\x42\x2d\x9e\x0b\xf9\xce\xba\xcf\xc6\x6d\xd6\x73\x35\xd2\x50\x08\x3f\x7b\xd7\xd2\x00\xf5\x0d\x33\xb6\x96\x99\x64\xb1\xb5\x4d\xef\x5d\xcc\xbf\x1d\x49\x07\x08\xaf\x8f\x6f\xc6\xe1\x06\xd7\x2f\x6f\x12\xbb\xec\xa1\x26\xb8\xcb\x40\xcf\x60\xaf\x63\xaf\x2d\xdf\xfc\x7a\x65\xc7\x0e\xff\x84\x7f\x1d\x83\xb8\x23\xdb\xaa\x6c\x79\x44\xd6\x84\x6b\x9f\x0d\x58\x1a\x08\xd7\x44\x1a\x85\xb3\x03\x46\x38\x16\xc4\xac\x7b\x2b\x0a\x02\xdf\x64\x70\xfc\x6d\xcb\x50\x49\x74\x25\x39\xcc\x31\x53\xc4\x31\xe8\x61\xd4\xa8\xbb\x75\x19\x90\x05\xbb\xaf\x31\x8b\x6b\x4e\x18\xdc\x16\x22\x65\x84\x2f\xf4\xb2\xa1\xc2\x2d\xbe\x23\xdb\xbf\x63\x63\x27\x0e\x46\x95\x8c\xea\x38\x3a\x89\xea\xe5\x0e\x81\x83\xf2\x18\x20\xcb\x8c\x57\xae\x69\x83\x16\xc7\x35\xe2\x78\x45\xd0\xd8\x8f\x5c\x1f\xdd\xdc\xd4\xe2\x7c\x00\xc2\x14\x19\x40\xf6\x4d\x06\x2f\x43\x64\xbb\x47\x6d\x0e\xeb\x28\x36\x6e\x94\xc8\xd3\x80\xba\x3d\x21\xa0\x3d\x6f\x8d\xdb\x2d\x05\xeb\x9b\x7b\x84\x0d\x01\xbd\x42\x3b\x04\x4c\x26\xf0\xd7\x4a\x69\x9b\x74\x18\x27\xa5\xb0\xa6\x6a\x8e\xad\x94\x3b\x8e\xba\x16\x03\x42\x8d\x46\x3f\xc1\xd8\xf6\xa8\x7e\xc3\xce\x86\xb3\x3e\x9e\x0e\xa9\xba\x1f\x1e\x66\x8a\x09\x4c\xfe\x80\x07\x80\x9c\x09\xa4\xc8\xf9\x3e\xb7\x85\x19\xcf\x82\x91\xe3\x1b\x8f\xd2\xf2\xe0\xa1\x4d\xe2\x3e\x1a\x7f\xf7\x6f\x32\xa4\xe7\x71\x64\xbc\x67\xda\x71\xd6\x90\xf6\x9d\x6d\x94\xa4\x39\xa3\xf9\xdd\x6e\xd8\x79\x4c\x4e\x63\x70\xab\xed\x44\x49\x19\x6b\x2c\xa9\xac\x43\x86\x5e\x52\x95\xa4\x25\x36\x79\x48\xec\x39\x6d\x26\x53\xb7\xb2\x19\xda\xf1\x32\x2d\x67\x88\xe7\x8b\x49\x85\xf0\xca\xec\xc9\x04\x2e\x4c\x86\x4c\x46\x3b\xc6\x67\x71\x6b\xb2\xd1\x71\xd2\x98\x20\x34\x36\x68\x40\x39\xb9\xff\xcf\xfd\xd9\x8e\x24\x56\x99\xe2\x8f\x64\xf1\x6e\x53\xc6\xe8\xfa\xf3\xab\x17\x37\x4f\x93\x6e\x12\xfa\xf9\xc9\xc4\x1a\x94\xd9\x2c\x55\x1a\x4b\xad\xfe\x41\xf5\x32\x46\x2f\x50\x12\x70\x16\x6a\x6a\x1c\x5c\x35\x53\xda\x54\x41\xf1\x71\xcb\x02\x6f\x30\x7b\x94\xd0\xad\x0c\x62\xbf\xd3\x28\x23\xc5\xdd\x60\x7f\xd9\x54\x10\xb1\x29\x97\xc2\x40\xaf\x85\xc6\xcc\xe4\x89\x26\x11\xfc\x19\xeb\x65\x9a\x13\xca\x2c\x58\x6a\xe7\x60\x62\x4b\xb3\xd4\xe6\x71\xa1\x47\x0c\x56\x4e\x9d\x33\x0b\x3c\x0c\x27\xf7\x97\x41\x21\xd3\x14\x35\x69\xce\x04\x27\x81\x8d\x19\xe0\x86\x48\xf2\x9e\xf2\x3b\x13\xea\x6a\x5d\x2c\x43\xd7\x63\x13\x66\x38\xcb\x02\x9a\xc3\x69\xe8\x6c\xda\xa6\xfe\xd1\x19\xa3\x26\x09\xe9\x24\xc5\x59\x06\x25\xbc\x82\xa8\xce\x46\x8c\x5a\xaf\x09\x8a\xe0\x04\xa2\xc8\xc4\xfd\x68\x7a\x86\x61\x29\xc9\x3c\x43\x66\x71\x15\xa6\xb7\xd1\xab\x32\x33\x83\xa5\xf9\x8c\xa6\xcd\xc7\xb3\x09\x9e\x9e\x4d\x18\x9d\x46\x49\xe3\x5a\x8c\xd1\xfb\xdf\xa7\x3b\x47\xfe\x44\x8c\x66\x3b\x16\xed\xd8\xdf\xe3\x27\xaa\x09\x2f\xa8\x32\x25\x4b\x81\x5a\x7a\x9f\xa1\x69\x9a\xa6\x43\xc4\x3c\x74\x22\xd2\xae\x00\x43\x4e\x87\xdc\xea\x4d\x3f\x46\xd7\x13\xd8\xb6\x5b\x9d\x18\x36\x02\x96\x14\x1f\x32\x3c\x23\x2c\x43\x97\x92\xac\xa9\xa8\x94\x49\x2e\x4d\xce\x68\xe7\x96\xb4\x28\x08\xcf\x90\x96\x15\x41\xd3\x17\x0c\xff\xab\x12\xa7\x4d\xaa\x38\xc8\xfa\x7e\x60\xfa\xdd\x1c\xfd\xb7\x50\xd7\x46\xc6\x61\xa9\x9c\x65\xf0\x1f\x81\x5d\x85\xd9\x3d\x66\xcc\xfa\x41\xd5\xcc\x19\x37\x1d\x5b\x0f\x6c\x0b\x53\xe8\x59\xca\x29\x94\x07\x07\x5d\x21\x76\xcd\x2e\x2e\x3b\xa4\xed\xe6\x1c\xa1\xd4\xce\x32\x30\xa9\x95\x21\x08\xf3\x02\xa6\x19\x7c\xef\xf6\xf2\x44\x3d\x4e\x55\x07\xd5\x01\x1c\x27\x5f\x4b\x5c\x17\xa8\xb1\xa2\x38\x00\xea\xad\x0f\x5c\xc6\xe9\xc0\xd9\x42\xae\x1f\x86\x75\x78\xf2\xf4\xa3\xf6\x76\x3c\xde\x21\x66\x90\xce\x0e\x83\x7a\x86\xf1\x27\x0b\xf1\xb7\x3f\x4a\x6b\x6f\x55\xdf\x8c\x9f\x02\xf8\x34\x6c\x07\x03\xd8\x7e\xb7\x94\xf7\x59\xd7\x17\x7c\xde\xd9\xef\x08\x33\x5f\xeb\xfc\x0e\x06\x9d\xdf\x07\xb2\xd1\x8f\xb9\x16\xf9\xbf\xe6\xf8\xfe\x0c\xca\x76\xc4\xd2\xe6\x06\x3e\x05\xb3\xf9\x52\x48\x6e\x2d\xcc\xbe\x1e\xb7\x2b\xc9\xaa\xd4\xdb\x26\xab\x78\x18\xd5\xf8\xf7\x66\x44\x17\x9a\xac\x54\x4c\xcd\xcf\x20\x2b\xf3\x59\x27\xe6\x5b\xe0\x82\x1f\x56\x65\x81\x35\x29\xc0\x82\x41\x8c\x95\xaa\x56\x26\x5b\xd7\x4b\xb2\x8d\x24\x81\x82\x30\xa2\x49\xe1\xea\xe6\xe7\x71\xa4\x65\x6a\x40\xaf\x0b\xac\xf1\xa1\x60\xc5\xa1\xf9\xe6\x99\x73\x13\x25\xbd\xcc\x77\x32\x81\x9f\xb1\xbc\x03\xb2\xa1\x4a\x1b\xb4\x6e\x1b\xac\x40\xb0\xc2\xa3\x44\x1e\x25\x4a\x52\xac\xb5\x8c\x51\x07\xb5\x29\x79\x2c\xf2\xba\xfb\xd3\x34\x66\x2d\xaa\xa0\x2f\x4b\xc7\x16\x7b\x2f\x49\x2b\x7d\x1c\xbb\xf2\x8d\x33\x2d\x5d\x4b\xdd\xe8\xa9\xf9\x90\xd2\xa2\xb5\xa3\xc9\x04\x7e\xc4\x7a\x49\xa4\x6b\xdd\xc2\x1a\x33\xd5\xe9\x55\x10\x56\xfc\x1d\x33\x93\x46\x5e\x37\x25\xeb\x93\xfa\xc4\x8d\x6e\x18\x3c\x6b\xcc\x4e\x47\x1d\xa3\xf4\x9d\xe2\x6d\x69\xb3\x36\x64\xbf\xde\xae\x31\x33\xe7\xee\x68\xb8\x2f\x07\x94\xed\x95\x98\x35\xee\xa7\x2b\x06\x50\x8a\x02\x2d\x04\xdb\x92\xca\xec\x29\xc3\x51\x4f\xaf\xc1\x12\x50\x7b\xb7\xb1\x45\x63\x77\xb7\x1a\xc5\x1a\xb3\xeb\x3b\xb2\xbd\x09\xd1\x3c\x24\x7d\x4b\x1c\x38\x48\x49\x64\x4e\xb8\xc6\x0b\x77\x8e\xde\x41\x78\xb5\x22\x75\x6a\x68\x95\xca\xad\x6e\x86\x6f\xed\xf7\x9b\x71\x87\x22\x80\x82\x70\xb1\x6a\xd2\xee\x60\x61\x30\xb1\x67\xa9\xa7\xa7\x2e\x03\xa4\xa8\x78\x11\xb7\x64\xfc\x05\x8e\x8f\x8e\x60\x12\xee\x90\x9c\x8e\x76\x38\x1a\x9d\x15\x74\x5d\x7b\x94\x52\x8a\x85\x24\x4a\x21\x50\x7a\xcb\x48\x86\x96\x84\x2e\x96\xfa\x04\x8e\x8f\xca\xcd\x29\xac\xb0\x5c\x50\x7e\x02\xdf\x95\x1b\x38\x32\xff\x6d\x2e\xdd\x23\x0b\x86\x51\x1e\xce\xac\x02\xb7\x5f\x0e\x29\x9f\x0b\x04\x52\x98\x7d\xea\x89\x19\x96\xde\x83\x59\x85\xe1\xe2\xde\xb9\xe6\xfa\xac\xad\xef\xb5\xf3\x2b\xca\x33\x74\xd4\x19\xc1\x9b\x0c\x1d\x1f\x1d\x35\x27\xb0\x9d\xc1\x13\xe8\x21\xf9\x7f\xfb\x28\x9f\x14\x74\xfd\xe8\xd4\x40\xb8\x86\xd6\x98\xd2\xb2\x52\xcb\x78\x8d\x59\x10\xcf\x3a\x26\xf9\xa6\xa2\xac\x80\x33\x2d\xa7\xf0\xd3\xd5\xcf\xef\x03\x83\xd4\xe1\x35\x50\xcd\x3d\xeb\x35\xa0\xe7\x9f\x6c\x9f\x08\x01\x2d\xdc\x7c\x68\xfe\xbe\xc0\x09\x77\x7c\xeb\xef\x89\x20\x17\xac\x5a\x71\x88\x4d\x06\x33\xb3\x3d\x4a\xd7\x1b\x50\x49\x0b\x6c\x08\xa9\xc3\x4a\xb3\xd0\xe9\xa5\x29\x8e\x75\xa5\xe0\x15\xa0\x06\x04\xc1\x09\x20\x14\xd6\x49\x03\x8b\x91\xbb\xb3\xba\xfa\xe5\xfc\x17\xb8\x27\x50\x08\xe0\x84\x14\xa0\xc4\x8a\x80\x35\x2e\x31\xf7\xcb\x28\x5f\x8c\x61\x56\x69\x28\x84\x73\xdb\x54\x59\x9f\x47\xb8\x56\xce\x79\x9b\xe1\x25\xe6\x0b\xf3\xbb\x20\x25\x13\x5b\xbf\xb7\x0e\x6f\x9e\x8a\x90\x7d\xb7\x8a\x30\x92\x6b\x21\xd1\xf4\xcc\xb5\x0d\xcd\xa6\x19\xaa\xef\xcf\x1c\x23\xfb\x3c\x34\xdf\x77\xce\x62\xab\xcc\x89\x2e\xba\x0c\xbe\x38\xf7\xac\x1d\x24\xe5\xb1\x14\x63\xb2\x2b\xb9\xee\x80\x0b\xc9\xfd\x0d\x5f\x6b\x2d\xe9\xac\xd2\xc4\xef\xab\x06\x9c\xb7\x51\xc6\xc0\x23\xae\x37\x9d\x1b\x83\x01\x32\xc3\xb6\xbf\xdf\x71\x48\x7f\xdd\x95\x5e\xf7\xc0\xf6\x4a\xc7\x8e\x5f\x15\x4e\x7f\x8b\x28\xec\x55\x06\xda\xd3\x71\x9c\xbd\xb9\x94\x48\x29\x24\xbc\x78\x11\xaa\x5b\x2a\x89\x96\x94\x28\xe3\x81\xc3\xe1\x15\xde\xdc\xfa\xa9\x9e\x33\x6e\x28\x31\x27\x33\xdc\xf7\xba\xa0\xc9\x46\x1f\x16\x98\x2f\x88\x44\x5f\x21\x91\x1f\x30\x65\x95\x24\x46\x12\xd1\x68\x37\x5f\xeb\x45\x01\x7b\xd5\xd3\x35\x98\xb4\x20\x2a\x97\xb4\xb4\xc9\x8c\x89\x23\xb5\x22\x23\x63\x4a\x35\x41\x27\x80\xac\x3f\x3c\xdd\x77\x92\xce\x31\x0c\x95\x6e\xab\xda\xde\xf7\xac\x3a\x73\x17\x32\xf5\xa1\x06\x28\xb2\xf2\x76\x50\x21\x96\xc9\x04\xde\x0b\x5c\x10\xb9\x17\xb3\xf1\xf1\xc6\x72\x72\x2a\x73\x46\x7e\x44\xd3\xfe\xc8\xed\x31\xaa\x89\x6e\x61\xac\x13\xdd\x81\x7c\xf9\x64\xc8\x6f\xf7\x42\x3a\xf7\x3c\x1a\x72\xcf\x5d\xc2\xad\x76\x0f\xd4\x94\x96\x43\x25\x56\x8a\xae\xc9\xed\x80\xba\xee\xc8\xb7\x0b\x7b\x2b\xee\xf0\xd6\x88\x54\x55\x79\x6e\x62\xe8\x09\xa0\x7b\x2c\xb9\x91\xf4\xe9\x30\x29\x75\x8c\x12\x25\xce\xa9\xde\x9e\xc0\x51\xfa\x3d\x7a\x54\xd0\xb5\x20\xbb\x5b\x87\x46\xdb\x2f\x21\xf7\x98\x45\xd7\xc4\x77\x3c\x57\x0d\xdd\xe0\xd3\x03\x97\xf6\xdd\xf6\xa1\x4f\x4a\x1d\x60\xf7\x2a\xa5\xcd\x5b\x77\x2f\x64\xac\xaa\x9d\x0b\x1e\x69\xf0\xe5\x04\xc4\x36\x4a\x98\x91\x7b\xcc\x35\xcc\x19\x56\x4b\xca\x17\x89\xc1\x45\x35\x2c\xb1\x32\x73\xb9\x89\x03\xa4\x48\x43\x3c\x3f\x89\x7b\xb2\x26\x72\x6c\x82\x8c\x2b\x06\x5c\x10\xc1\x8d\xc7\x54\x02\xa8\x8e\x14\x70\xa1\xeb\x72\x20\xed\x36\x2c\x1b\x5a\xaf\x8f\x6e\x52\xca\x39\x91\x26\x50\x1b\xcb\xb5\x87\xec\x8c\x76\x33\xcc\xe0\x98\x7b\x92\x7f\x17\xb9\x77\x92\xcd\xdf\xba\x86\x97\x0b\xae\x04\x23\x29\x13\x8b\x18\x7d\x7c\x77\xf9\xfe\xf5\xdb\x8b\x0f\x3f\x76\xd3\xe1\x60\xaf\x5e\x15\x76\x25\x87\xaa\xb7\xfe\x46\xfd\x6d\x5e\x5f\x5e\xbe\xfb\x70\x6e\xb7\x69\x23\xc4\x4c\x14\xdb\xb6\xf4\xf4\x92\x4d\x7a\x17\x44\x0f\xbd\xab\xc6\xe1\xfa\xed\x3d\x55\xba\xe9\x94\x3e\x4f\xf1\xaf\x78\x13\xd7\xd4\x54\x92\x9d\x98\x1f\x75\x82\x3b\x23\x73\x21\xc9\x27\xc2\x8b\x93\x36\x82\x6d\x96\x32\x69\xc9\xdf\x2c\x65\xaa\x88\xfe\x48\xfe\x55\x11\xa5\x7f\xb2\x8f\x45\xe2\xe8\x75\xa5\x97\x42\xd2\xff\x72\x6f\x6f\xc6\x10\x7d\xfa\xf1\xf5\xe5\x05\x28\xa2\x14\x15\xdc\xf9\xfb\x05\xd1\x6f\x85\xb8\xa3\x24\x8e\x54\x55\x12\xb9\xa0\x98\xeb\x5b\x0f\xe2\x3a\xc9\x28\x6a\xcf\xd8\x3c\x45\x70\x46\x1d\x10\x64\x84\x1b\xca\xdf\x3d\xf1\x50\x26\x1f\xff\xeb\xa7\x5f\x3e\xa4\xf6\x39\x88\x83\x3a\xed\x00\xb9\xb2\x31\x73\x5d\x7a\xfb\xa5\xdf\x1f\x09\x8b\xdd\x81\xde\x49\xe7\x6a\xe0\xb4\x63\x48\x8a\x10\x90\x44\x89\x4a\xe6\xe4\x76\x21\x71\xb9\x54\xe9\xaf\xee\xe5\x16\xd9\xe0\x55\xc9\x08\x08\x0e\x4b\x71\x0f\x5a\x40\xa5\x48\x47\xf5\xfd\xcd\x45\x29\x94\x36\xe2\x72\x9d\xcb\xae\x8a\x0f\x82\xd4\x84\xb4\x4a\xd7\xe7\x9e\x8d\xec\x8f\xf0\xae\x56\x45\xe3\x2f\xdd\x64\xe7\x54\x9f\x9c\x74\x80\x0b\x60\xc2\xc4\x4b\x57\x83\x9b\x04\x47\xc8\x9c\x00\x13\x0b\xca\x3b\x47\x31\x38\xea\x84\x35\xcb\xe0\xbb\xa3\x5e\x3f\xbc\xb9\x9d\x71\x57\x54\x71\x32\xd0\xef\xe8\x29\xf7\x08\x3a\xaa\xec\x1e\x83\xe8\x0b\xae\x89\x5c\x63\x16\xb7\x73\x63\x78\x79\x74\x74\x54\x97\xf8\x46\xe2\x2e\xcf\x7e\x4f\xf9\x9d\x72\x85\x3b\x76\x2d\x07\x37\x6e\x5f\xac\xdd\xb4\x0f\x5a\x6c\x6e\xfe\x7a\xcf\x12\x3b\xb9\x67\x61\x2e\xf8\x9c\xca\xd5\xcf\xa2\xc0\xfe\x15\x48\xba\x32\x9f\x9f\xf9\x89\x5b\xb7\xac\x5d\x60\x67\xdf\x34\x4f\xdb\x0c\x34\x61\x87\xc6\xea\x7b\x30\x8e\x9a\x0f\x78\xe5\x9f\xf3\xb9\xb7\x22\xcf\xec\x9c\xc7\x7a\x6b\xaf\xc1\xbb\xcb\xde\x3b\x73\x78\x1e\xa3\x8a\x79\xe0\xee\xeb\x3f\x3b\xf6\xd6\x51\xf7\x46\xd7\xaf\x7c\xba\xe4\xde\xce\x34\x0f\x9f\x04\x99\x64\x9e\x14\xc6\x44\x2e\xce\xeb\xe6\x85\xe5\xb4\x73\x57\x82\xc7\xc8\x5e\x90\xda\x66\x4b\x91\x76\x4b\x80\x20\x25\x6e\xde\x27\x40\xef\x4d\x41\x7d\x15\x9a\x2f\x29\x2b\x24\xe1\xfe\x81\x52\x4b\x03\x58\x13\xbe\x38\xaf\x5f\x10\xd8\x90\x50\x04\x37\x6d\xee\x5d\x83\xc6\x72\x41\xcc\xaf\x85\x63\x5c\x06\xe8\xe2\xc3\xe5\xdf\xae\x82\x2e\xc8\x64\x62\x22\x15\xd5\x0a\x38\xd6\x95\xc4\xcc\x84\x2b\xbe\x48\xd3\xb4\xdb\x3f\xf3\xbb\xda\xe7\x0a\xf6\x22\x3d\xb2\xd5\x0b\x29\xa2\x31\x7c\x33\x34\x9c\x24\x06\xb7\x16\x8b\x05\x23\xa3\xc0\x26\x87\xd9\x07\xbe\x77\xd5\xe3\x16\x4c\xdd\x96\x27\x1e\x2b\x4a\x7c\x81\x51\xb3\x30\x5f\x6e\xc6\xcd\x3b\xc4\xf6\x54\xbd\x4d\x5c\x4d\x5c\x83\xb9\x1e\x15\x84\x85\x85\xb1\x70\xca\x17\x8c\xd4\x05\x69\xc3\xc8\x3e\xa6\xc1\xc7\x18\x81\x75\xf5\xe8\x63\x9b\x71\x30\xbb\xeb\xac\xed\xdd\xe5\xf3\x38\x00\xe9\xf6\x58\x4a\xf7\xcc\xb4\x53\x22\xa0\x09\xf2\x49\xd8\xc5\xb9\x7d\x2d\xc7\xef\xfc\x8b\xd5\xc0\x28\xc3\x58\x6d\x21\x5c\x3e\x60\xaa\x0e\x34\xb6\x68\x93\xb0\xbc\xda\x3d\x87\xeb\x3c\x9a\x42\x2f\x8e\xea\x32\x34\x7a\x04\xf2\xad\xc9\x19\x77\x41\x7b\x0a\x14\xae\xeb\x50\x84\x9e\xa1\x41\xe4\xb8\x4b\xc0\x18\xbe\x40\x0c\x2e\x8a\x7d\x94\x34\xa2\x7e\x13\xb6\x1e\xbe\x24\xe9\x29\x1c\xb5\x62\xeb\x3b\xc6\x2f\x70\x69\x0f\xf8\xd3\x58\xb5\xb3\xf8\x29\xac\xd8\x5d\xf4\x08\x3f\x3a\x0f\x4b\xce\xa9\x2a\x19\xde\xda\x1b\x2b\x17\x49\x7c\x9a\x40\xb9\x73\x92\x26\x6c\x3b\x9e\x39\xbd\xb5\x2e\x6e\x34\xb0\xa5\xe0\x71\x64\x27\xa3\xf1\xee\x15\x75\x47\xed\xad\x97\x0b\xbc\x96\x55\xd4\x25\x56\x8e\xe2\xf6\x76\x21\x79\xe2\x9b\x2c\x1c\x06\x09\x8b\xcc\xbd\xee\x70\x80\xbd\x38\xe2\xe6\xda\x25\x49\x5d\x4f\xf8\x4e\x51\x43\x55\x88\x35\x03\x74\x6e\x73\xf6\xc0\x85\xf6\x42\x48\xcb\x72\x34\xd3\xbc\xae\xf7\x1b\x09\xf5\xa9\x68\xa1\xc3\xf6\xc0\xb0\x4a\xf4\x77\x0a\xd5\xe9\x49\x9b\x75\x16\x0c\xed\xe7\x59\xd0\xc4\xce\xf6\x9a\x04\xda\xf6\x4e\xcf\x52\x02\x29\x2b\x62\x1c\x1e\x2d\x7a\xcc\xb1\x98\x9a\x2c\x1e\x9d\x31\x3a\xb5\x2e\xac\x30\xde\xcc\x5e\xf8\xa0\xa4\xef\x92\xfb\x67\xdd\x95\xd6\x10\x94\xf3\x83\x2b\xa2\x97\xa2\x40\xe3\xd0\x37\xfa\xb1\xe4\xd1\x85\x3b\xb9\x4d\x17\xc7\xee\x74\x52\x93\x1b\x66\x3e\x2e\xe7\x69\xee\x96\xfa\x2a\x1b\x18\xdd\x2f\x8d\x49\xf9\xf5\xd8\x3d\x95\xa2\x9a\x48\x57\x3a\x4a\x51\x2d\x96\xf5\x3d\x0f\x2f\x60\x85\xef\x4c\x7e\x6d\x8b\x0e\xe3\xbb\xfa\xe7\x78\xd4\xf8\x42\xcd\xb6\xf6\xc2\x88\xd4\xa4\x80\xac\x26\xee\xeb\xa5\xdc\x34\xfe\x3a\xf5\x14\xd8\x0e\xe7\xc9\xe3\xe2\x09\x42\xdd\x13\xea\xad\xff\xb9\x8a\x2b\xa8\x1a\x7c\x65\xd8\x7b\xaa\x3e\x69\x94\xf7\xa9\x5a\x14\x1c\xf5\x4b\x85\x88\xf3\x39\xdf\x78\xd1\xf4\x2f\x90\xec\xb0\xab\x2d\x24\x51\xa5\xe0\x8a\x5c\x91\x8d\xee\x14\xe6\x0f\xa3\x1e\xbc\x95\xb0\x96\x15\x19\x3c\xe1\x6e\x5d\xd9\x6b\x67\xda\xd7\x0e\x46\xe6\xee\xf2\x39\xcb\x76\xd2\xab\xdd\x37\x9f\x00\xbb\xb5\x4a\x4b\xdf\x6e\xa9\xd3\xb7\xfe\x01\x73\x42\x4b\x5a\x90\xf0\xed\xba\xd5\xe7\xe3\xd4\xc4\xa5\xbc\xb9\x61\xf0\x41\x69\x32\x71\xd3\x2f\x53\x58\xb8\x2b\xc8\x9a\x66\x6b\x4b\x60\x15\xda\xd8\x93\xd2\x42\x12\x58\x30\x31\xc3\x8c\x6d\x83\x95\xdf\xa6\xe6\xe0\xd6\xfd\x8d\xa1\xe2\x87\x3e\x18\x85\x21\xd0\xc6\xc6\x42\x8a\x12\x0a\x71\xcf\x83\xb5\xfe\x3d\x65\xfd\xd5\xd1\x38\x14\x3b\x03\x32\x5d\x8c\x2d\x45\xa9\xa0\x2a\xc7\xa0\xf0\x56\xd5\x4b\x4c\x5d\x03\xf7\x54\x2f\x5d\x75\x2f\xe6\xf6\x2d\x4f\xe7\x40\xea\x4b\xbb\x3f\xee\x5f\x0c\xbe\x9e\x8f\xc1\x75\xef\xc4\x5a\x5b\x97\xd6\x7b\x4c\xb5\xff\x5b\x2c\xa7\x83\x10\x2b\xca\x73\x02\x58\x6d\x79\x9e\xb8\x62\x7f\x4d\xe4\xd6\x22\xed\x32\x35\xd4\x8a\x60\xe6\xbb\x14\x72\x26\x14\x71\x7c\x08\xfe\x7c\x86\x89\x45\xf3\x97\x15\xcf\x98\x58\x44\xcd\x5f\xc2\xb8\x99\x7e\x3f\xaf\x7d\x2a\xf7\x5e\x2c\xd4\xf0\x2b\xb9\xe7\xe9\x82\xe8\xc0\x59\xfd\x1f\x77\x3f\x68\x82\x4b\x3a\x59\x1f\x4d\x98\x58\xa0\xc7\x0c\xb7\xf6\x25\x01\xd5\x66\x08\x32\xf0\x1e\xc3\xbd\x57\x9d\x5c\x7f\xde\x1c\x1d\x1d\x7e\xde\x7c\xff\xc3\xcd\xe7\xeb\xcf\xc5\xc1\xea\xe2\xc3\x0f\xbf\x74\x06\x8f\x56\x93\xc5\x18\x50\xf8\xe7\x17\x91\xcd\x17\x28\x9f\x8b\x68\x6a\xe0\x7d\xcf\x1e\x82\x76\xdd\x53\xb7\xfb\xc7\xeb\x8f\x1f\x9e\xb6\x9d\x6f\x5f\x47\x53\xb3\xe4\x0f\xec\xf8\xee\xe3\xc7\x27\x1e\xd0\x25\x44\xd1\xd4\xac\xf8\x03\x1b\x9e\xbf\x7b\xf3\xb7\xa7\x6d\xb8\xaa\x34\x29\xa2\xa9\x59\x10\xec\xd7\xe9\x14\x59\x3d\x5f\xea\x15\xab\x9b\x79\xfb\x1d\x68\x93\x73\xd4\x16\x10\x87\x35\x6e\x2e\x05\x63\xa0\x05\xcc\x84\xd6\x62\x65\x32\x6e\x63\xea\xa0\x89\x5c\x51\x8e\x99\x3b\xa7\xdf\x10\x73\xba\xc2\x9a\x34\x76\xa2\xec\xea\x2b\x51\x9e\x34\x24\xd9\xbb\x79\xdf\xba\x7a\xb0\x5d\xa7\x7a\xb7\x81\xc6\x94\x58\xa8\xa6\x31\xe5\x52\x4d\x43\xf5\x7f\x07\x00\x00\xff\xff\xcf\xc8\xb9\xff\xf6\x39\x00\x00")
+var _uiAssetsJsIndexJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x3b\x6b\x73\xe3\x36\x92\xdf\xf5\x2b\x3a\x1c\xdf\x88\x5c\xcb\xa4\x3d\xc9\xdd\x56\xd9\x96\xa7\xe6\x91\x4d\xbc\x95\x4c\xe6\x32\xde\xbd\x0f\x8e\xd7\x05\x89\x90\x84\x18\x02\xb8\x00\x28\x5b\x97\xf5\x7f\xbf\x6a\x3c\x48\x90\xa2\x3c\x4e\x2e\x7b\x57\x6b\x4f\x8d\x49\xa0\xd1\x68\xf4\x0b\xdd\x0d\xb0\x28\xe0\x6a\xc5\x34\x2c\x18\xa7\x30\x97\xc2\x10\x26\x34\x10\xce\xc1\xac\x28\xac\xa9\xd6\xb0\x90\x0a\x14\x15\x25\x55\x4c\x2c\x81\x33\x6d\x60\xc3\xe8\xbd\x1e\x8d\x0e\xd2\x45\x2d\xe6\x86\x49\x91\x66\xf0\xcb\x68\x04\xb0\x21\x0a\x0c\x99\x71\x0a\x53\x38\x48\x13\xfb\xf8\x82\x19\xba\xbe\xc5\x71\x49\x36\x19\x81\xfd\xa9\xd9\x5b\xa2\xe9\x47\x62\x56\x30\x75\x03\xf2\x92\x18\x92\x26\x35\x3b\x9a\x11\x4d\x8f\x2a\x62\x56\x2d\x38\xa9\xf6\xc0\x8f\x49\x15\x0d\x18\x37\x03\x16\x8c\xf2\x52\xf7\x60\x5d\xe3\xd1\xcf\x5a\x8a\x16\xd2\xac\x28\x29\x2d\xb5\xe3\x73\xfb\x7c\x11\xf5\xcd\x64\xb9\x0d\x7d\xf8\x1c\xf5\x55\x64\xc9\x04\x31\x52\xb9\xfe\x9a\xe7\xbe\x85\x21\xf6\xb3\x11\x72\x03\x99\x8b\xf3\x03\xe2\xa5\x4a\x7b\x06\xb9\xb7\x2b\xf5\xad\x59\x73\x98\xc2\xf8\xdc\xa8\x8b\xf1\xd9\x08\xba\x1d\x87\x53\x48\xce\xcd\xea\xe2\xbc\x30\xab\x8b\xe4\x0c\x8a\xc2\x4a\x62\xbe\xa2\xf3\xbb\x99\x7c\x18\x00\xc7\x05\x5c\x5c\xbe\xb7\x03\xc6\x67\x48\xc0\x41\x4e\xc9\x7c\x95\xba\x85\x4f\xa0\x91\xd6\xe2\x61\x02\xb6\x11\xc5\x06\xb0\x07\xd5\x18\x0e\x1d\x54\x6e\x98\xe1\x14\x0e\x61\xec\x70\x8f\x00\x1e\xb3\xb3\xd1\x3e\x12\x3e\x19\x62\x6a\x1d\xc8\x18\x02\x2a\xdc\x8a\x47\x9e\xfd\x39\xa9\x2a\x2a\xca\xf4\x20\x8d\x41\xb3\x0c\xfb\x91\x7f\xa1\xdf\x02\x67\x67\x3b\xcd\x28\x1a\xa4\x07\x7f\x1d\x8b\xe7\xb5\x52\x54\x98\x8f\x64\x89\x9a\x78\x72\xe6\x9b\xab\x35\x31\x73\x54\xa1\x7b\x26\x4a\x79\x9f\x73\x39\x27\xc8\x90\x5c\x53\xa2\xe6\xab\xdc\x76\xa7\x45\x35\x4d\x7f\x2a\x0f\xb3\xc2\x4e\xc5\x16\x90\xba\x71\x81\x59\x5d\xe4\x15\x51\x9a\x5e\x0a\xe3\x81\xae\x4f\x6e\xec\xb0\xc7\x40\x0a\x67\x6b\x66\x60\x0a\xaf\xfe\x3d\xa8\x8e\x5c\x2c\x34\xc5\xa6\x34\xc6\x74\x04\x27\x19\xfc\xc1\x81\x9f\x85\xc1\x0b\xc6\x0d\x55\x6f\x99\xd1\x9f\x25\xda\x81\xfe\x94\x5f\xff\x6d\x7a\x73\x38\xbd\xfe\xdb\xcb\x9b\xc3\x62\x99\x35\x98\x6a\x85\xba\x16\x1b\xd2\x21\x24\xaf\xed\x6c\xd3\x04\x0e\x3d\x99\x87\x90\xbc\x74\xe4\xd9\x46\xf7\x18\xb8\xd0\x12\x13\x38\x81\x48\x51\x4f\x5f\x22\x70\xdb\x9d\xff\x2c\x99\x48\xc7\x2f\xc7\x5d\x4e\x38\x7a\xdf\x12\x6f\x32\x4c\x54\xb5\x79\xe1\x1a\x67\x44\xb5\xa6\xe5\x9a\x2e\xe7\x52\x38\x40\x0f\xc3\xe6\xb1\xdd\xba\xd9\xde\xb3\x8d\x83\x29\xd9\xe6\x85\x6b\xd2\x38\xeb\x28\x60\x79\x4b\x54\xbe\x90\xf3\x5a\x33\xd1\x75\x56\xdd\x89\xf2\xb9\xd6\x69\x32\x97\x5c\xaa\x64\x02\xc9\x8c\x93\xf9\x5d\xe2\xa8\xcf\xce\x76\x91\xc9\xda\xfc\x0a\x6c\x2f\x66\xb3\x59\x8b\x2c\x78\x86\x1f\xad\x4b\x45\x03\x80\x52\xca\xa3\x92\x94\x1a\x7b\x02\x5a\xef\x72\xff\x64\xd7\xf4\x91\x71\x9e\xde\xd1\xed\x04\x36\x84\x87\xf9\x5a\xfd\xc0\x6e\xef\xa6\x74\x45\x04\xcc\x39\xd1\x7a\x9a\x38\x82\x8e\x1c\x4c\x72\x71\xae\x8d\x92\x62\x69\xcd\xfa\x8e\x6e\xd1\x9c\x4f\xcf\x0b\xdf\x08\xd8\xba\x21\x1c\x5b\x3b\x48\x96\x7c\x5b\x59\xd6\x43\xf3\x74\xa4\xe8\x5a\x6e\x68\x72\x71\x5e\x20\x64\xf8\x83\x7c\xef\x48\x26\xd8\x67\x4b\x64\xab\x0f\xcd\x32\x2b\x52\x7e\x0a\xcc\xed\xb1\x12\x65\x67\xe5\x52\x91\xb2\x64\x62\x79\xc4\xe9\xc2\x24\x93\x68\x82\x7b\x56\x9a\x55\x9a\xc1\x21\x9c\x1c\x67\x01\xf5\xb0\xaa\xb6\x28\x2b\x25\xab\x34\xa9\x38\x99\xd3\x95\xe4\x25\xb5\x32\x42\xf9\x44\xd4\xe3\xc8\x7c\x21\xd5\xd7\xd6\x7d\x7a\x5a\x5b\xac\x01\x69\x2c\x84\xef\xbd\x6b\x69\x80\xfa\x86\x99\x5a\xcb\xcc\xa6\xa9\xb5\x4d\xef\x5d\x10\xc7\x8e\xa4\x23\x84\xd7\x27\x37\x61\xc1\xee\xfd\xd5\x0d\x2e\xb4\x71\xc0\xd0\x63\xa0\x67\xb0\xd7\xb1\x37\x96\x6f\x7e\x3c\x6e\x3f\x45\x71\xf4\x3b\xfc\x74\x0c\xe2\x8e\x6e\xeb\xaa\xe5\x11\xdd\x50\x61\x7c\x34\x80\xf3\x01\x15\x86\x2a\xb8\xa3\x5b\xdb\x80\xc2\xb1\x20\xf9\x1d\xdd\xbe\x93\x25\x85\x2f\xa6\x70\xf2\x65\xcb\x50\x45\x4d\xad\x04\x2c\x08\xd7\x14\x17\xe3\x85\xea\x38\x6d\x5d\x06\x4c\xa3\xd9\x37\x84\xa7\x81\x13\x88\xdb\x42\xe4\x9c\x8a\xa5\x59\x35\x54\xb8\xc1\x77\x74\xfb\x57\x82\x4e\xd0\xc1\xe8\x8a\x33\x93\x8e\x4f\xbd\xbb\x08\x08\x1c\x94\xc7\x00\xd3\x29\x9c\xb4\xb4\x41\x8b\xe3\x3a\x11\x64\x4d\x93\x89\x6f\xb9\x3e\xbe\xb9\x09\xe2\x7c\x04\xca\x35\x1d\x40\xf6\xc5\x14\x5e\xc5\xc8\x76\x97\xda\x2c\xd6\x51\x8c\x6e\x94\xaa\xd0\x85\x08\xf7\x6c\x01\xed\x7a\x03\x6e\x37\x14\xac\x6f\x6e\x70\x3b\xc2\x86\x80\x5e\x27\x3b\x04\x14\x05\xfc\xb9\xd6\xc6\x06\x1d\xe8\xa4\x34\x31\x4c\x2f\x88\x95\xb2\x07\xe9\x8a\x21\x49\xb2\xb3\x9d\x8e\xbd\xc6\xb6\x47\xf5\x1b\x76\x36\x9c\xf5\xfb\xe9\x90\xaa\xfb\xe6\x61\xa6\x60\xa4\xe1\x17\x78\x08\x89\x33\x81\x1c\xf7\xa9\x66\x0a\x38\x84\x64\x1a\xb5\x9c\xdc\x78\x94\x96\x07\x8f\x6d\x10\xf7\x23\xfa\xbb\x7f\x92\x21\x1d\xa4\x63\x74\xa2\x79\xc7\x59\x43\xde\x77\xb6\xe3\x2c\x9f\x73\x36\xbf\x6b\x0d\x2d\x48\xfb\x29\x39\x4d\xc0\x8d\xb6\xbb\x4c\xc5\x38\x6f\x2c\xa9\x0a\x5b\x86\x59\x31\x9d\xe5\x15\xc1\x70\x29\xf5\x9c\xc6\xce\xdc\x8d\x6c\x9a\x76\xbc\x4c\xcb\x19\xea\xf9\x02\x15\x51\x64\x8d\x73\x72\x49\x4a\xa8\xc8\x92\x8e\x76\x8c\xcf\xe2\x36\xf4\xc1\xa4\x59\x63\x82\xd0\xd8\x20\x6a\xbd\xa0\xf7\xff\xb9\x3f\xda\x51\xd4\x2a\x53\xfa\x23\x5d\x7e\xfd\x50\xa5\xc9\xf5\x4f\xaf\x5f\xde\x3c\x4f\xba\x59\xec\xe7\x8b\xc2\x5a\x28\x4e\x96\x6b\x43\x94\xd1\xff\xc5\xcc\x2a\x4d\x5e\x26\x59\xc4\x59\x08\xd4\xe0\x9f\x5c\xd7\x33\x6d\x30\x0b\x4a\x4f\x5a\x16\x78\x83\xd9\xa3\x84\x6e\x64\xb4\xf7\x5b\x27\x6c\x23\xf1\xdd\xcd\xfe\x63\x93\x41\xa4\x98\x2e\x05\x3a\x90\x29\x46\x1a\xc2\x31\x9c\xc5\x40\xf0\x7b\x62\x56\xf9\x9c\x32\x6e\xc1\x72\xdb\x07\x85\x4d\xcd\x72\x1b\xc7\x85\x45\xe2\x0a\xa3\x91\x17\xce\x99\x79\xb3\xf1\xbc\xf6\x93\xda\x44\xa6\x49\x6a\xf2\x39\x97\x82\x46\x36\x86\xc0\x0d\x91\xf4\x3b\x26\xee\x60\xda\x90\x9f\x56\x81\xd4\x30\x69\x05\xe7\xd3\x88\xe6\xb8\x1b\x3a\x93\x86\x18\xe1\x20\x1d\x9f\x73\x86\x41\x48\x27\x28\x9e\x4e\xa1\x82\xd7\x30\x0e\xd1\x08\xaa\xf5\x86\x26\x63\x38\x85\xf1\x18\xf7\xfd\xf1\xc5\x39\x81\x95\xa2\x8b\x69\x82\x83\xa3\xbc\xf2\x10\xc6\xaf\xab\x29\x36\x56\x08\x97\x5c\x34\x8f\xe7\x05\xb9\x38\x2f\x38\xbb\x18\x67\x5e\x8c\xf8\xef\xd1\x3f\x3d\x9e\xed\x2c\xf9\x13\x45\xcd\x76\xb9\x5e\xb3\xe6\x78\x4d\xfb\x57\x14\x08\x2f\x99\xc6\x4c\xa6\x4c\x5a\x7a\x5f\x24\x17\x79\x9e\x0f\x11\xf3\xd8\xb0\x7d\x58\x80\x61\x5a\xb6\xe8\x72\xab\xd7\xfd\x14\x5d\xcf\x60\x5b\x07\xb5\xcd\x4e\x90\x8d\x40\x14\x23\x47\x9c\xcc\x28\x9f\x26\x1f\x15\xdd\x30\x59\x6b\x0c\x2e\x31\x66\xb4\x7d\x2b\x56\x96\x54\x4c\x13\xa3\x6a\x9a\x5c\xbc\xe4\xe4\xef\xb5\x3c\x6b\x42\xc5\x41\xd6\xf7\x37\xa6\xdf\xcc\xd1\x7f\x0a\x75\xfe\xe9\x71\xb4\x47\x2a\xe7\x53\xf8\x8f\xc8\xae\xbc\x63\x44\xc5\xb1\x85\x14\xf4\x83\xba\xe9\x43\x37\x9d\xa2\x6a\x55\x36\x31\x85\x9e\xa5\x9c\x41\x75\x78\xd8\x15\x62\xd7\xec\xd2\xaa\x43\xda\x6e\xcc\x11\x4b\xed\x7c\x0a\x18\x5a\x21\x41\x44\x94\x70\x31\x85\x3f\xba\xb9\xac\x73\xd6\xa3\xa7\xa9\xea\xa0\x3a\x84\x93\xec\xd7\x12\x17\x9e\x7a\x56\x14\xf6\x8f\x81\xf1\x2d\x23\xb2\xb3\x81\xb5\xc5\x5c\x3f\x8a\xf3\xf0\xec\xf9\x4b\xed\xcd\x78\xb2\x43\xcc\x20\x9d\x1d\x06\xc5\x7c\x39\xfa\xdd\x85\xf8\xcb\xff\x96\xd6\xde\xa8\x1e\xb5\xcf\x02\x7c\x1e\xb6\x43\xf8\x6d\x14\x0d\x4a\x79\xf4\xdb\x7c\xde\x79\xc4\xf7\xec\x99\x2e\xe4\xd7\x3a\xbf\xc3\x41\xe7\xf7\x81\x3e\x98\xa7\x5c\x8b\xfa\x7f\x73\x7c\xbf\x07\x65\x3b\x4e\xaf\x8d\x0d\x7c\x08\x66\xe3\xa5\x98\xdc\x20\xcc\xbe\x1e\xb7\x23\xe9\xba\x32\xdb\x26\xaa\x78\xf4\xb9\xea\x13\x11\xd1\xa5\xa1\x6b\x9d\x62\x01\xb9\x49\xe4\xdb\xa8\x93\x88\x2d\x08\x29\x8e\xea\xaa\x24\x86\x96\x60\xc1\x20\x25\x5a\xd7\x6b\x8c\xd6\xcd\x8a\x6e\xc7\x8a\x42\x49\x39\x35\xb4\x74\x79\xf3\x41\x3a\x36\x2a\x47\xd0\x6b\xac\x20\x1f\x49\x5e\x1e\xe1\x9b\x67\xce\xcd\x38\xeb\x45\xbe\x45\x01\xdf\x13\x75\x07\xf4\x81\x69\x83\x68\x11\x5a\x03\xd1\x20\x79\xe9\x51\x26\x1e\x65\x92\xe5\xc4\x18\x95\x26\x1d\xd4\x58\x5f\xb0\xc8\x43\xf5\xa7\x29\xcc\x5a\x54\x51\x5d\x96\x4d\xec\x22\x7a\x41\x5a\xe5\xf7\xb1\x2b\x5f\x38\x33\xca\x95\xd4\x51\x79\xf1\x21\x67\x65\x60\xa9\x65\xe5\x37\xc4\xac\x28\x96\x25\x28\x2f\xb1\x60\x14\x36\x1f\xf4\xef\xb6\xf1\xaf\x84\x63\x18\x79\xdd\xa4\xac\xcf\xaa\x13\x7b\x58\x87\x67\x43\x78\x33\x65\x28\xb9\xd8\x4a\xf1\xb6\xa2\x98\x35\x27\xf6\xf5\x76\x43\x38\xae\xbb\xa3\xe1\x3e\x1d\x40\x0a\x2c\x50\xee\xfe\x77\xc9\x40\x92\xb7\xc9\x21\xfe\x6e\x5c\xc6\x6e\xe8\x3a\x6e\xf5\xf4\x22\x96\x88\xda\xbb\x07\x9b\x34\x76\x67\x0b\x28\x36\x84\x5f\xdf\xd1\x6d\xb3\x64\xfc\xf7\x98\xf5\x2d\x71\x60\x21\x15\x55\x73\x2a\x0c\x59\xba\x75\x44\xc3\x71\x21\xa2\x5e\xd3\x10\x1a\xa2\x2c\xae\xdd\xe8\xa6\xf9\xd6\xbe\xdf\x84\xaa\x65\xf8\x29\xa9\x90\x6b\x26\x76\x07\x46\x1d\x7b\x86\x7a\x7a\x42\x1a\xa0\x64\x2d\xca\xb4\x25\xe3\x0f\x70\x72\x7c\x0c\x45\x3c\x43\xab\x1c\x2d\x3b\xc6\xe7\x25\xdb\x04\x8f\x52\x29\xb9\x54\x54\xeb\x04\xb4\xd9\x72\x3a\x4d\x56\x94\x2d\x57\xe6\x14\x4e\x8e\xab\x87\x33\x58\x13\xb5\x64\xe2\x14\xbe\xaa\x1e\xe0\x18\x7f\x6d\x2c\xdd\x23\x0b\x86\x51\x1e\xcd\xac\x02\xb7\x2f\x47\x4c\x2c\x64\x02\x4a\x72\xda\x42\xcd\x88\xf2\x1e\xcc\x2a\x8c\x90\xf7\xce\x35\x87\xb5\xb6\xbe\xd7\xf6\xaf\x99\x98\x26\xc7\x9d\x16\xf2\x30\x4d\x4e\x8e\x8f\x9b\x15\xd8\xca\xe0\x29\xf4\x90\xfc\xdb\x3e\xca\x8b\x92\x6d\x9e\xec\x1a\xd8\xae\xfd\x29\x13\x1a\x53\x5e\xd5\x7a\x95\x6e\x08\x6f\x59\xdd\xd4\xe7\xac\x49\xbe\xad\x19\x2f\x01\x0f\x7a\xe0\xdb\xab\xef\xbf\xf3\x1d\xa8\x40\x26\x3e\x06\x0a\xdc\x43\x4d\x4a\xa0\xe7\x9f\x6c\x49\x2c\x01\x56\x4e\x93\xbe\xf9\xfb\x04\x27\x9e\xf1\x9d\x3f\x27\x82\xb9\xe4\xf5\x5a\x40\x8a\x11\xcc\x0c\x4b\x88\x80\x49\x94\x14\x3a\x28\x7f\x51\x58\x8b\x0e\xdb\x4a\x33\xd0\x29\x74\xae\xed\x69\x0e\xbc\x86\x04\x9a\x9d\x07\x4e\x21\x69\x2a\x46\x7b\x06\x27\xee\xcc\xea\xea\x87\xf7\x3f\xc0\x3d\x85\x52\x82\xa0\xb4\x04\x2d\xd7\x14\xac\x71\xc9\x85\x1f\xc6\xc4\x72\x02\xb3\xda\x40\x29\xd1\xbf\x62\x45\xc2\xfa\x3c\x2a\x8c\x76\xce\x1b\x9b\x57\x44\x2c\xf1\x6f\x49\x2b\x2e\xb7\x7e\x6e\xcf\x3d\x77\xf2\x54\xc6\xec\xbb\xd5\x94\xd3\xb9\x91\x2a\xb9\x38\xb7\x05\x3f\x3b\xe9\x34\x09\xe7\x67\x8e\x91\x7d\x1e\xe2\xfb\xce\x5a\x6c\x96\x59\x98\xb2\xcb\xe0\xcb\xf7\x9e\xb5\x83\xa4\x3c\x15\x62\x14\xbb\x92\xeb\x36\xb8\x60\xa1\x3f\xe1\x1b\x63\x14\x9b\xd5\x86\xfa\x79\xf5\xa8\xe3\x0c\x1b\x65\x8c\x3c\xe2\xe6\xa1\x73\x62\x30\x40\x66\x5c\xf6\xf7\x33\x0e\xe9\xef\x27\xa7\x04\x9d\x05\xa3\xdc\x9d\x72\x5c\xe1\x01\x2a\xa2\x6b\x46\xa3\x1f\x8d\xb4\xa7\xe3\x38\x7b\x7d\x39\x55\x4a\x2a\x78\xf9\x32\x56\xb7\x5c\x51\xa3\x18\xd5\xe8\x81\xe3\xe6\x35\x79\xb8\xf5\x5d\x3d\x67\xdc\x50\x82\x02\x40\xee\x7b\x5d\xc0\x52\xd3\x51\x49\xc4\x92\xaa\xe4\x57\x48\xe4\x4f\x84\xf1\x5a\x51\x94\xc4\x78\xd4\xdf\x25\x3a\x13\x23\x1b\xec\x51\x0f\x74\x49\x2d\xa9\x9e\x2b\x56\xa1\x1c\x70\x15\x49\x50\xe4\x04\x4d\x29\x10\x74\x0a\x89\xf5\x87\x67\xfb\x56\xd2\x59\x06\x52\xe9\xa6\x0a\xf6\xbe\x67\x94\x0d\xfd\x5a\xad\x1a\xa0\xc8\xca\xdb\x41\xc5\x58\x8a\x02\xbe\x93\x78\x04\xbb\x17\x33\x6e\x1b\x68\x39\x73\xa6\xe6\x9c\x7e\x93\x5c\xf4\x5b\x6e\x4f\x92\x40\x74\x0b\x63\xfd\xeb\x0e\xe4\xab\x67\x43\x7e\xb9\x17\xd2\x62\x8e\x45\x34\x1a\x0d\x13\x6e\xb5\x7b\x34\xea\x08\xb2\x51\xc5\x8a\x68\xcd\x36\xf4\x76\x40\x5d\x77\xe4\xdb\x85\xbd\x95\x77\x64\x8b\x22\xd5\xf5\x7c\x8e\x7b\xe8\x29\x24\xf7\x44\x09\x26\x96\xc9\xd9\x30\x29\x61\x8f\x92\x15\x99\x33\xb3\x3d\x85\xe3\xfc\x8f\xc9\x93\x82\x86\xc3\xa1\xa9\x63\xa3\xed\xae\xeb\x97\xe1\x89\x1b\xa7\xe2\x81\x47\x7d\x97\x10\xa0\xcf\x46\x3b\x5d\xed\xa1\x7d\xb7\x7c\xe8\x83\x52\xe7\x56\x5a\x9f\x81\x9c\x6d\xe3\x56\x7f\x02\xd2\xe1\x6b\x51\xc0\x7b\x29\xc6\x06\x7c\x3a\x01\xa9\xdd\x25\xb0\xe5\x9e\x08\x03\x0b\x4e\xf4\x8a\x89\x65\x86\xd1\x25\x33\xb0\x22\x1a\xfb\xe6\xb8\x0f\xd0\x32\x8f\xf1\x7c\x2b\xef\xe9\x86\xaa\x09\x6e\x32\x2e\x19\x70\x9b\x08\x69\x3c\xa6\x96\xc0\xcc\x58\x83\x90\x26\xa4\x03\x2d\x86\x2e\xad\xd7\xc7\x37\x39\x13\x82\x2a\xdc\xa8\xd1\x72\x05\xbd\xef\xb5\x76\x23\xcc\x68\x99\x7b\x82\x7f\xb7\x73\xef\x04\x9b\x31\x92\xa2\xc0\xdb\x37\x5a\x72\x9a\x73\xb9\x4c\x93\x1f\xbf\xfe\xf8\xdd\x9b\x77\x97\x1f\xbe\xe9\x86\xc3\xd1\x5c\xbd\x2c\xec\x4a\x0d\x65\x6f\xfd\x89\xfa\xd3\xbc\xf9\xf8\xf1\xeb\x0f\xef\xed\x34\x0d\x8c\x99\xc9\x72\xdb\xa6\x9e\x5e\xb2\x0d\x76\x6f\x60\x8f\xbd\xa3\xc6\xe1\xfc\xed\x3b\xa6\x4d\x73\x52\x71\x90\x93\x9f\xc9\x43\x1a\xa8\xa9\x15\x3f\x85\x5a\xf1\x10\xe0\xce\xe8\x42\x2a\xfa\x89\x8a\xf2\xb4\xdd\xc1\x1e\x56\x2a\x6b\xc9\x7f\x58\xa9\x5c\x53\xf3\x23\xfd\x7b\x4d\xb5\xf9\xd6\x5e\x16\x49\xc7\x6f\x6a\xb3\x92\x8a\xfd\xb7\xad\x9c\x8f\x27\x30\xfe\xf4\xcd\x9b\x8f\x97\xa0\xa9\xd6\x4c\x0a\xb7\x03\x2f\xa9\x79\x27\xe5\x1d\xa3\xe9\x58\xd7\x15\x55\x4b\x46\x84\xb9\xf5\x20\xae\x92\x9c\x84\x03\x6c\x80\xc7\x40\x93\x37\xea\x88\x20\x14\x6e\x2c\x7f\x74\x0f\x58\x78\x87\x29\xfc\xf9\xd3\x0f\x1f\xf0\x24\x45\x53\x07\x15\xb0\xf9\xb3\x4b\xcc\xf5\x60\xea\xaa\xf4\xe8\x48\x74\xdb\xbf\x9b\xec\xf6\xfb\xfa\x47\x03\x8d\xa1\x59\x99\x6a\x4a\x41\x51\x2d\x6b\x35\xa7\xb7\x4b\x45\xaa\x95\xce\x7f\x76\x37\xb7\xe8\x03\x59\x57\x9c\x82\x14\xb0\x92\xf7\x60\x24\xd4\x9a\x36\x63\xa3\x33\xc5\x4a\x6a\x83\xe2\x72\xf7\x12\xe2\x25\x02\x0c\x82\x04\x42\x00\x3a\x8a\x11\x71\xcf\xee\xec\x4f\xf0\x2e\xa8\x22\xfa\x4b\xd7\xd9\x59\xd5\x27\x27\x1d\x10\x12\xb8\xc4\xfd\xd2\xe5\xe0\x98\xa0\x4a\x35\xa7\xc0\xe5\x92\x85\x60\xc4\x2d\x05\x71\xf8\xdd\x0e\x2d\xf7\xab\xe3\x5e\x3d\xbc\x39\x9d\x71\x47\x54\x69\x64\x92\xfe\xe9\xb1\xaf\xdc\xa3\x20\x01\xe4\x4d\xea\x2f\x83\x98\x4b\x3c\xd4\xc6\x43\xcf\xb6\x6f\x02\xaf\x8e\x8f\x8f\x43\x8a\x8f\x12\x77\x71\x36\xd6\xe0\x30\xd7\x3d\x48\xc7\xc4\x95\x1c\x5c\xbb\xbd\xb1\x76\xd3\x5e\x68\xb1\xb1\xf9\x9b\x3d\x43\x6c\xe7\x9e\x81\x73\x29\x16\x4c\xad\xbf\x97\x25\xf1\xb7\x40\xf2\x35\x3e\xbf\xf0\x1d\xb7\x6e\x58\x3b\xc0\xf6\xbe\x6d\xae\xb6\x21\x34\xe5\x47\x68\xf5\x3d\x18\x47\xcd\x07\xb2\xf6\xd7\xf9\xdc\x5d\x91\x17\xb6\xcf\x63\xbd\xb5\xc7\xe0\xdd\x61\xc8\x0d\x37\xa0\xe6\x1e\xb8\x7b\xfb\xcf\xb6\xbd\x73\xd4\xbd\x35\xe1\x96\x4f\x97\xdc\xdb\x99\x89\x48\x76\xc1\x3c\x2d\xd1\x44\x2e\xdf\x87\xe2\x85\xf5\x3d\xce\x5d\x49\x91\x26\xf6\x80\xd4\x16\x5b\xca\xbc\x9b\x02\x4c\x60\xf7\x7e\x42\xff\x4e\x41\x38\x0a\x9d\xaf\x18\x2f\x15\x15\xfe\x82\x52\x4b\x03\xd8\x3d\xf8\xf2\x7d\xb8\x41\x60\xb7\x84\xb0\x55\xb6\xf7\x1a\x0c\x51\x4b\x8a\x7f\x96\x8e\x71\x53\x48\x2e\x3f\x7c\xfc\xcb\x55\x54\x05\x29\x0a\xdc\xa9\xf0\x52\x97\x20\xa6\x56\x04\x2f\x5b\x32\xb1\xcc\xf3\xbc\x5b\x3f\xf3\xb3\xe2\x64\xee\x20\x7d\x6c\xb3\x17\x5a\x8e\x27\xf0\xc5\x50\x73\x96\xa1\x37\x30\x72\xb9\xe4\xb4\x3d\xc9\xde\xc7\xbe\x50\xbb\xea\x71\x0b\x2e\xdc\xfa\x4e\x3d\xd6\x24\xf3\x09\x46\x60\xe1\x7c\xf5\x30\x81\x90\x47\xb5\xab\xea\x4d\xe2\x72\xe2\x00\xe6\x6a\x54\xc1\xb6\x42\x61\xed\x13\x13\x4b\x4e\x43\x42\xda\x30\xb2\x8f\x69\xf0\x32\x46\x64\x5d\x3d\xfa\xf8\xc3\x24\xb2\xbd\x5d\x67\x6d\xcf\x2e\x0f\xd2\x08\xa4\x15\x31\xfe\xa2\x7d\xc1\xb4\x9b\x22\x24\x45\xe2\x53\x84\xcb\xf7\xf6\xb6\x9c\xb8\xf3\x37\x56\x23\xa3\x8c\xf7\x6a\x9c\xc6\x17\x03\x31\xeb\x48\x26\x16\x6d\x03\xf0\xd8\x3c\xb5\x64\x68\x5f\x79\xc4\x44\x0f\x6f\xb4\xb9\x34\x74\xfc\x04\xe4\x3b\x8c\xb3\x77\x41\x7b\x0a\x14\x8f\xeb\x50\x94\xbc\x48\x06\x91\x93\x2e\x01\x13\xd8\x99\xa1\x07\x5f\x96\xfb\x28\x69\x44\xfd\x36\x2e\x3d\x7c\x4e\xd2\x17\x70\xdc\x8a\xad\xef\x18\x3f\xc3\xa5\x3d\xe0\xcf\x63\xd5\xce\xe0\xe7\xb0\x62\x77\xd0\x13\xfc\xf0\x06\xe0\x82\xa6\xf7\x4c\x57\x9c\x6c\xed\x89\x95\xdb\x49\x7c\x98\xc0\x84\x73\xa5\xb8\x6d\x3b\x4e\x5b\x95\x03\xeb\xe2\x46\x03\x53\x4a\x91\x8e\x6d\xe7\x38\xf2\x75\x81\x87\x1d\xb5\xb7\x5e\x2e\xf2\x5a\xd8\x91\xaf\x88\x76\x14\xb7\xa7\x0b\xd9\x33\xef\x64\x91\x78\x93\xb0\xc8\x30\x57\x0d\xd5\xf3\xde\x3e\xe2\xfa\xda\x21\x9e\x15\x10\x2a\x45\x0d\x55\x31\xd6\x29\x24\xef\x6d\xcc\x1e\xb9\xd0\xde\x16\xd2\xb2\x3c\x99\x19\x11\xf2\xfd\xec\x2c\x86\x8e\xa8\x68\xa1\xe3\xf2\xc0\xb0\x4a\xf4\x67\x8a\xd5\xe9\x59\x93\x75\x06\x0c\xcd\xe7\x59\xd0\xec\x9d\xed\x31\x49\x54\xde\xe9\x59\x4a\x24\x65\x4d\xd1\xe1\xb1\xb2\xc7\x1c\xdc\x85\xdb\x28\x3e\x39\xe7\xec\xc2\xba\x30\x2c\x70\x24\xf6\x28\x2a\xc9\xfa\x2e\xb9\xbf\xd6\x5d\x69\x0d\x41\x39\x3f\xb8\xa6\x66\x25\xcb\x64\x12\xfb\x46\xdf\x96\x3d\x39\x70\x27\xb6\xe9\xe2\xd8\xed\xce\x02\xb9\x3e\x62\xb0\x91\x0f\x46\x31\xd1\x5d\xc0\xbe\xca\x46\x46\xf7\x43\x63\x52\x7e\xbc\xcd\x1b\xec\xc9\x8b\x72\xa9\xa3\x92\xf5\x72\xe5\x2d\x11\x4d\x73\x4d\xee\x28\x28\x97\x74\xe8\xd1\xee\x3a\x9e\x34\xbe\x58\xb3\xad\xbd\x70\xaa\xf0\xb4\x6a\x1a\x88\xfb\xf5\x52\xf6\x62\xee\xe5\x53\x60\x2b\x9c\xa7\x4f\x8b\x27\x8a\x66\x9e\x91\x6f\xfd\xdf\x65\x5c\x51\xd6\xe0\x33\xc3\xde\x55\xf5\xa2\x51\xde\xe7\x6a\x51\xb4\xd4\xcf\x25\x22\x2e\x7e\xfb\xc2\x8b\xa6\xdb\x03\x60\x9b\x6d\x7e\x92\x2b\xaa\x2b\x29\x34\xbd\xa2\x0f\x71\xea\xd3\xe6\x0f\x0d\xbc\x95\xb0\x51\x35\x1d\x5c\xe1\x6e\x5e\xd9\xa9\x8d\x38\x7a\x70\x77\x7c\xc0\xbb\xcd\x19\xfa\xc0\xe1\xad\xb2\x4f\x6b\x3f\x57\x09\x3f\xde\x61\xc7\xa4\x3e\xf6\xad\x7f\xc0\x9c\x92\x15\x2b\x69\x7c\x77\xdd\x5a\xd0\x49\x8e\xfb\x52\x08\xed\x9a\x4d\xa9\x28\xec\x7f\xf0\x2a\x87\xa5\x3b\x82\x0c\x34\x5b\x5b\x02\xab\xd0\x68\x4f\xda\x48\x45\x61\xc9\xe5\x8c\x70\xbe\x8d\x46\x7e\x99\xe3\xc2\xad\xfb\x9b\x40\x2d\x8e\xfc\x66\x14\xec\x15\x3d\x8b\x06\x26\xa0\x54\xb2\x82\x52\xde\x8b\x68\xac\xbf\x4f\x19\x5e\x1d\x8d\x43\x7b\x67\x80\x78\x95\xfb\x3d\xb6\x92\x95\x86\xba\x9a\x80\x26\x5b\x1d\x86\x08\x0c\xde\xef\x99\x59\xd9\x84\x1d\xe4\xc2\xde\xe5\xe9\x2c\x48\x7f\x6e\xf6\xa7\xfd\x0b\xe2\xeb\xf9\x18\x3f\xb7\x77\x35\x11\xbe\x57\x39\xdc\x13\x66\xfc\xb7\x58\x4e\x07\x21\xd5\x4c\xcc\x29\x10\xbd\x15\xf3\xcc\x76\x61\x15\x6c\x6b\x69\x8b\xc6\x7e\x99\x77\xb4\x22\xea\xf9\x2a\x87\x39\x97\x9a\x3a\x3e\xf8\x6c\x17\x7d\x14\x97\xcb\xe6\xcb\x8a\x17\x5c\x2e\xc7\xcd\x97\x30\xae\x27\x52\xbe\xc6\xaf\xf9\x39\xe4\x52\x0f\xdf\x92\x3b\xc8\x97\xd4\xa4\xbf\xfc\xab\xb8\x9f\xa4\x20\x15\x2b\x36\xc7\x05\x97\xcb\xa4\xed\x43\x2f\x70\x65\xdd\x6c\x82\xdf\x91\x25\x4f\x99\x74\xf0\x32\x0d\x88\x67\x2e\xdd\x50\x6e\xc3\x01\x3c\xdf\xe8\xd9\xaf\x3b\x0e\x38\x05\x57\x07\xb6\x2f\xed\x14\xf8\xdb\x14\x97\x03\x4c\x78\xef\x81\x59\x97\xd7\x20\xf2\x11\x47\x0f\x66\x41\x0c\xe1\x9f\x81\xa9\x88\x60\xf3\xcf\xc0\x94\x74\x56\xb7\x04\xad\x6b\x43\xcb\x24\x82\x78\x3c\xdb\x61\x01\x13\x76\xed\x07\xf9\x9a\x54\xce\xb3\xf6\xaf\x42\x50\x61\xd4\xb6\xef\xdd\xc2\x58\xff\xb1\x8b\x2d\xfd\x67\x2e\xa6\xb4\xf0\xf9\x5a\x2f\xb3\x7c\x65\xd6\xbc\xeb\xfd\x7c\x7e\x6e\x41\x2c\xfb\xfb\x88\x3d\x6a\xec\x82\x29\x44\x80\xd1\x9d\xdf\xe3\x09\x7c\x95\xe5\x46\xfe\xa5\xaa\xa8\x7a\x47\x74\x73\x43\xa4\xfd\xf5\xb4\xb9\xf1\x86\xad\x29\xc6\x5a\x10\x7f\x4f\x33\xc6\x6d\x2c\xd6\x80\xeb\x68\xb2\x1b\x04\x1f\x5f\x34\x20\xf8\xea\x6f\xe9\x80\x6d\x64\x22\xda\x4e\xfa\xdb\x4e\x13\x44\x38\x8c\xfe\x13\xc8\x7f\xfc\x03\x7e\x79\x8c\xf8\xda\xff\x8a\xa8\xfd\xb1\xc4\xe3\xd7\x5c\x76\xae\x3e\x7f\xdd\x17\x43\xee\xbe\x3c\x1e\x29\x0e\x72\xb9\xcd\x6a\x3b\x89\x43\x9f\xee\x66\xc7\xf1\x13\x3b\x9f\x62\x11\x22\xa8\xff\x7e\x2c\xf9\x49\xb4\xa1\x5e\xcf\x44\xfb\x1b\x3a\xfa\x8d\xce\x82\x50\xde\xe8\x3c\x82\xb3\xc4\xda\x6c\x7f\xc9\xde\x97\x59\xf5\xe9\xc3\xe6\xf8\xe9\x6b\xf7\x22\x5c\xcc\xed\xc7\x51\x77\xc1\x41\xc5\x5b\x27\xd8\x44\xa2\x58\xc8\x9c\x2b\x89\x5f\xd4\x4a\x98\x49\x63\xe4\x1a\x52\xce\xd0\xdb\x83\xa1\x6a\xcd\x04\xe1\xd9\x28\xa2\x87\x08\xb6\x26\x86\x36\xae\x52\xdb\xd1\x57\xb2\x3a\x0d\x10\xee\x7a\x86\xaf\x5e\x3e\xda\xc2\x63\x98\x6d\xa0\x36\x29\x97\xba\xa9\x4d\x02\x3c\x8e\x46\xa3\xc7\xec\x6c\xf4\x3f\x03\x00\xa0\x6b\xa0\xc2\xf9\x3b\x00\x00")
 
 func uiAssetsJsIndexJsBytes() ([]byte, error) {
 	return bindataRead(
@@ -347,7 +348,7 @@ func uiAssetsJsIndexJs() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "ui/assets/js/index.js", size: 14838, mode: os.FileMode(420), modTime: time.Unix(1475543104, 0)}
+	info := bindataFileInfo{name: "ui/assets/js/index.js", size: 15353, mode: os.FileMode(420), modTime: time.Unix(1792362995, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -472,6 +473,26 @@ func uiViewsIndexHtml() (*asset, error) {
 	return a, nil
 }
 
+var _uiViewsKube_resource_revisionsHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x53\x4d\x6f\xdb\x30\x0c\xbd\xe7\x57\x10\xda\x0e\x1b\x36\x7f\x34\x4d\xbb\x2d\x95\x3d\x74\x03\x76\xdd\x50\xf4\x5e\xc8\x16\xdd\x08\xb3\x25\x4f\x92\xb3\x16\x86\xfe\xfb\xa0\xc4\xdf\x4d\x33\xe4\x12\x91\x8f\xef\xf1\x91\x74\xdb\x02\xc7\x42\x48\x04\x92\x29\xfe\x4c\xc0\xb9\x15\x00\xe5\x62\x0f\x79\xc9\x8c\x49\x88\x56\x7f\x09\x08\x9e\x90\x8a\x09\xf9\xe0\x5f\xe9\x0a\x60\x8e\xc9\x55\x19\x3c\x99\xe0\x62\xdd\xe5\x00\xda\x16\xde\x36\xe2\x1b\x33\xf8\x8b\xd9\x1d\x6c\x13\x08\x27\x4f\xe7\x46\x98\x66\xf2\x11\x21\xd4\xb8\x17\x46\x28\x69\xc6\xe4\xcb\x3e\x7a\xf6\x73\xfa\x13\x08\x00\xdd\x6d\xa6\x35\xfe\x77\xd7\x09\x79\xed\x41\x35\x1c\xa2\x13\xf5\xa1\x45\x51\x4c\x91\xaa\x2c\x33\x96\xff\xbe\x57\xe0\x1c\x35\x15\x2b\xcb\x54\x77\x31\xb0\x0a\xf4\x69\xfe\x59\x55\x74\x2c\x6b\x5b\x40\xc9\x5f\x95\xcc\x1b\xad\x51\xda\x51\xe6\x5d\x17\x79\x7f\x9e\x80\x46\x4b\xd7\xb4\x4e\x97\x36\x6e\x1b\xbb\x53\x1a\x9c\x6b\xdb\x53\xd1\x8f\x30\x70\xcf\x00\xdf\x35\x32\x8b\xfc\xd6\x86\x3f\x94\xae\x98\x05\xb2\x8e\xe3\xeb\x20\xbe\x08\xe2\x35\x5c\x5c\x6d\xe3\xcd\x36\xbe\xf2\x57\x44\xa3\x7a\xb9\x8b\x5a\xe3\xb0\xcc\x8e\xef\x81\x8b\xa2\x20\xe9\x78\x06\xfe\xed\xab\x4d\xcd\x64\x0f\xf6\xb1\x87\x52\x48\x3c\x7a\xc0\x3f\x10\xfe\xac\x81\x7c\xf0\x32\x70\x48\x32\xce\x91\xfb\x86\x4b\x83\x53\x48\x30\x42\x34\x56\x6a\x7f\x04\x1d\x46\x7e\x10\xf5\x3c\xce\x79\xab\xe1\x3d\x3e\x1d\x26\x1d\x79\xe5\x74\x35\xe0\x68\x54\x6b\x5c\x38\x39\xf6\x21\x95\x9d\xee\x68\x86\x00\xa0\x85\xd2\x15\x54\x68\x77\x8a\x27\xa4\x56\xc6\x12\x60\xb9\x15\x4a\x26\x64\xf1\x71\x38\x17\xf5\x07\xf4\xb5\x1f\x74\xf2\xda\x75\xce\xbe\x81\x4e\x2a\x6b\xac\x55\x12\xec\x73\x8d\x09\x31\x4d\x56\x09\x4b\xfa\xe1\x65\x56\x42\x66\x65\xc0\xb1\x60\x4d\x69\x49\x7a\xf7\xff\x5b\xed\xa3\x7e\x1c\x47\xee\xa5\x28\x8d\xbc\xbd\x79\x74\x18\xd9\x74\x58\x34\xe2\x62\x9f\xae\x4e\x3e\x5f\x14\x50\x63\x9f\x4b\x1c\xd1\x43\x4b\x87\x33\x81\x70\xdc\x35\xb4\x90\xab\x52\xe9\x2d\xbc\xb9\xcc\x3f\x5d\x5f\xf2\x1b\xf0\x9e\x1e\xb5\x6a\x24\x0f\xfa\x14\x2f\x8a\x98\x7f\xbe\x01\x77\x9e\xb1\x3b\x8d\x09\x27\xfb\xb2\xd9\x6c\xd6\x27\x39\x8b\x35\x47\x8e\x23\x27\x8d\xba\xa6\x57\x33\x7f\xdd\x9f\xb6\x05\x94\x1c\x9c\x5b\xfd\x1b\x00\x74\xb1\xeb\x89\x69\x05\x00\x00")
+
+func uiViewsKube_resource_revisionsHtmlBytes() ([]byte, error) {
+	return bindataRead(
+		_uiViewsKube_resource_revisionsHtml,
+		"ui/views/kube_resource_revisions.html",
+	)
+}
+
+func uiViewsKube_resource_revisionsHtml() (*asset, error) {
+	bytes, err := uiViewsKube_resource_revisionsHtmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "ui/views/kube_resource_revisions.html", size: 1385, mode: os.FileMode(420), modTime: time.Unix(1792370214, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 var _uiViewsKube_resourcesHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xa4\x52\xcb\x6e\xab\x30\x10\xdd\xe7\x2b\xe6\x8e\x74\x97\x3c\x92\x5c\xdd\x45\x1a\x58\x75\xd5\x6d\x3f\x00\x39\xd8\x05\x47\xc6\xb6\x3c\x0e\x24\x42\xf9\xf7\xca\x04\xd1\xb4\x21\x91\xda\x8a\xcd\x20\xe6\xcc\x79\x70\xfa\x1e\xb8\x78\x93\x5a\x00\xee\x0c\x3f\x21\x9c\xcf\x8b\x05\xc0\xf6\x4f\x14\xc1\xf3\x1a\xa2\x28\x0f\x6f\x54\x3a\x69\x3d\x90\x2b\x33\x4c\x0e\x32\x61\x44\xc2\x53\xb2\xa7\xa4\x15\x9a\x1b\x97\xf0\x75\xdc\xfe\x8b\x1b\xa9\xe3\x3d\x61\xbe\x4d\x2e\x80\x7c\x38\xc5\x65\x0b\xa5\x62\x44\x19\x3a\xd3\x01\xdb\x99\x56\x14\xca\x54\x08\x92\x67\xd8\x30\xa9\x0b\x67\x3a\x1c\x96\xc7\xf5\xf0\xc1\x77\xa6\xa8\x1c\xb3\x35\xe1\x15\x1c\x81\xfc\x49\x89\x0c\xb9\x24\xab\xd8\x69\x03\xda\x68\x81\xf9\x80\xfd\x4c\x56\x1a\x15\x1d\x29\xfa\x3f\x41\x2c\xe3\x5c\xea\x6a\x03\xe9\xf0\xac\x53\x7b\x7c\x9a\x90\xc1\x65\x5b\x0d\xcc\xa5\x3d\x5c\x98\x11\x6a\x21\xab\xda\x67\xb8\x0c\xcb\x08\x9d\xe4\xbe\xce\x70\x99\xa6\x7f\x07\x97\x6d\x35\x11\x27\x5c\xb6\xdf\x55\x11\x8e\x06\x29\xb3\x2a\x1c\x6b\x7e\xa3\x62\x1c\x17\x5f\xf5\xfc\x28\xc3\xe5\xea\xf2\xb3\xac\xe1\x85\x62\x3b\xa1\x68\x3e\xd4\x55\x30\x34\xe6\x7a\x4f\x4b\x68\xd6\xcb\xeb\xd8\xac\x87\xdd\x72\x82\xcc\xc1\x95\x62\xac\xc1\x6d\xb5\x6e\x9d\x3d\xf6\xf0\x91\x72\xdf\x83\x17\x8d\x55\xcc\x0b\x40\xe9\x45\x53\x28\x49\x1e\x21\x0e\xfd\xbf\x97\xe5\xb5\x91\x79\xe2\xc7\xb4\x53\xb5\x43\xf9\x67\xf2\x99\x86\xbe\x07\xa1\x79\x90\xf2\x1e\x00\x00\xff\xff\x73\x4e\xf3\x73\x9f\x03\x00\x00")
 
 func uiViewsKube_resourcesHtmlBytes() ([]byte, error) {
@@ -663,6 +684,7 @@ var _bindata = map[string]func() (*asset, error){
 	"ui/assets/js/vendor/d3.v4.min.js": uiAssetsJsVendorD3V4MinJs,
 	"ui/assets/js/vendor/jquery-3.1.0.min.js": uiAssetsJsVendorJquery310MinJs,
 	"ui/views/index.html": uiViewsIndexHtml,
+	"ui/views/kube_resource_revisions.html": uiViewsKube_resource_revisionsHtml,
 	"ui/views/kube_resources.html": uiViewsKube_resourcesHtml,
 	"ui/views/layouts/layout.html": uiViewsLayoutsLayoutHtml,
 	"ui/views/login.html": uiViewsLoginHtml,
@@ -750,6 +772,7 @@ var _bintree = &bintree{nil, map[string]*bintree{
 		}},
 		"views": &bintree{nil, map[string]*bintree{
 			"index.html": &bintree{uiViewsIndexHtml, map[string]*bintree{}},
+			"kube_resource_revisions.html": &bintree{uiViewsKube_resource_revisionsHtml, map[string]*bintree{}},
 			"kube_resources.html": &bintree{uiViewsKube_resourcesHtml, map[string]*bintree{}},
 			"layouts": &bintree{nil, map[string]*bintree{
 				"layout.html": &bintree{uiViewsLayoutsLayoutHtml, map[string]*bintree{}},
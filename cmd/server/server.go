@@ -9,6 +9,7 @@ import (
 	"github.com/supergiant/supergiant/pkg/core"
 	"github.com/supergiant/supergiant/pkg/provider/aws"
 	"github.com/supergiant/supergiant/pkg/provider/digitalocean"
+	"github.com/supergiant/supergiant/pkg/provider/existing"
 	"github.com/supergiant/supergiant/pkg/server"
 )
 
@@ -43,6 +44,11 @@ func main() {
 				Client: digitalocean.Client,
 			}
 		}
+		c.ExistingProvider = func(creds map[string]string) core.Provider {
+			return &existing.Provider{
+				Core: c,
+			}
+		}
 
 		// We do this here, and not in core, so that we can ensure the file closes on exit.
 		if c.LogPath != "" {
@@ -108,6 +114,11 @@ func main() {
 			Usage:       "Enabled UI",
 			Destination: &c.UIEnabled,
 		},
+		cli.BoolFlag{
+			Name:        "metrics-enabled",
+			Usage:       "Expose Prometheus metrics at /metrics",
+			Destination: &c.MetricsEnabled,
+		},
 		cli.StringFlag{
 			Name:        "https-port",
 			Usage:       "HTTPS (SSL) port for the web interfaces",
@@ -123,6 +134,11 @@ func main() {
 			Usage:       "SSL key file",
 			Destination: &c.SSLKeyFile,
 		},
+		cli.StringFlag{
+			Name:        "encryption-key-file",
+			Usage:       "File of the key Secrets are encrypted with (created if it doesn't exist)",
+			Destination: &c.EncryptionKeyFile,
+		},
 		cli.StringFlag{
 			Name:        "log-file",
 			Usage:       "Log output filepath",
@@ -134,6 +150,11 @@ func main() {
 			Destination: &c.LogLevel,
 			// Value:  <--- NOTE just cuz you always forget you can set defaults
 		},
+		cli.StringFlag{
+			Name:        "helm-chart-dir",
+			Usage:       "Directory of the Helm charts HelmReleases can install",
+			Destination: &c.HelmChartDir,
+		},
 		cli.StringFlag{
 			Name:        "config-file",
 			Usage:       "JSON config filepath (command line arguments will override the values set here)",
@@ -15,14 +15,22 @@ set -e
 : ${KUBE_NAME?"Need to set KUBE_NAME"}
 : ${ENTRYPOINT_NAME?"Need to set ENTRYPOINT_NAME"}
 
+# Create an App to group the members, which are started together
+cat <<EOF | supergiant apps create -f -
+{
+  "kube_name": "$KUBE_NAME",
+  "namespace": "my-mongo-db",
+  "name": "my-mongo-db"
+}
+EOF
+
 # Create an external Service and Pod for each member
 for member in {0..2}
 do
 # Service
 cat <<EOF | supergiant kube_resources create -f -
 {
-  "kube_name": "$KUBE_NAME",
-  "namespace": "my-mongo-db",
+  "app_name": "my-mongo-db",
   "kind": "Service",
   "name": "member-$member",
   "template": {
@@ -49,10 +57,10 @@ EOF
 # Pod
 cat <<EOF | supergiant kube_resources create -f -
 {
-  "kube_name": "$KUBE_NAME",
-  "namespace": "my-mongo-db",
+  "app_name": "my-mongo-db",
   "kind": "Pod",
   "name": "member-$member",
+  "depends_on": ["Service/member-$member"],
   "template": {
     "metadata": {
       "labels": {
@@ -99,6 +107,10 @@ cat <<EOF | supergiant kube_resources create -f -
 EOF
 done
 
+# Start the Services, and then the Pods
+app_id=$(supergiant apps list --filter=name:my-mongo-db --format='{{ .ID }}')
+supergiant apps start --id=$app_id > /dev/null
+
 echo "Waiting for Pod to start"
 while [[ $(supergiant kube_resources list --filter=kind:Pod --filter=name:member-0 --format='{{ .Started }}') == 'false' ]]; do
   printf .
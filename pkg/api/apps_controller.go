@@ -0,0 +1,125 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/supergiant/supergiant/pkg/core"
+	"github.com/supergiant/supergiant/pkg/model"
+)
+
+func ListApps(core *core.Core, user *model.User, r *http.Request) (*Response, error) {
+	list := new(model.AppList)
+	resp, err := handleList(core, r, new(model.App), list)
+	if err != nil {
+		return nil, err
+	}
+	// The passive status of an App is that of its KubeResources, which are
+	// loaded for all Apps of the page at once.
+	if len(list.Items) == 0 {
+		return resp, nil
+	}
+	var names []string
+	for _, item := range list.Items {
+		names = append(names, item.Name)
+	}
+	var kubeResources []*model.KubeResource
+	if err := core.DB.Where("app_name IN (?)", names).Find(&kubeResources); err != nil {
+		return nil, err
+	}
+	byApp := make(map[string][]*model.KubeResource)
+	for _, kubeResource := range kubeResources {
+		byApp[kubeResource.AppName] = append(byApp[kubeResource.AppName], kubeResource)
+	}
+	for _, item := range list.Items {
+		item.KubeResources = byApp[item.Name]
+		item.SetPassiveStatus()
+	}
+	return resp, nil
+}
+
+func CreateApp(core *core.Core, user *model.User, r *http.Request) (*Response, error) {
+	item := new(model.App)
+	if err := decodeBodyInto(r, item); err != nil {
+		return nil, err
+	}
+	if err := core.Apps.Create(item); err != nil {
+		return nil, err
+	}
+	return itemResponse(core, item, http.StatusCreated)
+}
+
+func UpdateApp(core *core.Core, user *model.User, r *http.Request) (*Response, error) {
+	id, err := parseID(r)
+	if err != nil {
+		return nil, err
+	}
+	item := new(model.App)
+	if err := decodeBodyInto(r, item); err != nil {
+		return nil, err
+	}
+	if err := core.Apps.Update(id, new(model.App), item); err != nil {
+		return nil, err
+	}
+	return itemResponse(core, item, http.StatusAccepted)
+}
+
+func GetApp(core *core.Core, user *model.User, r *http.Request) (*Response, error) {
+	item := new(model.App)
+	id, err := parseID(r)
+	if err != nil {
+		return nil, err
+	}
+	if err := core.Apps.GetWithIncludes(id, item, []string{"KubeResources"}); err != nil {
+		return nil, err
+	}
+	return itemResponse(core, item, http.StatusOK)
+}
+
+func StartApp(core *core.Core, user *model.User, r *http.Request) (*Response, error) {
+	item, id, err := loadApp(core, r)
+	if err != nil {
+		return nil, err
+	}
+	if err := core.Apps.Start(id, new(model.App)).Async(); err != nil {
+		return nil, err
+	}
+	return itemResponse(core, item, http.StatusAccepted)
+}
+
+func StopApp(core *core.Core, user *model.User, r *http.Request) (*Response, error) {
+	item, id, err := loadApp(core, r)
+	if err != nil {
+		return nil, err
+	}
+	if err := core.Apps.Stop(id, new(model.App)).Async(); err != nil {
+		return nil, err
+	}
+	return itemResponse(core, item, http.StatusAccepted)
+}
+
+func DeleteApp(core *core.Core, user *model.User, r *http.Request) (*Response, error) {
+	item, id, err := loadApp(core, r)
+	if err != nil {
+		return nil, err
+	}
+	if err := core.Apps.Delete(id, new(model.App)).Async(); err != nil {
+		return nil, err
+	}
+	return itemResponse(core, item, http.StatusAccepted)
+}
+
+// Private
+
+// loadApp loads the App of a request with its KubeResources, to respond with.
+// The Action on the App loads its own copy, which it changes as it runs.
+func loadApp(core *core.Core, r *http.Request) (*model.App, *int64, error) {
+	id, err := parseID(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	item := new(model.App)
+	if err := core.Apps.GetWithIncludes(id, item, []string{"KubeResources"}); err != nil {
+		return nil, nil, err
+	}
+	return item, id, nil
+}
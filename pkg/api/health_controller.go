@@ -0,0 +1,33 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/supergiant/supergiant/pkg/core"
+	"github.com/supergiant/supergiant/pkg/model"
+)
+
+// Healthz is the liveness check; if the server can respond, it's alive.
+func Healthz(core *core.Core, r *http.Request) (*Response, error) {
+	return &Response{http.StatusOK, map[string]string{"status": "ok"}}, nil
+}
+
+// Readyz responds 503 if any readiness check fails.
+func Readyz(core *core.Core, r *http.Request) (*Response, error) {
+	readiness := core.Readiness()
+	status := http.StatusOK
+	if !readiness.Ready {
+		status = http.StatusServiceUnavailable
+	}
+	return &Response{status, readiness}, nil
+}
+
+// ---------- separating open (above) from restricted (below) handlers ---------
+
+func GetStatus(core *core.Core, user *model.User, r *http.Request) (*Response, error) {
+	status, err := core.Status()
+	if err != nil {
+		return nil, err
+	}
+	return &Response{http.StatusOK, status}, nil
+}
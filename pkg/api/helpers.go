@@ -1,20 +1,28 @@
 package api
 
 import (
+	"bufio"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io/ioutil"
+	"mime"
+	"net"
 	"net/http"
-	"os"
 	"reflect"
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/Sirupsen/logrus"
+	"github.com/ghodss/yaml"
 	"github.com/gorilla/mux"
 	"github.com/jinzhu/gorm"
 	"github.com/supergiant/supergiant/pkg/core"
+	"github.com/supergiant/supergiant/pkg/kubernetes"
 	"github.com/supergiant/supergiant/pkg/model"
+	"github.com/supergiant/supergiant/pkg/util"
 )
 
 type Response struct {
@@ -29,7 +37,7 @@ type bodyDecodingError struct { // status bad request
 }
 
 func (e *bodyDecodingError) Error() string {
-	return "Error decoding JSON body: " + e.err.Error()
+	return "Error decoding body: " + e.err.Error()
 }
 
 var (
@@ -52,7 +60,7 @@ func errorHTTPStatus(err error) int {
 	if _, ok := err.(*errorForbidden); ok {
 		return 403
 	}
-	if err == gorm.ErrRecordNotFound {
+	if err == gorm.ErrRecordNotFound || err == core.ErrorLogNotConfigured {
 		return 404
 	}
 	// TODO we can probably consolidate all same error codes (would need to be in
@@ -66,60 +74,35 @@ func errorHTTPStatus(err error) int {
 	if _, ok := err.(*model.ErrorChangedImmutableField); ok {
 		return 422
 	}
-	return 500
-}
-
-const logViewBytesize int64 = 2048
-
-func logHandler(core *core.Core) func(http.ResponseWriter, *http.Request) {
-	return func(w http.ResponseWriter, r *http.Request) {
-		if user := loadUser(core, w, r); user == nil {
-			return
-		}
-
-		if core.LogPath == "" {
-			msg := "No log file configured!\nCreate file and provide path to --log-file at startup.\n"
-			w.Write([]byte(msg))
-			return
-		}
-
-		file, err := os.Open(core.LogPath)
-		if err != nil {
-			panic(err)
-		}
-		defer file.Close()
-
-		stat, err := os.Stat(core.LogPath)
-		if err != nil {
-			panic(err)
-		}
-
-		fileBytesize := stat.Size()
-
-		var offset int64
-		var bufferSize int64
-
-		if fileBytesize < logViewBytesize {
-			offset = 0
-			bufferSize = fileBytesize
-		} else {
-			offset = fileBytesize - logViewBytesize
-			bufferSize = logViewBytesize
-		}
-
-		buf := make([]byte, bufferSize)
-
-		if _, err := file.ReadAt(buf, offset); err != nil {
-			panic(err)
-		}
-		w.Write(buf)
+	if err == core.ErrorProxyKubeconfigRequiresSSL {
+		return 422
+	}
+	// Errors from Kubernetes about the request, such as an invalid template, are
+	// surfaced as is. Anything else is a 500.
+	switch {
+	case kubernetes.IsForbidden(err):
+		return 403
+	case kubernetes.IsNotFound(err):
+		return 404
+	case kubernetes.IsConflict(err):
+		return 409
+	case kubernetes.IsInvalid(err):
+		return 422
 	}
+	return 500
 }
 
-func loadUser(core *core.Core, w http.ResponseWriter, r *http.Request) *model.User {
+// loadUser authenticates a request by its API token or session, or, if
+// proxyToken, by the proxy token of the user as a bearer token, which is what
+// kubectl sends through the Kube API proxy.
+func loadUser(core *core.Core, w http.ResponseWriter, r *http.Request, proxyToken bool) *model.User {
 	auth := r.Header.Get("Authorization")
 	tokenMatch := regexp.MustCompile(`^SGAPI (token|session)="([A-Za-z0-9]{32})"$`).FindStringSubmatch(auth)
 
+	if bearerMatch := regexp.MustCompile(`^Bearer ([A-Za-z0-9]{32})$`).FindStringSubmatch(auth); proxyToken && len(bearerMatch) == 2 {
+		tokenMatch = []string{auth, "proxy_token", bearerMatch[1]}
+	}
+
 	if len(tokenMatch) != 3 {
 		respond(w, nil, errorBadAuthHeader)
 		return nil
@@ -135,6 +118,15 @@ func loadUser(core *core.Core, w http.ResponseWriter, r *http.Request) *model.Us
 
 		return user
 
+	case "proxy_token":
+		user := new(model.User)
+		if err := core.DB.Where("proxy_token = ?", tokenMatch[2]).First(user); err != nil {
+			respond(w, nil, errorUnauthorized)
+			return nil
+		}
+
+		return user
+
 	case "session":
 		session := new(model.Session)
 		if err := core.Sessions.Get(tokenMatch[2], session); err != nil {
@@ -170,19 +162,162 @@ func respond(w http.ResponseWriter, resp *Response, err error) {
 
 func openHandler(c *core.Core, fn func(*core.Core, *http.Request) (*Response, error)) func(http.ResponseWriter, *http.Request) {
 	return func(w http.ResponseWriter, r *http.Request) {
+		rl := newRequestLog(c, w, r)
+		defer rl.write()
+
 		resp, err := fn(c, r)
-		respond(w, resp, err)
+		respond(rl, resp, err)
 	}
 }
 
 func restrictedHandler(core *core.Core, fn func(*core.Core, *model.User, *http.Request) (*Response, error)) func(http.ResponseWriter, *http.Request) {
 	return func(w http.ResponseWriter, r *http.Request) {
-		user := loadUser(core, w, r)
+		rl := newRequestLog(core, w, r)
+		defer rl.write()
+
+		user := loadUser(core, rl, r, false)
 		if user == nil {
 			return
 		}
+		rl.user = user
+
 		resp, err := fn(core, user, r)
-		respond(w, resp, err)
+		respond(rl, resp, err)
+	}
+}
+
+// streamingHandler is for restricted handlers which write their own response.
+// If fn returns an error before writing, the error is responded as usual.
+func streamingHandler(core *core.Core, fn func(*core.Core, *model.User, http.ResponseWriter, *http.Request) error) func(http.ResponseWriter, *http.Request) {
+	return userStreamingHandler(core, fn, false)
+}
+
+// proxyHandler is a streamingHandler which also accepts proxy tokens, for the
+// Kube API proxy.
+func proxyHandler(core *core.Core, fn func(*core.Core, *model.User, http.ResponseWriter, *http.Request) error) func(http.ResponseWriter, *http.Request) {
+	return userStreamingHandler(core, fn, true)
+}
+
+func userStreamingHandler(core *core.Core, fn func(*core.Core, *model.User, http.ResponseWriter, *http.Request) error, proxyToken bool) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		rl := newRequestLog(core, w, r)
+		defer rl.write()
+
+		user := loadUser(core, rl, r, proxyToken)
+		if user == nil {
+			return
+		}
+		rl.user = user
+
+		if err := fn(core, user, rl, r); err != nil {
+			if !rl.wroteHeader {
+				respond(rl, nil, err)
+				return
+			}
+			rl.err = err
+		}
+	}
+}
+
+//------------------------------------------------------------------------------
+
+// requestLog wraps the ResponseWriter of a request to record its status, and
+// logs the request once handled. Each request is assigned an ID (unless it
+// already has an X-Request-Id header), which is returned in the X-Request-Id
+// response header.
+type requestLog struct {
+	http.ResponseWriter
+
+	core        *core.Core
+	r           *http.Request
+	id          string
+	start       time.Time
+	status      int
+	wroteHeader bool
+	user        *model.User
+
+	// err is an error which occurred after the response was written.
+	err error
+
+	// audit is set for requests which must always be logged, such as mutating
+	// calls through the Kube API proxy, with fields describing them.
+	audit logrus.Fields
+}
+
+func newRequestLog(core *core.Core, w http.ResponseWriter, r *http.Request) *requestLog {
+	id := r.Header.Get("X-Request-Id")
+	if id == "" {
+		id = util.RandomString(16)
+	}
+	w.Header().Set("X-Request-Id", id)
+
+	return &requestLog{
+		ResponseWriter: w,
+		core:           core,
+		r:              r,
+		id:             id,
+		start:          time.Now(),
+		status:         http.StatusOK,
+	}
+}
+
+func (rl *requestLog) WriteHeader(status int) {
+	rl.status = status
+	rl.wroteHeader = true
+	rl.ResponseWriter.WriteHeader(status)
+}
+
+func (rl *requestLog) Write(b []byte) (int, error) {
+	rl.wroteHeader = true
+	return rl.ResponseWriter.Write(b)
+}
+
+// Flush implements http.Flusher, for streaming responses.
+func (rl *requestLog) Flush() {
+	if flusher, ok := rl.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// Hijack implements http.Hijacker, for WebSocket connections.
+func (rl *requestLog) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hijacker, ok := rl.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, errors.New("ResponseWriter does not implement http.Hijacker")
+	}
+	rl.status = http.StatusSwitchingProtocols
+	rl.wroteHeader = true
+	return hijacker.Hijack()
+}
+
+func (rl *requestLog) write() {
+	fields := logrus.Fields{
+		"request_id": rl.id,
+		"method":     rl.r.Method,
+		"path":       rl.r.URL.Path,
+		"status":     rl.status,
+		"duration":   time.Since(rl.start).Seconds(),
+	}
+	if rl.user != nil {
+		fields["user"] = rl.user.Username
+	}
+	if rl.err != nil {
+		fields["error"] = rl.err.Error()
+	}
+	for key, value := range rl.audit {
+		fields[key] = value
+	}
+	entry := rl.core.Log.WithFields(fields)
+	msg := fmt.Sprintf("%s %s %d", rl.r.Method, rl.r.URL.Path, rl.status)
+
+	// GETs are logged at debug level; the UI polls constantly.
+	switch {
+	case rl.status >= 500 || rl.err != nil:
+		entry.Error(msg)
+	case rl.r.Method == "GET" && rl.audit == nil:
+		entry.Debug(msg)
+	default:
+		entry.Info(msg)
 	}
 }
 
@@ -197,14 +332,48 @@ func parseID(r *http.Request) (*int64, error) {
 	return &id64, nil
 }
 
+// invalidQueryParam returns the validation error of a query param which could
+// not be parsed.
+func invalidQueryParam(name string, value string, expected string) error {
+	return core.NewErrorValidationFailed(fmt.Errorf("%s: %q is not %s", name, value, expected))
+}
+
 func decodeBodyInto(r *http.Request, item model.Model) error {
-	if err := json.NewDecoder(r.Body).Decode(item); err != nil {
-		return &bodyDecodingError{err}
+	if err := decodeBody(r, item); err != nil {
+		return err
 	}
 	model.ZeroReadonlyFields(item)
 	return nil
 }
 
+// decodeBody decodes a JSON body into v, or a YAML body when the Content-Type
+// is YAML (e.g. application/yaml).
+func decodeBody(r *http.Request, v interface{}) error {
+	if !isYAMLContentType(r.Header.Get("Content-Type")) {
+		if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+			return &bodyDecodingError{err}
+		}
+		return nil
+	}
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return &bodyDecodingError{err}
+	}
+	if err := yaml.Unmarshal(body, v); err != nil {
+		return &bodyDecodingError{err}
+	}
+	return nil
+}
+
+func isYAMLContentType(contentType string) bool {
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	switch mediaType {
+	case "application/yaml", "application/x-yaml", "text/yaml", "text/x-yaml":
+		return true
+	}
+	return false
+}
+
 func itemResponse(core *core.Core, item model.Model, status int) (*Response, error) {
 	core.SetResourceActionStatus(item)
 	item.SetPassiveStatus()
@@ -0,0 +1,72 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/supergiant/supergiant/pkg/core"
+	"github.com/supergiant/supergiant/pkg/model"
+)
+
+func ListKubeResourceTemplates(core *core.Core, user *model.User, r *http.Request) (*Response, error) {
+	return handleList(core, r, new(model.KubeResourceTemplate), new(model.KubeResourceTemplateList))
+}
+
+func CreateKubeResourceTemplate(core *core.Core, user *model.User, r *http.Request) (*Response, error) {
+	item := new(model.KubeResourceTemplate)
+	if err := decodeBodyInto(r, item); err != nil {
+		return nil, err
+	}
+	if err := core.KubeResourceTemplates.Create(item); err != nil {
+		return nil, err
+	}
+	return itemResponse(core, item, http.StatusCreated)
+}
+
+func UpdateKubeResourceTemplate(core *core.Core, user *model.User, r *http.Request) (*Response, error) {
+	id, err := parseID(r)
+	if err != nil {
+		return nil, err
+	}
+	item := new(model.KubeResourceTemplate)
+	if err := decodeBodyInto(r, item); err != nil {
+		return nil, err
+	}
+	if err := core.KubeResourceTemplates.Update(id, new(model.KubeResourceTemplate), item); err != nil {
+		return nil, err
+	}
+	return itemResponse(core, item, http.StatusAccepted)
+}
+
+func GetKubeResourceTemplate(core *core.Core, user *model.User, r *http.Request) (*Response, error) {
+	item, err := getKubeResourceTemplate(core, r)
+	if err != nil {
+		return nil, err
+	}
+	return itemResponse(core, item, http.StatusOK)
+}
+
+func DeleteKubeResourceTemplate(core *core.Core, user *model.User, r *http.Request) (*Response, error) {
+	// Load item first so we can have attributes ready in Delete
+	item, err := getKubeResourceTemplate(core, r)
+	if err != nil {
+		return nil, err
+	}
+	if err := core.KubeResourceTemplates.Delete(item.ID, item); err != nil {
+		return nil, err
+	}
+	return itemResponse(core, item, http.StatusAccepted)
+}
+
+// Private
+
+func getKubeResourceTemplate(core *core.Core, r *http.Request) (*model.KubeResourceTemplate, error) {
+	item := new(model.KubeResourceTemplate)
+	id, err := parseID(r)
+	if err != nil {
+		return nil, err
+	}
+	if err := core.KubeResourceTemplates.Get(id, item); err != nil {
+		return nil, err
+	}
+	return item, nil
+}
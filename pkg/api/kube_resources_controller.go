@@ -1,9 +1,14 @@
 package api
 
 import (
+	"io"
 	"net/http"
+	"strconv"
+	"time"
 
+	"github.com/gorilla/websocket"
 	"github.com/supergiant/supergiant/pkg/core"
+	"github.com/supergiant/supergiant/pkg/kubernetes"
 	"github.com/supergiant/supergiant/pkg/model"
 )
 
@@ -16,6 +21,7 @@ func CreateKubeResource(core *core.Core, user *model.User, r *http.Request) (*Re
 	if err := decodeBodyInto(r, item); err != nil {
 		return nil, err
 	}
+	item.RevisionAuthor = user.Username
 	if err := core.KubeResources.Create(item); err != nil {
 		return nil, err
 	}
@@ -31,6 +37,7 @@ func UpdateKubeResource(core *core.Core, user *model.User, r *http.Request) (*Re
 	if err := decodeBodyInto(r, item); err != nil {
 		return nil, err
 	}
+	item.RevisionAuthor = user.Username
 	if err := core.KubeResources.Update(id, new(model.KubeResource), item); err != nil {
 		return nil, err
 	}
@@ -84,3 +91,209 @@ func DeleteKubeResource(core *core.Core, user *model.User, r *http.Request) (*Re
 	}
 	return itemResponse(core, item, http.StatusAccepted)
 }
+
+// ImportKubeResources adopts existing resources of a Kube as KubeResources.
+func ImportKubeResources(core *core.Core, user *model.User, r *http.Request) (*Response, error) {
+	item := new(model.KubeResourceImport)
+	if err := decodeBody(r, item); err != nil {
+		return nil, err
+	}
+	if err := core.KubeResources.Import(item); err != nil {
+		return nil, err
+	}
+	return &Response{http.StatusCreated, item}, nil
+}
+
+// CreateKubeResourcesFromManifest creates a KubeResource for each resource of
+// a YAML or JSON manifest.
+func CreateKubeResourcesFromManifest(core *core.Core, user *model.User, r *http.Request) (*Response, error) {
+	item := new(model.KubeResourceManifest)
+	if err := decodeBody(r, item); err != nil {
+		return nil, err
+	}
+	if err := core.KubeResources.CreateFromManifest(item); err != nil {
+		return nil, err
+	}
+	return &Response{http.StatusCreated, item}, nil
+}
+
+// ListKubeResourceRevisions lists the revisions of a KubeResource, in order.
+func ListKubeResourceRevisions(core *core.Core, user *model.User, r *http.Request) (*Response, error) {
+	id, err := parseID(r)
+	if err != nil {
+		return nil, err
+	}
+	list := new(model.KubeResourceRevisionList)
+	if err := core.KubeResources.Revisions(id, list); err != nil {
+		return nil, err
+	}
+	return &Response{http.StatusOK, list}, nil
+}
+
+// RollbackKubeResource sets the Template of a KubeResource back to that of the
+// revision in the revision query param.
+func RollbackKubeResource(core *core.Core, user *model.User, r *http.Request) (*Response, error) {
+	id, err := parseID(r)
+	if err != nil {
+		return nil, err
+	}
+	revisionParam := r.URL.Query().Get("revision")
+	revision, err := strconv.Atoi(revisionParam)
+	if err != nil {
+		return nil, invalidQueryParam("revision", revisionParam, "an integer")
+	}
+	item := &model.KubeResource{RevisionAuthor: user.Username}
+	if err := core.KubeResources.Rollback(id, revision, item); err != nil {
+		return nil, err
+	}
+	return itemResponse(core, item, http.StatusAccepted)
+}
+
+// GetKubeResourceLogs streams the log of a Pod KubeResource as plain text.
+func GetKubeResourceLogs(core *core.Core, user *model.User, w http.ResponseWriter, r *http.Request) error {
+	id, err := parseID(r)
+	if err != nil {
+		return err
+	}
+	query, err := decodePodLogQuery(r)
+	if err != nil {
+		return err
+	}
+	logs, err := core.KubeResources.PodLogs(id, query)
+	if err != nil {
+		return err
+	}
+	defer logs.Close()
+
+	// A followed log only ends when closed
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-r.Context().Done():
+			logs.Close()
+		case <-done:
+		}
+	}()
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	flusher := w.(http.Flusher)
+
+	buf := make([]byte, 32*1024)
+	for {
+		n, err := logs.Read(buf)
+		if n > 0 {
+			if _, err := w.Write(buf[:n]); err != nil {
+				return err
+			}
+			flusher.Flush()
+		}
+		if err == io.EOF || r.Context().Err() != nil {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+var execUpgrader = &websocket.Upgrader{
+	Subprotocols: []string{kubernetes.ExecProtocol},
+}
+
+// ExecKubeResource runs a command in a Pod KubeResource, proxying a WebSocket
+// connection which speaks the kubernetes.ExecProtocol.
+func ExecKubeResource(core *core.Core, user *model.User, w http.ResponseWriter, r *http.Request) error {
+	id, err := parseID(r)
+	if err != nil {
+		return err
+	}
+	query := decodeExecQuery(r)
+
+	k8sConn, err := core.KubeResources.ExecPod(id, query)
+	if err != nil {
+		return err
+	}
+	defer k8sConn.Close()
+
+	conn, err := execUpgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	return proxyWebSocket(conn, k8sConn)
+}
+
+// Private
+
+// decodePodLogQuery reads a PodLogQuery from query params, e.g.
+// ?container=app&tail=100&since=2016-10-01T00:00:00Z&follow=true
+func decodePodLogQuery(r *http.Request) (query *model.PodLogQuery, err error) {
+	qstr := r.URL.Query()
+
+	query = &model.PodLogQuery{
+		Container: qstr.Get("container"),
+		Follow:    qstr.Get("follow") == "true",
+	}
+
+	if tail := qstr.Get("tail"); tail != "" {
+		if query.Tail, err = strconv.Atoi(tail); err != nil {
+			return nil, invalidQueryParam("tail", tail, "an integer")
+		}
+	}
+	if since := qstr.Get("since"); since != "" {
+		if query.Since, err = time.Parse(time.RFC3339, since); err != nil {
+			return nil, invalidQueryParam("since", since, "an RFC3339 time")
+		}
+	}
+
+	return query, nil
+}
+
+// decodeExecQuery reads an ExecQuery from query params, e.g.
+// ?container=app&command=ls&command=-l&stdin=true&tty=true
+func decodeExecQuery(r *http.Request) *model.ExecQuery {
+	qstr := r.URL.Query()
+
+	return &model.ExecQuery{
+		Container: qstr.Get("container"),
+		Command:   qstr["command"],
+		Stdin:     qstr.Get("stdin") == "true",
+		TTY:       qstr.Get("tty") == "true",
+	}
+}
+
+// proxyWebSocket copies messages between a and b until either is closed, and
+// returns the first error other than a normal closure.
+func proxyWebSocket(a *websocket.Conn, b *websocket.Conn) error {
+	errs := make(chan error, 2)
+	copyMessages := func(dst *websocket.Conn, src *websocket.Conn) {
+		for {
+			messageType, message, err := src.ReadMessage()
+			if err != nil {
+				// Pass the closure on, so that the other side stops as well
+				closeMessage := websocket.FormatCloseMessage(websocket.CloseNormalClosure, "")
+				if closeErr, ok := err.(*websocket.CloseError); ok {
+					closeMessage = websocket.FormatCloseMessage(closeErr.Code, closeErr.Text)
+				}
+				dst.WriteControl(websocket.CloseMessage, closeMessage, time.Now().Add(time.Second))
+				errs <- err
+				return
+			}
+			if err := dst.WriteMessage(messageType, message); err != nil {
+				errs <- err
+				return
+			}
+		}
+	}
+	go copyMessages(a, b)
+	go copyMessages(b, a)
+
+	err := <-errs
+	if websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+		return nil
+	}
+	return err
+}
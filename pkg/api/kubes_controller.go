@@ -2,8 +2,12 @@ package api
 
 import (
 	"net/http"
+	"path"
+	"strings"
 
+	"github.com/Sirupsen/logrus"
 	"github.com/supergiant/supergiant/pkg/core"
+	"github.com/supergiant/supergiant/pkg/kubernetes"
 	"github.com/supergiant/supergiant/pkg/model"
 )
 
@@ -72,3 +76,146 @@ func ProvisionKube(core *core.Core, user *model.User, r *http.Request) (*Respons
 	}
 	return itemResponse(core, item, http.StatusAccepted)
 }
+
+// GetKubeKubeconfig writes a kubeconfig YAML for the user to access the Kube
+// directly.
+func GetKubeKubeconfig(core *core.Core, user *model.User, w http.ResponseWriter, r *http.Request) error {
+	id, err := parseID(r)
+	if err != nil {
+		return err
+	}
+	var config *kubernetes.Kubeconfig
+	if r.URL.Query().Get("proxy") == "true" {
+		config, err = core.Kubes.ProxyKubeconfig(id, user)
+	} else {
+		config, err = core.Kubes.Kubeconfig(id, user)
+	}
+	if err != nil {
+		return err
+	}
+	out, err := config.YAML()
+	if err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/yaml")
+	w.WriteHeader(http.StatusOK)
+	_, err = w.Write(out)
+	return err
+}
+
+// ProxyKube forwards requests under /api/v0/kubes/:id/proxy/ to the Kube API,
+// with the credentials of the Kube. Users can only read the resources allowed
+// by isProxyReadAllowed; anything else requires an admin. Changes, and
+// requests which are denied, are always logged.
+func ProxyKube(core *core.Core, user *model.User, w http.ResponseWriter, r *http.Request) error {
+	id, err := parseID(r)
+	if err != nil {
+		return err
+	}
+	kubePath := r.URL.Path[strings.Index(r.URL.Path, "/proxy/")+len("/proxy"):]
+
+	mutating := isMutatingRequest(r)
+	if mutating || !isProxyReadAllowed(kubePath) {
+		if err := ensureAdmin(user); err != nil {
+			if rl, ok := w.(*requestLog); ok {
+				rl.audit = logrus.Fields{"audit": "kube_proxy", "kube_id": *id, "denied": true}
+			}
+			return err
+		}
+	}
+	if mutating {
+		if rl, ok := w.(*requestLog); ok {
+			rl.audit = logrus.Fields{"audit": "kube_proxy", "kube_id": *id}
+		}
+	}
+
+	return core.Kubes.Proxy(id, w, r, kubePath)
+}
+
+// Private
+
+// isMutatingRequest returns whether r may change what it's sent to. Upgrade
+// requests, such as exec, always may.
+func isMutatingRequest(r *http.Request) bool {
+	switch r.Method {
+	case "GET", "HEAD", "OPTIONS":
+		return r.Header.Get("Upgrade") != ""
+	}
+	return true
+}
+
+// proxyReadableResources are the resources, and resource/subresources, of the
+// Kube API which users other than admins can read through the proxy. Secrets,
+// and the proxy, exec, attach and portforward subresources, are deliberately
+// absent.
+var proxyReadableResources = map[string]bool{
+	"componentstatuses":        true,
+	"configmaps":               true,
+	"cronjobs":                 true,
+	"daemonsets":               true,
+	"deployments":              true,
+	"deployments/scale":        true,
+	"endpoints":                true,
+	"events":                   true,
+	"horizontalpodautoscalers": true,
+	"ingresses":                true,
+	"jobs":                     true,
+	"limitranges":              true,
+	"namespaces":               true,
+	"nodes":                    true,
+	"persistentvolumeclaims":   true,
+	"persistentvolumes":        true,
+	"pods":                     true,
+	"pods/log":                 true,
+	"replicasets":              true,
+	"replicationcontrollers":   true,
+	"resourcequotas":           true,
+	"serviceaccounts":          true,
+	"services":                 true,
+	"statefulsets":             true,
+	"storageclasses":           true,
+}
+
+// isProxyReadAllowed returns whether users other than admins may read
+// kubePath of the Kube API: the discovery paths (/api, /apis/extensions etc.,
+// /version and /openapi/v2), and the proxyReadableResources, in all namespaces
+// or one.
+func isProxyReadAllowed(kubePath string) bool {
+	// Paths such as /api/v1/pods/../secrets are resolved by the Kube API
+	if path.Clean(kubePath) != kubePath {
+		return false
+	}
+	if kubePath == "/version" || kubePath == "/openapi/v2" {
+		return true
+	}
+
+	parts := strings.Split(strings.TrimPrefix(kubePath, "/"), "/")
+	switch {
+	case parts[0] == "api" && len(parts) <= 2, parts[0] == "apis" && len(parts) <= 3:
+		return true
+	case parts[0] == "api":
+		parts = parts[2:]
+	case parts[0] == "apis":
+		parts = parts[3:]
+	default:
+		return false
+	}
+
+	// /watch/namespaces/ns/pods is the same as /namespaces/ns/pods?watch=1
+	if len(parts) > 1 && parts[0] == "watch" {
+		parts = parts[1:]
+	}
+	// namespaces/ns/pods[/name[/subresource]], except namespaces/ns itself
+	if len(parts) > 2 && parts[0] == "namespaces" {
+		parts = parts[2:]
+	}
+
+	// resource[/name[/subresource]]
+	switch len(parts) {
+	case 1, 2:
+		return proxyReadableResources[parts[0]]
+	case 3:
+		return proxyReadableResources[parts[0]+"/"+parts[2]]
+	}
+	return false
+}
@@ -0,0 +1,94 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/supergiant/supergiant/pkg/core"
+	"github.com/supergiant/supergiant/pkg/model"
+)
+
+const defaultLogLimit = 100
+
+func ListLog(core *core.Core, user *model.User, r *http.Request) (*Response, error) {
+	query, err := decodeLogQuery(r)
+	if err != nil {
+		return nil, err
+	}
+	entries, _, err := core.ReadLog(query)
+	if err != nil {
+		return nil, err
+	}
+	return &Response{http.StatusOK, &model.LogEntryList{Items: entries}}, nil
+}
+
+// FollowLog streams matching entries as newline-delimited JSON, starting
+// with the same entries ListLog would respond with, until the client
+// disconnects.
+func FollowLog(core *core.Core, user *model.User, w http.ResponseWriter, r *http.Request) error {
+	query, err := decodeLogQuery(r)
+	if err != nil {
+		return err
+	}
+	entries, offset, err := core.ReadLog(query)
+	if err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	w.WriteHeader(http.StatusOK)
+
+	encoder := json.NewEncoder(w)
+	flusher := w.(http.Flusher)
+
+	for _, entry := range entries {
+		if err := encoder.Encode(entry); err != nil {
+			return err
+		}
+	}
+	flusher.Flush()
+
+	return core.FollowLog(query, offset, r.Context().Done(), func(entry *model.LogEntry) error {
+		if err := encoder.Encode(entry); err != nil {
+			return err
+		}
+		flusher.Flush()
+		return nil
+	})
+}
+
+// Private
+
+// decodeLogQuery reads a LogQuery from query params, e.g.
+// ?level=warning&since=2016-10-01T00:00:00Z&model=KubeResource&model_id=5&q=error&limit=50
+func decodeLogQuery(r *http.Request) (query *model.LogQuery, err error) {
+	qstr := r.URL.Query()
+
+	query = &model.LogQuery{
+		Level:   qstr.Get("level"),
+		Model:   qstr.Get("model"),
+		ModelID: qstr.Get("model_id"),
+		Text:    qstr.Get("q"),
+		Limit:   defaultLogLimit,
+	}
+
+	if since := qstr.Get("since"); since != "" {
+		if query.Since, err = time.Parse(time.RFC3339, since); err != nil {
+			return nil, invalidQueryParam("since", since, "an RFC3339 time")
+		}
+	}
+	if until := qstr.Get("until"); until != "" {
+		if query.Until, err = time.Parse(time.RFC3339, until); err != nil {
+			return nil, invalidQueryParam("until", until, "an RFC3339 time")
+		}
+	}
+	if limit := qstr.Get("limit"); limit != "" {
+		if query.Limit, err = strconv.Atoi(limit); err != nil {
+			return nil, invalidQueryParam("limit", limit, "an integer")
+		}
+	}
+
+	return query, nil
+}
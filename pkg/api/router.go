@@ -9,6 +9,10 @@ import (
 func NewRouter(core *core.Core) *mux.Router {
 	r := mux.NewRouter()
 
+	// Liveness and readiness checks can't be authenticated
+	r.HandleFunc("/healthz", openHandler(core, Healthz)).Methods("GET")
+	r.HandleFunc("/readyz", openHandler(core, Readyz)).Methods("GET")
+
 	s := r.PathPrefix("/api/v0").Subrouter()
 
 	// Login request can't be authenticated
@@ -24,6 +28,7 @@ func NewRouter(core *core.Core) *mux.Router {
 	s.HandleFunc("/users/{id}", restrictedHandler(core, UpdateUser)).Methods("PATCH", "PUT")
 	s.HandleFunc("/users/{id}", restrictedHandler(core, DeleteUser)).Methods("DELETE")
 	s.HandleFunc("/users/{id}/regenerate_api_token", restrictedHandler(core, RegenerateUserAPIToken)).Methods("POST")
+	s.HandleFunc("/users/{id}/regenerate_proxy_token", restrictedHandler(core, RegenerateUserProxyToken)).Methods("POST")
 
 	s.HandleFunc("/cloud_accounts", restrictedHandler(core, CreateCloudAccount)).Methods("POST")
 	s.HandleFunc("/cloud_accounts", restrictedHandler(core, ListCloudAccounts)).Methods("GET")
@@ -36,15 +41,43 @@ func NewRouter(core *core.Core) *mux.Router {
 	s.HandleFunc("/kubes/{id}", restrictedHandler(core, GetKube)).Methods("GET")
 	s.HandleFunc("/kubes/{id}", restrictedHandler(core, UpdateKube)).Methods("PATCH", "PUT")
 	s.HandleFunc("/kubes/{id}/provision", restrictedHandler(core, ProvisionKube)).Methods("POST")
+	s.HandleFunc("/kubes/{id}/kubeconfig", streamingHandler(core, GetKubeKubeconfig)).Methods("GET")
+	s.PathPrefix("/kubes/{id}/proxy/").HandlerFunc(proxyHandler(core, ProxyKube))
 	s.HandleFunc("/kubes/{id}", restrictedHandler(core, DeleteKube)).Methods("DELETE")
 
 	s.HandleFunc("/kube_resources", restrictedHandler(core, CreateKubeResource)).Methods("POST")
+	s.HandleFunc("/kube_resources/import", restrictedHandler(core, ImportKubeResources)).Methods("POST")
+	s.HandleFunc("/kube_resources/manifest", restrictedHandler(core, CreateKubeResourcesFromManifest)).Methods("POST")
 	s.HandleFunc("/kube_resources", restrictedHandler(core, ListKubeResources)).Methods("GET")
 	s.HandleFunc("/kube_resources/{id}", restrictedHandler(core, GetKubeResource)).Methods("GET")
 	s.HandleFunc("/kube_resources/{id}", restrictedHandler(core, UpdateKubeResource)).Methods("PATCH", "PUT")
 	s.HandleFunc("/kube_resources/{id}/start", restrictedHandler(core, StartKubeResource)).Methods("POST")
 	s.HandleFunc("/kube_resources/{id}/stop", restrictedHandler(core, StopKubeResource)).Methods("POST")
+	s.HandleFunc("/kube_resources/{id}/revisions", restrictedHandler(core, ListKubeResourceRevisions)).Methods("GET")
+	s.HandleFunc("/kube_resources/{id}/rollback", restrictedHandler(core, RollbackKubeResource)).Methods("POST")
 	s.HandleFunc("/kube_resources/{id}", restrictedHandler(core, DeleteKubeResource)).Methods("DELETE")
+	s.HandleFunc("/kube_resources/{id}/logs", streamingHandler(core, GetKubeResourceLogs)).Methods("GET")
+	s.HandleFunc("/kube_resources/{id}/exec", streamingHandler(core, ExecKubeResource)).Methods("GET")
+
+	s.HandleFunc("/kube_resource_templates", restrictedHandler(core, CreateKubeResourceTemplate)).Methods("POST")
+	s.HandleFunc("/kube_resource_templates", restrictedHandler(core, ListKubeResourceTemplates)).Methods("GET")
+	s.HandleFunc("/kube_resource_templates/{id}", restrictedHandler(core, GetKubeResourceTemplate)).Methods("GET")
+	s.HandleFunc("/kube_resource_templates/{id}", restrictedHandler(core, UpdateKubeResourceTemplate)).Methods("PATCH", "PUT")
+	s.HandleFunc("/kube_resource_templates/{id}", restrictedHandler(core, DeleteKubeResourceTemplate)).Methods("DELETE")
+
+	s.HandleFunc("/apps", restrictedHandler(core, CreateApp)).Methods("POST")
+	s.HandleFunc("/apps", restrictedHandler(core, ListApps)).Methods("GET")
+	s.HandleFunc("/apps/{id}", restrictedHandler(core, GetApp)).Methods("GET")
+	s.HandleFunc("/apps/{id}", restrictedHandler(core, UpdateApp)).Methods("PATCH", "PUT")
+	s.HandleFunc("/apps/{id}/start", restrictedHandler(core, StartApp)).Methods("POST")
+	s.HandleFunc("/apps/{id}/stop", restrictedHandler(core, StopApp)).Methods("POST")
+	s.HandleFunc("/apps/{id}", restrictedHandler(core, DeleteApp)).Methods("DELETE")
+
+	s.HandleFunc("/secrets", restrictedHandler(core, CreateSecret)).Methods("POST")
+	s.HandleFunc("/secrets", restrictedHandler(core, ListSecrets)).Methods("GET")
+	s.HandleFunc("/secrets/{id}", restrictedHandler(core, GetSecret)).Methods("GET")
+	s.HandleFunc("/secrets/{id}", restrictedHandler(core, UpdateSecret)).Methods("PATCH", "PUT")
+	s.HandleFunc("/secrets/{id}", restrictedHandler(core, DeleteSecret)).Methods("DELETE")
 
 	s.HandleFunc("/nodes", restrictedHandler(core, CreateNode)).Methods("POST")
 	s.HandleFunc("/nodes", restrictedHandler(core, ListNodes)).Methods("GET")
@@ -70,7 +103,15 @@ func NewRouter(core *core.Core) *mux.Router {
 	s.HandleFunc("/entrypoint_listeners/{id}", restrictedHandler(core, UpdateEntrypointListener)).Methods("PATCH", "PUT")
 	s.HandleFunc("/entrypoint_listeners/{id}", restrictedHandler(core, DeleteEntrypointListener)).Methods("DELETE")
 
-	s.HandleFunc("/log", logHandler(core)).Methods("GET")
+	s.HandleFunc("/status", restrictedHandler(core, GetStatus)).Methods("GET")
+
+	s.HandleFunc("/log", streamingHandler(core, FollowLog)).Methods("GET").Queries("follow", "true")
+	s.HandleFunc("/log", restrictedHandler(core, ListLog)).Methods("GET")
+
+	// Prometheus scrape endpoint (opt-in with --metrics-enabled)
+	if core.Metrics != nil {
+		r.Handle("/metrics", core.Metrics).Methods("GET")
+	}
 
 	return r
 }
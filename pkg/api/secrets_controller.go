@@ -0,0 +1,62 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/supergiant/supergiant/pkg/core"
+	"github.com/supergiant/supergiant/pkg/model"
+)
+
+func ListSecrets(core *core.Core, user *model.User, r *http.Request) (*Response, error) {
+	return handleList(core, r, new(model.Secret), new(model.SecretList))
+}
+
+func CreateSecret(core *core.Core, user *model.User, r *http.Request) (*Response, error) {
+	item := new(model.Secret)
+	if err := decodeBodyInto(r, item); err != nil {
+		return nil, err
+	}
+	if err := core.Secrets.Create(item); err != nil {
+		return nil, err
+	}
+	return itemResponse(core, item, http.StatusCreated)
+}
+
+func UpdateSecret(core *core.Core, user *model.User, r *http.Request) (*Response, error) {
+	id, err := parseID(r)
+	if err != nil {
+		return nil, err
+	}
+	item := new(model.Secret)
+	if err := decodeBodyInto(r, item); err != nil {
+		return nil, err
+	}
+	if err := core.Secrets.Update(id, item); err != nil {
+		return nil, err
+	}
+	return itemResponse(core, item, http.StatusAccepted)
+}
+
+func GetSecret(core *core.Core, user *model.User, r *http.Request) (*Response, error) {
+	item := new(model.Secret)
+	id, err := parseID(r)
+	if err != nil {
+		return nil, err
+	}
+	if err := core.Secrets.Get(id, item); err != nil {
+		return nil, err
+	}
+	return itemResponse(core, item, http.StatusOK)
+}
+
+func DeleteSecret(core *core.Core, user *model.User, r *http.Request) (*Response, error) {
+	item := new(model.Secret)
+	id, err := parseID(r)
+	if err != nil {
+		return nil, err
+	}
+	if err := core.Secrets.Delete(id, item).Async(); err != nil {
+		return nil, err
+	}
+	return itemResponse(core, item, http.StatusAccepted)
+}
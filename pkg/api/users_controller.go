@@ -156,3 +156,23 @@ func RegenerateUserAPIToken(core *core.Core, user *model.User, r *http.Request)
 	}
 	return itemResponse(core, item, http.StatusAccepted)
 }
+
+func RegenerateUserProxyToken(core *core.Core, user *model.User, r *http.Request) (*Response, error) {
+	item := new(model.User)
+	id, err := parseID(r)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ensure the requester is this User, or an Admin
+	if err := ensureSameUser(id, user); err != nil {
+		if err = ensureAdmin(user); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := core.Users.RegenerateProxyToken(id, item); err != nil {
+		return nil, err
+	}
+	return itemResponse(core, item, http.StatusAccepted)
+}
@@ -4,13 +4,17 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"reflect"
 
+	"github.com/ghodss/yaml"
 	"github.com/mitchellh/go-homedir"
 	"github.com/supergiant/supergiant/pkg/client"
+	"github.com/supergiant/supergiant/pkg/kubernetes"
 	"github.com/supergiant/supergiant/pkg/model"
 	"github.com/urfave/cli"
 )
@@ -73,6 +77,45 @@ func New(clientFn func(*cli.Context) *client.Client, stdin *os.File, version str
 			}...),
 			Action: sgcli.commandKubectl,
 		},
+		{
+			Name:  "logs",
+			Usage: "query the server log (-f to follow)",
+			Flags: append(baseFlags, []cli.Flag{
+				cli.BoolFlag{
+					Name:  "follow, f",
+					Usage: "stream new log entries",
+				},
+				cli.StringFlag{
+					Name:  "level, l",
+					Usage: "minimum level, e.g. --level=warning",
+				},
+				cli.StringFlag{
+					Name:  "since",
+					Usage: "--since=1h or --since=2016-10-01T00:00:00Z",
+				},
+				cli.StringFlag{
+					Name:  "until",
+					Usage: "--until=10m or --until=2016-10-01T00:00:00Z",
+				},
+				cli.StringFlag{
+					Name:  "model",
+					Usage: "model type, e.g. --model=KubeResource",
+				},
+				cli.StringFlag{
+					Name:  "model-id",
+					Usage: "model ID (use with --model)",
+				},
+				cli.StringFlag{
+					Name:  "grep, g",
+					Usage: "case-insensitive message text",
+				},
+				cli.IntFlag{
+					Name:  "limit",
+					Usage: "number of most recent entries (default 100)",
+				},
+			}...),
+			Action: sgcli.commandLogs,
+		},
 		{
 			Name:  "cloud_accounts",
 			Usage: "actions for CloudAccounts",
@@ -115,6 +158,29 @@ func New(clientFn func(*cli.Context) *client.Client, stdin *os.File, version str
 				sgcli.commandGet("Kubes", new(model.Kube)),
 				sgcli.commandUpdate("Kubes", new(model.Kube)),
 				sgcli.commandAction("delete", "Delete", "Kubes", new(model.Kube)),
+				{
+					Name:  "kubeconfig",
+					Usage: "print a kubeconfig for the Kube (--merge to add it to ~/.kube/config)",
+					Flags: append(baseFlags, []cli.Flag{
+						cli.StringFlag{
+							Name:  "id",
+							Usage: "the Kube ID",
+						},
+						cli.BoolFlag{
+							Name:  "merge",
+							Usage: "merge into the kubeconfig file and switch to its context",
+						},
+						cli.StringFlag{
+							Name:  "file",
+							Usage: "the kubeconfig file to merge into (default $KUBECONFIG or ~/.kube/config)",
+						},
+						cli.BoolFlag{
+							Name:  "proxy",
+							Usage: "access the Kube through the Supergiant server, with your proxy token",
+						},
+					}...),
+					Action: sgcli.commandKubeconfig,
+				},
 			},
 		},
 		{
@@ -161,6 +227,41 @@ func New(clientFn func(*cli.Context) *client.Client, stdin *os.File, version str
 				sgcli.commandAction("resize", "Resize", "Volumes", new(model.Volume)),
 			},
 		},
+		{
+			Name:  "kube_resource_templates",
+			Usage: "actions for Kube Resource Templates",
+			Subcommands: []cli.Command{
+				sgcli.commandList("KubeResourceTemplates", new(model.KubeResourceTemplateList)),
+				sgcli.commandCreate("KubeResourceTemplates", new(model.KubeResourceTemplate)),
+				sgcli.commandGet("KubeResourceTemplates", new(model.KubeResourceTemplate)),
+				sgcli.commandUpdate("KubeResourceTemplates", new(model.KubeResourceTemplate)),
+				sgcli.commandAction("delete", "Delete", "KubeResourceTemplates", new(model.KubeResourceTemplate)),
+			},
+		},
+		{
+			Name:  "apps",
+			Usage: "actions for Apps",
+			Subcommands: []cli.Command{
+				sgcli.commandList("Apps", new(model.AppList)),
+				sgcli.commandCreate("Apps", new(model.App)),
+				sgcli.commandGet("Apps", new(model.App)),
+				sgcli.commandUpdate("Apps", new(model.App)),
+				sgcli.commandAction("delete", "Delete", "Apps", new(model.App)),
+				sgcli.commandAction("start", "Start", "Apps", new(model.App)),
+				sgcli.commandAction("stop", "Stop", "Apps", new(model.App)),
+			},
+		},
+		{
+			Name:  "secrets",
+			Usage: "actions for Secrets",
+			Subcommands: []cli.Command{
+				sgcli.commandList("Secrets", new(model.SecretList)),
+				sgcli.commandCreate("Secrets", new(model.Secret)),
+				sgcli.commandGet("Secrets", new(model.Secret)),
+				sgcli.commandUpdate("Secrets", new(model.Secret)),
+				sgcli.commandAction("delete", "Delete", "Secrets", new(model.Secret)),
+			},
+		},
 		{
 			Name:  "kube_resources",
 			Usage: "actions for Kube Resources",
@@ -172,6 +273,125 @@ func New(clientFn func(*cli.Context) *client.Client, stdin *os.File, version str
 				sgcli.commandAction("delete", "Delete", "KubeResources", new(model.KubeResource)),
 				sgcli.commandAction("start", "Start", "KubeResources", new(model.KubeResource)),
 				sgcli.commandAction("stop", "Stop", "KubeResources", new(model.KubeResource)),
+				{
+					Name:  "import",
+					Usage: "adopt existing resources of a Kube as Kube Resources",
+					Flags: append(baseFlags, []cli.Flag{
+						cli.StringFlag{
+							Name:  "kube-name",
+							Usage: "the Kube to import from",
+						},
+						cli.StringSliceFlag{
+							Name:  "kind",
+							Usage: "--kind=Service --kind=extensions/v1beta1/Deployment",
+						},
+						cli.StringSliceFlag{
+							Name:  "namespace",
+							Usage: "namespaces to import from (default all)",
+						},
+					}...),
+					Action: sgcli.commandKubeResourceImport,
+				},
+				{
+					Name:  "create-manifest",
+					Usage: "create a Kube Resource for each resource of a YAML or JSON manifest",
+					Flags: append(baseFlags, []cli.Flag{
+						cli.StringFlag{
+							Name:  "kube-name",
+							Usage: "the Kube to create in",
+						},
+						cli.StringFlag{
+							Name:  "namespace",
+							Usage: "namespace of resources which don't set one (default \"default\")",
+						},
+						cli.StringFlag{
+							Name:  "file, f",
+							Usage: "YAML or JSON manifest file, which may contain multiple documents",
+						},
+					}...),
+					Action: sgcli.commandKubeResourceCreateManifest,
+				},
+				{
+					Name:  "revisions",
+					Usage: "list the revisions of a Kube Resource",
+					Flags: append(baseFlags, []cli.Flag{
+						cli.StringFlag{
+							Name:  "id",
+							Usage: "the resource ID",
+						},
+						cli.StringFlag{
+							Name:  "format",
+							Usage: "--format=\"{{ .Revision }} {{ .Author }}\"",
+						},
+					}...),
+					Action: sgcli.commandKubeResourceRevisions,
+				},
+				{
+					Name:  "rollback",
+					Usage: "set the template of a Kube Resource back to that of a revision",
+					Flags: append(baseFlags, []cli.Flag{
+						cli.StringFlag{
+							Name:  "id",
+							Usage: "the resource ID",
+						},
+						cli.IntFlag{
+							Name:  "revision",
+							Usage: "the revision to roll back to",
+						},
+					}...),
+					Action: sgcli.commandKubeResourceRollback,
+				},
+				{
+					Name:  "logs",
+					Usage: "print the log of a Pod (-f to follow)",
+					Flags: append(baseFlags, []cli.Flag{
+						cli.StringFlag{
+							Name:  "id",
+							Usage: "the resource ID",
+						},
+						cli.StringFlag{
+							Name:  "container",
+							Usage: "container name, if the Pod has more than one",
+						},
+						cli.IntFlag{
+							Name:  "tail",
+							Usage: "number of most recent lines",
+						},
+						cli.StringFlag{
+							Name:  "since",
+							Usage: "--since=1h or --since=2016-10-01T00:00:00Z",
+						},
+						cli.BoolFlag{
+							Name:  "follow, f",
+							Usage: "stream new lines",
+						},
+					}...),
+					Action: sgcli.commandKubeResourceLogs,
+				},
+				{
+					Name:      "exec",
+					Usage:     "run a command in a Pod",
+					ArgsUsage: "-- COMMAND [ARGS...]",
+					Flags: append(baseFlags, []cli.Flag{
+						cli.StringFlag{
+							Name:  "id",
+							Usage: "the resource ID",
+						},
+						cli.StringFlag{
+							Name:  "container",
+							Usage: "container name, if the Pod has more than one",
+						},
+						cli.BoolFlag{
+							Name:  "stdin, i",
+							Usage: "pass stdin to the command",
+						},
+						cli.BoolFlag{
+							Name:  "tty",
+							Usage: "allocate a terminal for the command",
+						},
+					}...),
+					Action: sgcli.commandKubeResourceExec,
+				},
 			},
 		},
 	}
@@ -224,7 +444,7 @@ func (sgcli *CLI) commandCreate(collectionName string, item model.Model) cli.Com
 		Flags: append(baseFlags, []cli.Flag{
 			cli.StringFlag{
 				Name:  "file, f",
-				Usage: "JSON input file",
+				Usage: "JSON or YAML input file",
 			},
 		}...),
 		Action: func(c *cli.Context) error {
@@ -278,7 +498,7 @@ func (sgcli *CLI) commandUpdate(collectionName string, item model.Model) cli.Com
 			},
 			cli.StringFlag{
 				Name:  "file, f",
-				Usage: "JSON input file",
+				Usage: "JSON or YAML input file",
 			},
 		}...),
 		Action: func(c *cli.Context) error {
@@ -344,13 +564,16 @@ func (sgcli *CLI) commandKubectl(c *cli.Context) error {
 		return err
 	}
 
-	args := []string{
-		"--api-version=v1",
-		"--insecure-skip-tls-verify=true",
-		"--server=https://" + kube.MasterPublicIP,
-		"--cluster=" + kube.Name,
-		"--username=" + kube.Username,
-		"--password=" + kube.Password,
+	// Certificates are passed to kubectl as files
+	dir, err := ioutil.TempDir("", "supergiant-kubectl")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+
+	args, err := kubectlArgs(kube, dir)
+	if err != nil {
+		return err
 	}
 	// Prepend the user's kubectl args, which will look like `get pods --namespace=kube-system`
 	args = append([]string(c.Args()), args...)
@@ -363,24 +586,176 @@ func (sgcli *CLI) commandKubectl(c *cli.Context) error {
 	return cmd.Run()
 }
 
+func (sgcli *CLI) commandKubeconfig(c *cli.Context) error {
+	id := c.Int64("id")
+	kubes := sgcli.Client(c).Kubes
+	kubeconfig := kubes.Kubeconfig
+	if c.Bool("proxy") {
+		kubeconfig = kubes.ProxyKubeconfig
+	}
+	config, err := kubeconfig(&id)
+	if err != nil {
+		return err
+	}
+
+	if !c.Bool("merge") {
+		out, err := config.YAML()
+		if err != nil {
+			return err
+		}
+		_, err = os.Stdout.Write(out)
+		return err
+	}
+
+	path := c.String("file")
+	if path == "" {
+		if path, err = kubeconfigPath(); err != nil {
+			return err
+		}
+	}
+	existing, err := ioutil.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	merged, err := kubernetes.MergeKubeconfig(existing, config)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(path, merged, 0600); err != nil {
+		return err
+	}
+	fmt.Printf("Merged into %s, switched to context %s\n", path, config.CurrentContext)
+	return nil
+}
+
+func (sgcli *CLI) commandLogs(c *cli.Context) error {
+	query, err := logQuery(c)
+	if err != nil {
+		return err
+	}
+
+	if c.Bool("follow") {
+		return sgcli.Client(c).Logs.Follow(query, printLogEntry)
+	}
+
+	list := new(model.LogEntryList)
+	if err := sgcli.Client(c).Logs.List(query, list); err != nil {
+		return err
+	}
+	for _, entry := range list.Items {
+		printLogEntry(entry)
+	}
+	return nil
+}
+
+func (sgcli *CLI) commandKubeResourceLogs(c *cli.Context) error {
+	id := c.Int64("id")
+	since, err := parseTimeFlag(c, "since")
+	if err != nil {
+		return err
+	}
+	query := &model.PodLogQuery{
+		Container: c.String("container"),
+		Tail:      c.Int("tail"),
+		Since:     since,
+		Follow:    c.Bool("follow"),
+	}
+	return sgcli.Client(c).KubeResources.Logs(&id, query, os.Stdout)
+}
+
+func (sgcli *CLI) commandKubeResourceRevisions(c *cli.Context) error {
+	id := c.Int64("id")
+	list := new(model.KubeResourceRevisionList)
+	if err := sgcli.Client(c).KubeResources.Revisions(&id, list); err != nil {
+		return err
+	}
+	return printList(c, list)
+}
+
+func (sgcli *CLI) commandKubeResourceRollback(c *cli.Context) error {
+	id := c.Int64("id")
+	item := new(model.KubeResource)
+	if err := sgcli.Client(c).KubeResources.Rollback(&id, c.Int("revision"), item); err != nil {
+		return err
+	}
+	return printObj(item)
+}
+
+func (sgcli *CLI) commandKubeResourceImport(c *cli.Context) error {
+	item := &model.KubeResourceImport{
+		KubeName:   c.String("kube-name"),
+		Kinds:      c.StringSlice("kind"),
+		Namespaces: c.StringSlice("namespace"),
+	}
+	if err := sgcli.Client(c).KubeResources.Import(item); err != nil {
+		return err
+	}
+	return printObj(item)
+}
+
+func (sgcli *CLI) commandKubeResourceCreateManifest(c *cli.Context) error {
+	manifest, err := sgcli.readInputFile(c)
+	if err != nil {
+		return err
+	}
+	item := &model.KubeResourceManifest{
+		KubeName:  c.String("kube-name"),
+		Namespace: c.String("namespace"),
+		Manifest:  string(manifest),
+	}
+	if err := sgcli.Client(c).KubeResources.CreateFromManifest(item); err != nil {
+		return err
+	}
+	return printObj(item)
+}
+
+func (sgcli *CLI) commandKubeResourceExec(c *cli.Context) error {
+	id := c.Int64("id")
+	query := &model.ExecQuery{
+		Container: c.String("container"),
+		Command:   []string(c.Args()),
+		Stdin:     c.Bool("stdin"),
+		TTY:       c.Bool("tty"),
+	}
+	if len(query.Command) == 0 {
+		return errors.New("command required, e.g. supergiant kube_resources exec --id=1 -- ls -l")
+	}
+
+	conn, err := sgcli.Client(c).KubeResources.Exec(&id, query)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	var stdin io.Reader
+	if query.Stdin {
+		stdin = sgcli.Stdin
+	}
+	return streamExec(conn, stdin, os.Stdout, os.Stderr)
+}
+
 // Helpers
 
-func (sgcli *CLI) decodeInputFileInto(c *cli.Context, item model.Model) (err error) {
-	var file *os.File
+func (sgcli *CLI) decodeInputFileInto(c *cli.Context, item model.Model) error {
+	data, err := sgcli.readInputFile(c)
+	if err != nil {
+		return err
+	}
+	// JSON is also YAML
+	return yaml.Unmarshal(data, item)
+}
 
+// readInputFile reads the file given with -f, or stdin with -f -.
+func (sgcli *CLI) readInputFile(c *cli.Context) ([]byte, error) {
 	switch filepath := c.String("f"); filepath {
 	case "":
-		return errors.New("-f required")
+		return nil, errors.New("-f required")
 	case "-":
-		file = sgcli.Stdin
+		return ioutil.ReadAll(sgcli.Stdin)
 	default:
-		file, err = os.Open(filepath)
-		if err != nil {
-			return err
-		}
-		defer file.Close()
+		return ioutil.ReadFile(filepath)
 	}
-
-	err = json.NewDecoder(file).Decode(item)
-	return err
 }
@@ -1,12 +1,18 @@
 package cli_test
 
 import (
+	"encoding/json"
+	"errors"
+	"io"
 	"io/ioutil"
 	"os"
 	"testing"
+	"time"
 
+	"github.com/gorilla/websocket"
 	"github.com/supergiant/supergiant/pkg/cli"
 	"github.com/supergiant/supergiant/pkg/client"
+	"github.com/supergiant/supergiant/pkg/kubernetes"
 	"github.com/supergiant/supergiant/pkg/model"
 	"github.com/supergiant/supergiant/test/fake_client"
 	cli_lib "github.com/urfave/cli"
@@ -17,7 +23,15 @@ import (
 // TODO we could use this everywhere
 func idInt64(n int64) *int64 { return &n }
 
+func rawMessage(s string) *json.RawMessage {
+	msg := json.RawMessage(s)
+	return &msg
+}
+
 func TestCLIRun(t *testing.T) {
+	// Commands whose fake client returns an error would otherwise exit
+	cli_lib.OsExiter = func(int) {}
+
 	Convey("CLI works correctly", t, func() {
 		table := []struct {
 			// Input
@@ -85,6 +99,129 @@ func TestCLIRun(t *testing.T) {
 					&model.CloudAccount{},
 				},
 			},
+			// Logs List
+			{
+				command:             []string{"supergiant", "logs", "--level=warning", "--model=KubeResource", "--model-id=5", "--until=2016-10-01T00:00:00Z"},
+				clientCommandCalled: "Logs.List",
+				clientCommandArgs: []interface{}{
+					&model.LogQuery{
+						Level:   "warning",
+						Until:   time.Date(2016, 10, 1, 0, 0, 0, 0, time.UTC),
+						Model:   "KubeResource",
+						ModelID: "5",
+					},
+					&model.LogEntryList{},
+				},
+			},
+			// Logs Follow
+			{
+				command:             []string{"supergiant", "logs", "-f", "--grep=error", "--limit=10"},
+				clientCommandCalled: "Logs.Follow",
+				clientCommandArgs: []interface{}{
+					&model.LogQuery{
+						Text:  "error",
+						Limit: 10,
+					},
+				},
+			},
+			// Kubes Kubeconfig
+			{
+				command:             []string{"supergiant", "kubes", "kubeconfig", "--id=1"},
+				clientCommandCalled: "Kubes.Kubeconfig",
+				clientCommandArgs:   []interface{}{idInt64(1)},
+			},
+			// Kubes Kubeconfig through the proxy
+			{
+				command:             []string{"supergiant", "kubes", "kubeconfig", "--id=1", "--proxy"},
+				clientCommandCalled: "Kubes.ProxyKubeconfig",
+				clientCommandArgs:   []interface{}{idInt64(1)},
+			},
+			// KubeResources Logs
+			{
+				command:             []string{"supergiant", "kube_resources", "logs", "--id=1", "--container=app", "--tail=10", "-f"},
+				clientCommandCalled: "KubeResources.Logs",
+				clientCommandArgs: []interface{}{
+					idInt64(1),
+					&model.PodLogQuery{
+						Container: "app",
+						Tail:      10,
+						Follow:    true,
+					},
+				},
+			},
+			// KubeResources Import
+			{
+				command:             []string{"supergiant", "kube_resources", "import", "--kube-name=test", "--kind=Service", "--kind=extensions/v1beta1/Deployment", "--namespace=default"},
+				clientCommandCalled: "KubeResources.Import",
+				clientCommandArgs: []interface{}{
+					&model.KubeResourceImport{
+						KubeName:   "test",
+						Kinds:      []string{"Service", "extensions/v1beta1/Deployment"},
+						Namespaces: []string{"default"},
+					},
+				},
+			},
+			// KubeResources Create from YAML
+			{
+				command: []string{"supergiant", "kube_resources", "create", "-f", "-"},
+				stdin: `kube_name: test
+kind: ConfigMap
+namespace: default
+name: config
+template:
+  data:
+    debug: "true"
+`,
+				clientCommandCalled: "KubeResources.Create",
+				clientCommandArgs: []interface{}{
+					&model.KubeResource{
+						KubeName:  "test",
+						Kind:      "ConfigMap",
+						Namespace: "default",
+						Name:      "config",
+						Template:  rawMessage(`{"data":{"debug":"true"}}`),
+					},
+				},
+			},
+			// KubeResources CreateFromManifest
+			{
+				command: []string{"supergiant", "kube_resources", "create-manifest", "--kube-name=test", "--namespace=blog", "-f", "-"},
+				stdin: `kind: ConfigMap
+metadata:
+  name: config
+---
+kind: Secret
+metadata:
+  name: password
+`,
+				clientCommandCalled: "KubeResources.CreateFromManifest",
+				clientCommandArgs: []interface{}{
+					&model.KubeResourceManifest{
+						KubeName:  "test",
+						Namespace: "blog",
+						Manifest:  "kind: ConfigMap\nmetadata:\n  name: config\n---\nkind: Secret\nmetadata:\n  name: password\n",
+					},
+				},
+			},
+			// KubeResources Rollback
+			{
+				command:             []string{"supergiant", "kube_resources", "rollback", "--id=1", "--revision=2"},
+				clientCommandCalled: "KubeResources.Rollback",
+				clientCommandArgs:   []interface{}{idInt64(1), 2, new(model.KubeResource)},
+			},
+			// KubeResources Exec
+			{
+				command:             []string{"supergiant", "kube_resources", "exec", "--id=1", "-i", "--tty", "--", "sh", "-c", "ls -l"},
+				clientCommandCalled: "KubeResources.Exec",
+				clientCommandArgs: []interface{}{
+					idInt64(1),
+					&model.ExecQuery{
+						Command: []string{"sh", "-c", "ls -l"},
+						Stdin:   true,
+						TTY:     true,
+					},
+				},
+			},
 		}
 
 		for _, item := range table {
@@ -94,6 +231,18 @@ func TestCLIRun(t *testing.T) {
 
 			clientFn := func(_ *cli_lib.Context) *client.Client {
 				return &client.Client{
+					Logs: &fake_client.Logs{
+						ListFn: func(query *model.LogQuery, list *model.LogEntryList) error {
+							clientCommandCalled = "Logs.List"
+							clientCommandArgs = []interface{}{query, list}
+							return nil
+						},
+						FollowFn: func(query *model.LogQuery, _ func(*model.LogEntry) error) error {
+							clientCommandCalled = "Logs.Follow"
+							clientCommandArgs = []interface{}{query}
+							return nil
+						},
+					},
 					Sessions: &fake_client.Sessions{
 						Collection: fake_client.Collection{
 							ListFn: func(list model.List) error {
@@ -214,6 +363,16 @@ func TestCLIRun(t *testing.T) {
 								return nil
 							},
 						},
+						KubeconfigFn: func(id *int64) (*kubernetes.Kubeconfig, error) {
+							clientCommandCalled = "Kubes.Kubeconfig"
+							clientCommandArgs = []interface{}{id}
+							return kubernetes.NewKubeconfig(&model.Kube{Name: "test"}), nil
+						},
+						ProxyKubeconfigFn: func(id *int64) (*kubernetes.Kubeconfig, error) {
+							clientCommandCalled = "Kubes.ProxyKubeconfig"
+							clientCommandArgs = []interface{}{id}
+							return kubernetes.NewKubeconfig(&model.Kube{Name: "test"}), nil
+						},
 					},
 					KubeResources: &fake_client.KubeResources{
 						Collection: fake_client.Collection{
@@ -253,6 +412,31 @@ func TestCLIRun(t *testing.T) {
 							clientCommandArgs = []interface{}{id, m}
 							return nil
 						},
+						LogsFn: func(id *int64, query *model.PodLogQuery, _ io.Writer) error {
+							clientCommandCalled = "KubeResources.Logs"
+							clientCommandArgs = []interface{}{id, query}
+							return nil
+						},
+						ImportFn: func(m *model.KubeResourceImport) error {
+							clientCommandCalled = "KubeResources.Import"
+							clientCommandArgs = []interface{}{m}
+							return nil
+						},
+						CreateFromManifestFn: func(m *model.KubeResourceManifest) error {
+							clientCommandCalled = "KubeResources.CreateFromManifest"
+							clientCommandArgs = []interface{}{m}
+							return nil
+						},
+						RollbackFn: func(id *int64, revision int, m *model.KubeResource) error {
+							clientCommandCalled = "KubeResources.Rollback"
+							clientCommandArgs = []interface{}{id, revision, m}
+							return nil
+						},
+						ExecFn: func(id *int64, query *model.ExecQuery) (*websocket.Conn, error) {
+							clientCommandCalled = "KubeResources.Exec"
+							clientCommandArgs = []interface{}{id, query}
+							return nil, errors.New("not connecting in test")
+						},
 					},
 					Volumes: &fake_client.Volumes{
 						Collection: fake_client.Collection{
@@ -2,15 +2,24 @@ package cli
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"html/template"
+	"io"
 	"io/ioutil"
 	"os"
+	"path/filepath"
 	"reflect"
+	"sort"
 	"strings"
+	"time"
 
+	"github.com/gorilla/websocket"
 	"github.com/imdario/mergo"
+	"github.com/mitchellh/go-homedir"
 	"github.com/supergiant/supergiant/pkg/client"
+	"github.com/supergiant/supergiant/pkg/kubernetes"
+	"github.com/supergiant/supergiant/pkg/model"
 	"github.com/urfave/cli"
 )
 
@@ -87,3 +96,155 @@ func listFilters(c *cli.Context) (map[string][]string, error) {
 	}
 	return filters, nil
 }
+
+func logQuery(c *cli.Context) (query *model.LogQuery, err error) {
+	query = &model.LogQuery{
+		Level:   c.String("level"),
+		Model:   c.String("model"),
+		ModelID: c.String("model-id"),
+		Text:    c.String("grep"),
+		Limit:   c.Int("limit"),
+	}
+	if query.Since, err = parseTimeFlag(c, "since"); err != nil {
+		return nil, err
+	}
+	if query.Until, err = parseTimeFlag(c, "until"); err != nil {
+		return nil, err
+	}
+	return query, nil
+}
+
+// parseTimeFlag accepts a duration before now (e.g. 1h) or an RFC3339 time.
+func parseTimeFlag(c *cli.Context, name string) (time.Time, error) {
+	value := c.String(name)
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if d, err := time.ParseDuration(value); err == nil {
+		return time.Now().Add(-d), nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("Invalid %s flag '%s'", name, value)
+	}
+	return t, nil
+}
+
+func printLogEntry(entry *model.LogEntry) error {
+	line := entry.Message
+	if !entry.Time.IsZero() {
+		line = fmt.Sprintf("%s %-7s %s", entry.Time.Format(time.RFC3339), strings.ToUpper(entry.Level), entry.Message)
+	}
+
+	var keys []string
+	for key := range entry.Fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		line += fmt.Sprintf(" %s=%v", key, entry.Fields[key])
+	}
+
+	fmt.Println(line)
+	return nil
+}
+
+// streamExec passes stdin (unless nil) to an exec connection, and writes the
+// output of the command to stdout and stderr until it exits.
+func streamExec(conn *websocket.Conn, stdin io.Reader, stdout io.Writer, stderr io.Writer) error {
+	if stdin != nil {
+		go func() {
+			buf := make([]byte, 1024)
+			for {
+				n, err := stdin.Read(buf)
+				if n > 0 {
+					message := append([]byte{kubernetes.ExecStdin}, buf[:n]...)
+					if err := conn.WriteMessage(websocket.BinaryMessage, message); err != nil {
+						return
+					}
+				}
+				if err != nil {
+					return
+				}
+			}
+		}()
+	}
+
+	for {
+		_, message, err := conn.ReadMessage()
+		if err != nil {
+			if websocket.IsCloseError(err, websocket.CloseNormalClosure) {
+				return nil
+			}
+			return err
+		}
+		// Kubernetes opens each stream with an empty message
+		if len(message) < 2 {
+			continue
+		}
+		switch message[0] {
+		case kubernetes.ExecStdout:
+			stdout.Write(message[1:])
+		case kubernetes.ExecStderr:
+			stderr.Write(message[1:])
+		case kubernetes.ExecError:
+			return errors.New(string(message[1:]))
+		}
+	}
+}
+
+// kubectlArgs returns the kubectl flags for connecting to kube, writing its
+// certificates to dir.
+func kubectlArgs(kube *model.Kube, dir string) ([]string, error) {
+	args := []string{
+		"--api-version=v1",
+		"--server=https://" + kube.MasterPublicIP,
+		"--cluster=" + kube.Name,
+	}
+
+	writeFile := func(name string, content string) (string, error) {
+		path := filepath.Join(dir, name)
+		return path, ioutil.WriteFile(path, []byte(content), 0600)
+	}
+
+	if kube.CACert != "" {
+		path, err := writeFile("ca.pem", kube.CACert)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, "--certificate-authority="+path)
+	} else {
+		args = append(args, "--insecure-skip-tls-verify=true")
+	}
+
+	switch {
+	case kube.BearerToken != "":
+		args = append(args, "--token="+kube.BearerToken)
+	case kube.ClientCert != "":
+		certPath, err := writeFile("client.pem", kube.ClientCert)
+		if err != nil {
+			return nil, err
+		}
+		keyPath, err := writeFile("client-key.pem", kube.ClientKey)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, "--client-certificate="+certPath, "--client-key="+keyPath)
+	default:
+		args = append(args, "--username="+kube.Username, "--password="+kube.Password)
+	}
+	return args, nil
+}
+
+// kubeconfigPath returns the kubeconfig file kubectl uses: the first one in
+// $KUBECONFIG, or ~/.kube/config.
+func kubeconfigPath() (string, error) {
+	if paths := filepath.SplitList(os.Getenv("KUBECONFIG")); len(paths) > 0 && paths[0] != "" {
+		return paths[0], nil
+	}
+	home, err := homedir.Dir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".kube", "config"), nil
+}
@@ -0,0 +1,21 @@
+package client
+
+import "github.com/supergiant/supergiant/pkg/model"
+
+type AppsInterface interface {
+	CollectionInterface
+	Start(*int64, *model.App) error
+	Stop(*int64, *model.App) error
+}
+
+type Apps struct {
+	Collection
+}
+
+func (c *Apps) Start(id *int64, m *model.App) error {
+	return c.client.request("POST", c.memberPath(id)+"/start", nil, m, nil)
+}
+
+func (c *Apps) Stop(id *int64, m *model.App) error {
+	return c.client.request("POST", c.memberPath(id)+"/stop", nil, m, nil)
+}
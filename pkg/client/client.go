@@ -9,7 +9,10 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"strings"
 
+	"github.com/gorilla/websocket"
+	"github.com/supergiant/supergiant/pkg/kubernetes"
 	"github.com/supergiant/supergiant/pkg/model"
 )
 
@@ -21,16 +24,21 @@ type Client struct {
 	AuthToken string
 
 	httpClient *http.Client
-
-	Sessions            SessionsInterface
-	Users               UsersInterface
-	CloudAccounts       CloudAccountsInterface
-	Kubes               KubesInterface
-	KubeResources       KubeResourcesInterface
-	Volumes             VolumesInterface
-	Entrypoints         EntrypointsInterface
-	EntrypointListeners EntrypointListenersInterface
-	Nodes               NodesInterface
+	tlsConfig  *tls.Config
+
+	Sessions              SessionsInterface
+	Users                 UsersInterface
+	CloudAccounts         CloudAccountsInterface
+	Kubes                 KubesInterface
+	KubeResources         KubeResourcesInterface
+	KubeResourceTemplates KubeResourceTemplatesInterface
+	Apps                  AppsInterface
+	Secrets               SecretsInterface
+	Volumes               VolumesInterface
+	Entrypoints           EntrypointsInterface
+	EntrypointListeners   EntrypointListenersInterface
+	Nodes                 NodesInterface
+	Logs                  LogsInterface
 }
 
 func New(url string, authType string, authToken string, certFile string) *Client {
@@ -51,9 +59,10 @@ func New(url string, authType string, authToken string, certFile string) *Client
 		if ok := roots.AppendCertsFromPEM(pem); !ok {
 			panic("failed to parse root certificate")
 		}
-		transport.TLSClientConfig = &tls.Config{
+		client.tlsConfig = &tls.Config{
 			RootCAs: roots,
 		}
+		transport.TLSClientConfig = client.tlsConfig
 	}
 
 	client.httpClient = &http.Client{
@@ -65,69 +74,125 @@ func New(url string, authType string, authToken string, certFile string) *Client
 	client.CloudAccounts = &CloudAccounts{Collection{client, "cloud_accounts"}}
 	client.Kubes = &Kubes{Collection{client, "kubes"}}
 	client.KubeResources = &KubeResources{Collection{client, "kube_resources"}}
+	client.KubeResourceTemplates = &KubeResourceTemplates{Collection{client, "kube_resource_templates"}}
+	client.Apps = &Apps{Collection{client, "apps"}}
+	client.Secrets = &Secrets{Collection{client, "secrets"}}
 	client.Volumes = &Volumes{Collection{client, "volumes"}}
 	client.Entrypoints = &Entrypoints{Collection{client, "entrypoints"}}
 	client.EntrypointListeners = &EntrypointListeners{Collection{client, "entrypoint_listeners"}}
 	client.Nodes = &Nodes{Collection{client, "nodes"}}
+	client.Logs = &Logs{client}
 
 	return client
 }
 
 func (c *Client) request(method string, path string, in interface{}, out interface{}, queryValues map[string][]string) error {
-	body := new(bytes.Buffer)
-	if in != nil {
-		if err := json.NewEncoder(body).Encode(in); err != nil {
+	resp, err := c.do(method, path, in, queryValues)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if out != nil {
+		if err = json.NewDecoder(resp.Body).Decode(out); err != nil {
 			return err
 		}
 	}
 
-	requestURL, err := url.Parse(c.BaseURL + "/api/v0/" + path)
+	return nil
+}
+
+// dial opens a WebSocket connection.
+func (c *Client) dial(path string, queryValues map[string][]string) (*websocket.Conn, error) {
+	requestURL, err := c.url(path, queryValues)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	// http -> ws, https -> wss
+	requestURL.Scheme = strings.Replace(requestURL.Scheme, "http", "ws", 1)
 
-	q := requestURL.Query()
-	for key, values := range queryValues {
-		for _, value := range values {
-			q.Add(key, value)
+	dialer := &websocket.Dialer{
+		TLSClientConfig: c.tlsConfig,
+		Subprotocols:    []string{kubernetes.ExecProtocol},
+	}
+	header := make(http.Header)
+	header.Set("Authorization", c.authorization())
+
+	conn, resp, err := dialer.Dial(requestURL.String(), header)
+	if err == websocket.ErrBadHandshake {
+		defer resp.Body.Close()
+		return nil, responseError(resp)
+	}
+	return conn, err
+}
+
+// do performs a request, returning the response only if successful. The caller
+// must close the response body.
+func (c *Client) do(method string, path string, in interface{}, queryValues map[string][]string) (*http.Response, error) {
+	body := new(bytes.Buffer)
+	if in != nil {
+		if err := json.NewEncoder(body).Encode(in); err != nil {
+			return nil, err
 		}
 	}
-	requestURL.RawQuery = q.Encode()
+
+	requestURL, err := c.url(path, queryValues)
+	if err != nil {
+		return nil, err
+	}
 
 	req, err := http.NewRequest(method, requestURL.String(), body)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	req.Header.Set("Authorization", fmt.Sprintf(`SGAPI %s="%s"`, c.AuthType, c.AuthToken))
+	req.Header.Set("Authorization", c.authorization())
 
 	req.Close = true
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if resp.Status[:2] != "20" {
 		defer resp.Body.Close()
-		body, err := ioutil.ReadAll(resp.Body)
-		if err != nil {
-			return err
-		}
-		errModel := new(model.Error)
-		if err := json.Unmarshal(body, errModel); err != nil {
-			// If unmarshalling failed, we have to fallback to capturing the full text
-			errModel.Message = string(body)
-		}
-		return errModel
+		return nil, responseError(resp)
 	}
 
-	if out != nil {
-		defer resp.Body.Close()
-		if err = json.NewDecoder(resp.Body).Decode(out); err != nil {
-			return err
+	return resp, nil
+}
+
+func (c *Client) url(path string, queryValues map[string][]string) (*url.URL, error) {
+	requestURL, err := url.Parse(c.BaseURL + "/api/v0/" + path)
+	if err != nil {
+		return nil, err
+	}
+
+	q := requestURL.Query()
+	for key, values := range queryValues {
+		for _, value := range values {
+			q.Add(key, value)
 		}
 	}
+	requestURL.RawQuery = q.Encode()
+	return requestURL, nil
+}
 
-	return nil
+func (c *Client) authorization() string {
+	return fmt.Sprintf(`SGAPI %s="%s"`, c.AuthType, c.AuthToken)
+}
+
+// responseError reads the error of an unsuccessful response.
+func responseError(resp *http.Response) error {
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	errModel := new(model.Error)
+	if err := json.Unmarshal(body, errModel); err != nil {
+		// If unmarshalling failed, we have to fallback to capturing the full text
+		errModel.Message = string(body)
+	}
+	return errModel
 }
@@ -0,0 +1,9 @@
+package client
+
+type KubeResourceTemplatesInterface interface {
+	CollectionInterface
+}
+
+type KubeResourceTemplates struct {
+	Collection
+}
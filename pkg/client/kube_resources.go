@@ -1,11 +1,23 @@
 package client
 
-import "github.com/supergiant/supergiant/pkg/model"
+import (
+	"io"
+	"strconv"
+
+	"github.com/gorilla/websocket"
+	"github.com/supergiant/supergiant/pkg/model"
+)
 
 type KubeResourcesInterface interface {
 	CollectionInterface
 	Start(*int64, *model.KubeResource) error
 	Stop(*int64, *model.KubeResource) error
+	Logs(*int64, *model.PodLogQuery, io.Writer) error
+	Exec(*int64, *model.ExecQuery) (*websocket.Conn, error)
+	Import(*model.KubeResourceImport) error
+	CreateFromManifest(*model.KubeResourceManifest) error
+	Revisions(*int64, *model.KubeResourceRevisionList) error
+	Rollback(*int64, int, *model.KubeResource) error
 }
 
 type KubeResources struct {
@@ -19,3 +31,46 @@ func (c *KubeResources) Start(id *int64, m *model.KubeResource) error {
 func (c *KubeResources) Stop(id *int64, m *model.KubeResource) error {
 	return c.client.request("POST", c.memberPath(id)+"/stop", nil, m, nil)
 }
+
+// Logs writes the log of a Pod KubeResource to w. If query.Follow is set, it
+// returns once the connection is closed.
+func (c *KubeResources) Logs(id *int64, query *model.PodLogQuery, w io.Writer) error {
+	resp, err := c.client.do("GET", c.memberPath(id)+"/logs", nil, query.QueryValues())
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	_, err = io.Copy(w, resp.Body)
+	return err
+}
+
+// Exec runs a command in a Pod KubeResource, and returns its connection, which
+// speaks the kubernetes.ExecProtocol.
+func (c *KubeResources) Exec(id *int64, query *model.ExecQuery) (*websocket.Conn, error) {
+	return c.client.dial(c.memberPath(id)+"/exec", query.QueryValues())
+}
+
+// Import adopts existing resources of a Kube as KubeResources, setting the
+// Imported, Skipped and Failed resources on m.
+func (c *KubeResources) Import(m *model.KubeResourceImport) error {
+	return c.client.request("POST", c.basePath+"/import", m, m, nil)
+}
+
+// CreateFromManifest creates a KubeResource for each resource of m.Manifest,
+// setting the Results on m.
+func (c *KubeResources) CreateFromManifest(m *model.KubeResourceManifest) error {
+	return c.client.request("POST", c.basePath+"/manifest", m, m, nil)
+}
+
+// Revisions sets the revisions of a KubeResource on list, in order.
+func (c *KubeResources) Revisions(id *int64, list *model.KubeResourceRevisionList) error {
+	return c.client.request("GET", c.memberPath(id)+"/revisions", nil, list, nil)
+}
+
+// Rollback sets the Template of a KubeResource back to that of one of its
+// revisions, and applies it if the KubeResource is started.
+func (c *KubeResources) Rollback(id *int64, revision int, m *model.KubeResource) error {
+	queryValues := map[string][]string{"revision": {strconv.Itoa(revision)}}
+	return c.client.request("POST", c.memberPath(id)+"/rollback", nil, m, queryValues)
+}
@@ -1,10 +1,18 @@
 package client
 
-import "github.com/supergiant/supergiant/pkg/model"
+import (
+	"io/ioutil"
+
+	"github.com/ghodss/yaml"
+	"github.com/supergiant/supergiant/pkg/kubernetes"
+	"github.com/supergiant/supergiant/pkg/model"
+)
 
 type KubesInterface interface {
 	CollectionInterface
 	Provision(*int64, *model.Kube) error
+	Kubeconfig(*int64) (*kubernetes.Kubeconfig, error)
+	ProxyKubeconfig(*int64) (*kubernetes.Kubeconfig, error)
 }
 
 type Kubes struct {
@@ -14,3 +22,35 @@ type Kubes struct {
 func (c *Kubes) Provision(id *int64, m *model.Kube) error {
 	return c.client.request("POST", c.memberPath(id)+"/provision", nil, m, nil)
 }
+
+// Kubeconfig returns a kubeconfig for the current user to access the Kube
+// directly.
+func (c *Kubes) Kubeconfig(id *int64) (*kubernetes.Kubeconfig, error) {
+	return c.kubeconfig(c.memberPath(id) + "/kubeconfig")
+}
+
+// ProxyKubeconfig returns a kubeconfig for the current user to access the Kube
+// through the Kube API proxy of Supergiant.
+func (c *Kubes) ProxyKubeconfig(id *int64) (*kubernetes.Kubeconfig, error) {
+	return c.kubeconfig(c.memberPath(id) + "/kubeconfig?proxy=true")
+}
+
+// Private
+
+func (c *Kubes) kubeconfig(path string) (*kubernetes.Kubeconfig, error) {
+	resp, err := c.client.do("GET", path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	config := new(kubernetes.Kubeconfig)
+	if err := yaml.Unmarshal(body, config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
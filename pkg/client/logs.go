@@ -0,0 +1,48 @@
+package client
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/supergiant/supergiant/pkg/model"
+)
+
+type LogsInterface interface {
+	List(*model.LogQuery, *model.LogEntryList) error
+	Follow(*model.LogQuery, func(*model.LogEntry) error) error
+}
+
+type Logs struct {
+	client *Client
+}
+
+func (c *Logs) List(query *model.LogQuery, list *model.LogEntryList) error {
+	return c.client.request("GET", "log", nil, list, query.QueryValues())
+}
+
+// Follow calls fn with each entry streamed from the server, until the
+// connection is closed or fn returns an error.
+func (c *Logs) Follow(query *model.LogQuery, fn func(*model.LogEntry) error) error {
+	queryValues := query.QueryValues()
+	queryValues["follow"] = []string{"true"}
+
+	resp, err := c.client.do("GET", "log", nil, queryValues)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	decoder := json.NewDecoder(resp.Body)
+	for {
+		entry := new(model.LogEntry)
+		if err := decoder.Decode(entry); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		if err := fn(entry); err != nil {
+			return err
+		}
+	}
+}
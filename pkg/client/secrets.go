@@ -0,0 +1,9 @@
+package client
+
+type SecretsInterface interface {
+	CollectionInterface
+}
+
+type Secrets struct {
+	Collection
+}
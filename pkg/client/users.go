@@ -5,6 +5,7 @@ import "github.com/supergiant/supergiant/pkg/model"
 type UsersInterface interface {
 	CollectionInterface
 	RegenerateAPIToken(interface{}, *model.User) error
+	RegenerateProxyToken(interface{}, *model.User) error
 }
 
 type Users struct {
@@ -14,3 +15,7 @@ type Users struct {
 func (c *Users) RegenerateAPIToken(id interface{}, m *model.User) error {
 	return c.client.request("POST", c.memberPath(id)+"/regenerate_api_token", nil, m, nil)
 }
+
+func (c *Users) RegenerateProxyToken(id interface{}, m *model.User) error {
+	return c.client.request("POST", c.memberPath(id)+"/regenerate_proxy_token", nil, m, nil)
+}
@@ -6,6 +6,8 @@ import (
 	"strings"
 	"time"
 
+	"github.com/Sirupsen/logrus"
+	"github.com/satori/go.uuid"
 	"github.com/supergiant/supergiant/pkg/model"
 	"github.com/supergiant/supergiant/pkg/util"
 )
@@ -53,7 +55,11 @@ func (a *Action) Now() error {
 	// Remove Action from map regardless of success or failure
 	defer a.stopUnlessCancelled()
 
-	return a.Fn(a)
+	err := a.Fn(a)
+	if err != nil {
+		a.Core.Metrics.ObserveActionFailure(a)
+	}
+	return err
 }
 
 func (a *Action) Async() error {
@@ -76,9 +82,10 @@ func (a *Action) Async() error {
 
 			a.Status.Error = err.Error()
 
-			a.Core.Log.Error(err)
+			a.Core.Log.WithFields(a.logFields()).Error(err)
 
 			if a.Status.Retries >= a.Status.MaxRetries {
+				a.Core.Metrics.ObserveActionFailure(a)
 				return // Don't goto Remove from Actions
 			}
 
@@ -86,6 +93,7 @@ func (a *Action) Async() error {
 			time.Sleep(time.Second)
 
 			a.Status.Retries++
+			a.Core.Metrics.ObserveActionRetry(a)
 		}
 
 		// Remove from Actions
@@ -111,11 +119,25 @@ func (a *Action) GetStatus() *model.ActionStatus {
 // Private
 
 func (a *Action) description() string {
-	modelType := strings.Split(reflect.TypeOf(a.Model).String(), ".")[1]
-	return fmt.Sprintf("%s %s %s", a.Status.Description, modelType, a.ResourceID)
+	return fmt.Sprintf("%s %s %s", a.Status.Description, a.modelType(), a.ResourceID)
+}
+
+func (a *Action) modelType() string {
+	return modelType(a.Model)
+}
+
+// logFields identify the Action and its Model in structured log entries.
+func (a *Action) logFields() logrus.Fields {
+	fields := modelLogFields(a.Model)
+	fields["action_id"] = a.Status.ID
+	return fields
 }
 
 func (a *Action) prepare() error {
+	if a.Status.ID == "" {
+		a.Status.ID = uuid.NewV4().String()
+	}
+
 	// Load model
 	if a.ResourceID != "" {
 		return nil
@@ -150,6 +172,18 @@ func (a *Action) stopUnlessCancelled() {
 ////////////////////////////////////////////////////////////////////////////////
 //\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\\
 
+func modelType(m model.Model) string {
+	return strings.Split(reflect.TypeOf(m).String(), ".")[1]
+}
+
+func modelLogFields(m model.Model) logrus.Fields {
+	fields := logrus.Fields{"model": modelType(m)}
+	if id := reflect.Indirect(reflect.ValueOf(m.GetID())); id.IsValid() {
+		fields["model_id"] = id.Interface()
+	}
+	return fields
+}
+
 func (c *Core) SetResourceActionStatus(m model.Model) {
 	if ai := c.Actions.Get(m.GetUUID()); ai != nil {
 		m.SetActionStatus(ai.(ActionInterface).GetStatus())
@@ -0,0 +1,253 @@
+package core
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/supergiant/supergiant/pkg/model"
+)
+
+type Apps struct {
+	Collection
+}
+
+func (c *Apps) Start(id *int64, m *model.App) ActionInterface {
+	return &Action{
+		Status: &model.ActionStatus{
+			Description: "starting",
+			MaxRetries:  5,
+		},
+		Core:  c.Core,
+		Scope: c.Core.DB.Preload("KubeResources"),
+		Model: m,
+		ID:    id,
+		Fn: func(a *Action) error {
+			// Started first, so that KubeResources created meanwhile are started
+			if err := c.Core.DB.Model(m).Update("started", true); err != nil {
+				return err
+			}
+			for _, kubeResource := range inDependencyOrder(m.KubeResources) {
+				// Reloaded, since the Action may be retried
+				if err := c.Core.DB.First(kubeResource, *kubeResource.ID); err != nil {
+					return err
+				}
+				if kubeResource.Ready {
+					continue
+				}
+				if err := c.startKubeResource(a, kubeResource); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+}
+
+func (c *Apps) Stop(id *int64, m *model.App) ActionInterface {
+	return &Action{
+		Status: &model.ActionStatus{
+			Description: "stopping",
+			MaxRetries:  5,
+		},
+		Core:           c.Core,
+		Scope:          c.Core.DB.Preload("KubeResources"),
+		Model:          m,
+		ID:             id,
+		CancelExisting: true,
+		Fn: func(a *Action) error {
+			if err := c.Core.DB.Model(m).Update("started", false); err != nil {
+				return err
+			}
+			kubeResources := inDependencyOrder(m.KubeResources)
+			for i := len(kubeResources) - 1; i >= 0; i-- {
+				kubeResource := kubeResources[i]
+				if err := c.Core.DB.First(kubeResource, *kubeResource.ID); err != nil {
+					return err
+				}
+				if !kubeResource.Started {
+					continue
+				}
+				if err := c.Core.KubeResources.Stop(kubeResource.ID, kubeResource).Now(); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+}
+
+func (c *Apps) Delete(id *int64, m *model.App) ActionInterface {
+	return &Action{
+		Status: &model.ActionStatus{
+			Description: "deleting",
+			MaxRetries:  5,
+		},
+		Core:           c.Core,
+		Scope:          c.Core.DB.Preload("KubeResources"),
+		Model:          m,
+		ID:             id,
+		CancelExisting: true,
+		Fn: func(a *Action) error {
+			kubeResources := inDependencyOrder(m.KubeResources)
+			for i := len(kubeResources) - 1; i >= 0; i-- {
+				kubeResource := kubeResources[i]
+				if err := c.Core.KubeResources.Delete(kubeResource.ID, kubeResource).Now(); err != nil {
+					return err
+				}
+			}
+			return c.Collection.Delete(id, m)
+		},
+	}
+}
+
+// Private
+
+// startKubeResource starts a KubeResource of an App, or waits for it to start
+// if it is already being started.
+func (c *Apps) startKubeResource(a *Action, m *model.KubeResource) error {
+	err := c.Core.KubeResources.Start(m.ID, m).Now()
+	if _, starting := err.(*RepeatedActionError); !starting {
+		return err
+	}
+	desc := fmt.Sprintf("%s '%s' in Namespace '%s' to start", m.Kind, m.Name, m.Namespace)
+	return a.CancellableWaitFor(desc, c.Core.KubeResourceStartTimeout, time.Second, func() (bool, error) {
+		if err := c.Core.DB.First(m, *m.ID); err != nil {
+			return false, err
+		}
+		return m.Ready, nil
+	})
+}
+
+// setApp validates a KubeResource of an App, defaulting its Kube and Namespace
+// to those of the App, and returns the App.
+func (c *KubeResources) setApp(m *model.KubeResource) (*model.App, error) {
+	app := new(model.App)
+	if err := c.Core.DB.Preload("KubeResources").First(app, "name = ?", m.AppName); err != nil {
+		return nil, &ErrorMissingRequiredParent{"AppName", "KubeResource"}
+	}
+
+	if m.KubeName == "" {
+		m.KubeName = app.KubeName
+	}
+	if m.Namespace == "" {
+		m.Namespace = app.Namespace
+	}
+	if m.KubeName != app.KubeName || m.Namespace != app.Namespace {
+		return nil, &ErrorValidationFailed{fmt.Errorf("KubeResources of App %s must be in Kube %s and Namespace %s", app.Name, app.KubeName, app.Namespace)}
+	}
+
+	// The dependencies of the KubeResource must be in the App, and not depend
+	// on it in turn.
+	kubeResources := []*model.KubeResource{m}
+	for _, kubeResource := range app.KubeResources {
+		if m.ID == nil || *kubeResource.ID != *m.ID {
+			kubeResources = append(kubeResources, kubeResource)
+		}
+	}
+	refs := make(map[string]*model.KubeResource)
+	for _, kubeResource := range kubeResources {
+		refs[kubeResourceRef(kubeResource)] = kubeResource
+	}
+	for _, ref := range m.DependsOn {
+		if refs[ref] == nil || refs[ref] == m {
+			return nil, &ErrorValidationFailed{fmt.Errorf("DependsOn: %s is not another KubeResource of App %s", ref, app.Name)}
+		}
+	}
+	if cycle := dependencyCycle(kubeResources); cycle != nil {
+		return nil, &ErrorValidationFailed{fmt.Errorf("DependsOn: cycle %s", strings.Join(cycle, " -> "))}
+	}
+	return app, nil
+}
+
+// kubeResourceRef is how a KubeResource is referred to in DependsOn.
+func kubeResourceRef(m *model.KubeResource) string {
+	return m.Kind + "/" + m.Name
+}
+
+// inDependencyOrder returns the KubeResources of an App in the order they are
+// started, after those they depend on, and otherwise in the order they were
+// created. Dependencies which are not in the App (or which form a cycle) are
+// ignored.
+func inDependencyOrder(kubeResources []*model.KubeResource) []*model.KubeResource {
+	created := make([]*model.KubeResource, len(kubeResources))
+	copy(created, kubeResources)
+	sort.Sort(kubeResourcesByID(created))
+
+	refs := make(map[string]*model.KubeResource)
+	for _, kubeResource := range created {
+		refs[kubeResourceRef(kubeResource)] = kubeResource
+	}
+
+	var ordered []*model.KubeResource
+	visited := make(map[*model.KubeResource]bool)
+	var visit func(*model.KubeResource)
+	visit = func(m *model.KubeResource) {
+		if visited[m] {
+			return
+		}
+		visited[m] = true
+		for _, ref := range m.DependsOn {
+			if dependency := refs[ref]; dependency != nil {
+				visit(dependency)
+			}
+		}
+		ordered = append(ordered, m)
+	}
+	for _, kubeResource := range created {
+		visit(kubeResource)
+	}
+	return ordered
+}
+
+// dependencyCycle returns the refs of a cycle in the DependsOn of
+// kubeResources, beginning and ending with the same one, or nil if there is
+// none.
+func dependencyCycle(kubeResources []*model.KubeResource) []string {
+	refs := make(map[string]*model.KubeResource)
+	for _, kubeResource := range kubeResources {
+		refs[kubeResourceRef(kubeResource)] = kubeResource
+	}
+
+	done := make(map[string]bool)
+	var path []string
+	var visit func(string) []string
+	visit = func(ref string) []string {
+		for i, pathRef := range path {
+			if pathRef == ref {
+				return append(append([]string{}, path[i:]...), ref)
+			}
+		}
+		if done[ref] || refs[ref] == nil {
+			return nil
+		}
+		path = append(path, ref)
+		for _, dependency := range refs[ref].DependsOn {
+			if cycle := visit(dependency); cycle != nil {
+				return cycle
+			}
+		}
+		path = path[:len(path)-1]
+		done[ref] = true
+		return nil
+	}
+	for _, kubeResource := range kubeResources {
+		if cycle := visit(kubeResourceRef(kubeResource)); cycle != nil {
+			return cycle
+		}
+	}
+	return nil
+}
+
+type kubeResourcesByID []*model.KubeResource
+
+func (r kubeResourcesByID) Len() int      { return len(r) }
+func (r kubeResourcesByID) Swap(i, j int) { r[i], r[j] = r[j], r[i] }
+func (r kubeResourcesByID) Less(i, j int) bool {
+	// KubeResources which are not created yet are last
+	if r[i].ID == nil || r[j].ID == nil {
+		return r[j].ID == nil && r[i].ID != nil
+	}
+	return *r[i].ID < *r[j].ID
+}
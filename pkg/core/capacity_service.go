@@ -39,6 +39,10 @@ func (s *CapacityService) Perform() error {
 	// 2. "scaling" should be an action on Kube, so we can see the status (actually that may not make sense, just use Nodes ?)
 
 	for _, kube := range kubes {
+		// Supergiant can't add or remove Nodes of imported Kubes
+		if kube.CloudAccount.Provider == "existing" {
+			continue
+		}
 		if err := newKubeScaler(s, kube).Scale(); err != nil {
 			return err
 		}
@@ -164,7 +168,7 @@ func (s *KubeScaler) Scale() error {
 
 		// eventual option to delete nodes when there are pods (w/ or wo/ volumes?) that could move to other nodes (we would have to calculate that)
 
-		hasPods, err := hasPodsWithReservedResources(s.service.Core, node)
+		hasPods, err := hasPodsWithReservedResources(s.service.Core, node, s.kube.NodesStopped)
 		if err != nil {
 			return fmt.Errorf("Capacity service error when fetching Pods for Node: %s", err)
 		}
@@ -271,6 +275,10 @@ func (s *KubeScaler) incomingPods() (incomingPods []*kubernetes.Pod, err error)
 		}
 
 		for _, pod := range pendingPods {
+			// Pods which run on every Node don't bring up Nodes which are stopped
+			if s.kube.NodesStopped && isSystemPod(pod) {
+				continue
+			}
 			hasTrackedEvent, err := s.hasTrackedEvent(pod)
 			if err != nil {
 				return nil, err
@@ -374,7 +382,11 @@ func (pnode1 *projectedNode) canMergeWith(pnode2 *projectedNode) bool {
 
 //------------------------------------------------------------------------------
 
-func hasPodsWithReservedResources(c *Core, node *model.Node) (bool, error) {
+// hasPodsWithReservedResources returns whether a Node runs Pods which request
+// resources. While the Nodes of its Kube are stopped, Pods which run on every
+// Node (of DaemonSets) or in kube-system don't count, so that it can scale to
+// zero Nodes.
+func hasPodsWithReservedResources(c *Core, node *model.Node, nodesStopped bool) (bool, error) {
 	k8s := c.K8S(node.Kube)
 	pods, err := k8s.ListPods("fieldSelector=spec.nodeName=" + node.Name + ",status.phase=Running")
 	if err != nil {
@@ -382,6 +394,9 @@ func hasPodsWithReservedResources(c *Core, node *model.Node) (bool, error) {
 	}
 
 	for _, pod := range pods {
+		if nodesStopped && isSystemPod(pod) {
+			continue
+		}
 		for _, container := range pod.Spec.Containers {
 
 			// TODO
@@ -405,3 +420,15 @@ func hasPodsWithReservedResources(c *Core, node *model.Node) (bool, error) {
 
 	return false, nil
 }
+
+func isSystemPod(pod *kubernetes.Pod) bool {
+	if pod.Metadata.Namespace == "kube-system" {
+		return true
+	}
+	for _, owner := range pod.Metadata.OwnerReferences {
+		if owner.Kind == "DaemonSet" {
+			return true
+		}
+	}
+	return false
+}
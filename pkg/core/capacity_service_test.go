@@ -15,6 +15,31 @@ import (
 	. "github.com/smartystreets/goconvey/convey"
 )
 
+// reservedContainers request resources, and systemPods run them on every Node.
+var (
+	reservedContainers = []kubernetes.Container{
+		{
+			Resources: kubernetes.Resources{
+				Requests: kubernetes.ResourceValues{CPU: "100m", Memory: "64Mi"},
+			},
+		},
+	}
+	systemPods = []*kubernetes.Pod{
+		{
+			Metadata: kubernetes.Metadata{Name: "kube-proxy", Namespace: "kube-system"},
+			Spec:     kubernetes.PodSpec{Containers: reservedContainers},
+		},
+		{
+			Metadata: kubernetes.Metadata{
+				Name:            "fluentd-x7k2p",
+				Namespace:       "logging",
+				OwnerReferences: []kubernetes.OwnerReference{{Kind: "DaemonSet", Name: "fluentd"}},
+			},
+			Spec: kubernetes.PodSpec{Containers: reservedContainers},
+		},
+	}
+)
+
 func TestCapacityServicePerform(t *testing.T) {
 	Convey("CapacityService Perform works correctly", t, func() {
 		table := []struct {
@@ -192,6 +217,43 @@ func TestCapacityServicePerform(t *testing.T) {
 				err:              nil,
 			},
 
+			// Imported Kubes are not scaled
+			{
+				kubes: []*model.Kube{
+					{
+						CloudAccount: &model.CloudAccount{
+							Provider: "existing",
+						},
+						Name:      "test-kube",
+						NodeSizes: []string{"existing"},
+						Nodes: []*model.Node{
+							{
+								KubeName: "test-kube",
+								Name:     "node-1.biz",
+								Size:     "existing",
+							},
+						},
+					},
+				},
+				mockPendingPods: []*kubernetes.Pod{
+					{
+						Metadata: kubernetes.Metadata{
+							Name: "test-pod",
+						},
+					},
+				},
+				mockPodEvents: map[string][]*kubernetes.Event{
+					"test-pod": []*kubernetes.Event{
+						{
+							Message: "failed to fit in any node",
+						},
+					},
+				},
+				nodeSizesCreated: nil,
+				nodeNamesDeleted: nil,
+				err:              nil,
+			},
+
 			// On DB Find error
 			{
 				mockDBFindError: errors.New("DBFindError"),
@@ -351,6 +413,78 @@ func TestCapacityServicePerform(t *testing.T) {
 				nodeNamesDeleted:    []string{"existing-node"},
 				err:                 errors.New("Capacity service error when deleting Node: NodeDeleteError"),
 			},
+
+			// Nodes which only run system Pods are deleted while the Nodes of the Kube are stopped
+			{
+				kubes: []*model.Kube{
+					{
+						CloudAccount: &model.CloudAccount{
+							// Provider is used to fetch NodeSizes off of Core
+							Provider: "test-provider",
+						},
+						Name: "test-kube",
+						Nodes: []*model.Node{
+							{
+								Name: "existing-node",
+							},
+						},
+						NodeSizes: []string{
+							"1gb-test-size",
+						},
+						NodesStopped: true,
+					},
+				},
+				providerNodeSizes: map[string][]*core.NodeSize{
+					"test-provider": []*core.NodeSize{
+						{
+							Name:     "1gb-test-size",
+							RAMGIB:   1,
+							CPUCores: 1,
+						},
+					},
+				},
+				mockNodeExistingPods: map[string][]*kubernetes.Pod{
+					"existing-node": systemPods,
+				},
+				nodeNamesDeleted: []string{"existing-node"},
+				err:              nil,
+			},
+
+			// Nodes which run system Pods are kept otherwise
+			{
+				kubes: []*model.Kube{
+					{
+						CloudAccount: &model.CloudAccount{
+							// Provider is used to fetch NodeSizes off of Core
+							Provider: "test-provider",
+						},
+						Name: "test-kube",
+						Nodes: []*model.Node{
+							{
+								Name: "existing-node",
+							},
+						},
+						NodeSizes: []string{
+							"1gb-test-size",
+						},
+						NodesStopped: false,
+					},
+				},
+				providerNodeSizes: map[string][]*core.NodeSize{
+					"test-provider": []*core.NodeSize{
+						{
+							Name:     "1gb-test-size",
+							RAMGIB:   1,
+							CPUCores: 1,
+						},
+					},
+				},
+				mockNodeExistingPods: map[string][]*kubernetes.Pod{
+					"existing-node": systemPods,
+				},
+				nodeNamesDeleted: nil,
+				err:              nil,
+			},
 		}
 
 		for _, item := range table {
@@ -429,3 +563,106 @@ func TestCapacityServicePerform(t *testing.T) {
 		}
 	})
 }
+
+func TestCapacityServiceNodesStopped(t *testing.T) {
+	Convey("CapacityService scales the Nodes of a Kube to zero while they're stopped, and back up when they're started", t, func() {
+		kube := &model.Kube{
+			CloudAccount: &model.CloudAccount{Provider: "test-provider"},
+			Name:         "test-kube",
+			NodeSizes:    []string{"1gb-test-size"},
+			NodesStopped: true,
+		}
+		nodes := []*model.Node{
+			{Name: "existing-node"},
+		}
+		runningPods := map[string][]*kubernetes.Pod{
+			"existing-node": systemPods,
+		}
+		var pendingPods []*kubernetes.Pod
+
+		var nodeSizesCreated []string
+		var nodeNamesDeleted []string
+
+		c := &core.Core{
+			Log: logrus.New(),
+
+			Settings: core.Settings{
+				NodeSizes: map[string][]*core.NodeSize{
+					"test-provider": []*core.NodeSize{
+						{
+							Name:     "1gb-test-size",
+							RAMGIB:   1,
+							CPUCores: 1,
+						},
+					},
+				},
+			},
+
+			DB: &fake_core.DB{
+				FindFn: func(out interface{}, where ...interface{}) error {
+					switch reflect.TypeOf(out).String() {
+					case "*[]*model.Kube":
+						reflect.ValueOf(out).Elem().Set(reflect.ValueOf([]*model.Kube{kube}))
+					case "*[]*model.Node":
+						reflect.ValueOf(out).Elem().Set(reflect.ValueOf(nodes))
+					}
+					return nil
+				},
+			},
+
+			Nodes: &fake_core.Nodes{
+				CreateFn: func(m *model.Node) error {
+					nodeSizesCreated = append(nodeSizesCreated, m.Size)
+					return nil
+				},
+				DeleteFn: func(_ *int64, m *model.Node) core.ActionInterface {
+					return &fake_core.Action{
+						NowFn: func() error {
+							nodeNamesDeleted = append(nodeNamesDeleted, m.Name)
+							nodes = nil
+							return nil
+						},
+					}
+				},
+			},
+
+			K8S: func(kube *model.Kube) kubernetes.ClientInterface {
+				return &fake_core.KubernetesClient{
+					ListPodsFn: func(query string) ([]*kubernetes.Pod, error) {
+						rxp := regexp.MustCompile("fieldSelector=spec.nodeName=([^,]+),status.phase=Running")
+						if rxp.MatchString(query) {
+							return runningPods[rxp.FindStringSubmatch(query)[1]], nil
+						}
+						return pendingPods, nil
+					},
+					ListEventsFn: func(query string) ([]*kubernetes.Event, error) {
+						return []*kubernetes.Event{
+							{Message: "no nodes available to schedule pods"},
+						}, nil
+					},
+				}
+			},
+		}
+
+		service := &core.CapacityService{
+			Core:            c,
+			WaitBeforeScale: 0,
+		}
+
+		// The Node, which only runs system Pods, is deleted
+		So(service.Perform(), ShouldBeNil)
+		So(nodeNamesDeleted, ShouldResemble, []string{"existing-node"})
+		So(nodeSizesCreated, ShouldBeNil)
+
+		// The system Pods, left pending, don't bring it back
+		runningPods = nil
+		pendingPods = systemPods
+		So(service.Perform(), ShouldBeNil)
+		So(nodeSizesCreated, ShouldBeNil)
+
+		// Once the Nodes are started, they do
+		kube.NodesStopped = false
+		So(service.Perform(), ShouldBeNil)
+		So(nodeSizesCreated, ShouldResemble, []string{"1gb-test-size"})
+	})
+}
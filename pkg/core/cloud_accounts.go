@@ -15,6 +15,12 @@ func (c *CloudAccounts) Create(m *model.CloudAccount) error {
 	if err := validateFields(m); err != nil {
 		return err
 	}
+	if m.Provider == "existing" {
+		// Empty Credentials are not serialized
+		m.CredentialsJSON = []byte("{}")
+	} else if len(m.Credentials) == 0 {
+		return &ErrorValidationFailed{errors.New("Credentials: zero value")}
+	}
 
 	if err := c.provider(m).ValidateAccount(m); err != nil {
 		return &ErrorValidationFailed{err}
@@ -36,13 +42,19 @@ func (c *CloudAccounts) Delete(id *int64, m *model.CloudAccount) error {
 // Private methods                                                            //
 ////////////////////////////////////////////////////////////////////////////////
 
-func (c *CloudAccounts) provider(m *model.CloudAccount) Provider {
+func (c *CloudAccounts) provider(m *model.CloudAccount) (provider Provider) {
 	switch m.Provider {
 	case "aws":
-		return c.Core.AWSProvider(m.Credentials)
+		provider = c.Core.AWSProvider(m.Credentials)
 	case "digitalocean":
-		return c.Core.DOProvider(m.Credentials)
+		provider = c.Core.DOProvider(m.Credentials)
+	case "existing":
+		provider = c.Core.ExistingProvider(m.Credentials)
 	default:
 		panic("Could not load provider interface for " + m.Provider)
 	}
+	if c.Core.Metrics != nil {
+		provider = &instrumentedProvider{provider, m.Provider, c.Core.Metrics}
+	}
+	return provider
 }
@@ -29,15 +29,7 @@ func (c *Collection) GetWithIncludes(id *int64, m model.Model, includes []string
 }
 
 func (c *Collection) Update(id *int64, oldM model.Model, m model.Model) error {
-	if err := model.CheckImmutableFields(m); err != nil {
-		return err
-	}
-	// Load model from DB
-	if err := c.Core.DB.First(oldM, *id); err != nil {
-		return err
-	}
-	// Merge old item attributes into the empty fields of the newItem
-	if err := mergo.Merge(m, oldM); err != nil {
+	if err := c.merge(id, oldM, m); err != nil {
 		return err
 	}
 	return c.Core.DB.Save(m)
@@ -71,3 +63,17 @@ func (c *Collection) inParallel(model interface{}, fn func(interface{}) error) (
 	wg.Wait()
 	return
 }
+
+// merge loads oldM, and merges its attributes into the empty fields of m, which
+// may not change immutable fields.
+func (c *Collection) merge(id *int64, oldM model.Model, m model.Model) error {
+	if err := model.CheckImmutableFields(m); err != nil {
+		return err
+	}
+	// Load model from DB
+	if err := c.Core.DB.First(oldM, *id); err != nil {
+		return err
+	}
+	// Merge old item attributes into the empty fields of the newItem
+	return mergo.Merge(m, oldM)
+}
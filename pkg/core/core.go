@@ -38,10 +38,15 @@ type Settings struct {
 	HTTPSPort              string `json:"https_port"`
 	SSLCertFile            string `json:"ssl_cert_file"`
 	SSLKeyFile             string `json:"ssl_key_file"`
+	EncryptionKeyFile      string `json:"encryption_key_file"`
 	LogPath                string `json:"log_file"`
 	LogLevel               string `json:"log_level"`
 	UIEnabled              bool   `json:"ui_enabled"`
 	CapacityServiceEnabled bool   `json:"capacity_service_enabled"`
+	MetricsEnabled         bool   `json:"metrics_enabled"`
+
+	// HelmChartDir is the directory of the charts HelmReleases can install.
+	HelmChartDir string `json:"helm_chart_dir"`
 
 	// NOTE these MUST be provided in ascending order by cost in order to
 	// correctly provision the smallest size on Kube creation
@@ -60,14 +65,20 @@ type Core struct {
 
 	// NOTE we set these 2 in cmd/server.go to prevent having to load all the
 	// cloud provider various lib code everytime we load core
-	AWSProvider func(map[string]string) Provider
-	DOProvider  func(map[string]string) Provider
+	AWSProvider      func(map[string]string) Provider
+	DOProvider       func(map[string]string) Provider
+	ExistingProvider func(map[string]string) Provider
 
 	K8S func(*model.Kube) kubernetes.ClientInterface
 
-	DefaultProvisioner Provisioner
-	PodProvisioner     Provisioner
-	ServiceProvisioner Provisioner
+	MetricsSource func(*model.Kube) kubernetes.MetricsSource
+
+	DefaultProvisioner     Provisioner
+	PodProvisioner         Provisioner
+	DeploymentProvisioner  Provisioner
+	StatefulSetProvisioner Provisioner
+	ServiceProvisioner     Provisioner
+	HelmReleaseProvisioner Provisioner
 
 	APIClient func(authType string, authToken string) *client.Client
 
@@ -75,18 +86,35 @@ type Core struct {
 
 	DB DBInterface
 
-	Sessions            SessionsInterface
-	Users               *Users
-	CloudAccounts       *CloudAccounts
-	Kubes               *Kubes
-	KubeResources       KubeResourcesInterface
-	Volumes             VolumesInterface
-	Entrypoints         *Entrypoints
-	EntrypointListeners EntrypointListenersInterface
-	Nodes               NodesInterface
+	Sessions              SessionsInterface
+	Users                 *Users
+	CloudAccounts         *CloudAccounts
+	Kubes                 *Kubes
+	KubeResources         KubeResourcesInterface
+	KubeResourceTemplates *KubeResourceTemplates
+	Apps                  *Apps
+	Secrets               *Secrets
+	Volumes               VolumesInterface
+	Entrypoints           *Entrypoints
+	EntrypointListeners   EntrypointListenersInterface
+	Nodes                 NodesInterface
 
 	// TODO should this be a pseudo-collection like Sessions?
 	Actions *SafeMap
+
+	// KubeResourceInformers watches the resources of KubeResources.
+	KubeResourceInformers *KubeResourceInformers
+
+	// Metrics is nil unless MetricsEnabled is set.
+	Metrics *Metrics
+
+	// encryptionKey is the key Secrets are encrypted with, loaded from
+	// EncryptionKeyFile.
+	encryptionKey []byte
+
+	// Tracked for readiness checks
+	safeMaps          []*SafeMap
+	recurringServices []*RecurringService
 }
 
 // NOTE this used to be core.New(), but due to how we load in values from the
@@ -132,6 +160,15 @@ func (c *Core) InitializeForeground() error {
 		}
 	}
 
+	// Encryption key of Secrets
+	if c.EncryptionKeyFile != "" {
+		key, err := loadEncryptionKey(c.EncryptionKeyFile)
+		if err != nil {
+			return err
+		}
+		c.encryptionKey = key
+	}
+
 	// Logging
 	c.Log = logrus.New()
 	if c.LogLevel != "" {
@@ -141,6 +178,10 @@ func (c *Core) InitializeForeground() error {
 		}
 		c.Log.Level = levelInt
 	}
+	// The log file is JSON so that it can be queried through the log API
+	if c.LogPath != "" {
+		c.Log.Formatter = new(logrus.JSONFormatter)
+	}
 	// db.LogMode(true)
 
 	// DB
@@ -167,6 +208,10 @@ func (c *Core) InitializeForeground() error {
 		&model.User{},
 		&model.Kube{},
 		&model.KubeResource{},
+		&model.KubeResourceRevision{},
+		&model.KubeResourceTemplate{},
+		&model.App{},
+		&model.Secret{},
 		&model.CloudAccount{},
 		&model.Volume{},
 		&model.Entrypoint{},
@@ -177,11 +222,23 @@ func (c *Core) InitializeForeground() error {
 		return err
 	}
 
+	// EntrypointListeners were unique by port before the rules of HTTP
+	// Entrypoints shared ports
+	listenersScope := gormDB.NewScope(&model.EntrypointListener{})
+	if listenersScope.Dialect().HasIndex(listenersScope.TableName(), "entrypoint_port") {
+		if err = gormDB.Model(&model.EntrypointListener{}).RemoveIndex("entrypoint_port").Error; err != nil {
+			return err
+		}
+	}
+
 	c.DB = &DB{c, gormDB}
 
 	c.Users = &Users{Collection{c}}
 	c.Kubes = &Kubes{Collection{c}}
 	c.KubeResources = &KubeResources{Collection{c}}
+	c.KubeResourceTemplates = &KubeResourceTemplates{Collection{c}}
+	c.Apps = &Apps{Collection{c}}
+	c.Secrets = &Secrets{Collection{c}}
 	c.CloudAccounts = &CloudAccounts{Collection{c}}
 	c.Volumes = &Volumes{Collection{c}}
 	c.Entrypoints = &Entrypoints{Collection{c}}
@@ -192,18 +249,33 @@ func (c *Core) InitializeForeground() error {
 	// Actions for async work
 	c.Actions = NewSafeMap(c)
 
+	// Prometheus metrics
+	if c.MetricsEnabled {
+		c.Metrics = NewMetrics(c)
+	}
+
 	// Kubernetes Client
+	k8sHTTPClients := kubernetes.NewHTTPClients(30 * time.Second)
+	k8sDiscovery := kubernetes.NewDiscovery(10 * time.Minute)
+	c.KubeResourceInformers = NewKubeResourceInformers(c)
 	c.K8S = func(kube *model.Kube) kubernetes.ClientInterface {
-		return &kubernetes.Client{
+		return c.KubeResourceInformers.Client(kube, &kubernetes.Client{
 			Kube:       kube,
-			HTTPClient: kubernetes.DefaultHTTPClient,
-		}
+			HTTPClient: c.Metrics.InstrumentHTTPClient(k8sHTTPClients.Get(kube)),
+			Discovery:  k8sDiscovery,
+		})
+	}
+	c.MetricsSource = func(kube *model.Kube) kubernetes.MetricsSource {
+		return kubernetes.NewMetricsSource(kube, c.K8S(kube))
 	}
 
 	// Kubernetes Provisioners
 	c.DefaultProvisioner = &DefaultProvisioner{c}
 	c.PodProvisioner = &PodProvisioner{c}
+	c.DeploymentProvisioner = &DeploymentProvisioner{c}
+	c.StatefulSetProvisioner = &StatefulSetProvisioner{c}
 	c.ServiceProvisioner = &ServiceProvisioner{c}
+	c.HelmReleaseProvisioner = &HelmReleaseProvisioner{c}
 
 	c.KubeResourceStartTimeout = 20 * time.Minute
 
@@ -221,37 +293,28 @@ func (c *Core) InitializeForeground() error {
 func (c *Core) InitializeBackground() {
 	// Recurring services
 	if c.CapacityServiceEnabled {
-		capacityService := &RecurringService{
-			core: c,
-			service: &CapacityService{
-				Core:            c,
-				WaitBeforeScale: 2 * time.Minute,
-			},
-			interval: 30 * time.Second,
+		capacityService := &CapacityService{
+			Core:            c,
+			WaitBeforeScale: 2 * time.Minute,
 		}
-		go capacityService.Run()
-	}
-
-	nodeObserver := &RecurringService{
-		core:     c,
-		service:  &NodeObserver{c},
-		interval: 30 * time.Second,
+		c.runRecurringService(capacityService, 30*time.Second)
 	}
-	go nodeObserver.Run()
-
-	kubeResourceObserver := &RecurringService{
-		core:     c,
-		service:  &KubeResourceObserver{c},
-		interval: 15 * time.Second,
-	}
-	go kubeResourceObserver.Run()
+	c.runRecurringService(&NodeObserver{c}, 30*time.Second)
+	c.runRecurringService(c.KubeResourceInformers, 15*time.Second)
+	c.runRecurringService(&KubeResourceObserver{c}, 15*time.Second)
+	c.runRecurringService(&SessionExpirer{c}, 15*time.Second)
+	c.runRecurringService(&Scheduler{Core: c}, 15*time.Second)
+}
 
-	sessionExpirer := &RecurringService{
-		core:     c,
-		service:  &SessionExpirer{c},
-		interval: 15 * time.Second,
+func (c *Core) runRecurringService(service Service, interval time.Duration) {
+	rs := &RecurringService{
+		core:          c,
+		service:       service,
+		interval:      interval,
+		lastTickStart: time.Now(),
 	}
-	go sessionExpirer.Run()
+	c.recurringServices = append(c.recurringServices, rs)
+	go rs.Run()
 }
 
 //------------------------------------------------------------------------------
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/go-validator/validator"
 	"github.com/jinzhu/gorm"
@@ -22,8 +23,12 @@ type DBInterface interface {
 	Limit(limit interface{}) DBInterface
 	Offset(offset interface{}) DBInterface
 	Model(value interface{}) DBInterface
+	Select(query interface{}, args ...interface{}) DBInterface
+	Group(query string) DBInterface
 	Update(attrs ...interface{}) error
 	Count(interface{}) error
+	Scan(dest interface{}) error
+	Ping() error
 }
 
 type DB struct {
@@ -32,6 +37,7 @@ type DB struct {
 }
 
 func (db *DB) Create(m model.Model) error {
+	defer db.core.Metrics.ObserveDBQuery("create", time.Now())
 	m.SetUUID()
 	setDefaultFields(m)
 	marshalSerializedFields(m)
@@ -45,6 +51,7 @@ func (db *DB) Create(m model.Model) error {
 }
 
 func (db *DB) Save(m model.Model) error {
+	defer db.core.Metrics.ObserveDBQuery("save", time.Now())
 	marshalSerializedFields(m)
 	if err := validateFields(m); err != nil {
 		return err
@@ -53,6 +60,7 @@ func (db *DB) Save(m model.Model) error {
 }
 
 func (db *DB) Find(out interface{}, where ...interface{}) error {
+	defer db.core.Metrics.ObserveDBQuery("find", time.Now())
 	if err := db.DB.Find(out, where...).Error; err != nil {
 		return err
 	}
@@ -65,6 +73,7 @@ func (db *DB) Find(out interface{}, where ...interface{}) error {
 }
 
 func (db *DB) First(out interface{}, where ...interface{}) error {
+	defer db.core.Metrics.ObserveDBQuery("first", time.Now())
 	if err := db.DB.First(out, where...).Error; err != nil {
 		return err
 	}
@@ -74,6 +83,7 @@ func (db *DB) First(out interface{}, where ...interface{}) error {
 }
 
 func (db *DB) Delete(m model.Model) error {
+	defer db.core.Metrics.ObserveDBQuery("delete", time.Now())
 	// if m.GetID() == nil {
 	// 	return errors.New("ID required for Delete")
 	// }
@@ -117,14 +127,39 @@ func (db *DB) Model(value interface{}) DBInterface {
 	}
 }
 
+func (db *DB) Select(query interface{}, args ...interface{}) DBInterface {
+	return &DB{
+		db.core,
+		db.DB.Select(query, args...),
+	}
+}
+
+func (db *DB) Group(query string) DBInterface {
+	return &DB{
+		db.core,
+		db.DB.Group(query),
+	}
+}
+
 func (db *DB) Update(attrs ...interface{}) error {
+	defer db.core.Metrics.ObserveDBQuery("update", time.Now())
 	return db.DB.Update(attrs...).Error
 }
 
 func (db *DB) Count(value interface{}) error {
+	defer db.core.Metrics.ObserveDBQuery("count", time.Now())
 	return db.DB.Count(value).Error
 }
 
+func (db *DB) Scan(dest interface{}) error {
+	defer db.core.Metrics.ObserveDBQuery("scan", time.Now())
+	return db.DB.Scan(dest).Error
+}
+
+func (db *DB) Ping() error {
+	return db.DB.DB().Ping()
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 // Private methods                                                            //
 ////////////////////////////////////////////////////////////////////////////////
@@ -175,6 +210,12 @@ type ErrorValidationFailed struct {
 	error
 }
 
+// NewErrorValidationFailed wraps err, e.g. from parsing a request, as an
+// ErrorValidationFailed.
+func NewErrorValidationFailed(err error) error {
+	return &ErrorValidationFailed{err}
+}
+
 func (err *ErrorValidationFailed) Error() string {
 	return "Validation failed: " + err.error.Error()
 }
@@ -0,0 +1,80 @@
+package core
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+)
+
+// encryptionKeySize is the size of the AES-256 key Secrets are encrypted with.
+const encryptionKeySize = 32
+
+// loadEncryptionKey reads the hex-encoded key of path, creating it with a
+// random key if it doesn't exist.
+func loadEncryptionKey(path string) ([]byte, error) {
+	keyHex, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		key := make([]byte, encryptionKeySize)
+		if _, err := io.ReadFull(rand.Reader, key); err != nil {
+			return nil, err
+		}
+		if err := ioutil.WriteFile(path, []byte(hex.EncodeToString(key)+"\n"), 0600); err != nil {
+			return nil, err
+		}
+		return key, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := hex.DecodeString(strings.TrimSpace(string(keyHex)))
+	if err != nil || len(key) != encryptionKeySize {
+		return nil, fmt.Errorf("Encryption key file %s must contain a hex-encoded %d-byte key", path, encryptionKeySize)
+	}
+	return key, nil
+}
+
+// encrypt encrypts plaintext with AES-GCM, returning the nonce followed by the
+// ciphertext.
+func (c *Core) encrypt(plaintext []byte) ([]byte, error) {
+	gcm, err := c.gcm()
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	return gcm.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+// decrypt decrypts data encrypted by encrypt.
+func (c *Core) decrypt(data []byte) ([]byte, error) {
+	gcm, err := c.gcm()
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < gcm.NonceSize() {
+		return nil, errors.New("Encrypted data is too short")
+	}
+	nonce, ciphertext := data[:gcm.NonceSize()], data[gcm.NonceSize():]
+	return gcm.Open(nil, nonce, ciphertext, nil)
+}
+
+func (c *Core) gcm() (cipher.AEAD, error) {
+	if c.encryptionKey == nil {
+		return nil, errors.New("No encryption key configured. Must provide --encryption-key-file.")
+	}
+	block, err := aes.NewCipher(c.encryptionKey)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -1,6 +1,12 @@
 package core
 
-import "github.com/supergiant/supergiant/pkg/model"
+import (
+	"errors"
+	"strings"
+
+	"github.com/jinzhu/gorm"
+	"github.com/supergiant/supergiant/pkg/model"
+)
 
 type EntrypointListenersInterface interface {
 	Create(*model.EntrypointListener) error
@@ -16,6 +22,9 @@ type EntrypointListeners struct {
 }
 
 func (c *EntrypointListeners) Create(m *model.EntrypointListener) error {
+	if err := c.validateRule(m); err != nil {
+		return err
+	}
 	if err := c.Collection.Create(m); err != nil {
 		return err
 	}
@@ -33,6 +42,9 @@ func (c *EntrypointListeners) Provision(id *int64, m *model.EntrypointListener)
 		Model: m,
 		ID:    id,
 		Fn: func(a *Action) error {
+			if m.IsHTTP() {
+				return applyIngress(c.Core, m.Entrypoint.Kube, m)
+			}
 			return c.Core.CloudAccounts.provider(m.Entrypoint.Kube.CloudAccount).CreateEntrypointListener(m, a)
 		},
 	}
@@ -50,10 +62,62 @@ func (c *EntrypointListeners) Delete(id *int64, m *model.EntrypointListener) Act
 		ID:    id,
 		// ResourceID: m.UUID,
 		Fn: func(a *Action) error {
-			if err := c.Core.CloudAccounts.provider(m.Entrypoint.Kube.CloudAccount).DeleteEntrypointListener(m, a); err != nil {
+			if m.IsHTTP() {
+				if err := deleteIngress(c.Core, m.Entrypoint.Kube, m); err != nil {
+					return err
+				}
+			} else if err := c.Core.CloudAccounts.provider(m.Entrypoint.Kube.CloudAccount).DeleteEntrypointListener(m, a); err != nil {
 				return err
 			}
 			return c.Collection.Delete(id, m)
 		},
 	}
 }
+
+// Private
+
+// validateRule checks that a listener is a rule (with Host and Path) if, and
+// only if, its Entrypoint is an HTTP Entrypoint, and sets the ports of rules.
+func (c *EntrypointListeners) validateRule(m *model.EntrypointListener) error {
+	if m.EntrypointName == "" {
+		return &ErrorValidationFailed{errors.New("EntrypointName: zero value")}
+	}
+	entrypoint := new(model.Entrypoint)
+	if err := c.Core.DB.First(entrypoint, "name = ?", m.EntrypointName); err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return &ErrorMissingRequiredParent{"EntrypointName", "EntrypointListener"}
+		}
+		return err
+	}
+
+	if entrypoint.Type != "HTTP" {
+		if m.IsHTTP() {
+			return &ErrorValidationFailed{errors.New("Host and Path are only for listeners of HTTP Entrypoints")}
+		}
+		if m.NodePort == 0 {
+			return &ErrorValidationFailed{errors.New("NodePort: zero value")}
+		}
+		return nil
+	}
+
+	switch {
+	case m.Path == "":
+		return &ErrorValidationFailed{errors.New("Path: zero value")}
+	case !strings.HasPrefix(m.Path, "/"):
+		return &ErrorValidationFailed{errors.New("Path: must start with /")}
+	case m.Namespace == "":
+		return &ErrorValidationFailed{errors.New("Namespace: zero value")}
+	case m.ServiceName == "":
+		return &ErrorValidationFailed{errors.New("ServiceName: zero value")}
+	case m.ServicePort == 0:
+		return &ErrorValidationFailed{errors.New("ServicePort: zero value")}
+	}
+
+	m.EntrypointPort = 80
+	m.EntrypointProtocol = "HTTP"
+	if m.TLSSecretName != "" {
+		m.EntrypointPort = 443
+		m.EntrypointProtocol = "HTTPS"
+	}
+	return nil
+}
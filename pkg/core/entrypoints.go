@@ -1,12 +1,25 @@
 package core
 
-import "github.com/supergiant/supergiant/pkg/model"
+import (
+	"fmt"
+
+	"github.com/supergiant/supergiant/pkg/model"
+)
 
 type Entrypoints struct {
 	Collection
 }
 
 func (c *Entrypoints) Create(m *model.Entrypoint) error {
+	if m.Type == "HTTP" {
+		var entrypoints []*model.Entrypoint
+		if err := c.Core.DB.Find(&entrypoints, "kube_name = ? AND type = ?", m.KubeName, "HTTP"); err != nil {
+			return err
+		}
+		if len(entrypoints) > 0 {
+			return &ErrorValidationFailed{fmt.Errorf("Kube %s already has HTTP Entrypoint %s", m.KubeName, entrypoints[0].Name)}
+		}
+	}
 	if err := c.Collection.Create(m); err != nil {
 		return err
 	}
@@ -25,7 +38,13 @@ func (c *Entrypoints) Provision(id *int64, m *model.Entrypoint) ActionInterface
 		Model: m,
 		ID:    id,
 		Fn: func(a *Action) error {
-			return c.Core.CloudAccounts.provider(m.Kube.CloudAccount).CreateEntrypoint(m, a)
+			if err := c.Core.CloudAccounts.provider(m.Kube.CloudAccount).CreateEntrypoint(m, a); err != nil {
+				return err
+			}
+			if m.Type != "HTTP" {
+				return nil
+			}
+			return c.provisionIngressController(m, a)
 		},
 	}
 }
@@ -41,12 +60,22 @@ func (c *Entrypoints) Delete(id *int64, m *model.Entrypoint) ActionInterface {
 		Model: m,
 		ID:    id,
 		Fn: func(a *Action) error {
-			// Delete listener records directly
+			// Delete listener records directly, and the Ingresses of HTTP listeners
 			for _, listener := range m.EntrypointListeners {
+				if listener.IsHTTP() {
+					if err := deleteIngress(c.Core, m.Kube, listener); err != nil {
+						return err
+					}
+				}
 				if err := c.Core.DB.Delete(listener); err != nil {
 					return err
 				}
 			}
+			if m.Type == "HTTP" {
+				if err := c.deleteIngressController(m); err != nil {
+					return err
+				}
+			}
 			if err := c.Core.CloudAccounts.provider(m.Kube.CloudAccount).DeleteEntrypoint(m, a); err != nil {
 				return err
 			}
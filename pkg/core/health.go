@@ -0,0 +1,103 @@
+package core
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/supergiant/supergiant/pkg/model"
+)
+
+const safeMapResponseTimeout = 5 * time.Second
+
+// Readiness runs all readiness checks: DB connectivity, SafeMap goroutines,
+// and freshness of RecurringServices.
+func (c *Core) Readiness() *model.Readiness {
+	readiness := &model.Readiness{Ready: true}
+
+	addCheck := func(name string, err error) {
+		check := &model.HealthCheck{Name: name, Healthy: err == nil}
+		if err != nil {
+			check.Error = err.Error()
+			readiness.Ready = false
+		}
+		readiness.Checks = append(readiness.Checks, check)
+	}
+
+	addCheck("db", c.DB.Ping())
+
+	var safeMapErr error
+	for _, m := range c.safeMaps {
+		if !m.Responding(safeMapResponseTimeout) {
+			safeMapErr = fmt.Errorf("SafeMap did not respond within %s", safeMapResponseTimeout)
+			break
+		}
+	}
+	addCheck("safe_maps", safeMapErr)
+
+	for _, rs := range c.recurringServices {
+		var err error
+		if rs.Stale() {
+			err = fmt.Errorf("No tick started in %s", rs.staleAfter())
+		}
+		addCheck("recurring_service:"+rs.name(), err)
+	}
+
+	return readiness
+}
+
+// Status reports API and MetricsSource reachability for every ready Kube. Kubes
+// are checked in parallel.
+func (c *Core) Status() (*model.Status, error) {
+	var kubes []*model.Kube
+	if err := c.DB.Find(&kubes); err != nil {
+		return nil, err
+	}
+
+	status := &model.Status{
+		Version: c.Version,
+		Kubes:   make([]*model.KubeStatus, len(kubes)),
+	}
+
+	var wg sync.WaitGroup
+	for i, kube := range kubes {
+		kubeStatus := &model.KubeStatus{
+			KubeName:    kube.Name,
+			Ready:       kube.Ready,
+			TLSVerified: kube.CACert != "",
+		}
+		status.Kubes[i] = kubeStatus
+
+		if !kube.Ready {
+			kubeStatus.Error = "Kube is not ready"
+			continue
+		}
+
+		wg.Add(1)
+		go func(kube *model.Kube) {
+			defer wg.Done()
+			c.checkKube(kube, kubeStatus)
+		}(kube)
+	}
+	wg.Wait()
+
+	return status, nil
+}
+
+// Private
+
+func (c *Core) checkKube(kube *model.Kube, kubeStatus *model.KubeStatus) {
+	k8s := c.K8S(kube)
+
+	if _, err := k8s.ListNamespaces(""); err != nil {
+		kubeStatus.Error = err.Error()
+		return
+	}
+	kubeStatus.Reachable = true
+
+	if _, err := c.MetricsSource(kube).NodeUsage(); err != nil {
+		kubeStatus.MetricsError = err.Error()
+		return
+	}
+	kubeStatus.MetricsAvailable = true
+}
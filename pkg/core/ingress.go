@@ -0,0 +1,303 @@
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/supergiant/supergiant/pkg/kubernetes"
+	"github.com/supergiant/supergiant/pkg/model"
+)
+
+// The ingress controller of the HTTP Entrypoint of a Kube runs in its own
+// Namespace, which is deleted with the Entrypoint.
+const (
+	ingressNamespace      = "supergiant-ingress"
+	ingressControllerName = "nginx-ingress-controller"
+	ingressBackendName    = "default-http-backend"
+	ingressClass          = "nginx"
+
+	ingressControllerImage = "gcr.io/google_containers/nginx-ingress-controller:0.9.0-beta.15"
+	ingressBackendImage    = "gcr.io/google_containers/defaultbackend:1.4"
+)
+
+// ingressPorts are the ports of the load balancer of an HTTP Entrypoint, by
+// the name of the port of the ingress controller Service they map to.
+var ingressPorts = map[string]int64{
+	"http":  80,
+	"https": 443,
+}
+
+// provisionIngressController creates the ingress controller of an HTTP
+// Entrypoint in its Kube, unless it exists, and maps the ports of the load
+// balancer to its NodePorts. TLS is terminated by the controller, so both
+// ports are forwarded as TCP. On a Kube without load balancers, the
+// controller listens on the ports of every Node instead.
+func (c *Entrypoints) provisionIngressController(m *model.Entrypoint, a *Action) error {
+	k8s := c.Core.K8S(m.Kube)
+	if err := k8s.EnsureNamespace(ingressNamespace); err != nil {
+		return err
+	}
+	hostPorts := ingressOnHostPorts(m.Kube)
+	for _, resource := range ingressControllerResources(hostPorts) {
+		apiVersion := resource["apiVersion"].(string)
+		kind := resource["kind"].(string)
+		var out json.RawMessage
+		if err := k8s.CreateResource(apiVersion, kind, ingressNamespace, resource, &out); err != nil {
+			return err
+		}
+	}
+	if hostPorts {
+		return nil
+	}
+
+	service := new(resourcePorts)
+	var out json.RawMessage
+	if err := k8s.GetResource("v1", "Service", ingressNamespace, ingressControllerName, &out); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(out, service); err != nil {
+		return err
+	}
+
+	provider := c.Core.CloudAccounts.provider(m.Kube.CloudAccount)
+	for _, port := range service.Spec.Ports {
+		entrypointPort, ok := ingressPorts[port.Name]
+		if !ok {
+			continue
+		}
+		if port.NodePort == 0 {
+			return fmt.Errorf("Service %s/%s has no nodePort for port %s", ingressNamespace, ingressControllerName, port.Name)
+		}
+		listener := &model.EntrypointListener{
+			Entrypoint:         m,
+			EntrypointName:     m.Name,
+			Name:               port.Name,
+			EntrypointPort:     entrypointPort,
+			EntrypointProtocol: "TCP",
+			NodePort:           port.NodePort,
+			NodeProtocol:       "TCP",
+		}
+		if err := provider.CreateEntrypointListener(listener, a); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ingressOnHostPorts returns whether the ingress controller of a Kube listens
+// on ports 80 and 443 of its Nodes, since the provider of the Kube has no load
+// balancer to map them to its NodePorts (DigitalOcean Entrypoints are no-ops).
+func ingressOnHostPorts(kube *model.Kube) bool {
+	return kube.CloudAccount.Provider == "digitalocean"
+}
+
+// deleteIngressController deletes the ingress controller of an HTTP
+// Entrypoint, with its Namespace.
+func (c *Entrypoints) deleteIngressController(m *model.Entrypoint) error {
+	err := c.Core.K8S(m.Kube).DeleteResource("v1", "Namespace", "", ingressNamespace)
+	if err != nil && !kubernetes.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// applyIngress creates the Ingress of the rule of a listener of an HTTP
+// Entrypoint. Listeners can't be changed, so an existing Ingress is kept.
+func applyIngress(c *Core, kube *model.Kube, m *model.EntrypointListener) error {
+	k8s := c.K8S(kube)
+	if err := k8s.EnsureNamespace(m.Namespace); err != nil {
+		return err
+	}
+	var out json.RawMessage
+	return k8s.CreateResource("extensions/v1beta1", "Ingress", m.Namespace, ingressDefinition(m), &out)
+}
+
+// deleteIngress deletes the Ingress of the rule of a listener of an HTTP
+// Entrypoint.
+func deleteIngress(c *Core, kube *model.Kube, m *model.EntrypointListener) error {
+	err := c.K8S(kube).DeleteResource("extensions/v1beta1", "Ingress", m.Namespace, m.Name)
+	if err != nil && !kubernetes.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// ingressDefinition returns the Ingress of the rule of a listener. It is named
+// after the listener, which is unique, as a Kube has one HTTP Entrypoint.
+func ingressDefinition(m *model.EntrypointListener) map[string]interface{} {
+	rule := map[string]interface{}{
+		"http": map[string]interface{}{
+			"paths": []interface{}{
+				map[string]interface{}{
+					"path": m.Path,
+					"backend": map[string]interface{}{
+						"serviceName": m.ServiceName,
+						"servicePort": m.ServicePort,
+					},
+				},
+			},
+		},
+	}
+	if m.Host != "" {
+		rule["host"] = m.Host
+	}
+	spec := map[string]interface{}{
+		"rules": []interface{}{rule},
+	}
+	if m.TLSSecretName != "" {
+		tls := map[string]interface{}{"secretName": m.TLSSecretName}
+		if m.Host != "" {
+			tls["hosts"] = []string{m.Host}
+		}
+		spec["tls"] = []interface{}{tls}
+	}
+
+	return map[string]interface{}{
+		"apiVersion": "extensions/v1beta1",
+		"kind":       "Ingress",
+		"metadata": map[string]interface{}{
+			"namespace": m.Namespace,
+			"name":      m.Name,
+			"labels": map[string]interface{}{
+				"supergiant.io/entrypoint": m.EntrypointName,
+			},
+			"annotations": map[string]interface{}{
+				"kubernetes.io/ingress.class": ingressClass,
+			},
+		},
+		"spec": spec,
+	}
+}
+
+// ingressControllerResources returns the resources of the ingress controller:
+// an nginx controller, exposed by a NodePort Service, and the backend it
+// serves requests which match no rule with. If hostPorts is set, the
+// controller is a DaemonSet listening on ports 80 and 443 of every Node.
+func ingressControllerResources(hostPorts bool) []map[string]interface{} {
+	backendLabels := map[string]interface{}{"app": ingressBackendName}
+	controllerLabels := map[string]interface{}{"app": ingressControllerName}
+
+	controllerPorts := []interface{}{
+		map[string]interface{}{"name": "http", "containerPort": 80},
+		map[string]interface{}{"name": "https", "containerPort": 443},
+	}
+	controllerKind := "Deployment"
+	controllerSpec := map[string]interface{}{
+		"replicas": 2,
+	}
+	if hostPorts {
+		for _, port := range controllerPorts {
+			port := port.(map[string]interface{})
+			port["hostPort"] = port["containerPort"]
+		}
+		controllerKind = "DaemonSet"
+		controllerSpec = map[string]interface{}{}
+	}
+	controllerSpec["template"] = map[string]interface{}{
+		"metadata": map[string]interface{}{"labels": controllerLabels},
+		"spec": map[string]interface{}{
+			"containers": []interface{}{
+				map[string]interface{}{
+					"name":  ingressControllerName,
+					"image": ingressControllerImage,
+					"args": []string{
+						"/nginx-ingress-controller",
+						"--default-backend-service=" + ingressNamespace + "/" + ingressBackendName,
+						"--ingress-class=" + ingressClass,
+					},
+					"env": []interface{}{
+						fieldRefEnv("POD_NAME", "metadata.name"),
+						fieldRefEnv("POD_NAMESPACE", "metadata.namespace"),
+					},
+					"ports": controllerPorts,
+				},
+			},
+		},
+	}
+
+	return []map[string]interface{}{
+		{
+			"apiVersion": "extensions/v1beta1",
+			"kind":       "Deployment",
+			"metadata": map[string]interface{}{
+				"name":   ingressBackendName,
+				"labels": backendLabels,
+			},
+			"spec": map[string]interface{}{
+				"replicas": 1,
+				"template": map[string]interface{}{
+					"metadata": map[string]interface{}{"labels": backendLabels},
+					"spec": map[string]interface{}{
+						"containers": []interface{}{
+							map[string]interface{}{
+								"name":  ingressBackendName,
+								"image": ingressBackendImage,
+								"ports": []interface{}{
+									map[string]interface{}{"containerPort": 8080},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			"apiVersion": "v1",
+			"kind":       "Service",
+			"metadata": map[string]interface{}{
+				"name":   ingressBackendName,
+				"labels": backendLabels,
+			},
+			"spec": map[string]interface{}{
+				"selector": backendLabels,
+				"ports": []interface{}{
+					map[string]interface{}{"port": 80, "targetPort": 8080},
+				},
+			},
+		},
+		{
+			"apiVersion": "extensions/v1beta1",
+			"kind":       controllerKind,
+			"metadata": map[string]interface{}{
+				"name":   ingressControllerName,
+				"labels": controllerLabels,
+			},
+			"spec": controllerSpec,
+		},
+		{
+			"apiVersion": "v1",
+			"kind":       "Service",
+			"metadata": map[string]interface{}{
+				"name":   ingressControllerName,
+				"labels": controllerLabels,
+			},
+			"spec": map[string]interface{}{
+				"type":     "NodePort",
+				"selector": controllerLabels,
+				"ports": []interface{}{
+					map[string]interface{}{"name": "http", "port": 80, "targetPort": "http"},
+					map[string]interface{}{"name": "https", "port": 443, "targetPort": "https"},
+				},
+			},
+		},
+	}
+}
+
+func fieldRefEnv(name string, fieldPath string) map[string]interface{} {
+	return map[string]interface{}{
+		"name": name,
+		"valueFrom": map[string]interface{}{
+			"fieldRef": map[string]interface{}{"fieldPath": fieldPath},
+		},
+	}
+}
+
+// resourcePorts holds the ports of a Service.
+type resourcePorts struct {
+	Spec struct {
+		Ports []struct {
+			Name     string `json:"name"`
+			NodePort int64  `json:"nodePort"`
+		} `json:"ports"`
+	} `json:"spec"`
+}
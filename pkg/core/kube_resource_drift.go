@@ -0,0 +1,100 @@
+package core
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/supergiant/supergiant/pkg/kubernetes"
+	"github.com/supergiant/supergiant/pkg/model"
+)
+
+// driftReconcileInterval is the least time between reconciling the drift of a
+// KubeResource, so that a resource which something else keeps changing is not
+// applied continually.
+const driftReconcileInterval = time.Minute
+
+// detectDrift sets the Drift of a KubeResource which was started before it was
+// refreshed, by comparing its Definition with the resource in Kubernetes, and
+// returns whether the drift should be reconciled. The Drift of a stopped
+// KubeResource is left until it is started or stopped again.
+func (c *KubeResources) detectDrift(m *model.KubeResource, wasStarted bool) (reconcile bool, err error) {
+	// HelmReleases have no resource of their own, and the resource is expected
+	// to change while starting, stopping, or updating.
+	if !wasStarted || m.Kind == model.HelmReleaseKind || m.Definition == nil || len(*m.Definition) == 0 || c.actionRunning(m) {
+		return false, nil
+	}
+
+	drift := new(model.KubeResourceDrift)
+
+	var live json.RawMessage
+	err = c.Core.K8S(m.Kube).GetResource(m.APIVersion(), m.Kind, m.Namespace, m.Name, &live)
+	if kubernetes.IsNotFound(err) {
+		drift.Missing = true
+	} else if err != nil {
+		return false, err
+	} else if drift.Fields, err = definitionDiff(c.Core, m, live); err != nil {
+		return false, err
+	}
+
+	if !drift.Missing && len(drift.Fields) == 0 {
+		m.Drift, m.DriftJSON = nil, nil
+		return false, nil
+	}
+
+	now := time.Now()
+	drift.DetectedAt = &now
+	if m.Drift != nil {
+		drift.DetectedAt = m.Drift.DetectedAt
+		drift.ReconciledAt = m.Drift.ReconciledAt
+	}
+	m.Drift = drift
+
+	// A Pod which is not running can't be applied again
+	if m.DriftPolicy != "reconcile" || (!drift.Missing && !m.Ready) {
+		return false, nil
+	}
+	if drift.ReconciledAt != nil && now.Sub(*drift.ReconciledAt) < driftReconcileInterval {
+		return false, nil
+	}
+	drift.ReconciledAt = &now
+	return true, nil
+}
+
+// reconcileDrift applies the Definition of a drifted KubeResource again, by
+// starting it if its resource is missing, and updating it otherwise.
+func (c *KubeResources) reconcileDrift(m *model.KubeResource) error {
+	c.Core.Log.Infof("Reconciling drift of %s '%s' in Namespace '%s'", m.Kind, m.Name, m.Namespace)
+	if m.Drift.Missing {
+		// NOTE we call this from core to get the interface
+		return c.Core.KubeResources.Start(m.ID, m).Async()
+	}
+	return c.apply(m.ID, m).Async()
+}
+
+// actionRunning returns whether an Action, e.g. starting or stopping, is being
+// performed on m.
+func (c *KubeResources) actionRunning(m *model.KubeResource) bool {
+	ai := c.Core.Actions.Get(m.UUID)
+	if ai == nil {
+		return false
+	}
+	status := ai.(ActionInterface).GetStatus()
+	return status.Retries < status.MaxRetries
+}
+
+// definitionDiff returns the paths of the fields of the Definition of m which
+// differ in live.
+func definitionDiff(c *Core, m *model.KubeResource, live json.RawMessage) ([]string, error) {
+	var desired, liveMap map[string]interface{}
+	if err := json.Unmarshal(*m.Definition, &desired); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(live, &liveMap); err != nil {
+		return nil, err
+	}
+	rendered, err := renderSecretParameters(c, m, desired)
+	if err != nil {
+		return nil, err
+	}
+	return kubernetes.Diff(rendered.(map[string]interface{}), liveMap), nil
+}
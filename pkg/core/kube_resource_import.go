@@ -0,0 +1,184 @@
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/go-validator/validator"
+	"github.com/supergiant/supergiant/pkg/model"
+)
+
+// systemNamespaces are not imported unless they are listed explicitly.
+var systemNamespaces = map[string]bool{
+	"kube-system":     true,
+	"kube-public":     true,
+	"kube-node-lease": true,
+}
+
+// Import adopts the existing resources of m.Kinds in m.Namespaces of a Kube
+// as started KubeResources, without re-creating them. Resources which are
+// already KubeResources (detected by the kube_namespace_kind_name index), are
+// managed by another resource, or are invalid as KubeResources are skipped.
+// Resources which can't be listed or saved are reported in m.Failed, and the
+// rest are still imported, so that a partial import is visible and can be
+// repeated.
+func (c *KubeResources) Import(m *model.KubeResourceImport) error {
+	if err := validator.Validate(m); err != nil {
+		return &ErrorValidationFailed{err}
+	}
+	m.Imported = []*model.KubeResource{}
+	m.Skipped = []*model.SkippedKubeResource{}
+	m.Failed = []*model.SkippedKubeResource{}
+
+	kube := new(model.Kube)
+	if err := c.Core.DB.Preload("CloudAccount").First(kube, "name = ?", m.KubeName); err != nil {
+		return &ErrorMissingRequiredParent{"KubeName", "KubeResourceImport"}
+	}
+	k8s := c.Core.K8S(kube)
+
+	namespaces := m.Namespaces
+	if len(namespaces) == 0 {
+		k8sNamespaces, err := k8s.ListNamespaces("")
+		if err != nil {
+			return err
+		}
+		for _, namespace := range k8sNamespaces {
+			if systemNamespaces[namespace.Metadata.Name] {
+				continue
+			}
+			namespaces = append(namespaces, namespace.Metadata.Name)
+		}
+	}
+
+	for _, kindWithVersion := range m.Kinds {
+		apiVersion, kind := splitKind(kindWithVersion)
+
+		for _, namespace := range namespaces {
+			list, err := k8s.ListResources(apiVersion, kind, namespace)
+			if err != nil {
+				m.Failed = append(m.Failed, &model.SkippedKubeResource{
+					Kind:      kind,
+					Namespace: namespace,
+					Reason:    err.Error(),
+				})
+				continue
+			}
+
+			for _, item := range list.Items {
+				kubeResource, skipReason, err := adoptedKubeResource(kube, apiVersion, kind, item)
+				if err == nil && skipReason == "" {
+					skipReason, err = c.createImported(kubeResource)
+				}
+				if err != nil {
+					failed := &model.SkippedKubeResource{Kind: kind, Namespace: namespace, Reason: err.Error()}
+					if kubeResource != nil {
+						failed.Name = kubeResource.Name
+					}
+					m.Failed = append(m.Failed, failed)
+					continue
+				}
+
+				if skipReason != "" {
+					m.Skipped = append(m.Skipped, &model.SkippedKubeResource{
+						Kind:      kind,
+						Namespace: kubeResource.Namespace,
+						Name:      kubeResource.Name,
+						Reason:    skipReason,
+					})
+					continue
+				}
+				m.Imported = append(m.Imported, kubeResource)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Private
+
+// createImported creates an adopted KubeResource, returning why it was skipped
+// if it is invalid or already exists.
+func (c *KubeResources) createImported(m *model.KubeResource) (skipReason string, err error) {
+	if err := c.Collection.Create(m); err != nil {
+		if _, ok := err.(*ErrorValidationFailed); ok {
+			return err.Error(), nil
+		}
+		if isUniqueViolation(err) {
+			return "already a KubeResource", nil
+		}
+		return "", err
+	}
+	return "", nil
+}
+
+// adoptedKubeResource returns a started KubeResource for the live resource
+// item, with the Artifact set to item, and the Template and Definition to item
+// without its status and the metadata set by Kubernetes. skipReason is set if
+// the resource should not be adopted.
+func adoptedKubeResource(kube *model.Kube, apiVersion string, kind string, item json.RawMessage) (m *model.KubeResource, skipReason string, err error) {
+	var resource map[string]interface{}
+	if err := json.Unmarshal(item, &resource); err != nil {
+		return nil, "", err
+	}
+	metadata, _ := resource["metadata"].(map[string]interface{})
+	if metadata == nil {
+		metadata = make(map[string]interface{})
+	}
+
+	m = &model.KubeResource{
+		KubeName: kube.Name,
+		Kind:     kind,
+		Started:  true,
+	}
+	m.Namespace, _ = metadata["namespace"].(string)
+	m.Name, _ = metadata["name"].(string)
+
+	// Resources created by controllers, e.g. the Pods of a ReplicaSet, are
+	// managed through their owner.
+	if owners, _ := metadata["ownerReferences"].([]interface{}); len(owners) > 0 {
+		if owner, ok := owners[0].(map[string]interface{}); ok {
+			return m, fmt.Sprintf("managed by %v %v", owner["kind"], owner["name"]), nil
+		}
+	}
+
+	// Items of lists don't always have their apiVersion and kind
+	resource["apiVersion"] = apiVersion
+	resource["kind"] = kind
+	delete(resource, "status")
+	for _, key := range []string{"uid", "selfLink", "resourceVersion", "generation", "creationTimestamp"} {
+		delete(metadata, key)
+	}
+	resource["metadata"] = metadata
+
+	definition, err := json.Marshal(resource)
+	if err != nil {
+		return nil, "", err
+	}
+	template := json.RawMessage(definition)
+	definitionCopy := append(json.RawMessage(nil), definition...)
+	artifact := append(json.RawMessage(nil), item...)
+
+	m.Template = &template
+	m.Definition = &definitionCopy
+	m.Artifact = &artifact
+	return m, "", nil
+}
+
+// splitKind splits a kind prefixed with its apiVersion, such as
+// extensions/v1beta1/Deployment. Kinds without one are in the core API (v1).
+func splitKind(kindWithVersion string) (apiVersion string, kind string) {
+	i := strings.LastIndex(kindWithVersion, "/")
+	if i == -1 {
+		return "v1", kindWithVersion
+	}
+	return kindWithVersion[:i], kindWithVersion[i+1:]
+}
+
+// isUniqueViolation returns whether err is from a unique index, as reported by
+// SQLite or Postgres.
+func isUniqueViolation(err error) bool {
+	msg := err.Error()
+	return strings.Contains(msg, "UNIQUE constraint failed") || strings.Contains(msg, "duplicate key value violates unique constraint")
+}
@@ -0,0 +1,163 @@
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/supergiant/supergiant/pkg/kubernetes"
+	"github.com/supergiant/supergiant/pkg/model"
+)
+
+// KubeResourceInformers runs a kubernetes.Informer for each kind of
+// KubeResource in each Namespace of each Kube that has KubeResources, so that
+// only the Namespaces managed by Supergiant are cached. KubeResources are
+// refreshed when their resource changes, and the K8S client reads resources
+// from the Informer caches instead of requesting them.
+type KubeResourceInformers struct {
+	core *Core
+
+	mutex     sync.Mutex
+	informers map[string]*runningInformer
+}
+
+type runningInformer struct {
+	*kubernetes.Informer
+	stop chan struct{}
+}
+
+func NewKubeResourceInformers(c *Core) *KubeResourceInformers {
+	return &KubeResourceInformers{
+		core:      c,
+		informers: make(map[string]*runningInformer),
+	}
+}
+
+// Perform starts Informers for kinds and Namespaces that have come into use,
+// and stops those no longer used by any KubeResource.
+func (s *KubeResourceInformers) Perform() error {
+	var kubeResources []*model.KubeResource
+	if err := s.core.DB.Preload("Kube").Find(&kubeResources); err != nil {
+		return err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	inUse := make(map[string]bool)
+	for _, kubeResource := range kubeResources {
+		// HelmReleases are not a kind of resource in Kubernetes
+		if kubeResource.Kube == nil || kubeResource.Kind == model.HelmReleaseKind {
+			continue
+		}
+		key := kubeResourceInformerKey(kubeResource.Kube.Name, kubeResource.APIVersion(), kubeResource.Kind, kubeResource.Namespace)
+		inUse[key] = true
+
+		if _, running := s.informers[key]; !running {
+			s.start(key, kubeResource.Kube, kubeResource.APIVersion(), kubeResource.Kind, kubeResource.Namespace)
+		}
+	}
+
+	for key, informer := range s.informers {
+		if !inUse[key] {
+			close(informer.stop)
+			delete(s.informers, key)
+		}
+	}
+	return nil
+}
+
+// Get returns a cached resource, and whether it exists. If synced is false,
+// there is no cache to rely on. A nil *KubeResourceInformers never has a cache.
+func (s *KubeResourceInformers) Get(kube *model.Kube, apiVersion string, kind string, namespace string, name string) (obj json.RawMessage, exists bool, synced bool) {
+	if s == nil || kube == nil {
+		return nil, false, false
+	}
+	s.mutex.Lock()
+	informer := s.informers[kubeResourceInformerKey(kube.Name, apiVersion, kind, namespace)]
+	s.mutex.Unlock()
+
+	if informer == nil {
+		return nil, false, false
+	}
+	return informer.Get(namespace, name)
+}
+
+// Synced returns true if changes to the resource of kubeResource are being
+// watched.
+func (s *KubeResourceInformers) Synced(kubeResource *model.KubeResource) bool {
+	_, _, synced := s.Get(kubeResource.Kube, kubeResource.APIVersion(), kubeResource.Kind, kubeResource.Namespace, kubeResource.Name)
+	return synced
+}
+
+// Client wraps a Kubernetes client so that GetResource reads from the
+// Informer caches.
+func (s *KubeResourceInformers) Client(kube *model.Kube, client kubernetes.ClientInterface) kubernetes.ClientInterface {
+	return &cachedK8SClient{client, kube, s}
+}
+
+// Private
+
+func (s *KubeResourceInformers) start(key string, kube *model.Kube, apiVersion string, kind string, namespace string) {
+	informer := &runningInformer{
+		Informer: kubernetes.NewInformer(s.core.K8S(kube), apiVersion, kind, namespace),
+		stop:     make(chan struct{}),
+	}
+	informer.OnChange = func(namespace string, name string, _ json.RawMessage) {
+		s.refresh(kube.Name, kind, namespace, name)
+	}
+	informer.OnError = func(err error) {
+		s.core.Log.Errorf("Error in %s Informer of Namespace %s of Kube %s: %s", kind, namespace, kube.Name, err)
+	}
+	s.informers[key] = informer
+
+	go informer.Run(informer.stop)
+}
+
+// refresh updates the Artifact and Started of the KubeResource of a changed
+// resource, if there is one.
+func (s *KubeResourceInformers) refresh(kubeName string, kind string, namespace string, name string) {
+	kubeResource := new(model.KubeResource)
+	err := s.core.DB.Preload("Kube").Where("kube_name = ? AND kind = ? AND namespace = ? AND name = ?", kubeName, kind, namespace, name).First(kubeResource)
+	if err != nil {
+		// Not managed by Supergiant
+		return
+	}
+	if err := s.core.KubeResources.Refresh(kubeResource); err != nil {
+		s.core.Log.Errorf("Error refreshing KubeResource %d: %s", *kubeResource.ID, err)
+	}
+}
+
+func kubeResourceInformerKey(kubeName string, apiVersion string, kind string, namespace string) string {
+	return kubeName + "/" + apiVersion + "/" + kind + "/" + namespace
+}
+
+// cachedK8SClient reads resources from the KubeResourceInformers while they
+// are synced, and requests them otherwise.
+type cachedK8SClient struct {
+	kubernetes.ClientInterface
+	kube      *model.Kube
+	informers *KubeResourceInformers
+}
+
+func (k *cachedK8SClient) GetResource(apiVersion string, kind string, namespace string, name string, out *json.RawMessage) error {
+	obj, exists, synced := k.informers.Get(k.kube, apiVersion, kind, namespace, name)
+	if !synced {
+		return k.ClientInterface.GetResource(apiVersion, kind, namespace, name, out)
+	}
+	if !exists {
+		return &kubernetes.StatusError{
+			Code: http.StatusNotFound,
+			Status: &kubernetes.Status{
+				Code:    http.StatusNotFound,
+				Reason:  "NotFound",
+				Message: fmt.Sprintf("%s '%s' in Namespace '%s' not found", kind, name, namespace),
+			},
+		}
+	}
+	if out != nil {
+		*out = obj
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+package core_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/supergiant/supergiant/pkg/core"
+	"github.com/supergiant/supergiant/pkg/kubernetes"
+	"github.com/supergiant/supergiant/pkg/model"
+	"github.com/supergiant/supergiant/test/fake_core"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestKubeResourceInformers(t *testing.T) {
+	Convey("KubeResourceInformers watches the kinds and Namespaces of KubeResources in use, and serves GetResource from cache", t, func() {
+		kubeResources := []*model.KubeResource{
+			{
+				Kube:      &model.Kube{Name: "test"},
+				Kind:      "Pod",
+				Namespace: "ns",
+				Name:      "a",
+				Template:  newRawMessage(`{}`),
+			},
+		}
+
+		refreshed := make(chan string, 10)
+		listed := make(chan string, 10)
+		block := make(chan struct{})
+
+		k8s := &fake_core.KubernetesClient{
+			GetResourceFn: func(apiVersion string, kind string, namespace string, name string, out *json.RawMessage) error {
+				*out = json.RawMessage(`{"from":"request"}`)
+				return nil
+			},
+			ListResourcesFn: func(apiVersion string, kind string, namespace string) (*kubernetes.ResourceList, error) {
+				listed <- namespace
+				return &kubernetes.ResourceList{
+					Metadata: kubernetes.ListMetadata{ResourceVersion: "1"},
+					Items: []json.RawMessage{
+						json.RawMessage(`{"metadata":{"namespace":"ns","name":"a"}}`),
+					},
+				}, nil
+			},
+			WatchResourcesFn: func(apiVersion string, kind string, namespace string, resourceVersion string, fn func(*kubernetes.WatchEvent) error) error {
+				<-block
+				return nil
+			},
+		}
+
+		c := &core.Core{
+			Log: logrus.New(),
+			DB: &fake_core.DB{
+				FindFn: func(out interface{}, where ...interface{}) error {
+					*out.(*[]*model.KubeResource) = kubeResources
+					return nil
+				},
+				FirstFn: func(out interface{}, where ...interface{}) error {
+					*out.(*model.KubeResource) = *kubeResources[0]
+					return nil
+				},
+			},
+			KubeResources: &fake_core.KubeResources{
+				RefreshFn: func(m *model.KubeResource) error {
+					refreshed <- m.Namespace + "/" + m.Name
+					return nil
+				},
+			},
+		}
+		c.KubeResourceInformers = core.NewKubeResourceInformers(c)
+		c.K8S = func(kube *model.Kube) kubernetes.ClientInterface {
+			return c.KubeResourceInformers.Client(kube, k8s)
+		}
+		client := c.K8S(kubeResources[0].Kube)
+
+		So(c.KubeResourceInformers.Perform(), ShouldBeNil)
+
+		select {
+		case name := <-refreshed:
+			So(name, ShouldEqual, "ns/a")
+		case <-time.After(time.Second):
+			t.Fatal("Timed out waiting for KubeResource refresh")
+		}
+		So(c.KubeResourceInformers.Synced(kubeResources[0]), ShouldBeTrue)
+		// Only the Namespace of the KubeResource is listed
+		So(<-listed, ShouldEqual, "ns")
+
+		out := new(json.RawMessage)
+		So(client.GetResource("v1", "Pod", "ns", "a", out), ShouldBeNil)
+		So(string(*out), ShouldEqual, `{"metadata":{"namespace":"ns","name":"a"}}`)
+
+		err := client.GetResource("v1", "Pod", "ns", "b", out)
+		So(kubernetes.IsNotFound(err), ShouldBeTrue)
+		So(err.Error(), ShouldEqual, "K8S 404 Not Found error: Pod 'b' in Namespace 'ns' not found")
+
+		// Kinds without a running Informer are requested
+		So(client.GetResource("v1", "Service", "ns", "a", out), ShouldBeNil)
+		So(string(*out), ShouldEqual, `{"from":"request"}`)
+
+		// As are Namespaces without KubeResources
+		So(client.GetResource("v1", "Pod", "other", "a", out), ShouldBeNil)
+		So(string(*out), ShouldEqual, `{"from":"request"}`)
+
+		Convey("Informers are stopped when their kind is no longer used", func() {
+			kubeResources = nil
+			So(c.KubeResourceInformers.Perform(), ShouldBeNil)
+
+			So(client.GetResource("v1", "Pod", "ns", "a", out), ShouldBeNil)
+			So(string(*out), ShouldEqual, `{"from":"request"}`)
+		})
+	})
+}
@@ -0,0 +1,99 @@
+package core
+
+import (
+	"encoding/json"
+
+	"github.com/go-validator/validator"
+	"github.com/supergiant/supergiant/pkg/kubernetes"
+	"github.com/supergiant/supergiant/pkg/model"
+)
+
+// CreateFromManifest creates (and starts) a KubeResource for each resource of
+// m.Manifest, in order, with the kind, namespace and name of the resource. A
+// resource which can't be created (e.g. because it is invalid, or already a
+// KubeResource) does not stop the rest; its error is set in m.Results.
+func (c *KubeResources) CreateFromManifest(m *model.KubeResourceManifest) error {
+	if err := validator.Validate(m); err != nil {
+		return &ErrorValidationFailed{err}
+	}
+	m.Results = []*model.KubeResourceManifestResult{}
+
+	manifests, err := kubernetes.DecodeManifests([]byte(m.Manifest))
+	if err != nil {
+		return &ErrorValidationFailed{err}
+	}
+
+	kube := new(model.Kube)
+	if err := c.Core.DB.First(kube, "name = ?", m.KubeName); err != nil {
+		return &ErrorMissingRequiredParent{"KubeName", "KubeResourceManifest"}
+	}
+
+	namespace := m.Namespace
+	if namespace == "" {
+		namespace = "default"
+	}
+
+	for _, manifest := range manifests {
+		kubeResource, err := manifestKubeResource(m.KubeName, namespace, manifest)
+		if err != nil {
+			return err
+		}
+		result := &model.KubeResourceManifestResult{
+			Kind:      kubeResource.Kind,
+			Namespace: kubeResource.Namespace,
+			Name:      kubeResource.Name,
+		}
+		m.Results = append(m.Results, result)
+
+		// NOTE we call this from core to get the interface
+		if err := c.Core.KubeResources.Create(kubeResource); err != nil {
+			if !isKubeResourceCreateError(err) {
+				return err
+			}
+			result.Error = err.Error()
+			continue
+		}
+		result.KubeResource = kubeResource
+	}
+
+	return nil
+}
+
+// Private
+
+// manifestKubeResource returns a KubeResource whose Template is manifest, in
+// the namespace of the manifest (or namespace if it has none).
+func manifestKubeResource(kubeName string, namespace string, manifest map[string]interface{}) (*model.KubeResource, error) {
+	metadata, _ := manifest["metadata"].(map[string]interface{})
+	if metadata == nil {
+		metadata = make(map[string]interface{})
+	}
+
+	m := &model.KubeResource{
+		KubeName:  kubeName,
+		Namespace: namespace,
+	}
+	m.Kind, _ = manifest["kind"].(string)
+	m.Name, _ = metadata["name"].(string)
+	if manifestNamespace, _ := metadata["namespace"].(string); manifestNamespace != "" {
+		m.Namespace = manifestNamespace
+	}
+
+	template, err := json.Marshal(manifest)
+	if err != nil {
+		return nil, err
+	}
+	templateRawMsg := json.RawMessage(template)
+	m.Template = &templateRawMsg
+	return m, nil
+}
+
+// isKubeResourceCreateError returns whether err is due to the KubeResource
+// being created, rather than to Supergiant itself.
+func isKubeResourceCreateError(err error) bool {
+	switch err.(type) {
+	case *ErrorValidationFailed, *ErrorMissingRequiredParent:
+		return true
+	}
+	return isUniqueViolation(err)
+}
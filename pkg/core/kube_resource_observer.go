@@ -1,9 +1,6 @@
 package core
 
-import (
-	"github.com/supergiant/supergiant/pkg/kubernetes"
-	"github.com/supergiant/supergiant/pkg/model"
-)
+import "github.com/supergiant/supergiant/pkg/model"
 
 type KubeResourceObserver struct {
 	core *Core
@@ -15,18 +12,21 @@ func (s *KubeResourceObserver) Perform() (err error) {
 		return err
 	}
 	for _, kubeResource := range kubeResources {
+		// Watched resources are refreshed when they change. Pods are still
+		// refreshed here to collect their metrics, and drifted resources to
+		// notice when they no longer are.
+		if kubeResource.Kind != "Pod" && kubeResource.Drift == nil && s.core.KubeResourceInformers.Synced(kubeResource) {
+			continue
+		}
+
 		// TODO this is a bit.. freestyle right now.
 		// We set i there and let the Save in Refresh() persist it.
-		// Don't care about errors from Heapster.
+		// Don't care about errors from the MetricsSource.
 		if kubeResource.Kind == "Pod" {
-			cpuMetrics, _ := s.core.K8S(kubeResource.Kube).ListPodHeapsterCPUUsageMetrics(kubeResource.Namespace, kubeResource.Name)
-			ramMetrics, _ := s.core.K8S(kubeResource.Kube).ListPodHeapsterRAMUsageMetrics(kubeResource.Namespace, kubeResource.Name)
-			if cpuMetrics != nil && ramMetrics != nil {
+			usage, err := s.core.MetricsSource(kubeResource.Kube).PodUsage(kubeResource.Namespace, kubeResource.Name)
+			if err == nil {
 				kubeResource.ExtraData = map[string]interface{}{
-					"metrics": map[string][]*kubernetes.HeapsterMetric{
-						"cpu_usage": cpuMetrics,
-						"ram_usage": ramMetrics,
-					},
+					"metrics": usage,
 				}
 			}
 		}
@@ -0,0 +1,181 @@
+package core
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/jinzhu/gorm"
+	"github.com/supergiant/supergiant/pkg/model"
+)
+
+// Revisions sets the KubeResourceRevisions of a KubeResource on list, in order.
+func (c *KubeResources) Revisions(id *int64, list *model.KubeResourceRevisionList) error {
+	if err := c.Get(id, new(model.KubeResource)); err != nil {
+		return err
+	}
+	list.Items = []*model.KubeResourceRevision{}
+	if err := c.Core.DB.Where("kube_resource_id = ?", *id).Find(&list.Items); err != nil {
+		return err
+	}
+	sort.Sort(revisionsByNumber(list.Items))
+	list.Total = int64(len(list.Items))
+	return nil
+}
+
+// Rollback sets the Template and Parameters of a KubeResource back to those of
+// one of its revisions, recording a new revision, and applies it if the
+// KubeResource is started. The Template is not rendered again, even if the
+// KubeResource has a KubeResourceTemplate.
+func (c *KubeResources) Rollback(id *int64, revision int, m *model.KubeResource) error {
+	author := m.RevisionAuthor
+	if err := c.Get(id, m); err != nil {
+		return err
+	}
+
+	rev := new(model.KubeResourceRevision)
+	if err := c.Core.DB.First(rev, "kube_resource_id = ? AND revision = ?", *id, revision); err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return &ErrorValidationFailed{fmt.Errorf("Revision %d of KubeResource does not exist", revision)}
+		}
+		return err
+	}
+
+	m.Template = rev.Template
+	m.Parameters = rev.Parameters
+	m.SecretParameters = nil
+	m.EncryptedSecretParameters = rev.EncryptedSecretParameters
+	m.RevisionAuthor = author
+
+	// Parameters are cleared by saving them empty
+	if m.Parameters == nil {
+		m.ParametersJSON = nil
+	}
+	if err := c.Core.DB.Save(m); err != nil {
+		return err
+	}
+	if err := c.recordRevision(m, rev.Revision); err != nil {
+		return err
+	}
+	if !m.Started {
+		return nil
+	}
+	return c.apply(id, m).Async()
+}
+
+// Private
+
+// recordRevision records the Template and Parameters of m as its next
+// revision.
+func (c *KubeResources) recordRevision(m *model.KubeResource, rollbackTo int) error {
+	revisions, err := c.revisions(m.ID)
+	if err != nil {
+		return err
+	}
+	revision := 1
+	if len(revisions) > 0 {
+		revision = revisions[len(revisions)-1].Revision + 1
+	}
+	return c.Core.DB.Create(&model.KubeResourceRevision{
+		KubeResourceID: m.ID,
+		Revision:       revision,
+		Author:         m.RevisionAuthor,
+		RollbackTo:     rollbackTo,
+		Template:       m.Template,
+		Parameters:     m.Parameters,
+
+		EncryptedSecretParameters: m.EncryptedSecretParameters,
+	})
+}
+
+// recordDefinition records the Definition m was just provisioned with on its
+// latest revision, or as a new revision (of the same Template) if the latest
+// has a different Definition, e.g. because it was provisioned with other
+// Volumes.
+func (c *KubeResources) recordDefinition(m *model.KubeResource) error {
+	revisions, err := c.revisions(m.ID)
+	if err != nil {
+		return err
+	}
+	// KubeResources created before revisions were recorded have none
+	if len(revisions) == 0 {
+		if err := c.recordRevision(m, 0); err != nil {
+			return err
+		}
+		return c.recordDefinition(m)
+	}
+
+	latest := revisions[len(revisions)-1]
+	if latest.Definition == nil {
+		latest.Definition = m.Definition
+		return c.Core.DB.Save(latest)
+	}
+	if jsonEqual(*latest.Definition, *m.Definition) {
+		return nil
+	}
+	return c.Core.DB.Create(&model.KubeResourceRevision{
+		KubeResourceID: m.ID,
+		Revision:       latest.Revision + 1,
+		Author:         m.RevisionAuthor,
+		Template:       m.Template,
+		Parameters:     m.Parameters,
+		Definition:     m.Definition,
+
+		EncryptedSecretParameters: m.EncryptedSecretParameters,
+	})
+}
+
+// revisions returns the revisions of a KubeResource in order.
+func (c *KubeResources) revisions(id *int64) ([]*model.KubeResourceRevision, error) {
+	var revisions []*model.KubeResourceRevision
+	if err := c.Core.DB.Where("kube_resource_id = ?", *id).Find(&revisions); err != nil {
+		return nil, err
+	}
+	sort.Sort(revisionsByNumber(revisions))
+	return revisions, nil
+}
+
+// templateChanged returns whether the Template, Parameters or SecretParameters
+// of m differ from those of oldM. Secret parameters are compared decrypted,
+// since they are encrypted again each time the Template is rendered.
+func (c *KubeResources) templateChanged(oldM *model.KubeResource, m *model.KubeResource) (bool, error) {
+	if oldM.Template == nil || m.Template == nil || !jsonEqual(*oldM.Template, *m.Template) {
+		return true, nil
+	}
+	if len(oldM.Parameters)+len(m.Parameters) > 0 && !reflect.DeepEqual(oldM.Parameters, m.Parameters) {
+		return true, nil
+	}
+	oldSecretParameters, err := c.Core.secretParameters(oldM)
+	if err != nil {
+		return false, err
+	}
+	secretParameters, err := c.Core.secretParameters(m)
+	if err != nil {
+		return false, err
+	}
+	return len(oldSecretParameters)+len(secretParameters) > 0 && !reflect.DeepEqual(oldSecretParameters, secretParameters), nil
+}
+
+// jsonEqual returns whether a and b are equal JSON, regardless of formatting
+// and the order of keys.
+func jsonEqual(a json.RawMessage, b json.RawMessage) bool {
+	if bytes.Equal(a, b) {
+		return true
+	}
+	var aObj, bObj interface{}
+	if err := json.Unmarshal(a, &aObj); err != nil {
+		return false
+	}
+	if err := json.Unmarshal(b, &bObj); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(aObj, bObj)
+}
+
+type revisionsByNumber []*model.KubeResourceRevision
+
+func (r revisionsByNumber) Len() int           { return len(r) }
+func (r revisionsByNumber) Swap(i, j int)      { r[i], r[j] = r[j], r[i] }
+func (r revisionsByNumber) Less(i, j int) bool { return r[i].Revision < r[j].Revision }
@@ -0,0 +1,406 @@
+package core
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+
+	"github.com/go-validator/validator"
+	"github.com/supergiant/supergiant/pkg/model"
+)
+
+type KubeResourceTemplates struct {
+	Collection
+}
+
+func (c *KubeResourceTemplates) Create(m *model.KubeResourceTemplate) error {
+	if err := validateKubeResourceTemplate(m); err != nil {
+		return err
+	}
+	return c.Collection.Create(m)
+}
+
+// Update changes a KubeResourceTemplate. Its KubeResources are rendered with
+// the changes when they are next updated.
+func (c *KubeResourceTemplates) Update(id *int64, oldM *model.KubeResourceTemplate, m *model.KubeResourceTemplate) error {
+	if err := c.Collection.merge(id, oldM, m); err != nil {
+		return err
+	}
+	if err := validateKubeResourceTemplate(m); err != nil {
+		return err
+	}
+	return c.Core.DB.Save(m)
+}
+
+func (c *KubeResourceTemplates) Delete(id *int64, m *model.KubeResourceTemplate) error {
+	if err := c.Core.DB.Where("kube_resource_template_name = ?", m.Name).Find(&m.KubeResources); err != nil {
+		return err
+	}
+	if len(m.KubeResources) > 0 {
+		return &ErrorValidationFailed{errors.New("Cannot delete KubeResourceTemplate that has KubeResources")}
+	}
+	return c.Collection.Delete(id, m)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// Private methods                                                            //
+////////////////////////////////////////////////////////////////////////////////
+
+// renderTemplate sets the Template of a KubeResource of a KubeResourceTemplate
+// by rendering the KubeResourceTemplate with the Parameters. The values of
+// secret parameters are moved to SecretParameters (and encrypted), and their
+// placeholders are left in Template, to be rendered by the DefaultProvisioner.
+func (c *KubeResources) renderTemplate(m *model.KubeResource) error {
+	template := new(model.KubeResourceTemplate)
+	if err := c.Core.DB.First(template, "name = ?", m.KubeResourceTemplateName); err != nil {
+		return &ErrorMissingRequiredParent{"KubeResourceTemplateName", "KubeResource"}
+	}
+
+	// Secret parameters which are already set are kept
+	if _, err := c.Core.secretParameters(m); err != nil {
+		return err
+	}
+
+	if m.Kind == "" {
+		m.Kind = template.Kind
+	} else if m.Kind != template.Kind {
+		return &ErrorValidationFailed{fmt.Errorf("Kind: must be %s, the Kind of KubeResourceTemplate %s", template.Kind, template.Name)}
+	}
+
+	values, err := templateParameterValues(template, m)
+	if err != nil {
+		return &ErrorValidationFailed{err}
+	}
+
+	var obj interface{}
+	if err := json.Unmarshal(*template.Template, &obj); err != nil {
+		return err
+	}
+	rendered, err := json.Marshal(renderParameters(obj, values))
+	if err != nil {
+		return err
+	}
+	renderedRawMsg := json.RawMessage(rendered)
+	m.Template = &renderedRawMsg
+	return c.Core.encryptSecretParameters(m)
+}
+
+// templateParameterValues validates the Parameters of m against those of
+// template, and returns the values to render template with. Secret values are
+// moved to the SecretParameters of m, and are not returned.
+func templateParameterValues(template *model.KubeResourceTemplate, m *model.KubeResource) (map[string]interface{}, error) {
+	declared := make(map[string]*model.TemplateParameter)
+	for _, param := range template.Parameters {
+		declared[param.Name] = param
+	}
+
+	for name, value := range m.Parameters {
+		param, ok := declared[name]
+		if !ok {
+			return nil, fmt.Errorf("Parameters: %s is not a parameter of KubeResourceTemplate %s", name, template.Name)
+		}
+		if err := checkParameterType(param, value); err != nil {
+			return nil, fmt.Errorf("Parameters: %s", err)
+		}
+		if param.Secret {
+			if m.SecretParameters == nil {
+				m.SecretParameters = make(map[string]interface{})
+			}
+			m.SecretParameters[name] = value
+			delete(m.Parameters, name)
+		}
+	}
+
+	values := make(map[string]interface{})
+	for _, param := range template.Parameters {
+		value, ok := m.Parameters[param.Name]
+		if param.Secret {
+			_, ok = m.SecretParameters[param.Name]
+		} else if !ok && param.Default != nil {
+			value, ok = param.Default, true
+		}
+
+		if !ok && param.Required {
+			return nil, fmt.Errorf("Parameters: %s is required", param.Name)
+		}
+		// Secrets are rendered when provisioning. Missing optional parameters are
+		// rendered as null.
+		if !param.Secret || !ok {
+			values[param.Name] = value
+		}
+	}
+	return values, nil
+}
+
+// validateKubeResourceTemplate validates the Parameters of m, and that every
+// placeholder in its Template is a parameter.
+func validateKubeResourceTemplate(m *model.KubeResourceTemplate) error {
+	if err := validateFields(m); err != nil {
+		return err
+	}
+
+	declared := make(map[string]bool)
+	for _, param := range m.Parameters {
+		if err := validator.Validate(param); err != nil {
+			return &ErrorValidationFailed{fmt.Errorf("Parameters: %s", err)}
+		}
+		if declared[param.Name] {
+			return &ErrorValidationFailed{fmt.Errorf("Parameters: %s is declared more than once", param.Name)}
+		}
+		declared[param.Name] = true
+
+		if param.Secret && param.Type != "" && param.Type != "string" {
+			return &ErrorValidationFailed{fmt.Errorf("Parameters: secret %s must be a string", param.Name)}
+		}
+		if param.Secret && param.Default != nil {
+			return &ErrorValidationFailed{fmt.Errorf("Parameters: secret %s cannot have a default", param.Name)}
+		}
+		if param.Default != nil {
+			if err := checkParameterType(param, param.Default); err != nil {
+				return &ErrorValidationFailed{fmt.Errorf("Parameters: default of %s", err)}
+			}
+		}
+	}
+
+	var obj interface{}
+	if err := json.Unmarshal(*m.Template, &obj); err != nil {
+		return &ErrorValidationFailed{err}
+	}
+	for _, name := range placeholderNames(obj) {
+		if !declared[name] {
+			return &ErrorValidationFailed{fmt.Errorf("Template: {{ %s }} is not a parameter", name)}
+		}
+	}
+	return nil
+}
+
+// checkParameterType returns an error if value (decoded from JSON) is not of
+// the Type of param.
+func checkParameterType(param *model.TemplateParameter, value interface{}) error {
+	typ := param.Type
+	if typ == "" {
+		typ = "string"
+	}
+	var ok bool
+	switch typ {
+	case "string":
+		_, ok = value.(string)
+	case "number":
+		_, ok = value.(float64)
+	case "integer":
+		f, isNumber := value.(float64)
+		ok = isNumber && f == math.Trunc(f)
+	case "boolean":
+		_, ok = value.(bool)
+	}
+	if !ok {
+		return fmt.Errorf("%s must be a %s", param.Name, typ)
+	}
+	return nil
+}
+
+// parameterPlaceholder matches placeholders of parameters, e.g. {{ replicas }}.
+var parameterPlaceholder = regexp.MustCompile(`\{\{\s*([A-Za-z_][A-Za-z0-9_]*)\s*\}\}`)
+
+// renderParameters returns a copy of obj (decoded from JSON) with the
+// placeholders of the parameters in values replaced. A string which is only a
+// placeholder is replaced with the value itself, so that it keeps its type.
+// Placeholders of other parameters are left as they are.
+func renderParameters(obj interface{}, values map[string]interface{}) interface{} {
+	switch obj := obj.(type) {
+	case map[string]interface{}:
+		rendered := make(map[string]interface{}, len(obj))
+		for key, value := range obj {
+			rendered[key] = renderParameters(value, values)
+		}
+		return rendered
+	case []interface{}:
+		rendered := make([]interface{}, len(obj))
+		for i, value := range obj {
+			rendered[i] = renderParameters(value, values)
+		}
+		return rendered
+	case string:
+		if match := parameterPlaceholder.FindStringSubmatch(obj); match != nil && match[0] == obj {
+			if value, ok := values[match[1]]; ok {
+				return value
+			}
+			return obj
+		}
+		return parameterPlaceholder.ReplaceAllStringFunc(obj, func(placeholder string) string {
+			value, ok := values[parameterPlaceholder.FindStringSubmatch(placeholder)[1]]
+			if !ok {
+				return placeholder
+			}
+			if value == nil {
+				return ""
+			}
+			return fmt.Sprint(value)
+		})
+	}
+	return obj
+}
+
+// placeholderNames returns the names of the parameters with placeholders in
+// obj (decoded from JSON).
+func placeholderNames(obj interface{}) (names []string) {
+	switch obj := obj.(type) {
+	case map[string]interface{}:
+		for _, value := range obj {
+			names = append(names, placeholderNames(value)...)
+		}
+	case []interface{}:
+		for _, value := range obj {
+			names = append(names, placeholderNames(value)...)
+		}
+	case string:
+		for _, match := range parameterPlaceholder.FindAllStringSubmatch(obj, -1) {
+			names = append(names, match[1])
+		}
+	}
+	return names
+}
+
+// secretParameters returns the SecretParameters of kubeResource, decrypting
+// them from its EncryptedSecretParameters if they are not set.
+func (c *Core) secretParameters(kubeResource *model.KubeResource) (map[string]interface{}, error) {
+	if kubeResource.SecretParameters != nil || len(kubeResource.EncryptedSecretParameters) == 0 {
+		return kubeResource.SecretParameters, nil
+	}
+	plaintext, err := c.decrypt(kubeResource.EncryptedSecretParameters)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(plaintext, &kubeResource.SecretParameters); err != nil {
+		return nil, err
+	}
+	return kubeResource.SecretParameters, nil
+}
+
+// encryptSecretParameters sets the EncryptedSecretParameters of kubeResource
+// from its SecretParameters, so that they are not stored in the clear.
+func (c *Core) encryptSecretParameters(kubeResource *model.KubeResource) error {
+	if len(kubeResource.SecretParameters) == 0 {
+		kubeResource.EncryptedSecretParameters = nil
+		return nil
+	}
+	data, err := json.Marshal(kubeResource.SecretParameters)
+	if err != nil {
+		return err
+	}
+	encrypted, err := c.encrypt(data)
+	if err != nil {
+		return &ErrorValidationFailed{err}
+	}
+	kubeResource.EncryptedSecretParameters = encrypted
+	return nil
+}
+
+// renderSecretParameters returns obj (decoded from JSON) with the secret
+// parameters of kubeResource rendered, for sending to Kubernetes.
+func renderSecretParameters(c *Core, kubeResource *model.KubeResource, obj interface{}) (interface{}, error) {
+	secretParameters, err := c.secretParameters(kubeResource)
+	if err != nil || len(secretParameters) == 0 {
+		return obj, err
+	}
+	return renderParameters(obj, secretParameters), nil
+}
+
+// scrubSecretParameters replaces the values of the secret parameters of
+// kubeResource in its Artifact, which is the resource as returned by
+// Kubernetes, with their placeholders, so that they are neither stored nor
+// returned by the API. Only whole string values are replaced (never keys):
+// those rendered from a placeholder in the same field of the Definition, and
+// those equal to a value, also base64-encoded, as in the data of a Secret.
+func scrubSecretParameters(c *Core, kubeResource *model.KubeResource) error {
+	if kubeResource.Artifact == nil || len(*kubeResource.Artifact) == 0 {
+		return nil
+	}
+	secretParameters, err := c.secretParameters(kubeResource)
+	if err != nil || len(secretParameters) == 0 {
+		return err
+	}
+	placeholders := make(map[string]string)
+	for name, value := range secretParameters {
+		secret, ok := value.(string)
+		if !ok || secret == "" {
+			continue
+		}
+		placeholder := "{{ " + name + " }}"
+		placeholders[secret] = placeholder
+		placeholders[base64.StdEncoding.EncodeToString([]byte(secret))] = placeholder
+	}
+
+	var obj, definition interface{}
+	if err := json.Unmarshal(*kubeResource.Artifact, &obj); err != nil {
+		return err
+	}
+	if kubeResource.Definition != nil && len(*kubeResource.Definition) > 0 {
+		if err := json.Unmarshal(*kubeResource.Definition, &definition); err != nil {
+			return err
+		}
+	}
+	// Kubernetes returns the stringData of a Secret base64-encoded in its data
+	if definitionMap, ok := definition.(map[string]interface{}); ok && kubeResource.Kind == "Secret" {
+		if stringData, ok := definitionMap["stringData"].(map[string]interface{}); ok {
+			data, _ := definitionMap["data"].(map[string]interface{})
+			if data == nil {
+				data = make(map[string]interface{})
+				definitionMap["data"] = data
+			}
+			for key, value := range stringData {
+				if _, ok := data[key]; !ok {
+					data[key] = value
+				}
+			}
+		}
+	}
+	artifact, err := json.Marshal(scrubValues(obj, definition, secretParameters, placeholders))
+	if err != nil {
+		return err
+	}
+	artifactRawMsg := json.RawMessage(artifact)
+	kubeResource.Artifact = &artifactRawMsg
+	return nil
+}
+
+// scrubValues returns a copy of obj (decoded from JSON) with the string values
+// rendered (or rendered and base64-encoded) from a placeholder in the same
+// field of definition replaced with it, and those in placeholders replaced with
+// theirs.
+func scrubValues(obj interface{}, definition interface{}, secretParameters map[string]interface{}, placeholders map[string]string) interface{} {
+	switch obj := obj.(type) {
+	case map[string]interface{}:
+		definitionMap, _ := definition.(map[string]interface{})
+		scrubbed := make(map[string]interface{}, len(obj))
+		for key, value := range obj {
+			scrubbed[key] = scrubValues(value, definitionMap[key], secretParameters, placeholders)
+		}
+		return scrubbed
+	case []interface{}:
+		definitionList, _ := definition.([]interface{})
+		scrubbed := make([]interface{}, len(obj))
+		for i, value := range obj {
+			var definitionValue interface{}
+			if i < len(definitionList) {
+				definitionValue = definitionList[i]
+			}
+			scrubbed[i] = scrubValues(value, definitionValue, secretParameters, placeholders)
+		}
+		return scrubbed
+	case string:
+		if template, ok := definition.(string); ok && parameterPlaceholder.MatchString(template) {
+			rendered, _ := renderParameters(template, secretParameters).(string)
+			if rendered == obj || base64.StdEncoding.EncodeToString([]byte(rendered)) == obj {
+				return template
+			}
+		}
+		if placeholder, ok := placeholders[obj]; ok {
+			return placeholder
+		}
+	}
+	return obj
+}
@@ -1,9 +1,13 @@
 package core
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"time"
 
+	"github.com/gorilla/websocket"
 	"github.com/supergiant/supergiant/pkg/model"
 	"github.com/supergiant/supergiant/pkg/util"
 )
@@ -17,6 +21,12 @@ type KubeResourcesInterface interface {
 	Start(*int64, *model.KubeResource) ActionInterface
 	Stop(*int64, *model.KubeResource) ActionInterface
 	Refresh(*model.KubeResource) error
+	PodLogs(*int64, *model.PodLogQuery) (io.ReadCloser, error)
+	ExecPod(*int64, *model.ExecQuery) (*websocket.Conn, error)
+	Import(*model.KubeResourceImport) error
+	CreateFromManifest(*model.KubeResourceManifest) error
+	Revisions(*int64, *model.KubeResourceRevisionList) error
+	Rollback(*int64, int, *model.KubeResource) error
 }
 
 type KubeResources struct {
@@ -24,16 +34,71 @@ type KubeResources struct {
 }
 
 func (c *KubeResources) Create(m *model.KubeResource) error {
+	var app *model.App
+	if m.AppName != "" {
+		var err error
+		if app, err = c.setApp(m); err != nil {
+			return err
+		}
+	}
+	if m.KubeResourceTemplateName != "" {
+		if err := c.renderTemplate(m); err != nil {
+			return err
+		}
+	}
+	if err := c.validateHelmRelease(m); err != nil {
+		return err
+	}
 	if err := c.Collection.Create(m); err != nil {
 		return err
 	}
+	if err := c.recordRevision(m, 0); err != nil {
+		return err
+	}
+	// KubeResources of an App are started with it
+	if app != nil && !app.Started {
+		return nil
+	}
 	// NOTE we call this from core to get the interface
 	return c.Core.KubeResources.Start(m.ID, m).Async()
 }
 
-// TODO
 func (c *KubeResources) Update(id *int64, oldM *model.KubeResource, m *model.KubeResource) error {
-	return c.Collection.Update(id, oldM, m)
+	if err := c.Collection.merge(id, oldM, m); err != nil {
+		return err
+	}
+	if m.AppName != "" {
+		if _, err := c.setApp(m); err != nil {
+			return err
+		}
+	}
+	// KubeResources of a KubeResourceTemplate are rendered again, e.g. with
+	// changed Parameters.
+	if m.KubeResourceTemplateName != "" {
+		if err := c.renderTemplate(m); err != nil {
+			return err
+		}
+	}
+	if err := c.validateHelmRelease(m); err != nil {
+		return err
+	}
+	if err := c.Core.DB.Save(m); err != nil {
+		return err
+	}
+	changed, err := c.templateChanged(oldM, m)
+	if err != nil {
+		return err
+	}
+	if changed {
+		if err := c.recordRevision(m, 0); err != nil {
+			return err
+		}
+	}
+	// A stopped KubeResource picks up the changes when it is next started.
+	if !m.Started {
+		return nil
+	}
+	return c.apply(id, m).Async()
 }
 
 func (c *KubeResources) Delete(id *int64, m *model.KubeResource) ActionInterface {
@@ -51,7 +116,10 @@ func (c *KubeResources) Delete(id *int64, m *model.KubeResource) ActionInterface
 			if err := c.provisioner(m).Teardown(m); err != nil {
 				return err
 			}
-			return c.Collection.Delete(id, m)
+			if err := c.Collection.Delete(id, m); err != nil {
+				return err
+			}
+			return c.Core.DB.Where("kube_resource_id = ?", *id).Delete(new(model.KubeResourceRevision))
 		},
 	}
 }
@@ -71,9 +139,20 @@ func (c *KubeResources) Start(id *int64, m *model.KubeResource) ActionInterface
 			if err := k8s.EnsureNamespace(m.Namespace); err != nil {
 				return err
 			}
+			// Definition is rendered again from Template
+			m.PreviousDefinition, m.Definition = m.Definition, nil
 			if err := c.provisioner(m).Provision(m); err != nil {
 				return err
 			}
+			if err := c.recordDefinition(m); err != nil {
+				return err
+			}
+			// The resource exists from here, so that it's updated and stopped even
+			// if it never becomes ready.
+			m.Started = true
+			if err := c.Core.DB.Model(m).Update("started", true); err != nil {
+				return err
+			}
 			// Wait for Resource to be ready
 			desc := fmt.Sprintf("%s '%s' in Namespace '%s' to start", m.Kind, m.Name, m.Namespace)
 			waitErr := util.WaitFor(desc, c.Core.KubeResourceStartTimeout, 3*time.Second, func() (bool, error) {
@@ -82,7 +161,9 @@ func (c *KubeResources) Start(id *int64, m *model.KubeResource) ActionInterface
 			if waitErr != nil {
 				return waitErr
 			}
-			return c.Core.DB.Model(m).Update("started", true)
+			m.Ready = true
+			m.Drift, m.DriftJSON = nil, nil
+			return c.Core.DB.Model(m).Update(map[string]interface{}{"ready": true, "readiness": m.Readiness, "drift_json": nil})
 		},
 	}
 }
@@ -101,33 +182,148 @@ func (c *KubeResources) Stop(id *int64, m *model.KubeResource) ActionInterface {
 		Fn: func(a *Action) error {
 			// NOTE we use default provisioner teardown here, because it works as a
 			// stop() for all resources... it does not clean up any assets, which is
-			// just what we need here.
-			provisioner := &DefaultProvisioner{c.Core}
+			// just what we need here. HelmReleases have no resource of their own,
+			// and are stopped by deleting the resources of the release.
+			var provisioner Provisioner = &DefaultProvisioner{c.Core}
+			if m.Kind == model.HelmReleaseKind {
+				provisioner = c.Core.HelmReleaseProvisioner
+			}
 			if err := provisioner.Teardown(m); err != nil {
 				return err
 			}
-			return c.Core.DB.Model(m).Update("started", false)
+			m.Drift, m.DriftJSON = nil, nil
+			m.Started, m.Ready = false, false
+			m.Readiness = ""
+			return c.Core.DB.Model(m).Update(map[string]interface{}{"started": false, "ready": false, "readiness": "", "drift_json": nil})
 		},
 	}
 }
 
+// Refresh updates the Artifact, Started, Ready and Readiness of a KubeResource
+// from Kubernetes, and detects whether the resource has drifted from its
+// Definition.
 func (c *KubeResources) Refresh(m *model.KubeResource) (err error) {
-	m.Started, err = c.provisioner(m).IsRunning(m)
+	if m.Artifact == nil {
+		artifact := make(json.RawMessage, 0)
+		m.Artifact = &artifact
+	}
+	wasStarted := m.Started
+	m.Ready, err = c.provisioner(m).IsRunning(m)
+	// A failed resource is recorded in Readiness
+	if _, failed := err.(*ErrorResourceFailed); failed {
+		err = nil
+	}
+	if err != nil {
+		return err
+	}
+	m.Started = resourceExists(m)
+	reconcile, err := c.detectDrift(m, wasStarted)
 	if err != nil {
 		return err
 	}
-	// Save Artifact and Started
-	return c.Core.DB.Save(m)
+	if err := scrubSecretParameters(c.Core, m); err != nil {
+		return err
+	}
+	// Only Artifact, Started, Ready, Readiness and Drift are written, so that
+	// changes made by Actions since m was loaded aren't lost.
+	marshalSerializedFields(m)
+	refreshed := map[string]interface{}{
+		"artifact_json": m.ArtifactJSON,
+		"started":       m.Started,
+		"ready":         m.Ready,
+		"readiness":     m.Readiness,
+		"drift_json":    m.DriftJSON,
+	}
+	if err := c.Core.DB.Model(&model.KubeResource{BaseModel: model.BaseModel{ID: m.ID}}).Update(refreshed); err != nil {
+		return err
+	}
+	if !reconcile {
+		return nil
+	}
+	return c.reconcileDrift(m)
+}
+
+// PodLogs returns the log of a Pod KubeResource, which the caller must close.
+func (c *KubeResources) PodLogs(id *int64, query *model.PodLogQuery) (io.ReadCloser, error) {
+	m, err := c.getPod(id)
+	if err != nil {
+		return nil, err
+	}
+	return c.Core.K8S(m.Kube).PodLogs(m.Namespace, m.Name, query)
+}
+
+// ExecPod runs a command in a Pod KubeResource, and returns its connection,
+// which speaks the kubernetes.ExecProtocol.
+func (c *KubeResources) ExecPod(id *int64, query *model.ExecQuery) (*websocket.Conn, error) {
+	if len(query.Command) == 0 {
+		return nil, &ErrorValidationFailed{errors.New("Command is required")}
+	}
+	m, err := c.getPod(id)
+	if err != nil {
+		return nil, err
+	}
+	return c.Core.K8S(m.Kube).ExecPod(m.Namespace, m.Name, query)
 }
 
 // Private
 
+// validateHelmRelease checks that the chart of a HelmRelease can be installed,
+// so that a missing chart or an unsupported template function is reported
+// when the KubeResource is saved, rather than when it's started.
+func (c *KubeResources) validateHelmRelease(m *model.KubeResource) error {
+	if m.Kind != model.HelmReleaseKind || m.Template == nil {
+		return nil
+	}
+	_, _, err := loadHelmRelease(c.Core, m)
+	return err
+}
+
+func (c *KubeResources) getPod(id *int64) (*model.KubeResource, error) {
+	m := new(model.KubeResource)
+	if err := c.GetWithIncludes(id, m, []string{"Kube"}); err != nil {
+		return nil, err
+	}
+	if m.Kind != "Pod" {
+		return nil, &ErrorValidationFailed{fmt.Errorf("KubeResource is a %s; logs and exec are only available for Pods", m.Kind)}
+	}
+	return m, nil
+}
+
+// apply provisions the updated definition of a started KubeResource, which the
+// Provisioner applies to the existing resource in Kubernetes.
+func (c *KubeResources) apply(id *int64, m *model.KubeResource) ActionInterface {
+	return &Action{
+		Status: &model.ActionStatus{
+			Description: "updating",
+			MaxRetries:  5,
+		},
+		Core:  c.Core,
+		Scope: c.Core.DB.Preload("Kube.CloudAccount"),
+		Model: m,
+		ID:    id,
+		Fn: func(a *Action) error {
+			// Definition is rendered again from Template
+			m.PreviousDefinition, m.Definition = m.Definition, nil
+			if err := c.provisioner(m).Provision(m); err != nil {
+				return err
+			}
+			return c.recordDefinition(m)
+		},
+	}
+}
+
 func (c *KubeResources) provisioner(m *model.KubeResource) Provisioner {
 	switch m.Kind {
 	case "Pod":
 		return c.Core.PodProvisioner
+	case "Deployment", "ReplicaSet":
+		return c.Core.DeploymentProvisioner
+	case "StatefulSet":
+		return c.Core.StatefulSetProvisioner
 	case "Service":
 		return c.Core.ServiceProvisioner
+	case model.HelmReleaseKind:
+		return c.Core.HelmReleaseProvisioner
 	}
 	return c.Core.DefaultProvisioner
 }
@@ -1,10 +1,25 @@
 package core
 
 import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/supergiant/supergiant/pkg/kubernetes"
 	"github.com/supergiant/supergiant/pkg/model"
 	"github.com/supergiant/supergiant/pkg/util"
 )
 
+// ErrorProxyKubeconfigRequiresSSL is returned for a proxy kubeconfig when
+// Supergiant serves plain HTTP on a host other than loopback, where the proxy
+// token in it would be sent in the clear.
+var ErrorProxyKubeconfigRequiresSSL = errors.New("A proxy kubeconfig contains your proxy token, and requires HTTPS; provide --https-port, --ssl-cert-file and --ssl-key-file at startup")
+
 type Kubes struct {
 	Collection
 }
@@ -15,6 +30,28 @@ func (c *Kubes) Create(m *model.Kube) error {
 		m.Username = util.RandomString(16)
 		m.Password = util.RandomString(8)
 	}
+	if m.ExistingConfig != nil {
+		// Imported Kubes are not sized by Supergiant, and are reached at the
+		// configured server
+		if m.MasterNodeSize == "" {
+			m.MasterNodeSize = "existing"
+		}
+		if len(m.NodeSizes) == 0 {
+			m.NodeSizes = []string{"existing"}
+		}
+		address, err := existingServerAddress(m.ExistingConfig.Server)
+		if err != nil {
+			return &ErrorValidationFailed{err}
+		}
+		m.MasterPublicIP = address
+		m.CACert = m.ExistingConfig.CACert
+	}
+
+	// Kubes provisioned by Supergiant are given a CACert when their master is
+	// created.
+	if _, err := kubernetes.TLSConfig(m); err != nil && (err != kubernetes.ErrorNoCACert || m.ExistingConfig != nil) {
+		return &ErrorValidationFailed{err}
+	}
 
 	if err := c.Collection.Create(m); err != nil {
 		return err
@@ -25,6 +62,186 @@ func (c *Kubes) Create(m *model.Kube) error {
 	return c.Core.Kubes.Provision(m.ID, m).Async()
 }
 
+// existingServerAddress returns the address of the Kubernetes API of an
+// imported Kube, as host[:port][/path], from server, which is an https URL, or
+// a host and port.
+func existingServerAddress(server string) (string, error) {
+	if !strings.Contains(server, "://") {
+		server = "https://" + server
+	}
+	u, err := url.Parse(server)
+	if err != nil {
+		return "", fmt.Errorf("ExistingConfig.Server: %s", err)
+	}
+	if u.Scheme != "https" || u.Host == "" {
+		return "", fmt.Errorf("ExistingConfig.Server: %q must be an https URL, or a host and port", server)
+	}
+	return u.Host + strings.TrimSuffix(u.Path, "/"), nil
+}
+
+// Kubeconfig returns a kubeconfig for user to access the Kube. If Supergiant
+// has the CA key of the Kube, admins are issued their own client certificate,
+// in the system:masters group. Anyone else gets the kubeconfig of the Kube API
+// proxy, so the credentials of the Kube are never handed out, and users are
+// held to what the proxy allows them.
+func (c *Kubes) Kubeconfig(id *int64, user *model.User) (*kubernetes.Kubeconfig, error) {
+	m := new(model.Kube)
+	if err := c.Get(id, m); err != nil {
+		return nil, err
+	}
+	// The API servers of Kubes provisioned by Supergiant don't authorize
+	// requests, so any certificate signed by the CA has full access.
+	if m.CAKey == "" || user.Role != model.UserRoleAdmin {
+		return c.ProxyKubeconfig(id, user)
+	}
+
+	config := kubernetes.NewKubeconfig(m)
+	cert, key, err := kubernetes.GenerateClientCert(m.CACert, m.CAKey, user.Username, []string{"system:masters"}, kubeconfigCertValidity)
+	if err != nil {
+		return nil, err
+	}
+	name := config.Users[0].Name + "-" + user.Username
+	config.Users[0] = &kubernetes.KubeconfigNamedUser{
+		Name: name,
+		User: &kubernetes.KubeconfigUser{
+			ClientCertificateData: []byte(cert),
+			ClientKeyData:         []byte(key),
+		},
+	}
+	config.Contexts[0].Context.User = name
+
+	return config, nil
+}
+
+// kubeconfigCertValidity is how long the client certificates of kubeconfigs
+// are valid. They can't be revoked, so they are short-lived, and admins fetch
+// a new kubeconfig daily.
+const kubeconfigCertValidity = 24 * time.Hour
+
+// ProxyKubeconfig returns a kubeconfig for user to access the Kube through the
+// Kube API proxy of Supergiant, authenticated with their proxy token, which is
+// generated for users created before there were proxy tokens. It requires
+// HTTPS, unless Supergiant is only published on loopback.
+func (c *Kubes) ProxyKubeconfig(id *int64, user *model.User) (*kubernetes.Kubeconfig, error) {
+	if !c.Core.SSLEnabled() && !isLoopbackHost(c.Core.PublishHost) {
+		return nil, ErrorProxyKubeconfigRequiresSSL
+	}
+
+	m := new(model.Kube)
+	if err := c.Get(id, m); err != nil {
+		return nil, err
+	}
+	if user.ProxyToken == "" {
+		if err := c.Core.Users.RegenerateProxyToken(user.ID, user); err != nil {
+			return nil, err
+		}
+	}
+	config := kubernetes.NewKubeconfig(m)
+
+	cluster := &kubernetes.KubeconfigCluster{
+		Server: fmt.Sprintf("%s/api/v0/kubes/%d/proxy", c.Core.BaseURL(), *id),
+	}
+	if c.Core.SSLEnabled() {
+		cert, err := ioutil.ReadFile(c.Core.SSLCertFile)
+		if err != nil {
+			return nil, err
+		}
+		cluster.CertificateAuthorityData = cert
+	}
+	config.Clusters[0].Cluster = cluster
+
+	name := config.Users[0].Name + "-" + user.Username
+	config.Users[0] = &kubernetes.KubeconfigNamedUser{
+		Name: name,
+		User: &kubernetes.KubeconfigUser{Token: user.ProxyToken},
+	}
+	config.Contexts[0].Context.User = name
+
+	return config, nil
+}
+
+// isLoopbackHost returns whether host only resolves to the local machine.
+func isLoopbackHost(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// Proxy forwards r to path of the Kube API, with the credentials of the Kube.
+func (c *Kubes) Proxy(id *int64, w http.ResponseWriter, r *http.Request, path string) error {
+	m := new(model.Kube)
+	if err := c.Get(id, m); err != nil {
+		return err
+	}
+	c.Core.K8S(m).ProxyRequest(w, r, path)
+	return nil
+}
+
+// DiscoverNodes syncs the Nodes of a Kube imported from an existing cluster,
+// which Supergiant does not provision, with the Nodes in Kubernetes. m.Nodes is
+// set to the result.
+func (c *Kubes) DiscoverNodes(m *model.Kube) error {
+	k8sNodes, err := c.Core.K8S(m).ListNodes("")
+	if err != nil {
+		return err
+	}
+	var existing []*model.Node
+	if err := c.Core.DB.Find(&existing, "kube_name = ?", m.Name); err != nil {
+		return err
+	}
+
+	var nodes []*model.Node
+	for _, knode := range k8sNodes {
+		var node *model.Node
+		for _, n := range existing {
+			if n.Name == knode.Metadata.Name {
+				node = n
+				break
+			}
+		}
+		if node == nil {
+			node = &model.Node{
+				KubeName:   m.Name,
+				Size:       knode.Metadata.Labels["beta.kubernetes.io/instance-type"],
+				Name:       knode.Metadata.Name,
+				ProviderID: knode.Spec.ExternalID,
+			}
+			if node.Size == "" {
+				node.Size = "existing"
+			}
+			if node.ProviderID == "" {
+				node.ProviderID = node.Name
+			}
+			node.ProviderCreationTimestamp, _ = time.Parse(time.RFC3339, knode.Metadata.CreationTimestamp)
+			if err := c.Core.DB.Create(node); err != nil {
+				return err
+			}
+		}
+		nodes = append(nodes, node)
+	}
+
+	// Forget Nodes removed from Kubernetes
+	for _, node := range existing {
+		found := false
+		for _, n := range nodes {
+			if n == node {
+				found = true
+				break
+			}
+		}
+		if !found {
+			if err := c.Core.DB.Delete(node); err != nil {
+				return err
+			}
+		}
+	}
+
+	m.Nodes = nodes
+	return nil
+}
+
 func (c *Kubes) Provision(id *int64, m *model.Kube) ActionInterface {
 	return &Action{
 		Status: &model.ActionStatus{
@@ -51,7 +268,7 @@ func (c *Kubes) Delete(id *int64, m *model.Kube) ActionInterface {
 			MaxRetries:  5,
 		},
 		Core:           c.Core,
-		Scope:          c.Core.DB.Preload("CloudAccount").Preload("KubeResources").Preload("Entrypoints").Preload("Volumes").Preload("Nodes"),
+		Scope:          c.Core.DB.Preload("CloudAccount").Preload("KubeResources").Preload("Apps").Preload("Secrets").Preload("Entrypoints").Preload("Volumes").Preload("Nodes"),
 		Model:          m,
 		ID:             id,
 		CancelExisting: true,
@@ -61,6 +278,19 @@ func (c *Kubes) Delete(id *int64, m *model.Kube) ActionInterface {
 				if err := c.Core.DB.Delete(kubeResource); err != nil {
 					return err
 				}
+				if err := c.Core.DB.Where("kube_resource_id = ?", *kubeResource.ID).Delete(new(model.KubeResourceRevision)); err != nil {
+					return err
+				}
+			}
+			for _, app := range m.Apps {
+				if err := c.Core.DB.Delete(app); err != nil {
+					return err
+				}
+			}
+			for _, secret := range m.Secrets {
+				if err := c.Core.DB.Delete(secret); err != nil {
+					return err
+				}
 			}
 			for _, entrypoint := range m.Entrypoints {
 				if err := c.Core.Entrypoints.Delete(entrypoint.ID, entrypoint).Now(); err != nil {
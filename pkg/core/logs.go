@@ -0,0 +1,240 @@
+package core
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/supergiant/supergiant/pkg/model"
+)
+
+var ErrorLogNotConfigured = errors.New("No log file configured; provide --log-file at startup")
+
+const (
+	logFollowInterval = time.Second
+
+	// logChunkSize is how much of the log file is read at a time when reading
+	// it backwards.
+	logChunkSize = 64 * 1024
+)
+
+// ReadLog returns the most recent log file entries matching query, and the
+// offset to follow the log from.
+func (c *Core) ReadLog(query *model.LogQuery) (entries []*model.LogEntry, offset int64, err error) {
+	if query.Level != "" {
+		if _, err := logrus.ParseLevel(query.Level); err != nil {
+			return nil, 0, &ErrorValidationFailed{err}
+		}
+	}
+
+	if query.Limit <= 0 {
+		offset, err = c.scanLog(0, func(entry *model.LogEntry) {
+			if logEntryMatches(entry, query) {
+				entries = append(entries, entry)
+			}
+		})
+		return entries, offset, err
+	}
+
+	// The most recent entries are read from the end, so that the whole file
+	// isn't read for every request.
+	offset, err = c.scanLogBackwards(func(entry *model.LogEntry) bool {
+		if logEntryMatches(entry, query) {
+			entries = append(entries, entry)
+		}
+		return len(entries) < query.Limit
+	})
+	for i, j := 0, len(entries)-1; i < j; i, j = i+1, j-1 {
+		entries[i], entries[j] = entries[j], entries[i]
+	}
+	return entries, offset, err
+}
+
+// FollowLog calls fn with each matching entry written to the log file after
+// offset, until stop is closed or fn returns an error.
+func (c *Core) FollowLog(query *model.LogQuery, offset int64, stop <-chan struct{}, fn func(*model.LogEntry) error) error {
+	for {
+		select {
+		case <-stop:
+			return nil
+		case <-time.After(logFollowInterval):
+		}
+
+		var fnErr error
+		var err error
+		offset, err = c.scanLog(offset, func(entry *model.LogEntry) {
+			if fnErr == nil && logEntryMatches(entry, query) {
+				fnErr = fn(entry)
+			}
+		})
+		if err != nil {
+			return err
+		}
+		if fnErr != nil {
+			return fnErr
+		}
+	}
+}
+
+// Private
+
+// scanLog calls fn with every complete line of the log file after offset, and
+// returns the offset of the end of the last complete line. If the file has
+// been truncated since offset was returned, it is read from the beginning.
+func (c *Core) scanLog(offset int64, fn func(*model.LogEntry)) (int64, error) {
+	if c.LogPath == "" {
+		return 0, ErrorLogNotConfigured
+	}
+
+	file, err := os.Open(c.LogPath)
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+
+	stat, err := file.Stat()
+	if err != nil {
+		return 0, err
+	}
+	if stat.Size() < offset {
+		offset = 0
+	}
+	if _, err := file.Seek(offset, os.SEEK_SET); err != nil {
+		return 0, err
+	}
+
+	reader := bufio.NewReader(file)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if err == io.EOF {
+			// A partial line is still being written; it's read on the next scan.
+			return offset, nil
+		}
+		if err != nil {
+			return 0, err
+		}
+		offset += int64(len(line))
+
+		if entry := parseLogEntry(line); entry != nil {
+			fn(entry)
+		}
+	}
+}
+
+// scanLogBackwards calls fn with every complete line of the log file, from the
+// last, until fn returns false, and returns the offset of the end of the last
+// complete line.
+func (c *Core) scanLogBackwards(fn func(*model.LogEntry) bool) (int64, error) {
+	if c.LogPath == "" {
+		return 0, ErrorLogNotConfigured
+	}
+
+	file, err := os.Open(c.LogPath)
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+
+	stat, err := file.Stat()
+	if err != nil {
+		return 0, err
+	}
+
+	offset := int64(-1)
+	pos := stat.Size()
+	chunk := make([]byte, logChunkSize)
+	// rest is the start of the line the previous chunk started in
+	var rest []byte
+	for pos > 0 {
+		size := int64(len(chunk))
+		if pos < size {
+			size = pos
+		}
+		pos -= size
+		if _, err := file.ReadAt(chunk[:size], pos); err != nil {
+			return 0, err
+		}
+		buf := append(append([]byte{}, chunk[:size]...), rest...)
+
+		for i := bytes.LastIndexByte(buf, '\n'); i >= 0; i = bytes.LastIndexByte(buf, '\n') {
+			if offset < 0 {
+				// A partial line is still being written after the last newline.
+				offset = pos + int64(i) + 1
+			} else if entry := parseLogEntry(buf[i+1:]); entry != nil && !fn(entry) {
+				return offset, nil
+			}
+			buf = buf[:i]
+		}
+		rest = buf
+	}
+
+	if offset < 0 {
+		return 0, nil
+	}
+	if entry := parseLogEntry(rest); entry != nil {
+		fn(entry)
+	}
+	return offset, nil
+}
+
+func parseLogEntry(line []byte) *model.LogEntry {
+	text := strings.TrimSpace(string(line))
+	if text == "" {
+		return nil
+	}
+
+	fields := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(text), &fields); err != nil {
+		return &model.LogEntry{Message: text}
+	}
+
+	entry := new(model.LogEntry)
+	if t, ok := fields["time"].(string); ok {
+		entry.Time, _ = time.Parse(time.RFC3339, t)
+	}
+	entry.Level, _ = fields["level"].(string)
+	entry.Message, _ = fields["msg"].(string)
+
+	delete(fields, "time")
+	delete(fields, "level")
+	delete(fields, "msg")
+	if len(fields) > 0 {
+		entry.Fields = fields
+	}
+
+	return entry
+}
+
+func logEntryMatches(entry *model.LogEntry, query *model.LogQuery) bool {
+	if query.Level != "" {
+		minLevel, _ := logrus.ParseLevel(query.Level)
+		level, err := logrus.ParseLevel(entry.Level)
+		// Lower logrus levels are more severe
+		if err != nil || level > minLevel {
+			return false
+		}
+	}
+	if !query.Since.IsZero() && (entry.Time.IsZero() || entry.Time.Before(query.Since)) {
+		return false
+	}
+	if !query.Until.IsZero() && (entry.Time.IsZero() || entry.Time.After(query.Until)) {
+		return false
+	}
+	if query.Model != "" && fmt.Sprint(entry.Fields["model"]) != query.Model {
+		return false
+	}
+	if query.ModelID != "" && fmt.Sprint(entry.Fields["model_id"]) != query.ModelID {
+		return false
+	}
+	if query.Text != "" && !strings.Contains(strings.ToLower(entry.Message), strings.ToLower(query.Text)) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,345 @@
+package core
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/supergiant/supergiant/pkg/kubernetes"
+	"github.com/supergiant/supergiant/pkg/metrics"
+	"github.com/supergiant/supergiant/pkg/model"
+)
+
+// Metrics holds the Prometheus metrics of the Supergiant server. It is only
+// set on Core when Settings.MetricsEnabled is true; all observe methods are
+// safe to call on a nil *Metrics.
+type Metrics struct {
+	*metrics.Registry
+
+	HTTPRequests        *metrics.CounterVec
+	HTTPRequestDuration *metrics.HistogramVec
+
+	ActionsInProgress *metrics.GaugeVec
+	ActionRetries     *metrics.CounterVec
+	ActionFailures    *metrics.CounterVec
+
+	RecurringServiceTickDuration *metrics.HistogramVec
+	RecurringServiceErrors       *metrics.CounterVec
+
+	ProviderCalls        *metrics.CounterVec
+	ProviderCallDuration *metrics.HistogramVec
+
+	KubernetesRequests        *metrics.CounterVec
+	KubernetesRequestDuration *metrics.HistogramVec
+
+	DBQueryDuration *metrics.HistogramVec
+
+	Kubes         *metrics.GaugeVec
+	Nodes         *metrics.GaugeVec
+	KubeResources *metrics.GaugeVec
+}
+
+func NewMetrics(c *Core) *Metrics {
+	r := metrics.NewRegistry()
+
+	m := &Metrics{
+		Registry: r,
+
+		HTTPRequests:        r.NewCounterVec("supergiant_http_requests_total", "Count of HTTP requests by route, method and status code.", "route", "method", "code"),
+		HTTPRequestDuration: r.NewHistogramVec("supergiant_http_request_duration_seconds", "HTTP request latency by route and method.", nil, "route", "method"),
+
+		ActionsInProgress: r.NewGaugeVec("supergiant_actions_in_progress", "Number of Actions currently running by model and description.", "model", "description"),
+		ActionRetries:     r.NewCounterVec("supergiant_action_retries_total", "Count of Action retries by model and description.", "model", "description"),
+		ActionFailures:    r.NewCounterVec("supergiant_action_failures_total", "Count of Actions that failed permanently by model and description.", "model", "description"),
+
+		RecurringServiceTickDuration: r.NewHistogramVec("supergiant_recurring_service_tick_duration_seconds", "Duration of RecurringService ticks by service.", nil, "service"),
+		RecurringServiceErrors:       r.NewCounterVec("supergiant_recurring_service_errors_total", "Count of RecurringService ticks that errored or panicked by service.", "service"),
+
+		ProviderCalls:        r.NewCounterVec("supergiant_provider_calls_total", "Count of cloud provider calls by provider, operation and result.", "provider", "operation", "result"),
+		ProviderCallDuration: r.NewHistogramVec("supergiant_provider_call_duration_seconds", "Cloud provider call latency by provider and operation.", []float64{.1, .5, 1, 5, 15, 30, 60, 300, 900, 1800}, "provider", "operation"),
+
+		KubernetesRequests:        r.NewCounterVec("supergiant_kubernetes_requests_total", "Count of Kubernetes API requests by method and status code.", "method", "code"),
+		KubernetesRequestDuration: r.NewHistogramVec("supergiant_kubernetes_request_duration_seconds", "Kubernetes API request latency by method.", nil, "method"),
+
+		DBQueryDuration: r.NewHistogramVec("supergiant_db_query_duration_seconds", "DB query latency by operation.", nil, "operation"),
+
+		Kubes:         r.NewGaugeVec("supergiant_kubes", "Number of Kubes by passive status.", "status"),
+		Nodes:         r.NewGaugeVec("supergiant_nodes", "Number of Nodes by passive status.", "status"),
+		KubeResources: r.NewGaugeVec("supergiant_kube_resources", "Number of KubeResources by passive status.", "status"),
+	}
+
+	r.OnCollect(func() {
+		m.collectActions(c)
+		m.collectPassiveStatuses(c)
+	})
+
+	return m
+}
+
+func (m *Metrics) ObserveHTTPRequest(route string, method string, code int, start time.Time) {
+	if m == nil {
+		return
+	}
+	m.HTTPRequests.Inc(route, method, strconv.Itoa(code))
+	m.HTTPRequestDuration.Observe(time.Since(start).Seconds(), route, method)
+}
+
+func (m *Metrics) ObserveActionRetry(a *Action) {
+	if m == nil {
+		return
+	}
+	m.ActionRetries.Inc(a.modelType(), a.Status.Description)
+}
+
+func (m *Metrics) ObserveActionFailure(a *Action) {
+	if m == nil {
+		return
+	}
+	m.ActionFailures.Inc(a.modelType(), a.Status.Description)
+}
+
+func (m *Metrics) ObserveRecurringServiceTick(name string, start time.Time, failed bool) {
+	if m == nil {
+		return
+	}
+	m.RecurringServiceTickDuration.Observe(time.Since(start).Seconds(), name)
+	if failed {
+		m.RecurringServiceErrors.Inc(name)
+	}
+}
+
+func (m *Metrics) ObserveProviderCall(provider string, operation string, start time.Time, err error) {
+	if m == nil {
+		return
+	}
+	result := "success"
+	if err != nil {
+		result = "error"
+	}
+	m.ProviderCalls.Inc(provider, operation, result)
+	m.ProviderCallDuration.Observe(time.Since(start).Seconds(), provider, operation)
+}
+
+func (m *Metrics) ObserveDBQuery(operation string, start time.Time) {
+	if m == nil {
+		return
+	}
+	m.DBQueryDuration.Observe(time.Since(start).Seconds(), operation)
+}
+
+// InstrumentHTTPClient returns a copy of client which records Kubernetes
+// request metrics.
+func (m *Metrics) InstrumentHTTPClient(client *http.Client) *http.Client {
+	if m == nil {
+		return client
+	}
+	transport := client.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	instrumented := *client
+	instrumented.Transport = &kubernetesMetricsRoundTripper{m, transport}
+	return &instrumented
+}
+
+// Private
+
+type kubernetesMetricsRoundTripper struct {
+	metrics *Metrics
+	next    http.RoundTripper
+}
+
+func (t *kubernetesMetricsRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	code := "error"
+	if err == nil {
+		code = strconv.Itoa(resp.StatusCode)
+	}
+	t.metrics.KubernetesRequests.Inc(req.Method, code)
+	t.metrics.KubernetesRequestDuration.Observe(time.Since(start).Seconds(), req.Method)
+	return resp, err
+}
+
+func (m *Metrics) collectActions(c *Core) {
+	counts := make(map[[2]string]float64)
+	for _, ai := range c.Actions.List() {
+		a, ok := ai.(*Action)
+		if !ok || a.Status.Cancelled || (a.Status.Retries >= a.Status.MaxRetries && a.Status.Error != "") {
+			continue // failed Actions are kept in the map, but are not in progress
+		}
+		counts[[2]string{a.modelType(), a.Status.Description}]++
+	}
+
+	var values []metrics.GaugeValue
+	for labelValues, count := range counts {
+		values = append(values, metrics.GaugeValue{LabelValues: []string{labelValues[0], labelValues[1]}, Value: count})
+	}
+	m.ActionsInProgress.Replace(values)
+}
+
+// collectPassiveStatuses counts Kubes, Nodes and KubeResources by status with
+// one grouped query each, so that scrapes don't load every record.
+func (m *Metrics) collectPassiveStatuses(c *Core) {
+	var kubes []struct {
+		Ready bool
+		Count int
+	}
+	err := c.DB.Model(new(model.Kube)).
+		Select("ready, COUNT(*) AS count").
+		Group("ready").
+		Scan(&kubes)
+	if err == nil {
+		counts := make(map[string]float64)
+		for _, row := range kubes {
+			status := "not ready"
+			if row.Ready {
+				status = "ready"
+			}
+			counts[status] += float64(row.Count)
+		}
+		m.Kubes.Replace(statusGaugeValues(counts))
+	}
+
+	var nodes []struct {
+		Provisioning bool
+		OutOfDisk    bool
+		Count        int
+	}
+	err = c.DB.Model(new(model.Node)).
+		Select("provider_id = '' AS provisioning, out_of_disk, COUNT(*) AS count").
+		Group("provider_id = '', out_of_disk").
+		Scan(&nodes)
+	if err == nil {
+		counts := make(map[string]float64)
+		for _, row := range nodes {
+			status := "running"
+			switch {
+			case row.Provisioning:
+				status = "provisioning"
+			case row.OutOfDisk:
+				status = "out of disk"
+			}
+			counts[status] += float64(row.Count)
+		}
+		m.Nodes.Replace(statusGaugeValues(counts))
+	}
+
+	var kubeResources []struct {
+		Drifted   bool
+		Readiness string
+		Ready     bool
+		Started   bool
+		Count     int
+	}
+	err = c.DB.Model(new(model.KubeResource)).
+		Select("COALESCE(LENGTH(drift_json), 0) > 0 AS drifted, readiness, ready, started, COUNT(*) AS count").
+		Group("COALESCE(LENGTH(drift_json), 0) > 0, readiness, ready, started").
+		Scan(&kubeResources)
+	if err == nil {
+		counts := make(map[string]float64)
+		for _, row := range kubeResources {
+			kubeResource := &model.KubeResource{
+				Readiness: row.Readiness,
+				Ready:     row.Ready,
+				Started:   row.Started,
+			}
+			if row.Drifted {
+				kubeResource.Drift = new(model.KubeResourceDrift)
+			}
+			kubeResource.SetPassiveStatus()
+			counts[kubeResource.PassiveStatus] += float64(row.Count)
+		}
+		m.KubeResources.Replace(statusGaugeValues(counts))
+	}
+}
+
+func statusGaugeValues(counts map[string]float64) (values []metrics.GaugeValue) {
+	for status, count := range counts {
+		values = append(values, metrics.GaugeValue{LabelValues: []string{status}, Value: count})
+	}
+	return values
+}
+
+//------------------------------------------------------------------------------
+
+// instrumentedProvider wraps a Provider to record call counts and latencies
+// by operation.
+type instrumentedProvider struct {
+	Provider
+	name    string
+	metrics *Metrics
+}
+
+func (p *instrumentedProvider) observe(operation string, start time.Time, err *error) {
+	p.metrics.ObserveProviderCall(p.name, operation, start, *err)
+}
+
+func (p *instrumentedProvider) ValidateAccount(m *model.CloudAccount) (err error) {
+	defer p.observe("ValidateAccount", time.Now(), &err)
+	return p.Provider.ValidateAccount(m)
+}
+
+func (p *instrumentedProvider) CreateKube(m *model.Kube, a *Action) (err error) {
+	defer p.observe("CreateKube", time.Now(), &err)
+	return p.Provider.CreateKube(m, a)
+}
+
+func (p *instrumentedProvider) DeleteKube(m *model.Kube, a *Action) (err error) {
+	defer p.observe("DeleteKube", time.Now(), &err)
+	return p.Provider.DeleteKube(m, a)
+}
+
+func (p *instrumentedProvider) CreateNode(m *model.Node, a *Action) (err error) {
+	defer p.observe("CreateNode", time.Now(), &err)
+	return p.Provider.CreateNode(m, a)
+}
+
+func (p *instrumentedProvider) DeleteNode(m *model.Node, a *Action) (err error) {
+	defer p.observe("DeleteNode", time.Now(), &err)
+	return p.Provider.DeleteNode(m, a)
+}
+
+func (p *instrumentedProvider) CreateVolume(m *model.Volume, a *Action) (err error) {
+	defer p.observe("CreateVolume", time.Now(), &err)
+	return p.Provider.CreateVolume(m, a)
+}
+
+func (p *instrumentedProvider) KubernetesVolumeDefinition(m *model.Volume) *kubernetes.Volume {
+	return p.Provider.KubernetesVolumeDefinition(m)
+}
+
+func (p *instrumentedProvider) WaitForVolumeAvailable(m *model.Volume, a *Action) (err error) {
+	defer p.observe("WaitForVolumeAvailable", time.Now(), &err)
+	return p.Provider.WaitForVolumeAvailable(m, a)
+}
+
+func (p *instrumentedProvider) ResizeVolume(m *model.Volume, a *Action) (err error) {
+	defer p.observe("ResizeVolume", time.Now(), &err)
+	return p.Provider.ResizeVolume(m, a)
+}
+
+func (p *instrumentedProvider) DeleteVolume(m *model.Volume, a *Action) (err error) {
+	defer p.observe("DeleteVolume", time.Now(), &err)
+	return p.Provider.DeleteVolume(m, a)
+}
+
+func (p *instrumentedProvider) CreateEntrypoint(m *model.Entrypoint, a *Action) (err error) {
+	defer p.observe("CreateEntrypoint", time.Now(), &err)
+	return p.Provider.CreateEntrypoint(m, a)
+}
+
+func (p *instrumentedProvider) DeleteEntrypoint(m *model.Entrypoint, a *Action) (err error) {
+	defer p.observe("DeleteEntrypoint", time.Now(), &err)
+	return p.Provider.DeleteEntrypoint(m, a)
+}
+
+func (p *instrumentedProvider) CreateEntrypointListener(m *model.EntrypointListener, a *Action) (err error) {
+	defer p.observe("CreateEntrypointListener", time.Now(), &err)
+	return p.Provider.CreateEntrypointListener(m, a)
+}
+
+func (p *instrumentedProvider) DeleteEntrypointListener(m *model.EntrypointListener, a *Action) (err error) {
+	defer p.observe("DeleteEntrypointListener", time.Now(), &err)
+	return p.Provider.DeleteEntrypointListener(m, a)
+}
@@ -17,9 +17,17 @@ func (s *NodeObserver) Perform() error {
 
 	for _, kube := range kubes {
 
+		// Nodes of imported Kubes are not provisioned by Supergiant
+		if kube.CloudAccount.Provider == "existing" {
+			if err := s.core.Kubes.DiscoverNodes(kube); err != nil {
+				s.core.Log.Errorf("Error discovering Nodes of Kube %s: %s", kube.Name, err)
+				continue
+			}
+		}
+
 		k8s := s.core.K8S(kube)
 
-		metrics, err := k8s.ListNodeHeapsterStats()
+		usage, err := s.core.MetricsSource(kube).NodeUsage()
 		if err != nil {
 			continue // very common to get an error here, and it's not critical
 		}
@@ -38,6 +46,9 @@ func (s *NodeObserver) Perform() error {
 					break
 				}
 			}
+			if knode == nil {
+				continue
+			}
 
 			// Set ExternalIP
 			for _, addr := range knode.Status.Addresses {
@@ -65,20 +76,26 @@ func (s *NodeObserver) Perform() error {
 				}
 			}
 
-			var metric *kubernetes.HeapsterStats
-			for _, mtrc := range metrics {
-				if mtrc.Name == node.Name {
-					metric = mtrc
+			var nodeUsage *kubernetes.NodeUsage
+			for _, nu := range usage {
+				if nu.Name == node.Name {
+					nodeUsage = nu
 					break
 				}
 			}
 
 			// Set Stats
-			if metric != nil {
-				node.CPUUsage = metric.CPUUsage
-				node.RAMUsage = metric.RAMUsage
-				node.CPULimit = int64(nodeSize.CPUCores * 1000)
-				node.RAMLimit = int64(nodeSize.RAMGIB * 1073741824)
+			if nodeUsage != nil {
+				node.CPUUsage = nodeUsage.CPUUsage
+				node.RAMUsage = nodeUsage.RAMUsage
+				if nodeSize != nil {
+					node.CPULimit = int64(nodeSize.CPUCores * 1000)
+					node.RAMLimit = int64(nodeSize.RAMGIB * 1073741824)
+				} else if cpu, ram, err := kubernetes.NodeCapacity(knode); err == nil {
+					// Sizes unknown to Supergiant, e.g. of imported Kubes
+					node.CPULimit = cpu
+					node.RAMLimit = ram
+				}
 			}
 
 			if err := s.core.DB.Save(node); err != nil {
@@ -1,6 +1,7 @@
 package core
 
 import (
+	"github.com/Sirupsen/logrus"
 	"github.com/supergiant/supergiant/pkg/model"
 )
 
@@ -28,7 +29,7 @@ func (p *Procedure) Run() error {
 			continue
 		}
 
-		p.Core.Log.Infof("Running step of %s procedure: %s", p.Name, step.desc)
+		p.Core.Log.WithFields(p.logFields()).Infof("Running step of %s procedure: %s", p.Name, step.desc)
 		if err := step.fn(); err != nil {
 			return err
 		}
@@ -45,3 +46,11 @@ func (p *Procedure) Run() error {
 	}
 	return nil
 }
+
+// Private
+
+func (p *Procedure) logFields() logrus.Fields {
+	fields := modelLogFields(p.Model)
+	fields["action_id"] = p.Action.Status.ID
+	return fields
+}
@@ -0,0 +1,91 @@
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/supergiant/supergiant/pkg/kubernetes"
+	"github.com/supergiant/supergiant/pkg/model"
+	"github.com/supergiant/supergiant/pkg/util"
+)
+
+// controllerScaleDownTimeout is how long the Pods of a controller (e.g. a
+// Deployment) being torn down have to terminate.
+const controllerScaleDownTimeout = 5 * time.Minute
+
+// controllerSpecs returns the Template of a controller KubeResource, its spec,
+// and the spec of its Pod template.
+func controllerSpecs(kubeResource *model.KubeResource) (templateMap map[string]interface{}, spec map[string]interface{}, podSpec map[string]interface{}, err error) {
+	if err = json.Unmarshal(*kubeResource.Template, &templateMap); err != nil {
+		return nil, nil, nil, err
+	}
+	spec, _ = templateMap["spec"].(map[string]interface{})
+	podTemplate, _ := spec["template"].(map[string]interface{})
+	podSpec, _ = podTemplate["spec"].(map[string]interface{})
+	if podSpec == nil {
+		return nil, nil, nil, fmt.Errorf("Missing spec.template.spec field on %s", kubeResource.Kind)
+	}
+	return templateMap, spec, podSpec, nil
+}
+
+// controllerReplicas returns the replicas of the spec of a controller, which
+// default to 1.
+func controllerReplicas(spec map[string]interface{}) int {
+	if replicas, ok := spec["replicas"].(float64); ok {
+		return int(replicas)
+	}
+	return 1
+}
+
+// setDefinition sets the Definition of a KubeResource to templateMap, as
+// rendered by its Provisioner.
+func setDefinition(kubeResource *model.KubeResource, templateMap map[string]interface{}) error {
+	marshalledDef, err := json.Marshal(templateMap)
+	if err != nil {
+		return err
+	}
+	rawMsgDef := json.RawMessage(marshalledDef)
+	kubeResource.Definition = &rawMsgDef
+	return nil
+}
+
+// scaleDownController scales a controller to 0 replicas, and waits for its
+// Pods to terminate, so that they no longer use its Volumes.
+func scaleDownController(c *Core, kubeResource *model.KubeResource) error {
+	k8s := c.K8S(kubeResource.Kube)
+	apiVersion := kubeResource.APIVersion()
+
+	patch := map[string]interface{}{
+		"spec": map[string]interface{}{
+			"replicas": 0,
+		},
+	}
+	var out json.RawMessage
+	if err := k8s.PatchResource(apiVersion, kubeResource.Kind, kubeResource.Namespace, kubeResource.Name, patch, &out); err != nil {
+		if kubernetes.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	desc := fmt.Sprintf("%s '%s' in Namespace '%s' to scale down", kubeResource.Kind, kubeResource.Name, kubeResource.Namespace)
+	return util.WaitFor(desc, controllerScaleDownTimeout, 3*time.Second, func() (bool, error) {
+		var obj json.RawMessage
+		if err := k8s.GetResource(apiVersion, kubeResource.Kind, kubeResource.Namespace, kubeResource.Name, &obj); err != nil {
+			if kubernetes.IsNotFound(err) {
+				return true, nil
+			}
+			return false, err
+		}
+		var objMap struct {
+			Status struct {
+				Replicas int `json:"replicas"`
+			} `json:"status"`
+		}
+		if err := json.Unmarshal(obj, &objMap); err != nil {
+			return false, err
+		}
+		return objMap.Status.Replicas == 0, nil
+	})
+}
@@ -2,9 +2,12 @@ package core
 
 import (
 	"encoding/json"
-	"strings"
+	"fmt"
+	"time"
 
+	"github.com/supergiant/supergiant/pkg/kubernetes"
 	"github.com/supergiant/supergiant/pkg/model"
+	"github.com/supergiant/supergiant/pkg/util"
 )
 
 type DefaultProvisioner struct {
@@ -25,10 +28,9 @@ func (p *DefaultProvisioner) Provision(kubeResource *model.KubeResource) error {
 		return err
 	}
 
-	if resource["apiVersion"] == nil {
-		resource["apiVersion"] = "v1"
-	}
+	apiVersion := kubeResource.APIVersion()
 
+	resource["apiVersion"] = apiVersion
 	resource["kind"] = kubeResource.Kind
 
 	metadata := make(map[string]interface{})
@@ -41,11 +43,39 @@ func (p *DefaultProvisioner) Provision(kubeResource *model.KubeResource) error {
 
 	resource["metadata"] = metadata
 
+	// Definition is the resource as sent, which drift is detected against
+	definition, err := json.Marshal(resource)
+	if err != nil {
+		return err
+	}
+	defRawMsg := json.RawMessage(definition)
+	kubeResource.Definition = &defRawMsg
+
+	// Secret parameters are only ever sent to Kubernetes
+	rendered, err := renderSecretParameters(p.Core, kubeResource, resource)
+	if err != nil {
+		return err
+	}
+	resource = rendered.(map[string]interface{})
+
 	k8s := p.Core.K8S(kubeResource.Kube)
 
 	artifact := make(json.RawMessage, 0)
 	kubeResource.Artifact = &artifact
-	if err := k8s.CreateResource(kubeResource.Kind, kubeResource.Namespace, resource, kubeResource.Artifact); err != nil {
+
+	// A started resource is updated in place with the new definition.
+	if kubeResource.Started {
+		previous, err := previousDefinition(p.Core, kubeResource)
+		if err != nil {
+			return err
+		}
+		if err := p.apply(kubeResource, previous, resource); err != nil {
+			return err
+		}
+	} else if err := k8s.CreateResource(apiVersion, kubeResource.Kind, kubeResource.Namespace, resource, kubeResource.Artifact); err != nil {
+		return err
+	}
+	if err := scrubSecretParameters(p.Core, kubeResource); err != nil {
 		return err
 	}
 
@@ -55,20 +85,75 @@ func (p *DefaultProvisioner) Provision(kubeResource *model.KubeResource) error {
 
 func (p *DefaultProvisioner) Teardown(kubeResource *model.KubeResource) error {
 	k8s := p.Core.K8S(kubeResource.Kube)
-	err := k8s.DeleteResource(kubeResource.Kind, kubeResource.Namespace, kubeResource.Name)
-	if err != nil && !strings.Contains(err.Error(), "404") {
+	err := k8s.DeleteResource(kubeResource.APIVersion(), kubeResource.Kind, kubeResource.Namespace, kubeResource.Name)
+	if err != nil && !kubernetes.IsNotFound(err) {
 		return err
 	}
 	return nil
 }
 
+// IsRunning returns whether the resource exists and is ready, according to the
+// readiness check of its kind.
 func (p *DefaultProvisioner) IsRunning(kubeResource *model.KubeResource) (bool, error) {
-	err := p.Core.K8S(kubeResource.Kube).GetResource(kubeResource.Kind, kubeResource.Namespace, kubeResource.Name, kubeResource.Artifact)
-	if err != nil {
-		if strings.Contains(err.Error(), "404") {
-			return false, nil
+	return resourceIsRunning(p.Core, kubeResource)
+}
+
+// Private
+
+// apply patches a running resource, which was last applied as previous (if
+// known), to resource, removing the fields resource no longer has. If
+// Kubernetes rejects the patch because it changes immutable fields, the
+// resource is deleted and created again. Any other invalid patch is returned,
+// leaving the resource as it is.
+func (p *DefaultProvisioner) apply(kubeResource *model.KubeResource, previous map[string]interface{}, resource map[string]interface{}) error {
+	k8s := p.Core.K8S(kubeResource.Kube)
+	apiVersion := kubeResource.APIVersion()
+
+	patch := kubernetes.MergePatch(previous, resource, kubernetes.SupportsStrategicMergePatch(apiVersion))
+	err := k8s.PatchResource(apiVersion, kubeResource.Kind, kubeResource.Namespace, kubeResource.Name, patch, kubeResource.Artifact)
+	if err == nil {
+		return nil
+	}
+
+	if !kubernetes.IsNotFound(err) {
+		if !kubernetes.IsImmutableFieldChange(err) {
+			return err
+		}
+
+		if err := p.Teardown(kubeResource); err != nil {
+			return err
 		}
-		return false, err
+		// Creating before deletion finishes would conflict
+		desc := fmt.Sprintf("%s '%s' in Namespace '%s' to be deleted", kubeResource.Kind, kubeResource.Name, kubeResource.Namespace)
+		waitErr := util.WaitFor(desc, p.Core.KubeResourceStartTimeout, time.Second, func() (bool, error) {
+			var obj json.RawMessage
+			err := k8s.GetResource(apiVersion, kubeResource.Kind, kubeResource.Namespace, kubeResource.Name, &obj)
+			if kubernetes.IsNotFound(err) {
+				return true, nil
+			}
+			return false, err
+		})
+		if waitErr != nil {
+			return waitErr
+		}
+	}
+
+	return k8s.CreateResource(apiVersion, kubeResource.Kind, kubeResource.Namespace, resource, kubeResource.Artifact)
+}
+
+// previousDefinition returns the PreviousDefinition of kubeResource with its
+// secret parameters, or nil if it has none.
+func previousDefinition(c *Core, kubeResource *model.KubeResource) (map[string]interface{}, error) {
+	if kubeResource.PreviousDefinition == nil || len(*kubeResource.PreviousDefinition) == 0 {
+		return nil, nil
+	}
+	var previous map[string]interface{}
+	if err := json.Unmarshal(*kubeResource.PreviousDefinition, &previous); err != nil || previous == nil {
+		return nil, err
+	}
+	rendered, err := renderSecretParameters(c, kubeResource, previous)
+	if err != nil {
+		return nil, err
 	}
-	return true, nil
+	return rendered.(map[string]interface{}), nil
 }
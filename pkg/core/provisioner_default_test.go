@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/supergiant/supergiant/pkg/core"
 	"github.com/supergiant/supergiant/pkg/kubernetes"
@@ -29,11 +30,12 @@ func TestDefaultProvisionerProvision(t *testing.T) {
 			mockCreateResourceError error
 
 			// Assertions
-			kindPassed      string
-			namespacePassed string
-			objInPassed     map[string]interface{}
-			outSaved        json.RawMessage
-			errorReturned   error
+			apiVersionPassed string
+			kindPassed       string
+			namespacePassed  string
+			objInPassed      map[string]interface{}
+			outSaved         json.RawMessage
+			errorReturned    error
 		}{
 			// A successful example
 			//------------------------------------------------------------------------
@@ -57,8 +59,9 @@ func TestDefaultProvisionerProvision(t *testing.T) {
 				mockCreateResourceOut:   json.RawMessage([]byte(`{"just":"making sure output is captured correctly"}`)),
 				mockCreateResourceError: nil,
 				// Assertions
-				kindPassed:      "Service",
-				namespacePassed: "test",
+				apiVersionPassed: "v1",
+				kindPassed:       "Service",
+				namespacePassed:  "test",
 				objInPassed: map[string]interface{}{
 					"apiVersion": "v1",
 					"kind":       "Service",
@@ -106,8 +109,9 @@ func TestDefaultProvisionerProvision(t *testing.T) {
 				mockCreateResourceOut:   json.RawMessage([]byte(`{"just":"making sure output is captured correctly (again)"}`)),
 				mockCreateResourceError: nil,
 				// Assertions
-				kindPassed:      "Service",
-				namespacePassed: "foo",
+				apiVersionPassed: "user-provided-version",
+				kindPassed:       "Service",
+				namespacePassed:  "foo",
 				objInPassed: map[string]interface{}{
 					"apiVersion": "user-provided-version",
 					"kind":       "Service",
@@ -129,6 +133,83 @@ func TestDefaultProvisionerProvision(t *testing.T) {
 				outSaved:      json.RawMessage([]byte(`{"just":"making sure output is captured correctly (again)"}`)),
 				errorReturned: nil,
 			},
+			// Secret parameters are rendered, but not saved in the Template
+			//------------------------------------------------------------------------
+			{
+				// Input
+				kubeResource: &model.KubeResource{
+					Namespace: "test",
+					Name:      "db",
+					Kind:      "Secret",
+					Template: newRawMessage(`{
+						"stringData": {
+							"password": "{{ password }}",
+							"url": "postgres://admin:{{ password }}@db"
+						}
+					}`),
+					SecretParameters: map[string]interface{}{"password": "hunter2"},
+				},
+				// Mocks
+				mockCreateResourceOut:   json.RawMessage([]byte(`{}`)),
+				mockCreateResourceError: nil,
+				// Assertions
+				apiVersionPassed: "v1",
+				kindPassed:       "Secret",
+				namespacePassed:  "test",
+				objInPassed: map[string]interface{}{
+					"apiVersion": "v1",
+					"kind":       "Secret",
+					"metadata": map[string]interface{}{
+						"namespace": "test",
+						"name":      "db",
+					},
+					"stringData": map[string]interface{}{
+						"password": "hunter2",
+						"url":      "postgres://admin:hunter2@db",
+					},
+				},
+				outSaved:      json.RawMessage([]byte(`{}`)),
+				errorReturned: nil,
+			},
+			// Only values of secret parameters are scrubbed from the Artifact, not
+			// keys or other values which contain them
+			//------------------------------------------------------------------------
+			{
+				// Input
+				kubeResource: &model.KubeResource{
+					Namespace: "test",
+					Name:      "db",
+					Kind:      "Secret",
+					Template: newRawMessage(`{
+						"stringData": {
+							"password": "{{ password }}",
+							"url": "postgres://admin:{{ password }}@db"
+						}
+					}`),
+					SecretParameters: map[string]interface{}{"password": "a"},
+				},
+				// Mocks
+				mockCreateResourceOut:   json.RawMessage([]byte(`{"apiVersion":"v1","kind":"Secret","metadata":{"annotations":{"a":"about"},"name":"db","namespace":"test"},"data":{"password":"YQ==","url":"cG9zdGdyZXM6Ly9hZG1pbjphQGRi"}}`)),
+				mockCreateResourceError: nil,
+				// Assertions
+				apiVersionPassed: "v1",
+				kindPassed:       "Secret",
+				namespacePassed:  "test",
+				objInPassed: map[string]interface{}{
+					"apiVersion": "v1",
+					"kind":       "Secret",
+					"metadata": map[string]interface{}{
+						"namespace": "test",
+						"name":      "db",
+					},
+					"stringData": map[string]interface{}{
+						"password": "a",
+						"url":      "postgres://admin:a@db",
+					},
+				},
+				outSaved:      json.RawMessage([]byte(`{"apiVersion":"v1","data":{"password":"{{ password }}","url":"postgres://admin:{{ password }}@db"},"kind":"Secret","metadata":{"annotations":{"a":"about"},"name":"db","namespace":"test"}}`)),
+				errorReturned: nil,
+			},
 			//------------------------------------------------------------------------
 			// When there's an unexpected error from Kubernetes
 			{
@@ -145,8 +226,9 @@ func TestDefaultProvisionerProvision(t *testing.T) {
 				mockCreateResourceOut:   nil,
 				mockCreateResourceError: errors.New("dunno what's happening"),
 				// Assertions
-				kindPassed:      "Secret",
-				namespacePassed: "beep",
+				apiVersionPassed: "v1",
+				kindPassed:       "Secret",
+				namespacePassed:  "beep",
 				objInPassed: map[string]interface{}{
 					"apiVersion": "v1",
 					"kind":       "Secret",
@@ -162,6 +244,7 @@ func TestDefaultProvisionerProvision(t *testing.T) {
 		}
 
 		for _, item := range table {
+			var apiVersionPassed string
 			var kindPassed string
 			var namespacePassed string
 			var objInPassed map[string]interface{}
@@ -171,7 +254,8 @@ func TestDefaultProvisionerProvision(t *testing.T) {
 			c := &core.Core{
 				K8S: func(_ *model.Kube) kubernetes.ClientInterface {
 					return &fake_core.KubernetesClient{
-						CreateResourceFn: func(kind string, namespace string, objIn map[string]interface{}, out *json.RawMessage) error {
+						CreateResourceFn: func(apiVersion string, kind string, namespace string, objIn map[string]interface{}, out *json.RawMessage) error {
+							apiVersionPassed = apiVersion
 							kindPassed = kind
 							namespacePassed = namespace
 							objInPassed = objIn
@@ -194,6 +278,7 @@ func TestDefaultProvisionerProvision(t *testing.T) {
 			err := provisioner.Provision(item.kubeResource)
 
 			So(err, ShouldResemble, item.errorReturned)
+			So(apiVersionPassed, ShouldEqual, item.apiVersionPassed)
 			So(kindPassed, ShouldEqual, item.kindPassed)
 			So(namespacePassed, ShouldEqual, item.namespacePassed)
 
@@ -205,7 +290,206 @@ func TestDefaultProvisionerProvision(t *testing.T) {
 			So(objInPassedMarshalled, ShouldResemble, itemObjInPassedMarshalled)
 
 			So(outSaved, ShouldResemble, item.outSaved)
+			So(string(*item.kubeResource.Template), ShouldNotContainSubstring, "hunter2")
+		}
+	})
+}
+
+//------------------------------------------------------------------------------
+
+func TestDefaultProvisionerProvisionStarted(t *testing.T) {
+	Convey("DefaultProvisioner Provision patches a started KubeResource, and recreates it when the patch changes immutable fields", t, func() {
+		table := []struct {
+			// Input
+			kubeResource *model.KubeResource
+
+			// Mocks
+			mockPatchResourceError error
+
+			// Assertions
+			callsMade     []string
+			outSaved      json.RawMessage
+			errorReturned error
+		}{
+			// A successful patch
+			//------------------------------------------------------------------------
+			{
+				// Input
+				kubeResource: &model.KubeResource{
+					Namespace: "test",
+					Name:      "test",
+					Kind:      "Service",
+					Started:   true,
+					Template:  newRawMessage(`{"spec": {}}`),
+				},
+				// Mocks
+				mockPatchResourceError: nil,
+				// Assertions
+				callsMade:     []string{"patch"},
+				outSaved:      json.RawMessage([]byte(`{"from":"patch"}`)),
+				errorReturned: nil,
+			},
+
+			// When the resource no longer exists in Kubernetes
+			//------------------------------------------------------------------------
+			{
+				// Input
+				kubeResource: &model.KubeResource{
+					Namespace: "test",
+					Name:      "test",
+					Kind:      "Service",
+					Started:   true,
+					Template:  newRawMessage(`{"spec": {}}`),
+				},
+				// Mocks
+				mockPatchResourceError: &kubernetes.StatusError{Code: 404, Status: &kubernetes.Status{Code: 404, Reason: "NotFound"}},
+				// Assertions
+				callsMade:     []string{"patch", "create"},
+				outSaved:      json.RawMessage([]byte(`{"from":"create"}`)),
+				errorReturned: nil,
+			},
+
+			// When the patch changes immutable fields
+			//------------------------------------------------------------------------
+			{
+				// Input
+				kubeResource: &model.KubeResource{
+					Namespace: "test",
+					Name:      "test",
+					Kind:      "Pod",
+					Started:   true,
+					Template:  newRawMessage(`{"spec": {}}`),
+				},
+				// Mocks
+				mockPatchResourceError: &kubernetes.StatusError{Code: 422, Status: &kubernetes.Status{Code: 422, Reason: "Invalid", Message: `Pod "test" is invalid: spec: Forbidden: pod updates may not change fields other than ...: field is immutable`}},
+				// Assertions
+				callsMade:     []string{"patch", "delete", "get", "create"},
+				outSaved:      json.RawMessage([]byte(`{"from":"create"}`)),
+				errorReturned: nil,
+			},
+
+			// When the patch has an invalid value, the running resource is kept
+			//------------------------------------------------------------------------
+			{
+				// Input
+				kubeResource: &model.KubeResource{
+					Namespace: "test",
+					Name:      "test",
+					Kind:      "Pod",
+					Started:   true,
+					Template:  newRawMessage(`{"spec": {}}`),
+				},
+				// Mocks
+				mockPatchResourceError: &kubernetes.StatusError{Code: 422, Status: &kubernetes.Status{Code: 422, Reason: "Invalid", Message: `Pod "test" is invalid: spec.containers[0].image: Required value`}},
+				// Assertions
+				callsMade:     []string{"patch"},
+				outSaved:      nil,
+				errorReturned: &kubernetes.StatusError{Code: 422, Status: &kubernetes.Status{Code: 422, Reason: "Invalid", Message: `Pod "test" is invalid: spec.containers[0].image: Required value`}},
+			},
+
+			// When there's an unexpected error from Kubernetes
+			//------------------------------------------------------------------------
+			{
+				// Input
+				kubeResource: &model.KubeResource{
+					Namespace: "test",
+					Name:      "test",
+					Kind:      "Service",
+					Started:   true,
+					Template:  newRawMessage(`{"spec": {}}`),
+				},
+				// Mocks
+				mockPatchResourceError: errors.New("dunno what's happening"),
+				// Assertions
+				callsMade:     []string{"patch"},
+				outSaved:      nil,
+				errorReturned: errors.New("dunno what's happening"),
+			},
+		}
+
+		for _, item := range table {
+			var callsMade []string
+			var outSaved json.RawMessage
+
+			c := &core.Core{
+				KubeResourceStartTimeout: time.Second,
+				K8S: func(_ *model.Kube) kubernetes.ClientInterface {
+					return &fake_core.KubernetesClient{
+						PatchResourceFn: func(apiVersion string, kind string, namespace string, name string, patch map[string]interface{}, out *json.RawMessage) error {
+							callsMade = append(callsMade, "patch")
+							if item.mockPatchResourceError != nil {
+								return item.mockPatchResourceError
+							}
+							*out = json.RawMessage([]byte(`{"from":"patch"}`))
+							return nil
+						},
+						DeleteResourceFn: func(apiVersion string, kind string, namespace string, name string) error {
+							callsMade = append(callsMade, "delete")
+							return nil
+						},
+						GetResourceFn: func(apiVersion string, kind string, namespace string, name string, out *json.RawMessage) error {
+							callsMade = append(callsMade, "get")
+							return &kubernetes.StatusError{Code: 404, Status: &kubernetes.Status{Code: 404, Reason: "NotFound"}}
+						},
+						CreateResourceFn: func(apiVersion string, kind string, namespace string, objIn map[string]interface{}, out *json.RawMessage) error {
+							callsMade = append(callsMade, "create")
+							*out = json.RawMessage([]byte(`{"from":"create"}`))
+							return nil
+						},
+					}
+				},
+				DB: &fake_core.DB{
+					SaveFn: func(m model.Model) error {
+						outSaved = *m.(*model.KubeResource).Artifact
+						return nil
+					},
+				},
+			}
+
+			provisioner := &core.DefaultProvisioner{c}
+			err := provisioner.Provision(item.kubeResource)
+
+			So(err, ShouldResemble, item.errorReturned)
+			So(callsMade, ShouldResemble, item.callsMade)
+			So(outSaved, ShouldResemble, item.outSaved)
+		}
+	})
+}
+
+func TestDefaultProvisionerProvisionRemovedFields(t *testing.T) {
+	Convey("DefaultProvisioner Provision removes the fields removed from the Definition of a started KubeResource", t, func() {
+		var patchPassed map[string]interface{}
+
+		c := &core.Core{
+			K8S: func(_ *model.Kube) kubernetes.ClientInterface {
+				return &fake_core.KubernetesClient{
+					PatchResourceFn: func(apiVersion string, kind string, namespace string, name string, patch map[string]interface{}, out *json.RawMessage) error {
+						patchPassed = patch
+						return nil
+					},
+				}
+			},
+			DB: &fake_core.DB{
+				SaveFn: func(m model.Model) error {
+					return nil
+				},
+			},
+		}
+
+		kubeResource := &model.KubeResource{
+			Namespace:          "test",
+			Name:               "test",
+			Kind:               "Service",
+			Started:            true,
+			Template:           newRawMessage(`{"spec": {"ports": [{"port": 80}]}}`),
+			PreviousDefinition: newRawMessage(`{"apiVersion": "v1", "kind": "Service", "metadata": {"labels": {"app": "test"}, "name": "test", "namespace": "test"}, "spec": {"ports": [{"port": 80}, {"port": 443}]}}`),
 		}
+
+		provisioner := &core.DefaultProvisioner{c}
+		So(provisioner.Provision(kubeResource), ShouldBeNil)
+
+		patch, _ := json.Marshal(patchPassed)
+		So(string(patch), ShouldEqual, `{"apiVersion":"v1","kind":"Service","metadata":{"labels":null,"name":"test","namespace":"test"},"spec":{"ports":[{"port":80},{"$patch":"delete","port":443}]}}`)
 	})
 }
 
@@ -221,10 +505,11 @@ func TestDefaultProvisionerTeardown(t *testing.T) {
 			mockDeleteResourceError error
 
 			// Assertions
-			kindPassed      string
-			namespacePassed string
-			namePassed      string
-			errorReturned   error
+			apiVersionPassed string
+			kindPassed       string
+			namespacePassed  string
+			namePassed       string
+			errorReturned    error
 		}{
 			// A successful example
 			//------------------------------------------------------------------------
@@ -238,10 +523,11 @@ func TestDefaultProvisionerTeardown(t *testing.T) {
 				// Mocks
 				mockDeleteResourceError: nil,
 				// Assertions
-				kindPassed:      "Bleeper",
-				namespacePassed: "fizz",
-				namePassed:      "bubb",
-				errorReturned:   nil,
+				apiVersionPassed: "v1",
+				kindPassed:       "Bleeper",
+				namespacePassed:  "fizz",
+				namePassed:       "bubb",
+				errorReturned:    nil,
 			},
 
 			// When there's a 404 error (we don't care)
@@ -254,12 +540,13 @@ func TestDefaultProvisionerTeardown(t *testing.T) {
 					Kind:      "Kind",
 				},
 				// Mocks
-				mockDeleteResourceError: errors.New("K8S 404 Not Found error"),
+				mockDeleteResourceError: &kubernetes.StatusError{Code: 404, Status: &kubernetes.Status{Code: 404, Reason: "NotFound"}},
 				// Assertions
-				kindPassed:      "Kind",
-				namespacePassed: "test",
-				namePassed:      "test",
-				errorReturned:   nil,
+				apiVersionPassed: "v1",
+				kindPassed:       "Kind",
+				namespacePassed:  "test",
+				namePassed:       "test",
+				errorReturned:    nil,
 			},
 
 			// When there's an unexpected error from Kubernetes
@@ -270,18 +557,21 @@ func TestDefaultProvisionerTeardown(t *testing.T) {
 					Namespace: "chip",
 					Name:      "chop",
 					Kind:      "Krampus",
+					Template:  newRawMessage(`{"apiVersion": "extensions/v1beta1"}`),
 				},
 				// Mocks
 				mockDeleteResourceError: errors.New("wots this"),
 				// Assertions
-				kindPassed:      "Krampus",
-				namespacePassed: "chip",
-				namePassed:      "chop",
-				errorReturned:   errors.New("wots this"),
+				apiVersionPassed: "extensions/v1beta1",
+				kindPassed:       "Krampus",
+				namespacePassed:  "chip",
+				namePassed:       "chop",
+				errorReturned:    errors.New("wots this"),
 			},
 		}
 
 		for _, item := range table {
+			var apiVersionPassed string
 			var kindPassed string
 			var namespacePassed string
 			var namePassed string
@@ -289,7 +579,8 @@ func TestDefaultProvisionerTeardown(t *testing.T) {
 			c := &core.Core{
 				K8S: func(_ *model.Kube) kubernetes.ClientInterface {
 					return &fake_core.KubernetesClient{
-						DeleteResourceFn: func(kind string, namespace string, name string) error {
+						DeleteResourceFn: func(apiVersion string, kind string, namespace string, name string) error {
+							apiVersionPassed = apiVersion
 							kindPassed = kind
 							namespacePassed = namespace
 							namePassed = name
@@ -304,6 +595,7 @@ func TestDefaultProvisionerTeardown(t *testing.T) {
 			err := provisioner.Teardown(item.kubeResource)
 
 			So(err, ShouldResemble, item.errorReturned)
+			So(apiVersionPassed, ShouldEqual, item.apiVersionPassed)
 			So(kindPassed, ShouldEqual, item.kindPassed)
 			So(namespacePassed, ShouldEqual, item.namespacePassed)
 			So(namePassed, ShouldEqual, item.namePassed)
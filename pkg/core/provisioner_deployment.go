@@ -0,0 +1,81 @@
+package core
+
+import (
+	"fmt"
+
+	"github.com/supergiant/supergiant/pkg/model"
+)
+
+// DeploymentProvisioner provisions Deployments and ReplicaSets, replacing the
+// SUPERGIANT_EXTERNAL_VOLUMEs of their Pod template with provider Volumes.
+// Since a Volume can only be attached to one Node, a controller with external
+// Volumes is limited to a single replica.
+type DeploymentProvisioner struct {
+	Core *Core
+}
+
+func (p *DeploymentProvisioner) Provision(kubeResource *model.KubeResource) error {
+	templateMap, spec, podSpec, err := controllerSpecs(kubeResource)
+	if err != nil {
+		return err
+	}
+
+	if !hasExternalVolumes(podSpec) {
+		return p.Core.DefaultProvisioner.Provision(kubeResource)
+	}
+
+	if controllerReplicas(spec) > 1 {
+		return fmt.Errorf("%s with a SUPERGIANT_EXTERNAL_VOLUME can only have 1 replica; use a StatefulSet for a Volume per replica", kubeResource.Kind)
+	}
+
+	// The old Pod must release its Volumes before the new one can attach them,
+	// which a rolling update would wait on forever.
+	if kubeResource.Kind == "Deployment" && spec["strategy"] == nil {
+		spec["strategy"] = map[string]interface{}{
+			"type": "Recreate",
+		}
+	}
+
+	volumes, err := externalVolumes(p.Core, kubeResource)
+	if err != nil {
+		return err
+	}
+
+	err = replaceExternalVolumes(podSpec, kubeResource.Kind, func(name string, sgVolDef map[string]interface{}) (interface{}, error) {
+		volume, err := ensureExternalVolume(p.Core, kubeResource, volumes, name, sgVolDef)
+		if err != nil {
+			return nil, err
+		}
+		return p.Core.CloudAccounts.provider(kubeResource.Kube.CloudAccount).KubernetesVolumeDefinition(volume), nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := setDefinition(kubeResource, templateMap); err != nil {
+		return err
+	}
+	return p.Core.DefaultProvisioner.Provision(kubeResource)
+}
+
+// Teardown scales the controller down before deleting it, so that its Volumes
+// are detached from Nodes when they are deleted.
+func (p *DeploymentProvisioner) Teardown(kubeResource *model.KubeResource) error {
+	volumes, err := ownedVolumes(p.Core, kubeResource)
+	if err != nil {
+		return err
+	}
+	if len(volumes) > 0 {
+		if err := scaleDownController(p.Core, kubeResource); err != nil {
+			return err
+		}
+	}
+	if err := p.Core.DefaultProvisioner.Teardown(kubeResource); err != nil {
+		return err
+	}
+	return teardownVolumes(p.Core, volumes)
+}
+
+func (p *DeploymentProvisioner) IsRunning(kubeResource *model.KubeResource) (bool, error) {
+	return resourceIsRunning(p.Core, kubeResource)
+}
@@ -0,0 +1,345 @@
+package core_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/supergiant/supergiant/pkg/core"
+	"github.com/supergiant/supergiant/pkg/kubernetes"
+	"github.com/supergiant/supergiant/pkg/model"
+	"github.com/supergiant/supergiant/pkg/provider/aws"
+	"github.com/supergiant/supergiant/test/fake_core"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestDeploymentProvisionerProvision(t *testing.T) {
+	Convey("DeploymentProvisioner Provision works correctly", t, func() {
+
+		var kubeResourceID int64 = 23
+
+		table := []struct {
+			// Input
+			kind     string
+			template string
+
+			// Mocks
+			mockExistingVolumes []*model.Volume
+
+			// Expectations
+			volumesCreated                       []string
+			definitionPassedToDefaultProvisioner map[string]interface{}
+			errorReturned                        error
+		}{
+			// A successful example, which defaults to the Recreate strategy
+			//------------------------------------------------------------------------
+			{
+				// Input
+				kind: "Deployment",
+				template: `{
+					"spec": {
+						"template": {
+							"spec": {
+								"volumes": [
+									{
+										"name": "data",
+										"SUPERGIANT_EXTERNAL_VOLUME": {
+											"type": "gp2",
+											"size": 10,
+											"reclaim_policy": "retain"
+										}
+									},
+									{
+										"name": "tmp",
+										"emptyDir": {}
+									}
+								]
+							}
+						}
+					}
+				}`,
+				// Expectations
+				volumesCreated: []string{"data"},
+				definitionPassedToDefaultProvisioner: map[string]interface{}{
+					"spec": map[string]interface{}{
+						"strategy": map[string]interface{}{
+							"type": "Recreate",
+						},
+						"template": map[string]interface{}{
+							"spec": map[string]interface{}{
+								"volumes": []interface{}{
+									map[string]interface{}{
+										"name": "data",
+										"awsElasticBlockStore": map[string]interface{}{
+											"volumeID": "data-id",
+											"fsType":   "ext4",
+										},
+									},
+									map[string]interface{}{
+										"name":     "tmp",
+										"emptyDir": map[string]interface{}{},
+									},
+								},
+							},
+						},
+					},
+				},
+				errorReturned: nil,
+			},
+
+			// A ReplicaSet with an existing Volume
+			//------------------------------------------------------------------------
+			{
+				// Input
+				kind: "ReplicaSet",
+				template: `{
+					"spec": {
+						"replicas": 1,
+						"template": {
+							"spec": {
+								"volumes": [
+									{
+										"name": "data",
+										"SUPERGIANT_EXTERNAL_VOLUME": {
+											"type": "gp2",
+											"size": 10
+										}
+									}
+								]
+							}
+						}
+					}
+				}`,
+				// Mocks
+				mockExistingVolumes: []*model.Volume{
+					{
+						KubeResourceID: &kubeResourceID,
+						Name:           "data",
+						ProviderID:     "vol-123",
+					},
+				},
+				// Expectations
+				volumesCreated: nil,
+				definitionPassedToDefaultProvisioner: map[string]interface{}{
+					"spec": map[string]interface{}{
+						"replicas": float64(1),
+						"template": map[string]interface{}{
+							"spec": map[string]interface{}{
+								"volumes": []interface{}{
+									map[string]interface{}{
+										"name": "data",
+										"awsElasticBlockStore": map[string]interface{}{
+											"volumeID": "vol-123",
+											"fsType":   "ext4",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				errorReturned: nil,
+			},
+
+			// Without external Volumes, the Template is provisioned as it is
+			//------------------------------------------------------------------------
+			{
+				// Input
+				kind:     "Deployment",
+				template: `{"spec": {"replicas": 3, "template": {"spec": {"containers": []}}}}`,
+				// Expectations
+				volumesCreated:                       nil,
+				definitionPassedToDefaultProvisioner: nil,
+				errorReturned:                        nil,
+			},
+
+			// More than 1 replica with external Volumes
+			//------------------------------------------------------------------------
+			{
+				// Input
+				kind: "Deployment",
+				template: `{
+					"spec": {
+						"replicas": 2,
+						"template": {
+							"spec": {
+								"volumes": [
+									{
+										"name": "data",
+										"SUPERGIANT_EXTERNAL_VOLUME": {
+											"type": "gp2",
+											"size": 10
+										}
+									}
+								]
+							}
+						}
+					}
+				}`,
+				// Expectations
+				volumesCreated:                       nil,
+				definitionPassedToDefaultProvisioner: nil,
+				errorReturned:                        errors.New("Deployment with a SUPERGIANT_EXTERNAL_VOLUME can only have 1 replica; use a StatefulSet for a Volume per replica"),
+			},
+
+			// Without a Pod template
+			//------------------------------------------------------------------------
+			{
+				// Input
+				kind:     "ReplicaSet",
+				template: `{"spec": {"replicas": 1}}`,
+				// Expectations
+				volumesCreated:                       nil,
+				definitionPassedToDefaultProvisioner: nil,
+				errorReturned:                        errors.New("Missing spec.template.spec field on ReplicaSet"),
+			},
+		}
+
+		for _, item := range table {
+			var volumesCreated []string
+			var definitionPassedToDefaultProvisioner map[string]interface{}
+
+			c := &core.Core{
+				DB: &fake_core.DB{
+					// Used to fetch Volumes
+					FindFn: func(out interface{}, _ ...interface{}) error {
+						reflect.ValueOf(out).Elem().Set(reflect.ValueOf(item.mockExistingVolumes))
+						return nil
+					},
+				},
+
+				Volumes: &fake_core.Volumes{
+					CreateFn: func(volume *model.Volume) error {
+						volumesCreated = append(volumesCreated, volume.Name)
+						volume.ProviderID = volume.Name + "-id"
+						return nil
+					},
+				},
+
+				DefaultProvisioner: &fake_core.Provisioner{
+					ProvisionFn: func(kubeResource *model.KubeResource) error {
+						if kubeResource.Definition != nil {
+							json.Unmarshal(*kubeResource.Definition, &definitionPassedToDefaultProvisioner)
+						}
+						return nil
+					},
+				},
+			}
+
+			c.CloudAccounts = &core.CloudAccounts{core.Collection{Core: c}}
+			c.AWSProvider = func(creds map[string]string) core.Provider {
+				return &aws.Provider{Core: c}
+			}
+
+			kubeResource := &model.KubeResource{
+				BaseModel: model.BaseModel{
+					ID: &kubeResourceID,
+				},
+				Kube: &model.Kube{
+					CloudAccount: &model.CloudAccount{
+						Provider: "aws",
+					},
+				},
+				KubeName:  "test",
+				Namespace: "test",
+				Name:      "test",
+				Kind:      item.kind,
+				Template:  newRawMessage(item.template),
+			}
+
+			provisioner := &core.DeploymentProvisioner{c}
+			err := provisioner.Provision(kubeResource)
+
+			So(err, ShouldResemble, item.errorReturned)
+			So(volumesCreated, ShouldResemble, item.volumesCreated)
+			So(definitionPassedToDefaultProvisioner, ShouldResemble, item.definitionPassedToDefaultProvisioner)
+		}
+	})
+}
+
+//------------------------------------------------------------------------------
+
+func TestDeploymentProvisionerIsRunning(t *testing.T) {
+	Convey("DeploymentProvisioner IsRunning returns whether the rollout is complete", t, func() {
+		table := []struct {
+			// Mocks
+			mockResource string
+			mockNotFound bool
+
+			// Expectations
+			running bool
+		}{
+			// All replicas are updated and ready
+			{
+				mockResource: `{"metadata": {"generation": 2}, "spec": {"replicas": 2}, "status": {"observedGeneration": 2, "replicas": 2, "updatedReplicas": 2, "readyReplicas": 2}}`,
+				running:      true,
+			},
+			// Replicas default to 1
+			{
+				mockResource: `{"spec": {}, "status": {"readyReplicas": 1}}`,
+				running:      true,
+			},
+			// Not all replicas are ready
+			{
+				mockResource: `{"metadata": {"generation": 2}, "spec": {"replicas": 2}, "status": {"observedGeneration": 2, "replicas": 2, "updatedReplicas": 2, "readyReplicas": 1}}`,
+				running:      false,
+			},
+			// The latest spec has not been observed
+			{
+				mockResource: `{"metadata": {"generation": 3}, "spec": {"replicas": 2}, "status": {"observedGeneration": 2, "replicas": 2, "updatedReplicas": 2, "readyReplicas": 2}}`,
+				running:      false,
+			},
+			// The rollout of the latest spec is in progress
+			{
+				mockResource: `{"metadata": {"generation": 3}, "spec": {"replicas": 2}, "status": {"observedGeneration": 3, "replicas": 3, "updatedReplicas": 1, "readyReplicas": 3}}`,
+				running:      false,
+			},
+			// A StatefulSet updating to a new revision
+			{
+				mockResource: `{"spec": {"replicas": 1}, "status": {"readyReplicas": 1, "currentRevision": "db-1", "updateRevision": "db-2"}}`,
+				running:      false,
+			},
+			// No status yet
+			{
+				mockResource: `{"spec": {"replicas": 1}}`,
+				running:      false,
+			},
+			// The resource doesn't exist
+			{
+				mockNotFound: true,
+				running:      false,
+			},
+		}
+
+		for _, item := range table {
+			c := &core.Core{
+				K8S: func(_ *model.Kube) kubernetes.ClientInterface {
+					return &fake_core.KubernetesClient{
+						GetResourceFn: func(apiVersion string, kind string, namespace string, name string, out *json.RawMessage) error {
+							if item.mockNotFound {
+								return &kubernetes.StatusError{Code: http.StatusNotFound, Status: &kubernetes.Status{Message: "not found"}}
+							}
+							*out = json.RawMessage(item.mockResource)
+							return nil
+						},
+					}
+				},
+			}
+
+			kubeResource := &model.KubeResource{
+				Namespace: "test",
+				Name:      "test",
+				Kind:      "Deployment",
+			}
+
+			provisioner := &core.DeploymentProvisioner{c}
+			running, err := provisioner.IsRunning(kubeResource)
+
+			So(err, ShouldBeNil)
+			So(running, ShouldEqual, item.running)
+		}
+	})
+}
@@ -0,0 +1,321 @@
+package core
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-validator/validator"
+	"github.com/supergiant/supergiant/pkg/helm"
+	"github.com/supergiant/supergiant/pkg/kubernetes"
+	"github.com/supergiant/supergiant/pkg/model"
+)
+
+// HelmReleaseProvisioner installs and upgrades HelmRelease KubeResources by
+// rendering their chart and applying each of its resources, which are tracked
+// in the HelmReleaseStatus Artifact.
+type HelmReleaseProvisioner struct {
+	Core *Core
+}
+
+func (p *HelmReleaseProvisioner) Provision(kubeResource *model.KubeResource) error {
+	release, chart, err := loadHelmRelease(p.Core, kubeResource)
+	if err != nil {
+		return err
+	}
+
+	oldStatus := helmReleaseStatus(kubeResource)
+	revision := oldStatus.Revision + 1
+
+	helmRelease := &helm.Release{
+		Name:      kubeResource.Name,
+		Namespace: kubeResource.Namespace,
+		Revision:  revision,
+		IsInstall: revision == 1,
+		IsUpgrade: revision > 1,
+		Service:   "Supergiant",
+	}
+
+	// Definition is rendered with the placeholders of secret parameters, which
+	// are only rendered in the resources sent to Kubernetes, since charts can
+	// transform values (e.g. with b64enc) so that they can't be scrubbed.
+	definitionManifests, err := chart.Render(helmRelease, release.Values)
+	if err != nil {
+		if len(kubeResource.SecretParameters)+len(kubeResource.EncryptedSecretParameters) > 0 {
+			return &ErrorValidationFailed{fmt.Errorf("%s (values of secret parameters must be quoted in the chart, e.g. with quote)", err)}
+		}
+		return &ErrorValidationFailed{err}
+	}
+	values, err := renderSecretParameters(p.Core, kubeResource, release.Values)
+	if err != nil {
+		return err
+	}
+	secretValues, _ := values.(map[string]interface{})
+	manifests, err := chart.Render(helmRelease, secretValues)
+	if err != nil {
+		return &ErrorValidationFailed{err}
+	}
+
+	previousManifests, err := previousHelmReleaseManifests(kubeResource)
+	if err != nil {
+		return err
+	}
+
+	// Definition is the rendered resources
+	definition, err := json.Marshal(map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "List",
+		"items":      definitionManifests,
+	})
+	if err != nil {
+		return err
+	}
+	definitionRawMsg := json.RawMessage(definition)
+	kubeResource.Definition = &definitionRawMsg
+
+	status := &model.HelmReleaseStatus{
+		Chart:    chart.Metadata.Name,
+		Version:  chart.Metadata.Version,
+		Revision: revision,
+	}
+
+	// Resources are patched if they exist, and created otherwise
+	defaultProvisioner := &DefaultProvisioner{p.Core}
+	for _, manifest := range manifests {
+		resource, err := helmReleaseResource(kubeResource, manifest)
+		if err != nil {
+			return &ErrorValidationFailed{err}
+		}
+		previous := previousManifests[resource.Kind+" "+resource.Name]
+		if err := defaultProvisioner.apply(resource, previous, manifest); err != nil {
+			return err
+		}
+		status.Resources = append(status.Resources, &model.HelmReleaseResource{
+			APIVersion: resource.APIVersion(),
+			Kind:       resource.Kind,
+			Name:       resource.Name,
+		})
+	}
+
+	// Resources which are no longer in the chart are deleted
+	var removed []*model.HelmReleaseResource
+	for _, oldResource := range oldStatus.Resources {
+		if findHelmReleaseResource(status.Resources, oldResource) == nil {
+			removed = append(removed, oldResource)
+		}
+	}
+	if err := p.deleteResources(kubeResource, removed); err != nil {
+		return err
+	}
+
+	return p.saveStatus(kubeResource, status)
+}
+
+func (p *HelmReleaseProvisioner) Teardown(kubeResource *model.KubeResource) error {
+	return p.deleteResources(kubeResource, helmReleaseStatus(kubeResource).Resources)
+}
+
+// IsRunning returns true when all resources of the release exist and are
+// ready. The readiness of each resource is recorded in the Artifact.
+func (p *HelmReleaseProvisioner) IsRunning(kubeResource *model.KubeResource) (bool, error) {
+	status := helmReleaseStatus(kubeResource)
+	if status.Revision == 0 {
+		return false, nil
+	}
+
+	k8s := p.Core.K8S(kubeResource.Kube)
+	status.Ready = true
+	ready := 0
+	for _, resource := range status.Resources {
+		var obj json.RawMessage
+		err := k8s.GetResource(resource.APIVersion, resource.Kind, helmResourceNamespace(kubeResource, resource.Kind), resource.Name, &obj)
+		if err != nil && !kubernetes.IsNotFound(err) {
+			return false, err
+		}
+		// A failed resource of the release is only not ready
+		resource.Ready = false
+		if err == nil {
+			resource.Ready, _, _ = resourceReadiness(resource.Kind, resource.Name, obj)
+		}
+		status.Ready = status.Ready && resource.Ready
+		if resource.Ready {
+			ready++
+		}
+	}
+
+	kubeResource.Readiness = ""
+	if !status.Ready {
+		kubeResource.Readiness = fmt.Sprintf("%d of %d resources ready", ready, len(status.Resources))
+	}
+
+	if err := setHelmReleaseStatus(kubeResource, status); err != nil {
+		return false, err
+	}
+	return status.Ready, nil
+}
+
+// Private
+
+func (p *HelmReleaseProvisioner) deleteResources(kubeResource *model.KubeResource, resources []*model.HelmReleaseResource) error {
+	k8s := p.Core.K8S(kubeResource.Kube)
+	// In reverse, so that e.g. a Deployment goes before its ConfigMap
+	for i := len(resources) - 1; i >= 0; i-- {
+		resource := resources[i]
+		err := k8s.DeleteResource(resource.APIVersion, resource.Kind, helmResourceNamespace(kubeResource, resource.Kind), resource.Name)
+		if err != nil && !kubernetes.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *HelmReleaseProvisioner) saveStatus(kubeResource *model.KubeResource, status *model.HelmReleaseStatus) error {
+	if err := setHelmReleaseStatus(kubeResource, status); err != nil {
+		return err
+	}
+	return p.Core.DB.Save(kubeResource)
+}
+
+// loadHelmRelease returns the HelmRelease Template of a KubeResource (with the
+// placeholders of its secret parameters), and its chart, which must be in the
+// HelmChartDir and only use supported template functions.
+func loadHelmRelease(c *Core, kubeResource *model.KubeResource) (*model.HelmRelease, *helm.Chart, error) {
+	release := new(model.HelmRelease)
+	if err := json.Unmarshal(*kubeResource.Template, release); err != nil {
+		return nil, nil, &ErrorValidationFailed{err}
+	}
+	if err := validator.Validate(release); err != nil {
+		return nil, nil, &ErrorValidationFailed{err}
+	}
+
+	chartPath, err := helmChartPath(c, release.Chart)
+	if err != nil {
+		return nil, nil, err
+	}
+	chart, err := helm.LoadChart(chartPath)
+	if os.IsNotExist(err) {
+		return nil, nil, &ErrorValidationFailed{fmt.Errorf("Chart: %q does not exist", release.Chart)}
+	} else if err != nil {
+		return nil, nil, &ErrorValidationFailed{err}
+	}
+	if err := chart.Validate(); err != nil {
+		return nil, nil, &ErrorValidationFailed{err}
+	}
+	return release, chart, nil
+}
+
+// helmChartPath returns the path of chart in the HelmChartDir. Charts can't be
+// outside of it, so that HelmReleases can't read arbitrary files of the server.
+func helmChartPath(c *Core, chart string) (string, error) {
+	if c.HelmChartDir == "" {
+		return "", &ErrorValidationFailed{errors.New("No Helm chart directory configured; provide --helm-chart-dir at startup")}
+	}
+	rel := filepath.Clean(chart)
+	if filepath.IsAbs(rel) || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", &ErrorValidationFailed{fmt.Errorf("Chart: %q must be a path in the Helm chart directory", chart)}
+	}
+	return filepath.Join(c.HelmChartDir, rel), nil
+}
+
+// helmResourceNamespace returns the namespace of a resource of a release,
+// which is that of the release unless the kind is cluster-scoped.
+func helmResourceNamespace(kubeResource *model.KubeResource, kind string) string {
+	if helm.IsClusterScoped(kind) {
+		return ""
+	}
+	return kubeResource.Namespace
+}
+
+// helmReleaseStatus returns the HelmReleaseStatus in the Artifact of a
+// HelmRelease, which is empty before it's first provisioned.
+func helmReleaseStatus(kubeResource *model.KubeResource) *model.HelmReleaseStatus {
+	status := new(model.HelmReleaseStatus)
+	if kubeResource.Artifact != nil && len(*kubeResource.Artifact) > 0 {
+		json.Unmarshal(*kubeResource.Artifact, status)
+	}
+	return status
+}
+
+func setHelmReleaseStatus(kubeResource *model.KubeResource, status *model.HelmReleaseStatus) error {
+	artifact, err := json.Marshal(status)
+	if err != nil {
+		return err
+	}
+	artifactRawMsg := json.RawMessage(artifact)
+	kubeResource.Artifact = &artifactRawMsg
+	return nil
+}
+
+// helmReleaseResource returns a KubeResource (which is not saved) for a
+// rendered manifest of a release, which is put in the Namespace of the release
+// unless its kind is cluster-scoped.
+func helmReleaseResource(kubeResource *model.KubeResource, manifest map[string]interface{}) (*model.KubeResource, error) {
+	metadata, _ := manifest["metadata"].(map[string]interface{})
+	if metadata == nil {
+		metadata = make(map[string]interface{})
+	}
+	name, _ := metadata["name"].(string)
+	kind, _ := manifest["kind"].(string)
+	if name == "" {
+		return nil, fmt.Errorf("%s in chart has no name", kind)
+	}
+	namespace := helmResourceNamespace(kubeResource, kind)
+	if namespace != "" {
+		metadata["namespace"] = namespace
+	} else {
+		delete(metadata, "namespace")
+	}
+	manifest["metadata"] = metadata
+
+	definition, err := json.Marshal(manifest)
+	if err != nil {
+		return nil, err
+	}
+	definitionRawMsg := json.RawMessage(definition)
+	artifact := make(json.RawMessage, 0)
+
+	return &model.KubeResource{
+		Kube:       kubeResource.Kube,
+		KubeName:   kubeResource.KubeName,
+		Kind:       kind,
+		Namespace:  namespace,
+		Name:       name,
+		Definition: &definitionRawMsg,
+		Artifact:   &artifact,
+		Started:    true,
+	}, nil
+}
+
+// previousHelmReleaseManifests returns the resources of the release as last
+// rendered, from its PreviousDefinition, by kind and name.
+func previousHelmReleaseManifests(kubeResource *model.KubeResource) (map[string]map[string]interface{}, error) {
+	manifests := make(map[string]map[string]interface{})
+	if kubeResource.PreviousDefinition == nil || len(*kubeResource.PreviousDefinition) == 0 {
+		return manifests, nil
+	}
+	list := new(struct {
+		Items []map[string]interface{} `json:"items"`
+	})
+	if err := json.Unmarshal(*kubeResource.PreviousDefinition, list); err != nil {
+		return nil, err
+	}
+	for _, manifest := range list.Items {
+		kind, _ := manifest["kind"].(string)
+		metadata, _ := manifest["metadata"].(map[string]interface{})
+		name, _ := metadata["name"].(string)
+		manifests[kind+" "+name] = manifest
+	}
+	return manifests, nil
+}
+
+func findHelmReleaseResource(resources []*model.HelmReleaseResource, resource *model.HelmReleaseResource) *model.HelmReleaseResource {
+	for _, r := range resources {
+		if r.APIVersion == resource.APIVersion && r.Kind == resource.Kind && r.Name == resource.Name {
+			return r
+		}
+	}
+	return nil
+}
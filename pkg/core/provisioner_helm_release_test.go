@@ -0,0 +1,327 @@
+package core_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/supergiant/supergiant/pkg/core"
+	"github.com/supergiant/supergiant/pkg/kubernetes"
+	"github.com/supergiant/supergiant/pkg/model"
+	"github.com/supergiant/supergiant/test/fake_core"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// writeHelmChart writes a chart with a ConfigMap, and a Deployment unless the
+// deployment value is false, into dir.
+func writeHelmChart(dir string) {
+	files := map[string]string{
+		"Chart.yaml":  "name: app\nversion: 1.0.0\n",
+		"values.yaml": "deployment: true\nreplicas: 1\n",
+		"templates/config.yaml": `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: {{ .Release.Name }}-config
+data:
+  revision: {{ .Release.Revision | quote }}
+`,
+		"templates/deployment.yaml": `{{- if .Values.deployment -}}
+apiVersion: extensions/v1beta1
+kind: Deployment
+metadata:
+  name: {{ .Release.Name }}
+spec:
+  replicas: {{ .Values.replicas }}
+{{- end -}}
+`,
+	}
+	for name, data := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			panic(err)
+		}
+		if err := ioutil.WriteFile(path, []byte(data), 0644); err != nil {
+			panic(err)
+		}
+	}
+}
+
+func TestHelmReleaseProvisionerProvision(t *testing.T) {
+	Convey("HelmReleaseProvisioner Provision renders the chart, applies its resources, and deletes removed ones", t, func() {
+		dir, err := ioutil.TempDir("", "chart")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(dir)
+		writeHelmChart(filepath.Join(dir, "app"))
+
+		table := []struct {
+			// Input
+			values   string
+			artifact string
+			// Mocks
+			existing map[string]bool
+			// Assertions
+			callsMade []string
+			status    *model.HelmReleaseStatus
+			err       error
+		}{
+			// Install
+			{
+				values: `{}`,
+				callsMade: []string{
+					"patch ConfigMap blog-config", "create ConfigMap blog-config",
+					"patch Deployment blog", "create Deployment blog",
+				},
+				status: &model.HelmReleaseStatus{
+					Chart:    "app",
+					Version:  "1.0.0",
+					Revision: 1,
+					Resources: []*model.HelmReleaseResource{
+						{APIVersion: "v1", Kind: "ConfigMap", Name: "blog-config"},
+						{APIVersion: "extensions/v1beta1", Kind: "Deployment", Name: "blog"},
+					},
+				},
+			},
+			// Upgrade, which removes the Deployment
+			{
+				values:   `{"deployment": false}`,
+				artifact: `{"chart":"app","version":"1.0.0","revision":1,"resources":[{"apiVersion":"v1","kind":"ConfigMap","name":"blog-config"},{"apiVersion":"extensions/v1beta1","kind":"Deployment","name":"blog"}]}`,
+				existing: map[string]bool{"ConfigMap blog-config": true, "Deployment blog": true},
+				callsMade: []string{
+					"patch ConfigMap blog-config",
+					"delete Deployment blog",
+				},
+				status: &model.HelmReleaseStatus{
+					Chart:    "app",
+					Version:  "1.0.0",
+					Revision: 2,
+					Resources: []*model.HelmReleaseResource{
+						{APIVersion: "v1", Kind: "ConfigMap", Name: "blog-config"},
+					},
+				},
+			},
+		}
+
+		for _, item := range table {
+			var callsMade []string
+			var saved *model.KubeResource
+
+			c := &core.Core{
+				Settings: core.Settings{HelmChartDir: dir},
+				K8S: func(_ *model.Kube) kubernetes.ClientInterface {
+					return &fake_core.KubernetesClient{
+						PatchResourceFn: func(apiVersion string, kind string, namespace string, name string, patch map[string]interface{}, out *json.RawMessage) error {
+							callsMade = append(callsMade, "patch "+kind+" "+name)
+							So(namespace, ShouldEqual, "sites")
+							So(patch["metadata"].(map[string]interface{})["namespace"], ShouldEqual, "sites")
+							if !item.existing[kind+" "+name] {
+								return &kubernetes.StatusError{Code: 404, Status: &kubernetes.Status{Code: 404}}
+							}
+							return nil
+						},
+						CreateResourceFn: func(apiVersion string, kind string, namespace string, objIn map[string]interface{}, out *json.RawMessage) error {
+							callsMade = append(callsMade, "create "+kind+" "+objIn["metadata"].(map[string]interface{})["name"].(string))
+							return nil
+						},
+						DeleteResourceFn: func(apiVersion string, kind string, namespace string, name string) error {
+							callsMade = append(callsMade, "delete "+kind+" "+name)
+							return nil
+						},
+					}
+				},
+				DB: &fake_core.DB{
+					SaveFn: func(m model.Model) error {
+						saved = m.(*model.KubeResource)
+						return nil
+					},
+				},
+			}
+
+			kubeResource := &model.KubeResource{
+				Namespace: "sites",
+				Name:      "blog",
+				Kind:      model.HelmReleaseKind,
+				Template:  newRawMessage(`{"chart": "app", "values": ` + item.values + `}`),
+			}
+			if item.artifact != "" {
+				kubeResource.Artifact = newRawMessage(item.artifact)
+			}
+
+			provisioner := &core.HelmReleaseProvisioner{c}
+			err := provisioner.Provision(kubeResource)
+
+			So(err, ShouldResemble, item.err)
+			So(callsMade, ShouldResemble, item.callsMade)
+
+			status := new(model.HelmReleaseStatus)
+			So(json.Unmarshal(*saved.Artifact, status), ShouldBeNil)
+			So(status, ShouldResemble, item.status)
+			So(string(*saved.Definition), ShouldContainSubstring, `"kind":"List"`)
+		}
+
+		Convey("Missing charts, charts outside the chart directory, and unsupported template functions are validation errors", func() {
+			writeHelmChart(filepath.Join(dir, "outside", "app"))
+			unsupported := filepath.Join(dir, "charts", "unsupported")
+			writeHelmChart(unsupported)
+			So(ioutil.WriteFile(filepath.Join(unsupported, "templates", "secret.yaml"), []byte(`{{ lookup "v1" "Secret" "" "" }}`), 0644), ShouldBeNil)
+
+			c := &core.Core{
+				Settings: core.Settings{HelmChartDir: filepath.Join(dir, "charts")},
+				DB:       new(fake_core.DB),
+			}
+			provisioner := &core.HelmReleaseProvisioner{c}
+
+			table := []struct {
+				template string
+				err      string
+			}{
+				{`{"values": {}}`, "Validation failed: Chart: zero value"},
+				{`{"chart": "nope"}`, `Validation failed: Chart: "nope" does not exist`},
+				{`{"chart": "../outside/app"}`, `Validation failed: Chart: "../outside/app" must be a path in the Helm chart directory`},
+				{`{"chart": "` + filepath.Join(dir, "outside", "app") + `"}`, `Validation failed: Chart: "` + filepath.Join(dir, "outside", "app") + `" must be a path in the Helm chart directory`},
+				{`{"chart": "unsupported"}`, `Validation failed: templates/secret.yaml:1: template function "lookup" is not supported`},
+			}
+			for _, item := range table {
+				err := provisioner.Provision(&model.KubeResource{
+					Kind:     model.HelmReleaseKind,
+					Template: newRawMessage(item.template),
+				})
+				_, ok := err.(*core.ErrorValidationFailed)
+				So(ok, ShouldBeTrue)
+				So(err.Error(), ShouldEqual, item.err)
+			}
+
+			c.HelmChartDir = ""
+			err := provisioner.Provision(&model.KubeResource{
+				Kind:     model.HelmReleaseKind,
+				Template: newRawMessage(`{"chart": "app"}`),
+			})
+			So(err.Error(), ShouldContainSubstring, "provide --helm-chart-dir")
+		})
+	})
+
+	Convey("HelmReleaseProvisioner Provision doesn't put cluster-scoped resources in the namespace of the release", t, func() {
+		dir, err := ioutil.TempDir("", "chart")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(dir)
+		writeHelmChart(filepath.Join(dir, "app"))
+		So(ioutil.WriteFile(filepath.Join(dir, "app", "templates", "role.yaml"), []byte("apiVersion: rbac.authorization.k8s.io/v1\nkind: ClusterRole\nmetadata:\n  name: reader\n"), 0644), ShouldBeNil)
+
+		namespaces := make(map[string]string)
+		c := &core.Core{
+			Settings: core.Settings{HelmChartDir: dir},
+			K8S: func(_ *model.Kube) kubernetes.ClientInterface {
+				return &fake_core.KubernetesClient{
+					PatchResourceFn: func(apiVersion string, kind string, namespace string, name string, patch map[string]interface{}, out *json.RawMessage) error {
+						metadataNamespace, _ := patch["metadata"].(map[string]interface{})["namespace"].(string)
+						So(metadataNamespace, ShouldEqual, namespace)
+						namespaces[kind] = namespace
+						return nil
+					},
+				}
+			},
+			DB: new(fake_core.DB),
+		}
+		provisioner := &core.HelmReleaseProvisioner{c}
+		err = provisioner.Provision(&model.KubeResource{
+			Namespace: "sites",
+			Name:      "blog",
+			Kind:      model.HelmReleaseKind,
+			Template:  newRawMessage(`{"chart": "app"}`),
+		})
+		So(err, ShouldBeNil)
+		So(namespaces, ShouldResemble, map[string]string{"ClusterRole": "", "ConfigMap": "sites", "Deployment": "sites"})
+	})
+}
+
+func TestHelmReleaseProvisionerIsRunning(t *testing.T) {
+	Convey("HelmReleaseProvisioner IsRunning returns whether all resources of the release are ready", t, func() {
+		artifact := `{"chart":"app","version":"1.0.0","revision":1,"resources":[{"apiVersion":"v1","kind":"ConfigMap","name":"blog-config"},{"apiVersion":"extensions/v1beta1","kind":"Deployment","name":"blog"}]}`
+
+		table := []struct {
+			// Input
+			artifact string
+			// Mocks
+			resources map[string]string
+			// Assertions
+			ready     []bool
+			isRunning bool
+		}{
+			// Before the release is installed
+			{
+				artifact:  "",
+				isRunning: false,
+			},
+			// All ready
+			{
+				artifact: artifact,
+				resources: map[string]string{
+					"ConfigMap blog-config": `{}`,
+					"Deployment blog":       `{"spec": {"replicas": 2}, "status": {"readyReplicas": 2}}`,
+				},
+				ready:     []bool{true, true},
+				isRunning: true,
+			},
+			// A Deployment which is not ready
+			{
+				artifact: artifact,
+				resources: map[string]string{
+					"ConfigMap blog-config": `{}`,
+					"Deployment blog":       `{"spec": {"replicas": 2}, "status": {"readyReplicas": 1}}`,
+				},
+				ready:     []bool{true, false},
+				isRunning: false,
+			},
+			// A resource which was deleted
+			{
+				artifact: artifact,
+				resources: map[string]string{
+					"Deployment blog": `{"status": {"readyReplicas": 1}}`,
+				},
+				ready:     []bool{false, true},
+				isRunning: false,
+			},
+		}
+
+		for _, item := range table {
+			c := &core.Core{
+				K8S: func(_ *model.Kube) kubernetes.ClientInterface {
+					return &fake_core.KubernetesClient{
+						GetResourceFn: func(apiVersion string, kind string, namespace string, name string, out *json.RawMessage) error {
+							resource, ok := item.resources[kind+" "+name]
+							if !ok {
+								return &kubernetes.StatusError{Code: 404, Status: &kubernetes.Status{Code: 404}}
+							}
+							*out = json.RawMessage(resource)
+							return nil
+						},
+					}
+				},
+			}
+
+			kubeResource := &model.KubeResource{Namespace: "sites", Name: "blog", Kind: model.HelmReleaseKind}
+			if item.artifact != "" {
+				kubeResource.Artifact = newRawMessage(item.artifact)
+			}
+
+			provisioner := &core.HelmReleaseProvisioner{c}
+			isRunning, err := provisioner.IsRunning(kubeResource)
+			So(err, ShouldBeNil)
+			So(isRunning, ShouldEqual, item.isRunning)
+
+			if item.artifact == "" {
+				continue
+			}
+			status := new(model.HelmReleaseStatus)
+			So(json.Unmarshal(*kubeResource.Artifact, status), ShouldBeNil)
+			var ready []bool
+			for _, resource := range status.Resources {
+				ready = append(ready, resource.Ready)
+			}
+			So(ready, ShouldResemble, item.ready)
+			So(status.Ready, ShouldEqual, item.isRunning)
+		}
+	})
+}
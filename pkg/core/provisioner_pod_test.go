@@ -437,7 +437,7 @@ func TestPodProvisionerProvision(t *testing.T) {
 				// We call K8S client to wait for Pod start
 				K8S: func(_ *model.Kube) kubernetes.ClientInterface {
 					return &fake_core.KubernetesClient{
-						GetResourceFn: func(kind string, namespace string, name string, out *json.RawMessage) error {
+						GetResourceFn: func(apiVersion string, kind string, namespace string, name string, out *json.RawMessage) error {
 							status := "True"
 							if item.mockPodStartTimeout {
 								status = "False"
@@ -83,13 +83,25 @@ func (p *ServiceProvisioner) Provision(kubeResource *model.KubeResource) error {
 			Name:           portName,
 		}
 
-		entrypointPort := entrypointDef["entrypoint_port"].(float64)
+		entrypointPort, _ := entrypointDef["entrypoint_port"].(float64)
 
 		newEntrypointListener.NodeProtocol, _ = port["protocol"].(string)
 		newEntrypointListener.EntrypointName, _ = entrypointDef["entrypoint_name"].(string)
 		newEntrypointListener.EntrypointPort = int64(entrypointPort)
 		newEntrypointListener.EntrypointProtocol, _ = entrypointDef["entrypoint_protocol"].(string)
 
+		// A host or path makes the listener a rule of an HTTP Entrypoint, which
+		// routes to this port of the Service.
+		newEntrypointListener.Host, _ = entrypointDef["host"].(string)
+		newEntrypointListener.Path, _ = entrypointDef["path"].(string)
+		newEntrypointListener.TLSSecretName, _ = entrypointDef["tls_secret_name"].(string)
+		if newEntrypointListener.IsHTTP() {
+			servicePort, _ := port["port"].(float64)
+			newEntrypointListener.Namespace = kubeResource.Namespace
+			newEntrypointListener.ServiceName = kubeResource.Name
+			newEntrypointListener.ServicePort = int64(servicePort)
+		}
+
 		// If there isn't already an EntrypointListener, prepare to create one
 		if existingAsset := assets[portName]; existingAsset == nil {
 			assets[portName] = &serviceProvisionerAsset{
@@ -99,14 +111,22 @@ func (p *ServiceProvisioner) Provision(kubeResource *model.KubeResource) error {
 
 		} else {
 			// If we have an existing Listener, then we received a nodePort assignment
-			// from Kubernetes. We preserve that here.
-			port["nodePort"] = int(existingAsset.model.NodePort)
+			// from Kubernetes. We preserve that here. (Rules of HTTP Entrypoints
+			// don't have one.)
+			if !existingAsset.model.IsHTTP() {
+				port["nodePort"] = int(existingAsset.model.NodePort)
+			}
 
+			// The ports of rules are set on create, so they aren't compared
 			definitionChanged :=
 				newEntrypointListener.NodeProtocol != existingAsset.model.NodeProtocol ||
 					newEntrypointListener.EntrypointName != existingAsset.model.EntrypointName ||
-					newEntrypointListener.EntrypointPort != existingAsset.model.EntrypointPort ||
-					newEntrypointListener.EntrypointProtocol != existingAsset.model.EntrypointProtocol
+					newEntrypointListener.Host != existingAsset.model.Host ||
+					newEntrypointListener.Path != existingAsset.model.Path ||
+					newEntrypointListener.ServicePort != existingAsset.model.ServicePort ||
+					newEntrypointListener.TLSSecretName != existingAsset.model.TLSSecretName ||
+					(!newEntrypointListener.IsHTTP() && (newEntrypointListener.EntrypointPort != existingAsset.model.EntrypointPort ||
+						newEntrypointListener.EntrypointProtocol != existingAsset.model.EntrypointProtocol))
 
 			if definitionChanged {
 				// If the definition changed, we have to replace.
@@ -114,6 +134,12 @@ func (p *ServiceProvisioner) Provision(kubeResource *model.KubeResource) error {
 				existingAsset.model.EntrypointName = newEntrypointListener.EntrypointName
 				existingAsset.model.EntrypointPort = newEntrypointListener.EntrypointPort
 				existingAsset.model.EntrypointProtocol = newEntrypointListener.EntrypointProtocol
+				existingAsset.model.Host = newEntrypointListener.Host
+				existingAsset.model.Path = newEntrypointListener.Path
+				existingAsset.model.Namespace = newEntrypointListener.Namespace
+				existingAsset.model.ServiceName = newEntrypointListener.ServiceName
+				existingAsset.model.ServicePort = newEntrypointListener.ServicePort
+				existingAsset.model.TLSSecretName = newEntrypointListener.TLSSecretName
 				existingAsset.plannedAction = serviceProvisionerAssetReplace
 
 			} else {
@@ -155,6 +181,16 @@ func (p *ServiceProvisioner) Provision(kubeResource *model.KubeResource) error {
 		return err
 	}
 
+	// Rules of HTTP Entrypoints route to the Service itself, so they don't need
+	// a nodePort assignment
+	for _, asset := range assets {
+		if asset.model.IsHTTP() {
+			if err := p.runPlannedAction(asset); err != nil {
+				return err
+			}
+		}
+	}
+
 	// Return now if not NodePort
 	svcType, _ := spec["type"].(string)
 	if svcType != "NodePort" {
@@ -181,7 +217,7 @@ func (p *ServiceProvisioner) Provision(kubeResource *model.KubeResource) error {
 
 		// If there is no asset, this is assumed to be a port without
 		// SUPERGIANT_ENTRYPOINT_LISTENER.
-		if asset == nil {
+		if asset == nil || asset.model.IsHTTP() {
 			continue
 		}
 
@@ -189,30 +225,31 @@ func (p *ServiceProvisioner) Provision(kubeResource *model.KubeResource) error {
 		nodePort := artifactPort["nodePort"].(float64)
 		asset.model.NodePort = int64(nodePort)
 
-		// Run the planned action.
-		switch asset.plannedAction {
+		if err := p.runPlannedAction(asset); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
 
-		case serviceProvisionerAssetKeep:
-			continue // we do nothing
+func (p *ServiceProvisioner) runPlannedAction(asset *serviceProvisionerAsset) error {
+	switch asset.plannedAction {
 
-		case serviceProvisionerAssetCreate:
-			if err := p.Core.EntrypointListeners.Create(asset.model); err != nil {
-				return err
-			}
+	case serviceProvisionerAssetCreate:
+		return p.Core.EntrypointListeners.Create(asset.model)
 
-		case serviceProvisionerAssetReplace:
-			// We don't want to overwrite asset.model, so we pass a dummy to render to
-			// (we pass it the name for testing convenience)
-			dummy := &model.EntrypointListener{Name: asset.model.Name}
-			if err := p.Core.EntrypointListeners.Delete(asset.model.ID, dummy).Now(); err != nil {
-				return err
-			}
-			if err := p.Core.EntrypointListeners.Create(asset.model); err != nil {
-				return err
-			}
+	case serviceProvisionerAssetReplace:
+		// We don't want to overwrite asset.model, so we pass a dummy to render to
+		// (we pass it the name for testing convenience)
+		dummy := &model.EntrypointListener{Name: asset.model.Name}
+		if err := p.Core.EntrypointListeners.Delete(asset.model.ID, dummy).Now(); err != nil {
+			return err
 		}
+		return p.Core.EntrypointListeners.Create(asset.model)
 	}
 
+	// Keep, we do nothing
 	return nil
 }
 
@@ -386,6 +386,70 @@ func TestServiceProvisionerProvision(t *testing.T) {
 				errorReturned:                  nil,
 			},
 
+			// A rule of an HTTP Entrypoint on a ClusterIP Service
+			//------------------------------------------------------------------------
+			{
+				// Input
+				kubeResource: &model.KubeResource{
+					BaseModel: model.BaseModel{
+						ID: &kubeResourceID,
+					},
+					Namespace: "test",
+					Name:      "website",
+					Kind:      "Service",
+					Template: newRawMessage(`{
+						"spec": {
+							"ports": [
+								{
+									"name": "website-http",
+									"port": 8080,
+									"protocol": "TCP",
+									"SUPERGIANT_ENTRYPOINT_LISTENER": {
+										"entrypoint_name": "my-entrypoint",
+										"host": "example.com",
+										"path": "/"
+									}
+								}
+							]
+						}
+					}`),
+				},
+				// Mocks
+				mockExistingEntrypointListeners:   nil,
+				mockDefaultProvisionError:         nil,
+				mockEntrypointListenerFetchError:  nil,
+				mockEntrypointListenerCreateError: nil,
+				mockEntrypointListenerDeleteError: nil,
+				mockNodePortAssigner:              nil,
+				// Expectations
+				definitionPassedToDefaultProvisioner: map[string]interface{}{
+					"spec": map[string]interface{}{
+						"ports": []interface{}{
+							map[string]interface{}{
+								"name":     "website-http",
+								"port":     8080,
+								"protocol": "TCP",
+							},
+						},
+					},
+				},
+				entrypointListenersCreated: []*model.EntrypointListener{
+					{
+						KubeResourceID: &kubeResourceID,
+						EntrypointName: "my-entrypoint",
+						Name:           "website-http",
+						NodeProtocol:   "TCP",
+						Host:           "example.com",
+						Path:           "/",
+						Namespace:      "test",
+						ServiceName:    "website",
+						ServicePort:    8080,
+					},
+				},
+				entrypointListenerNamesDeleted: nil,
+				errorReturned:                  nil,
+			},
+
 			// When Default Provision returns an error
 			//------------------------------------------------------------------------
 			{
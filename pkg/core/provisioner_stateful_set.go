@@ -0,0 +1,274 @@
+package core
+
+import (
+	"crypto/sha1"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/supergiant/supergiant/pkg/kubernetes"
+	"github.com/supergiant/supergiant/pkg/model"
+)
+
+// StatefulSetProvisioner provisions StatefulSets, replacing each
+// SUPERGIANT_EXTERNAL_VOLUME of their Pod template with a volumeClaimTemplate,
+// and creating a provider Volume for each ordinal. Kubernetes names the
+// PersistentVolumeClaim of volume "data" of ordinal 0 of StatefulSet "db"
+// "data-db-0", which is bound to a PersistentVolume of the provider Volume. The
+// Volume itself is named by statefulSetVolumeName, since Volume names are short
+// and unique across Namespaces and Kubes.
+type StatefulSetProvisioner struct {
+	Core *Core
+}
+
+func (p *StatefulSetProvisioner) Provision(kubeResource *model.KubeResource) error {
+	templateMap, spec, podSpec, err := controllerSpecs(kubeResource)
+	if err != nil {
+		return err
+	}
+
+	if !hasExternalVolumes(podSpec) {
+		return p.Core.DefaultProvisioner.Provision(kubeResource)
+	}
+
+	if err := p.ensureVolumes(kubeResource, podSpec, controllerReplicas(spec)); err != nil {
+		return err
+	}
+
+	claimTemplates, _ := spec["volumeClaimTemplates"].([]interface{})
+
+	err = replaceExternalVolumes(podSpec, kubeResource.Kind, func(name string, sgVolDef map[string]interface{}) (interface{}, error) {
+		size, err := externalVolumeSize(sgVolDef)
+		if err != nil {
+			return nil, err
+		}
+		claimTemplates = append(claimTemplates, map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"name": name,
+			},
+			"spec": claimSpec(size),
+		})
+		// The volume is mounted from the claim
+		return nil, nil
+	})
+	if err != nil {
+		return err
+	}
+	spec["volumeClaimTemplates"] = claimTemplates
+
+	if err := setDefinition(kubeResource, templateMap); err != nil {
+		return err
+	}
+	return p.Core.DefaultProvisioner.Provision(kubeResource)
+}
+
+// Teardown scales the StatefulSet down before deleting it, so that its Volumes
+// are detached from Nodes, and deletes the PersistentVolumeClaims and
+// PersistentVolumes of its Volumes, which Kubernetes leaves behind. The claim
+// of a Volume is the one its PersistentVolume is bound to.
+func (p *StatefulSetProvisioner) Teardown(kubeResource *model.KubeResource) error {
+	volumes, err := ownedVolumes(p.Core, kubeResource)
+	if err != nil {
+		return err
+	}
+	if len(volumes) > 0 {
+		if err := scaleDownController(p.Core, kubeResource); err != nil {
+			return err
+		}
+	}
+	if err := p.Core.DefaultProvisioner.Teardown(kubeResource); err != nil {
+		return err
+	}
+
+	k8s := p.Core.K8S(kubeResource.Kube)
+	for _, volume := range volumes {
+		var out json.RawMessage
+		if err := k8s.GetResource("v1", "PersistentVolume", "", volume.Name, &out); err != nil {
+			if kubernetes.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+		var pv struct {
+			Spec struct {
+				ClaimRef struct {
+					Name string `json:"name"`
+				} `json:"claimRef"`
+			} `json:"spec"`
+		}
+		if err := json.Unmarshal(out, &pv); err != nil {
+			return err
+		}
+		if claimName := pv.Spec.ClaimRef.Name; claimName != "" {
+			if err := k8s.DeleteResource("v1", "PersistentVolumeClaim", kubeResource.Namespace, claimName); err != nil && !kubernetes.IsNotFound(err) {
+				return err
+			}
+		}
+		if err := k8s.DeleteResource("v1", "PersistentVolume", "", volume.Name); err != nil && !kubernetes.IsNotFound(err) {
+			return err
+		}
+	}
+	return teardownVolumes(p.Core, volumes)
+}
+
+// IsRunning also creates the Volumes of ordinals added by scaling the
+// StatefulSet up in Kubernetes beyond the replicas of its Template.
+func (p *StatefulSetProvisioner) IsRunning(kubeResource *model.KubeResource) (bool, error) {
+	running, err := resourceIsRunning(p.Core, kubeResource)
+	if err != nil || len(*kubeResource.Artifact) == 0 {
+		return running, err
+	}
+
+	_, spec, podSpec, err := controllerSpecs(kubeResource)
+	if err != nil || !hasExternalVolumes(podSpec) {
+		return running, err
+	}
+
+	var artifact struct {
+		Spec map[string]interface{} `json:"spec"`
+	}
+	if err := json.Unmarshal(*kubeResource.Artifact, &artifact); err != nil {
+		return false, err
+	}
+	replicas := controllerReplicas(artifact.Spec)
+	if replicas <= controllerReplicas(spec) {
+		return running, nil
+	}
+
+	// KubeResources are refreshed with only their Kube loaded
+	if kubeResource.Kube.CloudAccount == nil {
+		kubeResource.Kube.CloudAccount = new(model.CloudAccount)
+		if err := p.Core.DB.First(kubeResource.Kube.CloudAccount, "name = ?", kubeResource.Kube.CloudAccountName); err != nil {
+			return false, err
+		}
+	}
+	if err := p.ensureVolumes(kubeResource, podSpec, replicas); err != nil {
+		return false, err
+	}
+	return running, nil
+}
+
+// Private
+
+// ensureVolumes ensures a Volume, PersistentVolume, and PersistentVolumeClaim
+// for each SUPERGIANT_EXTERNAL_VOLUME of podSpec, for ordinals 0 to replicas-1.
+func (p *StatefulSetProvisioner) ensureVolumes(kubeResource *model.KubeResource, podSpec map[string]interface{}, replicas int) error {
+	volumes, err := externalVolumes(p.Core, kubeResource)
+	if err != nil {
+		return err
+	}
+
+	volumeDefs, _ := podSpec["volumes"].([]interface{})
+	for _, vd := range volumeDefs {
+		volumeDef, _ := vd.(map[string]interface{})
+		sgVolDef, ok := volumeDef[externalVolumeKey].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, ok := volumeDef["name"].(string)
+		if !ok {
+			return fmt.Errorf("Missing or malformed 'name' field in %s Volume", kubeResource.Kind)
+		}
+
+		for i := 0; i < replicas; i++ {
+			volume, err := ensureExternalVolume(p.Core, kubeResource, volumes, statefulSetVolumeName(kubeResource, name, i), sgVolDef)
+			if err != nil {
+				return err
+			}
+			claimName := fmt.Sprintf("%s-%s-%d", name, kubeResource.Name, i)
+			if err := p.ensureClaim(kubeResource, volume, claimName); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// ensureClaim creates the PersistentVolume of a Volume, named as the Volume,
+// and the PersistentVolumeClaim claimName bound to it, which the StatefulSet's
+// Pod of the ordinal uses. Existing resources are left as they are.
+func (p *StatefulSetProvisioner) ensureClaim(kubeResource *model.KubeResource, volume *model.Volume, claimName string) error {
+	volumeDef := p.Core.CloudAccounts.provider(kubeResource.Kube.CloudAccount).KubernetesVolumeDefinition(volume)
+	if volumeDef == nil {
+		return errors.New("Provider does not support SUPERGIANT_EXTERNAL_VOLUME in a StatefulSet")
+	}
+
+	// The PersistentVolume's source is the provider's volume, without its name
+	source, err := json.Marshal(volumeDef)
+	if err != nil {
+		return err
+	}
+	var pvSpec map[string]interface{}
+	if err := json.Unmarshal(source, &pvSpec); err != nil {
+		return err
+	}
+	delete(pvSpec, "name")
+
+	pvSpec["capacity"] = map[string]interface{}{
+		"storage": fmt.Sprintf("%dGi", volume.Size),
+	}
+	pvSpec["accessModes"] = []interface{}{"ReadWriteOnce"}
+	pvSpec["storageClassName"] = ""
+	// Volumes are deleted by Supergiant, according to their ReclaimPolicy
+	pvSpec["persistentVolumeReclaimPolicy"] = "Retain"
+	pvSpec["claimRef"] = map[string]interface{}{
+		"namespace": kubeResource.Namespace,
+		"name":      claimName,
+	}
+
+	k8s := p.Core.K8S(kubeResource.Kube)
+
+	pv := map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "PersistentVolume",
+		"metadata": map[string]interface{}{
+			"name": volume.Name,
+		},
+		"spec": pvSpec,
+	}
+	var out json.RawMessage
+	if err := k8s.CreateResource("v1", "PersistentVolume", "", pv, &out); err != nil {
+		return err
+	}
+
+	pvcSpec := claimSpec(volume.Size)
+	pvcSpec["volumeName"] = volume.Name
+	pvc := map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "PersistentVolumeClaim",
+		"metadata": map[string]interface{}{
+			"namespace": kubeResource.Namespace,
+			"name":      claimName,
+		},
+		"spec": pvcSpec,
+	}
+	return k8s.CreateResource("v1", "PersistentVolumeClaim", kubeResource.Namespace, pvc, &out)
+}
+
+// claimSpec returns the spec of a PersistentVolumeClaim of size GB, which is
+// bound to a PersistentVolume of a Volume rather than dynamically provisioned.
+func claimSpec(size int) map[string]interface{} {
+	return map[string]interface{}{
+		"accessModes":      []interface{}{"ReadWriteOnce"},
+		"storageClassName": "",
+		"resources": map[string]interface{}{
+			"requests": map[string]interface{}{
+				"storage": fmt.Sprintf("%dGi", size),
+			},
+		},
+	}
+}
+
+// statefulSetVolumeName returns the name of the Volume of volume name of a
+// StatefulSet, of an ordinal. Volume names are at most 24 characters and unique
+// across Kubes, so it is name, shortened, with a hash of the Kube, Namespace
+// and StatefulSet, and the ordinal, e.g. "data-1f0c2b9a4e-0". It is the same
+// for the next StatefulSet of the same name, which reuses retained Volumes.
+func statefulSetVolumeName(kubeResource *model.KubeResource, name string, ordinal int) string {
+	sum := sha1.Sum([]byte(kubeResource.KubeName + "/" + kubeResource.Namespace + "/" + kubeResource.Name + "/" + name))
+	suffix := fmt.Sprintf("-%x-%d", sum[:5], ordinal)
+	if max := 24 - len(suffix); len(name) > max {
+		name = name[:max]
+	}
+	return name + suffix
+}
@@ -0,0 +1,386 @@
+package core_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/supergiant/supergiant/pkg/core"
+	"github.com/supergiant/supergiant/pkg/kubernetes"
+	"github.com/supergiant/supergiant/pkg/model"
+	"github.com/supergiant/supergiant/pkg/provider/aws"
+	"github.com/supergiant/supergiant/test/fake_core"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestStatefulSetProvisionerProvision(t *testing.T) {
+	Convey("StatefulSetProvisioner Provision creates a Volume and claim per ordinal", t, func() {
+
+		var kubeResourceID int64 = 42
+
+		var volumesCreated []string
+		var resourcesCreated []map[string]interface{}
+		var definitionPassedToDefaultProvisioner map[string]interface{}
+
+		c := &core.Core{
+			K8S: func(_ *model.Kube) kubernetes.ClientInterface {
+				return &fake_core.KubernetesClient{
+					CreateResourceFn: func(apiVersion string, kind string, namespace string, objIn map[string]interface{}, out *json.RawMessage) error {
+						resourcesCreated = append(resourcesCreated, objIn)
+						return nil
+					},
+				}
+			},
+
+			DB: &fake_core.DB{
+				// The Volume of ordinal 0 was created before
+				FindFn: func(out interface{}, _ ...interface{}) error {
+					existing := []*model.Volume{
+						{
+							KubeResourceID: &kubeResourceID,
+							Name:           "data-e95fa6e8ae-0",
+							Size:           20,
+							ProviderID:     "vol-0",
+						},
+					}
+					reflect.ValueOf(out).Elem().Set(reflect.ValueOf(existing))
+					return nil
+				},
+			},
+
+			Volumes: &fake_core.Volumes{
+				CreateFn: func(volume *model.Volume) error {
+					volumesCreated = append(volumesCreated, volume.Name)
+					volume.ProviderID = "vol-1"
+					return nil
+				},
+			},
+
+			DefaultProvisioner: &fake_core.Provisioner{
+				ProvisionFn: func(kubeResource *model.KubeResource) error {
+					json.Unmarshal(*kubeResource.Definition, &definitionPassedToDefaultProvisioner)
+					return nil
+				},
+			},
+		}
+
+		c.CloudAccounts = &core.CloudAccounts{core.Collection{Core: c}}
+		c.AWSProvider = func(creds map[string]string) core.Provider {
+			return &aws.Provider{Core: c}
+		}
+
+		kubeResource := &model.KubeResource{
+			BaseModel: model.BaseModel{
+				ID: &kubeResourceID,
+			},
+			Kube: &model.Kube{
+				CloudAccount: &model.CloudAccount{
+					Provider: "aws",
+				},
+			},
+			KubeName:  "test",
+			Namespace: "prod",
+			Name:      "db",
+			Kind:      "StatefulSet",
+			Template: newRawMessage(`{
+				"spec": {
+					"replicas": 2,
+					"template": {
+						"spec": {
+							"volumes": [
+								{
+									"name": "data",
+									"SUPERGIANT_EXTERNAL_VOLUME": {
+										"type": "gp2",
+										"size": 20
+									}
+								},
+								{
+									"name": "tmp",
+									"emptyDir": {}
+								}
+							]
+						}
+					}
+				}
+			}`),
+		}
+
+		provisioner := &core.StatefulSetProvisioner{c}
+		err := provisioner.Provision(kubeResource)
+		So(err, ShouldBeNil)
+
+		// Only the Volume of ordinal 1 is new
+		So(volumesCreated, ShouldResemble, []string{"data-e95fa6e8ae-1"})
+
+		So(definitionPassedToDefaultProvisioner, ShouldResemble, map[string]interface{}{
+			"spec": map[string]interface{}{
+				"replicas": float64(2),
+				"template": map[string]interface{}{
+					"spec": map[string]interface{}{
+						"volumes": []interface{}{
+							map[string]interface{}{
+								"name":     "tmp",
+								"emptyDir": map[string]interface{}{},
+							},
+						},
+					},
+				},
+				"volumeClaimTemplates": []interface{}{
+					map[string]interface{}{
+						"metadata": map[string]interface{}{
+							"name": "data",
+						},
+						"spec": map[string]interface{}{
+							"accessModes":      []interface{}{"ReadWriteOnce"},
+							"storageClassName": "",
+							"resources": map[string]interface{}{
+								"requests": map[string]interface{}{
+									"storage": "20Gi",
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+
+		// A PersistentVolume and PersistentVolumeClaim for each ordinal
+		var created []string
+		for _, resource := range resourcesCreated {
+			metadata := resource["metadata"].(map[string]interface{})
+			created = append(created, resource["kind"].(string)+" "+metadata["name"].(string))
+		}
+		So(created, ShouldResemble, []string{
+			"PersistentVolume data-e95fa6e8ae-0",
+			"PersistentVolumeClaim data-db-0",
+			"PersistentVolume data-e95fa6e8ae-1",
+			"PersistentVolumeClaim data-db-1",
+		})
+
+		pvJSON, _ := json.Marshal(resourcesCreated[2])
+		So(string(pvJSON), ShouldContainSubstring, `"awsElasticBlockStore":{"fsType":"ext4","volumeID":"vol-1"}`)
+		So(string(pvJSON), ShouldContainSubstring, `"claimRef":{"name":"data-db-1","namespace":"prod"}`)
+		So(string(pvJSON), ShouldContainSubstring, `"persistentVolumeReclaimPolicy":"Retain"`)
+
+		pvcJSON, _ := json.Marshal(resourcesCreated[3])
+		So(string(pvcJSON), ShouldContainSubstring, `"volumeName":"data-e95fa6e8ae-1"`)
+	})
+}
+
+//------------------------------------------------------------------------------
+
+func TestStatefulSetProvisionerVolumeNames(t *testing.T) {
+	Convey("StatefulSetProvisioner names Volumes uniquely within 24 characters, and claims as Kubernetes does", t, func() {
+		table := []struct {
+			// Input
+			namespace  string
+			name       string
+			volumeName string
+			// Expectations
+			volumes []string
+			claims  []string
+		}{
+			// A short name
+			{
+				namespace:  "prod",
+				name:       "db",
+				volumeName: "data",
+				volumes:    []string{"data-e95fa6e8ae-0", "data-e95fa6e8ae-1"},
+				claims:     []string{"data-db-0", "data-db-1"},
+			},
+			// The same StatefulSet in another Namespace has other Volumes
+			{
+				namespace:  "staging",
+				name:       "db",
+				volumeName: "data",
+				volumes:    []string{"data-f1dd64d453-0", "data-f1dd64d453-1"},
+				claims:     []string{"data-db-0", "data-db-1"},
+			},
+			// A long name is shortened, but its claims are not
+			{
+				namespace:  "prod",
+				name:       "elasticsearch-master",
+				volumeName: "elasticsearch-data",
+				volumes:    []string{"elasticsear-fd7ee28a44-0", "elasticsear-fd7ee28a44-1"},
+				claims:     []string{"elasticsearch-data-elasticsearch-master-0", "elasticsearch-data-elasticsearch-master-1"},
+			},
+		}
+
+		for _, item := range table {
+			var volumesCreated []string
+			var claimsCreated []string
+
+			c := &core.Core{
+				K8S: func(_ *model.Kube) kubernetes.ClientInterface {
+					return &fake_core.KubernetesClient{
+						CreateResourceFn: func(apiVersion string, kind string, namespace string, objIn map[string]interface{}, out *json.RawMessage) error {
+							if kind == "PersistentVolumeClaim" {
+								metadata := objIn["metadata"].(map[string]interface{})
+								claimsCreated = append(claimsCreated, metadata["name"].(string))
+							}
+							return nil
+						},
+					}
+				},
+				DB: new(fake_core.DB),
+				Volumes: &fake_core.Volumes{
+					CreateFn: func(volume *model.Volume) error {
+						volumesCreated = append(volumesCreated, volume.Name)
+						return nil
+					},
+				},
+				DefaultProvisioner: new(fake_core.Provisioner),
+			}
+			c.CloudAccounts = &core.CloudAccounts{core.Collection{Core: c}}
+			c.AWSProvider = func(creds map[string]string) core.Provider {
+				return &aws.Provider{Core: c}
+			}
+
+			kubeResource := &model.KubeResource{
+				Kube: &model.Kube{
+					CloudAccount: &model.CloudAccount{
+						Provider: "aws",
+					},
+				},
+				KubeName:  "test",
+				Namespace: item.namespace,
+				Name:      item.name,
+				Kind:      "StatefulSet",
+				Template: newRawMessage(`{
+					"spec": {
+						"replicas": 2,
+						"template": {
+							"spec": {
+								"volumes": [
+									{
+										"name": "` + item.volumeName + `",
+										"SUPERGIANT_EXTERNAL_VOLUME": {
+											"type": "gp2",
+											"size": 20
+										}
+									}
+								]
+							}
+						}
+					}
+				}`),
+			}
+
+			provisioner := &core.StatefulSetProvisioner{c}
+			err := provisioner.Provision(kubeResource)
+			So(err, ShouldBeNil)
+
+			So(volumesCreated, ShouldResemble, item.volumes)
+			So(claimsCreated, ShouldResemble, item.claims)
+			for _, name := range volumesCreated {
+				So(len(name), ShouldBeLessThanOrEqualTo, 24)
+			}
+		}
+	})
+}
+
+//------------------------------------------------------------------------------
+
+func TestStatefulSetProvisionerTeardown(t *testing.T) {
+	Convey("StatefulSetProvisioner Teardown scales down, and deletes or retains Volumes", t, func() {
+		var kubeResourceID int64 = 42
+
+		var patched map[string]interface{}
+		var resourcesDeleted []string
+		var volumeNamesDeleted []string
+		var defaultTeardownCalled bool
+
+		owned := []*model.Volume{
+			{
+				KubeResourceID: &kubeResourceID,
+				Name:           "data-e95fa6e8ae-0",
+			},
+			{
+				KubeResourceID: &kubeResourceID,
+				Name:           "logs-4c2a7c1a0b-0",
+				ReclaimPolicy:  "retain",
+			},
+		}
+
+		c := &core.Core{
+			K8S: func(_ *model.Kube) kubernetes.ClientInterface {
+				return &fake_core.KubernetesClient{
+					PatchResourceFn: func(apiVersion string, kind string, namespace string, name string, patch map[string]interface{}, out *json.RawMessage) error {
+						patched = patch
+						return nil
+					},
+					GetResourceFn: func(apiVersion string, kind string, namespace string, name string, out *json.RawMessage) error {
+						switch {
+						case kind != "PersistentVolume":
+							*out = json.RawMessage(`{"status": {"replicas": 0}}`)
+						case name == "data-e95fa6e8ae-0":
+							*out = json.RawMessage(`{"spec": {"claimRef": {"namespace": "prod", "name": "data-db-0"}}}`)
+						default:
+							*out = json.RawMessage(`{"spec": {"claimRef": {"namespace": "prod", "name": "logs-db-0"}}}`)
+						}
+						return nil
+					},
+					DeleteResourceFn: func(apiVersion string, kind string, namespace string, name string) error {
+						resourcesDeleted = append(resourcesDeleted, kind+" "+name)
+						return nil
+					},
+				}
+			},
+
+			DB: &fake_core.DB{
+				FindFn: func(out interface{}, _ ...interface{}) error {
+					reflect.ValueOf(out).Elem().Set(reflect.ValueOf(owned))
+					return nil
+				},
+			},
+
+			DefaultProvisioner: &fake_core.Provisioner{
+				TeardownFn: func(kubeResource *model.KubeResource) error {
+					defaultTeardownCalled = true
+					return nil
+				},
+			},
+		}
+		c.Volumes = &fake_core.Volumes{
+			DeleteFn: func(_ *int64, volume *model.Volume) core.ActionInterface {
+				return &fake_core.Action{
+					NowFn: func() error {
+						volumeNamesDeleted = append(volumeNamesDeleted, volume.Name)
+						return nil
+					},
+				}
+			},
+		}
+
+		kubeResource := &model.KubeResource{
+			BaseModel: model.BaseModel{
+				ID: &kubeResourceID,
+			},
+			Namespace: "prod",
+			Name:      "db",
+			Kind:      "StatefulSet",
+		}
+
+		provisioner := &core.StatefulSetProvisioner{c}
+		err := provisioner.Teardown(kubeResource)
+
+		So(err, ShouldBeNil)
+		So(patched, ShouldResemble, map[string]interface{}{
+			"spec": map[string]interface{}{
+				"replicas": 0,
+			},
+		})
+		So(defaultTeardownCalled, ShouldBeTrue)
+		So(resourcesDeleted, ShouldResemble, []string{
+			"PersistentVolumeClaim data-db-0",
+			"PersistentVolume data-e95fa6e8ae-0",
+			"PersistentVolumeClaim logs-db-0",
+			"PersistentVolume logs-4c2a7c1a0b-0",
+		})
+		So(volumeNamesDeleted, ShouldResemble, []string{"data-e95fa6e8ae-0"})
+		// The retained Volume is detached
+		So(owned[1].KubeResourceID, ShouldBeNil)
+	})
+}
@@ -0,0 +1,163 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/supergiant/supergiant/pkg/model"
+)
+
+// externalVolumeKey is the key of the Supergiant definition of a volume in the
+// volumes of a Pod spec, which is replaced with the Kubernetes definition of a
+// provider Volume.
+const externalVolumeKey = "SUPERGIANT_EXTERNAL_VOLUME"
+
+// replaceExternalVolumes replaces each volume of a Pod spec (of a Pod, or the
+// Pod template of kind) which has a SUPERGIANT_EXTERNAL_VOLUME with the
+// result of fn, called with its name and Supergiant definition, or removes it
+// if fn returns nil.
+func replaceExternalVolumes(spec map[string]interface{}, kind string, fn func(string, map[string]interface{}) (interface{}, error)) error {
+	volumeDefs, _ := spec["volumes"].([]interface{})
+
+	var newVolumeDefs []interface{}
+
+	for _, vd := range volumeDefs {
+
+		volumeDef := vd.(map[string]interface{})
+
+		sgVolDef, ok := volumeDef[externalVolumeKey].(map[string]interface{})
+		if !ok {
+			newVolumeDefs = append(newVolumeDefs, volumeDef) // Append to array so we preserve non-SG volumes
+			continue
+		}
+
+		name, okName := volumeDef["name"].(string)
+		if !okName {
+			return fmt.Errorf("Missing or malformed 'name' field in %s Volume", kind)
+		}
+
+		newVolumeDef, err := fn(name, sgVolDef)
+		if err != nil {
+			return err
+		}
+		if newVolumeDef != nil {
+			newVolumeDefs = append(newVolumeDefs, newVolumeDef)
+		}
+	}
+
+	// Replace old volume definitions in the original spec
+	spec["volumes"] = newVolumeDefs
+	return nil
+}
+
+// hasExternalVolumes returns whether a Pod spec has a volume with a
+// SUPERGIANT_EXTERNAL_VOLUME.
+func hasExternalVolumes(spec map[string]interface{}) bool {
+	volumeDefs, _ := spec["volumes"].([]interface{})
+	for _, vd := range volumeDefs {
+		if volumeDef, ok := vd.(map[string]interface{}); ok && volumeDef[externalVolumeKey] != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// externalVolumes returns the Volumes a KubeResource may use by name, which are
+// its own, and those retained (and detached) in its Kube. We load them so that
+// provisioning can be re-ran on error, and Volumes won't be recreated.
+func externalVolumes(c *Core, kubeResource *model.KubeResource) (map[string]*model.Volume, error) {
+	var volumes []*model.Volume
+	if err := c.DB.Find(&volumes, "kube_resource_id = ? OR (kube_name = ? AND kube_resource_id IS NULL)", kubeResource.ID, kubeResource.KubeName); err != nil {
+		return nil, err
+	}
+	volumeMap := make(map[string]*model.Volume)
+	for _, volume := range volumes {
+		volumeMap[volume.Name] = volume
+	}
+	return volumeMap, nil
+}
+
+// ensureExternalVolume returns the Volume named name of a KubeResource from
+// volumes, creating it from its SUPERGIANT_EXTERNAL_VOLUME definition if it
+// doesn't exist, and adopting it if it was retained.
+func ensureExternalVolume(c *Core, kubeResource *model.KubeResource, volumes map[string]*model.Volume, name string, sgVolDef map[string]interface{}) (*model.Volume, error) {
+	reclaimPolicy, _ := sgVolDef["reclaim_policy"].(string)
+
+	volume := volumes[name]
+
+	if volume == nil {
+		volume = &model.Volume{
+			Name:           name,
+			KubeName:       kubeResource.KubeName,
+			KubeResourceID: kubeResource.ID,
+			ReclaimPolicy:  reclaimPolicy,
+		}
+
+		if volType, ok := sgVolDef["type"].(string); ok {
+			volume.Type = volType
+		}
+
+		size, err := externalVolumeSize(sgVolDef)
+		if err != nil {
+			return nil, err
+		}
+		volume.Size = size
+
+		if volErr := c.Volumes.Create(volume); volErr != nil {
+			return nil, volErr
+		}
+		volumes[name] = volume
+		return volume, nil
+	}
+
+	if volume.KubeResourceID == nil {
+		volume.KubeResourceID = kubeResource.ID
+		if err := c.DB.Model(volume).Update("kube_resource_id", *kubeResource.ID); err != nil {
+			return nil, err
+		}
+	}
+	if volume.ReclaimPolicy != reclaimPolicy {
+		volume.ReclaimPolicy = reclaimPolicy
+		if err := c.DB.Model(volume).Update("reclaim_policy", reclaimPolicy); err != nil {
+			return nil, err
+		}
+	}
+	return volume, nil
+}
+
+// externalVolumeSize returns the size in GB of a SUPERGIANT_EXTERNAL_VOLUME
+// definition.
+func externalVolumeSize(sgVolDef map[string]interface{}) (int, error) {
+	size, ok := sgVolDef["size"].(float64)
+	if !ok {
+		return 0, errors.New("Missing or malformed 'size' field in SUPERGIANT_EXTERNAL_VOLUME")
+	}
+	return int(size), nil
+}
+
+// ownedVolumes returns the Volumes of a KubeResource.
+func ownedVolumes(c *Core, kubeResource *model.KubeResource) ([]*model.Volume, error) {
+	var volumes []*model.Volume
+	if err := c.DB.Find(&volumes, "kube_resource_id = ?", kubeResource.ID); err != nil {
+		return nil, err
+	}
+	return volumes, nil
+}
+
+// teardownVolumes deletes the Volumes of a torn down KubeResource, except
+// those with the retain ReclaimPolicy, which are detached from it.
+func teardownVolumes(c *Core, volumes []*model.Volume) error {
+	for _, volume := range volumes {
+		if volume.ReclaimPolicy == "retain" {
+			volume.KubeResourceID = nil
+			if err := c.DB.Model(volume).Update(map[string]interface{}{"kube_resource_id": nil}); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := c.Volumes.Delete(volume.ID, volume).Now(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
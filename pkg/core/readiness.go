@@ -0,0 +1,235 @@
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/supergiant/supergiant/pkg/kubernetes"
+	"github.com/supergiant/supergiant/pkg/model"
+)
+
+// readinessCheck returns whether a resource, as read from Kubernetes, is ready,
+// and a short description of its state, e.g. "1 of 3 ready". A resource which
+// has failed, and will not become ready, returns an *ErrorResourceFailed.
+type readinessCheck func(resource *resourceStatus) (ready bool, state string, err error)
+
+// readinessChecks are the readiness checks of kinds. A resource of any other
+// kind is ready once it exists.
+var readinessChecks = map[string]readinessCheck{
+	"Pod":                   podReady,
+	"Deployment":            replicasReady,
+	"StatefulSet":           replicasReady,
+	"ReplicaSet":            replicasReady,
+	"ReplicationController": replicasReady,
+	"DaemonSet":             daemonSetReady,
+	"Job":                   jobReady,
+	"Service":               serviceReady,
+	"PersistentVolumeClaim": persistentVolumeClaimReady,
+}
+
+// ErrorResourceFailed is returned by Provisioner IsRunning when the resource of
+// a KubeResource has failed, e.g. a Job which exceeded its backoff limit.
+type ErrorResourceFailed struct {
+	Kind   string
+	Name   string
+	Reason string
+}
+
+func (err *ErrorResourceFailed) Error() string {
+	return fmt.Sprintf("%s '%s' failed: %s", err.Kind, err.Name, err.Reason)
+}
+
+// resourceIsRunning gets the resource of a KubeResource into its Artifact, and
+// returns whether it exists and is ready, setting its Readiness. The Artifact
+// is left empty if the resource doesn't exist.
+func resourceIsRunning(c *Core, kubeResource *model.KubeResource) (bool, error) {
+	if kubeResource.Artifact == nil {
+		artifact := make(json.RawMessage, 0)
+		kubeResource.Artifact = &artifact
+	}
+	err := c.K8S(kubeResource.Kube).GetResource(kubeResource.APIVersion(), kubeResource.Kind, kubeResource.Namespace, kubeResource.Name, kubeResource.Artifact)
+	if err != nil {
+		if kubernetes.IsNotFound(err) {
+			*kubeResource.Artifact = make(json.RawMessage, 0)
+			kubeResource.Readiness = ""
+			return false, nil
+		}
+		return false, err
+	}
+
+	ready, state, err := resourceReadiness(kubeResource.Kind, kubeResource.Name, *kubeResource.Artifact)
+	kubeResource.Readiness = state
+	if failed, ok := err.(*ErrorResourceFailed); ok {
+		kubeResource.Readiness = "failed: " + failed.Reason
+	}
+	return ready, err
+}
+
+// resourceExists returns whether the resource of a KubeResource existed when
+// it was last read from Kubernetes by IsRunning. A HelmRelease has no resource
+// of its own, and exists from when it's started until it's stopped.
+func resourceExists(kubeResource *model.KubeResource) bool {
+	if kubeResource.Kind == model.HelmReleaseKind {
+		return kubeResource.Started
+	}
+	return kubeResource.Artifact != nil && len(*kubeResource.Artifact) > 0
+}
+
+// resourceReadiness runs the readiness check of kind on a resource named name.
+func resourceReadiness(kind string, name string, obj json.RawMessage) (ready bool, state string, err error) {
+	check, ok := readinessChecks[kind]
+	if !ok {
+		return true, "", nil
+	}
+	resource := new(resourceStatus)
+	if len(obj) > 0 {
+		if err := json.Unmarshal(obj, resource); err != nil {
+			return false, "", err
+		}
+	}
+	ready, state, err = check(resource)
+	if failed, ok := err.(*ErrorResourceFailed); ok {
+		failed.Kind, failed.Name = kind, name
+	}
+	return ready, state, err
+}
+
+// resourceStatus holds the fields of resources which readiness is checked by.
+type resourceStatus struct {
+	Metadata struct {
+		Generation int64 `json:"generation"`
+	} `json:"metadata"`
+	Spec struct {
+		Replicas    *int   `json:"replicas"`
+		Completions *int   `json:"completions"`
+		Type        string `json:"type"`
+	} `json:"spec"`
+	Status struct {
+		ObservedGeneration     int64  `json:"observedGeneration"`
+		Replicas               int    `json:"replicas"`
+		ReadyReplicas          int    `json:"readyReplicas"`
+		AvailableReplicas      *int   `json:"availableReplicas"`
+		UpdatedReplicas        *int   `json:"updatedReplicas"`
+		CurrentRevision        string `json:"currentRevision"`
+		UpdateRevision         string `json:"updateRevision"`
+		NumberReady            int    `json:"numberReady"`
+		DesiredNumberScheduled int    `json:"desiredNumberScheduled"`
+		Succeeded              int    `json:"succeeded"`
+		Phase                  string `json:"phase"`
+		Reason                 string `json:"reason"`
+		Conditions             []struct {
+			Type    string `json:"type"`
+			Status  string `json:"status"`
+			Reason  string `json:"reason"`
+			Message string `json:"message"`
+		} `json:"conditions"`
+		LoadBalancer struct {
+			Ingress []interface{} `json:"ingress"`
+		} `json:"loadBalancer"`
+	} `json:"status"`
+}
+
+// condition returns the status and reason of the condition of type condType,
+// or "" if there isn't one.
+func (r *resourceStatus) condition(condType string) (status string, reason string) {
+	for _, condition := range r.Status.Conditions {
+		if condition.Type == condType {
+			reason = condition.Reason
+			if reason == "" {
+				reason = condition.Message
+			}
+			return condition.Status, reason
+		}
+	}
+	return "", ""
+}
+
+// podReady checks the Ready condition of a Pod.
+func podReady(r *resourceStatus) (bool, string, error) {
+	if status, _ := r.condition("Ready"); status == "True" {
+		return true, "", nil
+	}
+	if r.Status.Phase == "Failed" {
+		return false, "", &ErrorResourceFailed{Reason: r.Status.Reason}
+	}
+	if r.Status.Phase == "" || r.Status.Phase == "Running" {
+		return false, "not ready", nil
+	}
+	return false, strings.ToLower(r.Status.Phase), nil
+}
+
+// replicasReady checks that the rollout of the latest spec of a controller is
+// complete, meaning all of its replicas are updated and available (or ready,
+// for controllers which don't report availability).
+func replicasReady(r *resourceStatus) (bool, string, error) {
+	if status, reason := r.condition("Progressing"); status == "False" && reason == "ProgressDeadlineExceeded" {
+		return false, "", &ErrorResourceFailed{Reason: reason}
+	}
+
+	replicas := 1
+	if r.Spec.Replicas != nil {
+		replicas = *r.Spec.Replicas
+	}
+	ready := r.Status.ReadyReplicas
+	if r.Status.AvailableReplicas != nil {
+		ready = *r.Status.AvailableReplicas
+	}
+
+	if r.Status.ObservedGeneration < r.Metadata.Generation ||
+		(r.Status.UpdatedReplicas != nil && *r.Status.UpdatedReplicas < replicas) ||
+		(r.Status.UpdateRevision != "" && r.Status.CurrentRevision != r.Status.UpdateRevision) {
+		return false, "updating", nil
+	}
+	if ready < replicas {
+		return false, fmt.Sprintf("%d of %d ready", ready, replicas), nil
+	}
+	return true, "", nil
+}
+
+// daemonSetReady checks that a DaemonSet's Pod is ready on each Node it is
+// scheduled on.
+func daemonSetReady(r *resourceStatus) (bool, string, error) {
+	if r.Status.ObservedGeneration < r.Metadata.Generation {
+		return false, "updating", nil
+	}
+	if r.Status.NumberReady < r.Status.DesiredNumberScheduled {
+		return false, fmt.Sprintf("%d of %d ready", r.Status.NumberReady, r.Status.DesiredNumberScheduled), nil
+	}
+	return true, "", nil
+}
+
+// jobReady checks that a Job has succeeded, or returns the reason it failed.
+func jobReady(r *resourceStatus) (bool, string, error) {
+	if status, reason := r.condition("Failed"); status == "True" {
+		return false, "", &ErrorResourceFailed{Reason: reason}
+	}
+	completions := 1
+	if r.Spec.Completions != nil {
+		completions = *r.Spec.Completions
+	}
+	if r.Status.Succeeded < completions {
+		return false, "running", nil
+	}
+	return true, "succeeded", nil
+}
+
+// serviceReady checks that a LoadBalancer Service has been assigned an
+// ingress.
+func serviceReady(r *resourceStatus) (bool, string, error) {
+	if r.Spec.Type == "LoadBalancer" && len(r.Status.LoadBalancer.Ingress) == 0 {
+		return false, "pending load balancer", nil
+	}
+	return true, "", nil
+}
+
+// persistentVolumeClaimReady checks that a PersistentVolumeClaim is Bound.
+func persistentVolumeClaimReady(r *resourceStatus) (bool, string, error) {
+	switch r.Status.Phase {
+	case "Bound":
+		return true, "", nil
+	case "Lost":
+		return false, "", &ErrorResourceFailed{Reason: "PersistentVolume lost"}
+	}
+	return false, "pending", nil
+}
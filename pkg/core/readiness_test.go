@@ -0,0 +1,146 @@
+package core_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/supergiant/supergiant/pkg/core"
+	"github.com/supergiant/supergiant/pkg/kubernetes"
+	"github.com/supergiant/supergiant/pkg/model"
+	"github.com/supergiant/supergiant/test/fake_core"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestDefaultProvisionerIsRunning(t *testing.T) {
+	Convey("DefaultProvisioner IsRunning checks the readiness of the resource by its kind", t, func() {
+		table := []struct {
+			// Input
+			kind string
+			// Mocks
+			mockResource string
+			// Expectations
+			running   bool
+			readiness string
+			err       error
+		}{
+			// Pods are ready by their Ready condition
+			{
+				kind:         "Pod",
+				mockResource: `{"status": {"phase": "Running", "conditions": [{"type": "Ready", "status": "True"}]}}`,
+				running:      true,
+			},
+			{
+				kind:         "Pod",
+				mockResource: `{"status": {"phase": "Pending", "conditions": [{"type": "Ready", "status": "False"}]}}`,
+				readiness:    "pending",
+			},
+			{
+				kind:         "Pod",
+				mockResource: `{"status": {"phase": "Failed", "reason": "Evicted"}}`,
+				readiness:    "failed: Evicted",
+				err:          &core.ErrorResourceFailed{Kind: "Pod", Name: "test", Reason: "Evicted"},
+			},
+			// Deployments by their available replicas
+			{
+				kind:         "Deployment",
+				mockResource: `{"spec": {"replicas": 3}, "status": {"updatedReplicas": 3, "readyReplicas": 3, "availableReplicas": 3}}`,
+				running:      true,
+			},
+			{
+				kind:         "Deployment",
+				mockResource: `{"spec": {"replicas": 3}, "status": {"updatedReplicas": 3, "readyReplicas": 3, "availableReplicas": 1}}`,
+				readiness:    "1 of 3 ready",
+			},
+			{
+				kind:         "Deployment",
+				mockResource: `{"spec": {"replicas": 1}, "status": {"conditions": [{"type": "Progressing", "status": "False", "reason": "ProgressDeadlineExceeded"}]}}`,
+				readiness:    "failed: ProgressDeadlineExceeded",
+				err:          &core.ErrorResourceFailed{Kind: "Deployment", Name: "test", Reason: "ProgressDeadlineExceeded"},
+			},
+			// StatefulSets by their ready replicas
+			{
+				kind:         "StatefulSet",
+				mockResource: `{"spec": {"replicas": 2}, "status": {"readyReplicas": 2, "currentRevision": "test-1", "updateRevision": "test-1"}}`,
+				running:      true,
+			},
+			// Jobs when they succeed
+			{
+				kind:         "Job",
+				mockResource: `{"spec": {"completions": 1}, "status": {"succeeded": 1}}`,
+				running:      true,
+				readiness:    "succeeded",
+			},
+			{
+				kind:         "Job",
+				mockResource: `{"spec": {"completions": 1}, "status": {"active": 1}}`,
+				readiness:    "running",
+			},
+			{
+				kind:         "Job",
+				mockResource: `{"status": {"failed": 6, "conditions": [{"type": "Failed", "status": "True", "reason": "BackoffLimitExceeded"}]}}`,
+				readiness:    "failed: BackoffLimitExceeded",
+				err:          &core.ErrorResourceFailed{Kind: "Job", Name: "test", Reason: "BackoffLimitExceeded"},
+			},
+			// LoadBalancer Services when they have an ingress
+			{
+				kind:         "Service",
+				mockResource: `{"spec": {"type": "LoadBalancer"}, "status": {"loadBalancer": {"ingress": [{"hostname": "a.elb.amazonaws.com"}]}}}`,
+				running:      true,
+			},
+			{
+				kind:         "Service",
+				mockResource: `{"spec": {"type": "LoadBalancer"}, "status": {"loadBalancer": {}}}`,
+				readiness:    "pending load balancer",
+			},
+			{
+				kind:         "Service",
+				mockResource: `{"spec": {"type": "ClusterIP"}}`,
+				running:      true,
+			},
+			// PersistentVolumeClaims when Bound
+			{
+				kind:         "PersistentVolumeClaim",
+				mockResource: `{"status": {"phase": "Bound"}}`,
+				running:      true,
+			},
+			{
+				kind:         "PersistentVolumeClaim",
+				mockResource: `{"status": {"phase": "Pending"}}`,
+				readiness:    "pending",
+			},
+			// Other kinds once they exist
+			{
+				kind:         "ConfigMap",
+				mockResource: `{"data": {}}`,
+				running:      true,
+			},
+		}
+
+		for _, item := range table {
+			c := &core.Core{
+				K8S: func(_ *model.Kube) kubernetes.ClientInterface {
+					return &fake_core.KubernetesClient{
+						GetResourceFn: func(apiVersion string, kind string, namespace string, name string, out *json.RawMessage) error {
+							*out = json.RawMessage(item.mockResource)
+							return nil
+						},
+					}
+				},
+			}
+
+			kubeResource := &model.KubeResource{
+				Namespace: "test",
+				Name:      "test",
+				Kind:      item.kind,
+			}
+
+			provisioner := &core.DefaultProvisioner{c}
+			running, err := provisioner.IsRunning(kubeResource)
+
+			So(err, ShouldResemble, item.err)
+			So(running, ShouldEqual, item.running)
+			So(kubeResource.Readiness, ShouldEqual, item.readiness)
+		}
+	})
+}
@@ -3,9 +3,15 @@ package core
 import (
 	"reflect"
 	"runtime/debug"
+	"sync"
 	"time"
 )
 
+// recurringServiceStaleFloor is the least time without a tick starting before
+// a RecurringService is considered stale, so that services with short
+// intervals are not reported while a slow tick (e.g. with many Kubes) runs.
+const recurringServiceStaleFloor = 10 * time.Minute
+
 type Service interface {
 	Perform() error
 }
@@ -14,25 +20,60 @@ type RecurringService struct {
 	core     *Core
 	service  Service
 	interval time.Duration
+
+	mutex         sync.Mutex
+	lastTickStart time.Time
 }
 
 func (s *RecurringService) Run() {
 	for _ = range time.NewTicker(s.interval).C {
+		s.setLastTickStart()
 		s.tick()
 	}
 }
 
+// Stale returns true if the service has not started a tick in staleAfter,
+// which means it has stopped or a tick is hanging (the ticker drops ticks
+// while one runs).
+func (s *RecurringService) Stale() bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return time.Since(s.lastTickStart) > s.staleAfter()
+}
+
+// staleAfter is 3 intervals, or recurringServiceStaleFloor if that is longer.
+func (s *RecurringService) staleAfter() time.Duration {
+	if staleAfter := 3 * s.interval; staleAfter > recurringServiceStaleFloor {
+		return staleAfter
+	}
+	return recurringServiceStaleFloor
+}
+
 func (s *RecurringService) tick() {
+	failed := true
+	defer s.observe(time.Now(), &failed)
 	defer s.recover()
 	if err := s.service.Perform(); err != nil {
 		s.core.Log.Error("Error in RecurringService "+s.name()+": ", err)
+		return
 	}
+	failed = false
+}
+
+func (s *RecurringService) setLastTickStart() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.lastTickStart = time.Now()
 }
 
 func (s *RecurringService) name() string {
 	return reflect.TypeOf(s.service).Elem().String()
 }
 
+func (s *RecurringService) observe(start time.Time, failed *bool) {
+	s.core.Metrics.ObserveRecurringServiceTick(s.name(), start, *failed)
+}
+
 func (s *RecurringService) recover() {
 	if r := recover(); r != nil {
 		s.core.Log.Error("Recovered in RecurringService "+s.name()+": ", r)
@@ -1,5 +1,7 @@
 package core
 
+import "time"
+
 type safeMapOpType int
 
 const (
@@ -34,6 +36,9 @@ func NewSafeMap(core *Core) *SafeMap {
 		make(chan *safeMapOp),
 	}
 
+	// Tracked for readiness checks
+	core.safeMaps = append(core.safeMaps, m)
+
 	go func() {
 		for {
 			op := <-m.ch
@@ -84,6 +89,24 @@ func (m *SafeMap) Delete(desc string, key string) {
 	m.op(safeMapDelete, desc, key, nil)
 }
 
+// Responding returns false if the SafeMap goroutine does not handle an op
+// within timeout.
+func (m *SafeMap) Responding(timeout time.Duration) bool {
+	// Buffered so the goroutine doesn't block on return if we've timed out
+	ch := make(chan interface{}, 1)
+	select {
+	case m.ch <- &safeMapOp{returnChannel: ch, opType: safeMapGet}:
+	case <-time.After(timeout):
+		return false
+	}
+	select {
+	case <-ch:
+		return true
+	case <-time.After(timeout):
+		return false
+	}
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 // Private                                                                    //
 ////////////////////////////////////////////////////////////////////////////////
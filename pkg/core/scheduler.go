@@ -0,0 +1,183 @@
+package core
+
+import (
+	"errors"
+	"time"
+
+	"github.com/go-validator/validator"
+	"github.com/supergiant/supergiant/pkg/cron"
+	"github.com/supergiant/supergiant/pkg/model"
+)
+
+func init() {
+	validator.SetValidationFunc("cron", validateCron)
+	validator.SetValidationFunc("timezone", validateTimeZone)
+}
+
+// Scheduler starts and stops the KubeResources and Apps which have a Schedule,
+// and the Nodes of Kubes which have a NodeSchedule, when their start or stop
+// expressions match a minute since its last run. Minutes which pass while
+// Supergiant isn't running are not caught up on.
+type Scheduler struct {
+	Core *Core
+
+	// LastRun is when the Scheduler last ran successfully. The minutes after
+	// it are checked on the next run, or none on the first, so that those of a
+	// run which failed are checked again.
+	LastRun time.Time
+}
+
+func (s *Scheduler) Perform() error {
+	now := time.Now()
+	if s.LastRun.IsZero() {
+		s.LastRun = now
+		return nil
+	}
+	if err := s.run(s.LastRun, now); err != nil {
+		return err
+	}
+	s.LastRun = now
+	return nil
+}
+
+// run starts and stops everything which is due in (since, now]. Starting or
+// stopping something again is harmless, so a run which failed part way can be
+// repeated.
+func (s *Scheduler) run(since time.Time, now time.Time) error {
+	// Kubes come first, as the Actions started below save the Kube they load
+	var kubes []*model.Kube
+	if err := s.Core.DB.Find(&kubes, "node_schedule_json IS NOT NULL"); err != nil {
+		return err
+	}
+	for _, kube := range kubes {
+		var stopped bool
+		switch s.due(kube.NodeSchedule, since, now) {
+		case "start":
+			stopped = false
+		case "stop":
+			stopped = true
+		default:
+			continue
+		}
+		if stopped == kube.NodesStopped {
+			continue
+		}
+		s.Core.Log.Infof("Setting Nodes of Kube '%s' stopped to %t on schedule", kube.Name, stopped)
+		if err := s.Core.DB.Model(kube).Update("nodes_stopped", stopped); err != nil {
+			return err
+		}
+	}
+
+	var kubeResources []*model.KubeResource
+	if err := s.Core.DB.Find(&kubeResources, "schedule_json IS NOT NULL"); err != nil {
+		return err
+	}
+	for _, kubeResource := range kubeResources {
+		switch s.due(kubeResource.Schedule, since, now) {
+		case "start":
+			s.Core.Log.Infof("Starting %s '%s' in Namespace '%s' on schedule", kubeResource.Kind, kubeResource.Name, kubeResource.Namespace)
+			if err := scheduled(s.Core.KubeResources.Start(kubeResource.ID, kubeResource).Async()); err != nil {
+				return err
+			}
+		case "stop":
+			// Whatever exists is stopped, ready or not
+			s.Core.Log.Infof("Stopping %s '%s' in Namespace '%s' on schedule", kubeResource.Kind, kubeResource.Name, kubeResource.Namespace)
+			if err := scheduled(s.Core.KubeResources.Stop(kubeResource.ID, kubeResource).Async()); err != nil {
+				return err
+			}
+		}
+	}
+
+	var apps []*model.App
+	if err := s.Core.DB.Find(&apps, "schedule_json IS NOT NULL"); err != nil {
+		return err
+	}
+	for _, app := range apps {
+		switch s.due(app.Schedule, since, now) {
+		case "start":
+			s.Core.Log.Infof("Starting App '%s' on schedule", app.Name)
+			if err := scheduled(s.Core.Apps.Start(app.ID, app).Async()); err != nil {
+				return err
+			}
+		case "stop":
+			s.Core.Log.Infof("Stopping App '%s' on schedule", app.Name)
+			if err := scheduled(s.Core.Apps.Stop(app.ID, app).Async()); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// due returns "start" or "stop" if that expression of schedule matches a
+// minute in (since, now], whichever matches last (stop if both do), or "" if
+// neither does.
+func (s *Scheduler) due(schedule *model.Schedule, since time.Time, now time.Time) string {
+	if schedule == nil {
+		return ""
+	}
+	location, err := time.LoadLocation(schedule.TimeZone)
+	if err != nil {
+		s.Core.Log.Errorf("Invalid schedule time zone %q: %s", schedule.TimeZone, err)
+		return ""
+	}
+	start, err := parseCron(schedule.Start)
+	if err != nil {
+		s.Core.Log.Errorf("Invalid schedule start %q: %s", schedule.Start, err)
+		return ""
+	}
+	stop, err := parseCron(schedule.Stop)
+	if err != nil {
+		s.Core.Log.Errorf("Invalid schedule stop %q: %s", schedule.Stop, err)
+		return ""
+	}
+
+	due := ""
+	for minute := since.Truncate(time.Minute).Add(time.Minute); !minute.After(now); minute = minute.Add(time.Minute) {
+		t := minute.In(location)
+		if start != nil && start.Matches(t) {
+			due = "start"
+		}
+		if stop != nil && stop.Matches(t) {
+			due = "stop"
+		}
+	}
+	return due
+}
+
+// scheduled returns the error of starting an Action on schedule, which is
+// none if the same Action is already running.
+func scheduled(err error) error {
+	if _, running := err.(*RepeatedActionError); running {
+		return nil
+	}
+	return err
+}
+
+// parseCron parses expr, which is nil if empty.
+func parseCron(expr string) (*cron.Expression, error) {
+	if expr == "" {
+		return nil, nil
+	}
+	return cron.Parse(expr)
+}
+
+func validateCron(v interface{}, _ string) error {
+	expr, ok := v.(string)
+	if !ok {
+		return validator.ErrUnsupported
+	}
+	_, err := parseCron(expr)
+	return err
+}
+
+func validateTimeZone(v interface{}, _ string) error {
+	name, ok := v.(string)
+	if !ok {
+		return validator.ErrUnsupported
+	}
+	if _, err := time.LoadLocation(name); err != nil {
+		return errors.New("unknown time zone " + name)
+	}
+	return nil
+}
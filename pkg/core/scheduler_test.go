@@ -0,0 +1,71 @@
+package core_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/supergiant/supergiant/pkg/core"
+	"github.com/supergiant/supergiant/pkg/model"
+	"github.com/supergiant/supergiant/test/fake_core"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestSchedulerPerform(t *testing.T) {
+	Convey("Scheduler Perform stops whatever is due, and checks the minutes of a failed run again", t, func() {
+		kubeResource := &model.KubeResource{
+			Kind:      "Deployment",
+			Namespace: "staging",
+			Name:      "web",
+			Schedule:  &model.Schedule{Stop: "* * * * *", TimeZone: "UTC"},
+			// Not known to be started, e.g. since it never became ready
+			Started: false,
+		}
+
+		var findErr error
+		var stopped []string
+
+		c := &core.Core{
+			Log: logrus.New(),
+
+			DB: &fake_core.DB{
+				FindFn: func(out interface{}, where ...interface{}) error {
+					if reflect.TypeOf(out).String() == "*[]*model.KubeResource" {
+						if findErr != nil {
+							return findErr
+						}
+						reflect.ValueOf(out).Elem().Set(reflect.ValueOf([]*model.KubeResource{kubeResource}))
+					}
+					return nil
+				},
+			},
+
+			KubeResources: &fake_core.KubeResources{
+				StopFn: func(_ *int64, m *model.KubeResource) core.ActionInterface {
+					return &fake_core.Action{
+						AsyncFn: func() error {
+							stopped = append(stopped, m.Name)
+							return nil
+						},
+					}
+				},
+			},
+		}
+
+		lastRun := time.Now().Add(-time.Minute)
+		scheduler := &core.Scheduler{Core: c, LastRun: lastRun}
+
+		findErr = errors.New("database is locked")
+		So(scheduler.Perform(), ShouldResemble, findErr)
+		So(stopped, ShouldBeNil)
+		So(scheduler.LastRun, ShouldResemble, lastRun)
+
+		findErr = nil
+		So(scheduler.Perform(), ShouldBeNil)
+		So(stopped, ShouldResemble, []string{"web"})
+		So(scheduler.LastRun.After(lastRun), ShouldBeTrue)
+	})
+}
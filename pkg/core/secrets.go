@@ -0,0 +1,289 @@
+package core
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/supergiant/supergiant/pkg/kubernetes"
+	"github.com/supergiant/supergiant/pkg/model"
+)
+
+// secretKeyRegexp matches the keys Kubernetes allows in the data of Secrets and
+// ConfigMaps.
+var secretKeyRegexp = regexp.MustCompile(`^[-._a-zA-Z0-9]+$`)
+
+// rollingKinds are the kinds of KubeResources which are restarted by a rolling
+// update when their Pod template changes.
+var rollingKinds = map[string]bool{
+	"Deployment":  true,
+	"StatefulSet": true,
+	"DaemonSet":   true,
+}
+
+type Secrets struct {
+	Collection
+}
+
+// Create encrypts the Data of a Secret, and provisions it in its Kube.
+func (c *Secrets) Create(m *model.Secret) error {
+	if err := c.setData(m); err != nil {
+		return err
+	}
+	m.Version = 1
+	if err := c.Collection.Create(m); err != nil {
+		return err
+	}
+	return c.Provision(m.ID, m).Async()
+}
+
+// Update rotates a Secret if its Data is set, provisioning the new version, and
+// restarting the KubeResources which use it.
+func (c *Secrets) Update(id *int64, m *model.Secret) error {
+	rotate := m.Data != nil
+	if rotate {
+		if err := c.setData(m); err != nil {
+			return err
+		}
+	}
+	if err := c.Collection.merge(id, new(model.Secret), m); err != nil {
+		return err
+	}
+	if !rotate {
+		return c.Core.DB.Save(m)
+	}
+
+	now := time.Now()
+	m.Version++
+	m.RotatedAt = &now
+	m.Ready = false
+	if err := c.Core.DB.Save(m); err != nil {
+		return err
+	}
+	return c.Provision(id, m).Async()
+}
+
+// Provision creates or updates the resource of a Secret in its Kube, and, once
+// the Secret has been rotated, restarts the KubeResources which use it.
+func (c *Secrets) Provision(id *int64, m *model.Secret) ActionInterface {
+	return &Action{
+		Status: &model.ActionStatus{
+			Description: "provisioning",
+			MaxRetries:  5,
+		},
+		Core:  c.Core,
+		Scope: c.Core.DB.Preload("Kube"),
+		Model: m,
+		ID:    id,
+		Fn: func(a *Action) error {
+			if err := c.apply(m); err != nil {
+				return err
+			}
+			if m.Version > 1 {
+				if err := c.restartConsumers(m); err != nil {
+					return err
+				}
+			}
+			return c.Core.DB.Model(m).Update("ready", true)
+		},
+	}
+}
+
+func (c *Secrets) Delete(id *int64, m *model.Secret) ActionInterface {
+	return &Action{
+		Status: &model.ActionStatus{
+			Description: "deleting",
+			MaxRetries:  5,
+		},
+		Core:           c.Core,
+		Scope:          c.Core.DB.Preload("Kube"),
+		Model:          m,
+		ID:             id,
+		CancelExisting: true,
+		Fn: func(a *Action) error {
+			err := c.Core.K8S(m.Kube).DeleteResource("v1", m.Kind, m.Namespace, m.Name)
+			if err != nil && !kubernetes.IsNotFound(err) {
+				return err
+			}
+			return c.Collection.Delete(id, m)
+		},
+	}
+}
+
+// Consumers returns the KubeResources which use a Secret, i.e. whose Template
+// refers to it by name, e.g. with secretKeyRef.
+func (c *Secrets) Consumers(m *model.Secret) ([]*model.KubeResource, error) {
+	var kubeResources []*model.KubeResource
+	if err := c.Core.DB.Find(&kubeResources, "kube_name = ? AND namespace = ?", m.KubeName, m.Namespace); err != nil {
+		return nil, err
+	}
+	var consumers []*model.KubeResource
+	for _, kubeResource := range kubeResources {
+		if kubeResource.Template == nil {
+			continue
+		}
+		var template interface{}
+		if err := json.Unmarshal(*kubeResource.Template, &template); err != nil {
+			return nil, err
+		}
+		if refersToSecret(template, m) {
+			consumers = append(consumers, kubeResource)
+		}
+	}
+	return consumers, nil
+}
+
+// Private
+
+// setData validates the Data of a Secret, and moves it to EncryptedData, so
+// that it is neither stored nor returned in the clear.
+func (c *Secrets) setData(m *model.Secret) error {
+	if len(m.Data) == 0 {
+		return &ErrorValidationFailed{errors.New("Data: must have at least one key")}
+	}
+	var keys []string
+	for key := range m.Data {
+		if !secretKeyRegexp.MatchString(key) {
+			return &ErrorValidationFailed{fmt.Errorf("Data: invalid key %q", key)}
+		}
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	data, err := json.Marshal(m.Data)
+	if err != nil {
+		return err
+	}
+	encrypted, err := c.Core.encrypt(data)
+	if err != nil {
+		return &ErrorValidationFailed{err}
+	}
+
+	m.EncryptedData = encrypted
+	m.Keys = keys
+	m.Data = nil
+	return nil
+}
+
+// apply creates the resource of a Secret in its Kube, or replaces it with the
+// current Data if it exists.
+func (c *Secrets) apply(m *model.Secret) error {
+	plaintext, err := c.Core.decrypt(m.EncryptedData)
+	if err != nil {
+		return err
+	}
+	var data map[string]string
+	if err := json.Unmarshal(plaintext, &data); err != nil {
+		return err
+	}
+
+	resource := map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       m.Kind,
+		"metadata": map[string]interface{}{
+			"namespace": m.Namespace,
+			"name":      m.Name,
+			"annotations": map[string]interface{}{
+				secretVersionAnnotation(m): strconv.Itoa(m.Version),
+			},
+		},
+	}
+	if m.Kind == "ConfigMap" {
+		resource["data"] = data
+	} else {
+		encoded := make(map[string]string, len(data))
+		for key, value := range data {
+			encoded[key] = base64.StdEncoding.EncodeToString([]byte(value))
+		}
+		resource["type"] = "Opaque"
+		resource["data"] = encoded
+	}
+
+	k8s := c.Core.K8S(m.Kube)
+	var out json.RawMessage
+	err = k8s.UpdateResource("v1", m.Kind, m.Namespace, m.Name, resource, &out)
+	if err == nil || !kubernetes.IsNotFound(err) {
+		return err
+	}
+	if err := k8s.EnsureNamespace(m.Namespace); err != nil {
+		return err
+	}
+	return k8s.CreateResource("v1", m.Kind, m.Namespace, resource, &out)
+}
+
+// restartConsumers triggers a rolling update of the started KubeResources
+// which use a rotated Secret, by annotating their Pod template with its
+// Version. Pods, and other kinds which aren't updated this way, keep the old
+// version until they are restarted.
+func (c *Secrets) restartConsumers(m *model.Secret) error {
+	consumers, err := c.Consumers(m)
+	if err != nil {
+		return err
+	}
+	k8s := c.Core.K8S(m.Kube)
+	for _, kubeResource := range consumers {
+		if !kubeResource.Started || !rollingKinds[kubeResource.Kind] {
+			continue
+		}
+		c.Core.Log.Infof("Restarting %s '%s' in Namespace '%s' for version %d of %s '%s'", kubeResource.Kind, kubeResource.Name, kubeResource.Namespace, m.Version, m.Kind, m.Name)
+		patch := map[string]interface{}{
+			"spec": map[string]interface{}{
+				"template": map[string]interface{}{
+					"metadata": map[string]interface{}{
+						"annotations": map[string]interface{}{
+							secretVersionAnnotation(m): strconv.Itoa(m.Version),
+						},
+					},
+				},
+			},
+		}
+		var out json.RawMessage
+		if err := k8s.PatchResource(kubeResource.APIVersion(), kubeResource.Kind, kubeResource.Namespace, kubeResource.Name, patch, &out); err != nil && !kubernetes.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// secretVersionAnnotation returns the annotation of the Version of a Secret,
+// e.g. "supergiant.io/secret.mongo".
+func secretVersionAnnotation(m *model.Secret) string {
+	return "supergiant.io/" + strings.ToLower(m.Kind) + "." + m.Name
+}
+
+// refersToSecret returns whether obj (decoded from JSON) refers to the Secret
+// (or ConfigMap) m by name, as env (secretKeyRef, secretRef), or as a volume
+// (secret, including as a projected source).
+func refersToSecret(obj interface{}, m *model.Secret) bool {
+	refKeys := []string{"secretKeyRef", "secretRef", "secret"}
+	if m.Kind == "ConfigMap" {
+		refKeys = []string{"configMapKeyRef", "configMapRef", "configMap"}
+	}
+
+	switch obj := obj.(type) {
+	case map[string]interface{}:
+		for _, key := range refKeys {
+			if ref, ok := obj[key].(map[string]interface{}); ok && (ref["name"] == m.Name || ref["secretName"] == m.Name) {
+				return true
+			}
+		}
+		for _, value := range obj {
+			if refersToSecret(value, m) {
+				return true
+			}
+		}
+	case []interface{}:
+		for _, value := range obj {
+			if refersToSecret(value, m) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -11,3 +11,9 @@ func (c *Users) RegenerateAPIToken(id *int64, m *model.User) error {
 	m.GenerateAPIToken()
 	return c.Core.DB.Model(m).Update("api_token", m.APIToken)
 }
+
+func (c *Users) RegenerateProxyToken(id *int64, m *model.User) error {
+	m.ID = id
+	m.GenerateProxyToken()
+	return c.Core.DB.Model(m).Update("proxy_token", m.ProxyToken)
+}
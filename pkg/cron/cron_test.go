@@ -0,0 +1,71 @@
+package cron_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/supergiant/supergiant/pkg/cron"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestExpressionMatches(t *testing.T) {
+	Convey("Expressions match the minutes they describe", t, func() {
+		// A Monday
+		monday := time.Date(2017, time.March, 6, 20, 0, 0, 0, time.UTC)
+
+		table := []struct {
+			// Input
+			expr string
+			t    time.Time
+			// Expectations
+			matches bool
+		}{
+			{expr: "* * * * *", t: monday, matches: true},
+			{expr: "0 20 * * *", t: monday, matches: true},
+			{expr: "0 20 * * *", t: monday.Add(time.Minute), matches: false},
+			{expr: "0 20 * * mon-fri", t: monday, matches: true},
+			{expr: "0 20 * * mon-fri", t: monday.AddDate(0, 0, 5), matches: false},
+			{expr: "0 20 * * 7", t: monday.AddDate(0, 0, 6), matches: true},
+			{expr: "*/15 * * * *", t: monday.Add(45 * time.Minute), matches: true},
+			{expr: "*/15 * * * *", t: monday.Add(50 * time.Minute), matches: false},
+			{expr: "5/15 * * * *", t: monday.Add(50 * time.Minute), matches: true},
+			{expr: "0 8,20 * mar *", t: monday, matches: true},
+			{expr: "0 8,20 * apr *", t: monday, matches: false},
+			// Day of month or day of week, when both are restricted
+			{expr: "0 20 1 * mon", t: monday, matches: true},
+			{expr: "0 20 6 * sun", t: monday, matches: true},
+			{expr: "0 20 1 * sun", t: monday, matches: false},
+			{expr: "@daily", t: monday.Add(4 * time.Hour), matches: true},
+		}
+
+		for _, item := range table {
+			expr, err := cron.Parse(item.expr)
+			So(err, ShouldBeNil)
+			So(expr.Matches(item.t), ShouldEqual, item.matches)
+		}
+	})
+}
+
+func TestParseErrors(t *testing.T) {
+	Convey("Parse rejects invalid expressions", t, func() {
+		table := []struct {
+			// Input
+			expr string
+			// Expectations
+			err string
+		}{
+			{expr: "0 20 * *", err: `cron expression "0 20 * *" must have 5 fields`},
+			{expr: "60 * * * *", err: `invalid value "60" in minute field`},
+			{expr: "0 20-8 * * *", err: `invalid range "20-8" in hour field`},
+			{expr: "*/0 * * * *", err: `invalid step "0" in minute field`},
+			{expr: "0 0 * * funday", err: `invalid value "funday" in day of week field`},
+		}
+
+		for _, item := range table {
+			_, err := cron.Parse(item.expr)
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, item.err)
+		}
+	})
+}
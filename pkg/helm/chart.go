@@ -0,0 +1,170 @@
+package helm
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ghodss/yaml"
+)
+
+// Chart is a Helm chart, loaded from a chart directory or tarball. Dependencies
+// (charts/ and requirements.yaml) are not supported.
+type Chart struct {
+	Metadata *Metadata
+
+	// Values are the defaults of values.yaml.
+	Values map[string]interface{}
+
+	// Templates are the files of templates/, by path (e.g.
+	// "templates/deployment.yaml").
+	Templates map[string]string
+
+	// Files are the other files of the chart, e.g. config files to put in a
+	// ConfigMap.
+	Files Files
+}
+
+// Metadata is the Chart.yaml of a chart, which templates access as .Chart.
+type Metadata struct {
+	Name        string `json:"name"`
+	Version     string `json:"version"`
+	AppVersion  string `json:"appVersion,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// LoadChart loads the chart at path, which is a chart directory or a tarball
+// (.tgz or .tar.gz) as made by `helm package`.
+func LoadChart(path string) (*Chart, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	var files map[string][]byte
+	if info.IsDir() {
+		files, err = loadDir(path)
+	} else {
+		files, err = loadTarball(path)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return newChart(files)
+}
+
+// Private
+
+func newChart(files map[string][]byte) (*Chart, error) {
+	chartYAML, ok := files["Chart.yaml"]
+	if !ok {
+		return nil, errors.New("Chart.yaml not found")
+	}
+	chart := &Chart{
+		Metadata:  new(Metadata),
+		Values:    make(map[string]interface{}),
+		Templates: make(map[string]string),
+		Files:     make(Files),
+	}
+	if err := yaml.Unmarshal(chartYAML, chart.Metadata); err != nil {
+		return nil, fmt.Errorf("Error parsing Chart.yaml: %s", err)
+	}
+	if chart.Metadata.Name == "" || chart.Metadata.Version == "" {
+		return nil, errors.New("Chart.yaml must have a name and version")
+	}
+
+	if valuesYAML, ok := files["values.yaml"]; ok {
+		if err := yaml.Unmarshal(valuesYAML, &chart.Values); err != nil {
+			return nil, fmt.Errorf("Error parsing values.yaml: %s", err)
+		}
+		if chart.Values == nil {
+			chart.Values = make(map[string]interface{})
+		}
+	}
+
+	for name, data := range files {
+		switch {
+		case strings.HasPrefix(name, "templates/"):
+			chart.Templates[name] = string(data)
+		case name != "Chart.yaml" && name != "values.yaml":
+			chart.Files[name] = data
+		}
+	}
+	return chart, nil
+}
+
+func loadDir(dir string) (map[string][]byte, error) {
+	files := make(map[string][]byte)
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		name, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		files[filepath.ToSlash(name)] = data
+		return nil
+	})
+	return files, err
+}
+
+// loadTarball reads the files of a chart tarball, which are all in a directory
+// named after the chart.
+func loadTarball(path string) (map[string][]byte, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	gz, err := gzip.NewReader(file)
+	if err != nil {
+		return nil, err
+	}
+	defer gz.Close()
+
+	files := make(map[string][]byte)
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		if !header.FileInfo().Mode().IsRegular() {
+			continue
+		}
+		parts := strings.SplitN(strings.TrimPrefix(header.Name, "./"), "/", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		data, err := ioutil.ReadAll(tr)
+		if err != nil {
+			return nil, err
+		}
+		files[parts[1]] = data
+	}
+	return files, nil
+}
+
+// templateNames returns the paths of the templates of c in order.
+func (c *Chart) templateNames() []string {
+	var names []string
+	for name := range c.Templates {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,167 @@
+package helm_test
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/supergiant/supergiant/pkg/helm"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+var testChartFiles = map[string]string{
+	"Chart.yaml": `name: web
+version: 0.1.0
+appVersion: "1.13"
+`,
+	"values.yaml": `replicas: 1
+image:
+  repository: nginx
+  tag: stable
+service:
+  enabled: true
+  port: 80
+`,
+	"templates/_helpers.tpl": `{{- define "web.fullname" -}}
+{{- printf "%s-%s" .Release.Name .Chart.Name | trunc 24 | trimSuffix "-" -}}
+{{- end -}}
+{{- define "web.labels" -}}
+app: {{ template "web.fullname" . }}
+release: {{ .Release.Name }}
+{{- end -}}`,
+	"templates/deployment.yaml": `apiVersion: extensions/v1beta1
+kind: Deployment
+metadata:
+  name: {{ include "web.fullname" . }}
+  labels:
+{{ include "web.labels" . | indent 4 }}
+spec:
+  replicas: {{ .Values.replicas }}
+  template:
+    metadata:
+      labels: {{- include "web.labels" . | nindent 8 }}
+    spec:
+      containers:
+      - name: web
+        image: "{{ .Values.image.repository }}:{{ .Values.image.tag | default .Chart.AppVersion }}"
+        {{- with .Values.resources }}
+        resources:
+{{ toYaml . | indent 10 }}
+        {{- end }}
+`,
+	"templates/service.yaml": `{{- if .Values.service.enabled -}}
+apiVersion: v1
+kind: Service
+metadata:
+  name: {{ include "web.fullname" . }}
+spec:
+  ports:
+  - port: {{ .Values.service.port }}
+{{- end -}}
+`,
+	"templates/config.yaml": `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: {{ include "web.fullname" . }}-config
+data:
+  revision: {{ .Release.Revision | quote }}
+  upgrade: {{ .Release.IsUpgrade | quote }}
+---
+# Comments only
+`,
+	"templates/NOTES.txt": `Visit {{ .Release.Name }}`,
+}
+
+// writeTestChart writes the test chart into dir/web.
+func writeTestChart(dir string) string {
+	chartDir := filepath.Join(dir, "web")
+	for name, data := range testChartFiles {
+		path := filepath.Join(chartDir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			panic(err)
+		}
+		if err := ioutil.WriteFile(path, []byte(data), 0644); err != nil {
+			panic(err)
+		}
+	}
+	return chartDir
+}
+
+// writeTestChartTarball writes the test chart as dir/web-0.1.0.tgz, as made by
+// `helm package`.
+func writeTestChartTarball(dir string) string {
+	path := filepath.Join(dir, "web-0.1.0.tgz")
+	file, err := os.Create(path)
+	if err != nil {
+		panic(err)
+	}
+	defer file.Close()
+	gz := gzip.NewWriter(file)
+	defer gz.Close()
+	tw := tar.NewWriter(gz)
+	defer tw.Close()
+
+	for name, data := range testChartFiles {
+		header := &tar.Header{Name: "web/" + name, Mode: 0644, Size: int64(len(data)), Typeflag: tar.TypeReg}
+		if err := tw.WriteHeader(header); err != nil {
+			panic(err)
+		}
+		if _, err := tw.Write([]byte(data)); err != nil {
+			panic(err)
+		}
+	}
+	return path
+}
+
+func TestLoadChart(t *testing.T) {
+	Convey("LoadChart loads chart directories and tarballs", t, func() {
+		dir, err := ioutil.TempDir("", "chart")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(dir)
+
+		So(os.MkdirAll(filepath.Join(dir, "empty"), 0755), ShouldBeNil)
+
+		table := []struct {
+			// Input
+			path string
+			// Expectations
+			metadata  *helm.Metadata
+			templates int
+			err       error
+		}{
+			{
+				path:      writeTestChart(dir),
+				metadata:  &helm.Metadata{Name: "web", Version: "0.1.0", AppVersion: "1.13"},
+				templates: 5,
+			},
+			{
+				path:      writeTestChartTarball(dir),
+				metadata:  &helm.Metadata{Name: "web", Version: "0.1.0", AppVersion: "1.13"},
+				templates: 5,
+			},
+			{
+				path: filepath.Join(dir, "empty"),
+				err:  errors.New("Chart.yaml not found"),
+			},
+		}
+
+		for _, item := range table {
+			chart, err := helm.LoadChart(item.path)
+			So(err, ShouldResemble, item.err)
+			if err != nil {
+				continue
+			}
+			So(chart.Metadata, ShouldResemble, item.metadata)
+			So(chart.Templates, ShouldHaveLength, item.templates)
+			So(chart.Values["image"], ShouldResemble, map[string]interface{}{"repository": "nginx", "tag": "stable"})
+		}
+
+		_, err = helm.LoadChart(filepath.Join(dir, "nope"))
+		So(os.IsNotExist(err), ShouldBeTrue)
+	})
+}
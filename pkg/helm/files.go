@@ -0,0 +1,84 @@
+package helm
+
+import (
+	"encoding/base64"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/ghodss/yaml"
+)
+
+// Files are the files of a chart other than Chart.yaml, values.yaml and its
+// templates, by path, which templates access as .Files.
+type Files map[string][]byte
+
+// Get returns the content of the file at name, or an empty string if there is
+// none.
+func (f Files) Get(name string) string {
+	return string(f.GetBytes(name))
+}
+
+// GetBytes returns the content of the file at name, or nil if there is none.
+func (f Files) GetBytes(name string) []byte {
+	return f[name]
+}
+
+// Glob returns the files whose paths match pattern, as matched by path.Match.
+func (f Files) Glob(pattern string) Files {
+	matched := make(Files)
+	for name, data := range f {
+		if ok, _ := path.Match(pattern, name); ok {
+			matched[name] = data
+		}
+	}
+	return matched
+}
+
+// Lines returns the lines of the file at name.
+func (f Files) Lines(name string) []string {
+	data := strings.TrimSuffix(f.Get(name), "\n")
+	if data == "" {
+		return []string{}
+	}
+	return strings.Split(data, "\n")
+}
+
+// AsConfig returns the files as the YAML data of a ConfigMap, keyed by their
+// base names.
+func (f Files) AsConfig() string {
+	data := make(map[string]string)
+	for _, name := range f.names() {
+		data[path.Base(name)] = string(f[name])
+	}
+	return toYAML(data)
+}
+
+// AsSecrets returns the files as the YAML data of a Secret, keyed by their base
+// names.
+func (f Files) AsSecrets() string {
+	data := make(map[string]string)
+	for _, name := range f.names() {
+		data[path.Base(name)] = base64.StdEncoding.EncodeToString(f[name])
+	}
+	return toYAML(data)
+}
+
+// Private
+
+func (f Files) names() []string {
+	var names []string
+	for name := range f {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func toYAML(v interface{}) string {
+	out, err := yaml.Marshal(v)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSuffix(string(out), "\n")
+}
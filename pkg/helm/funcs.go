@@ -0,0 +1,234 @@
+package helm
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/ghodss/yaml"
+	"github.com/supergiant/supergiant/pkg/util"
+)
+
+// maxIncludeDepth is how deeply include and tpl can be nested, as in Helm,
+// so that a template which includes itself fails instead of overflowing the
+// stack.
+const maxIncludeDepth = 1000
+
+// funcMap returns the functions available to the templates of t: include,
+// tpl and required as in Helm, and the Sprig functions charts commonly use.
+// As in Sprig, the value piped into a function is its last argument. Charts
+// using any other function are rejected when they are parsed.
+func funcMap(t *template.Template) template.FuncMap {
+	// depthErr is returned as it is by the include or tpl it fails, rather
+	// than wrapped by every one it is nested in.
+	depth := 0
+	var depthErr error
+	execute := func(t *template.Template, name string, data interface{}) (string, error) {
+		if depth >= maxIncludeDepth {
+			depthErr = fmt.Errorf("%q is nested more than %d times", name, maxIncludeDepth)
+			return "", depthErr
+		}
+		depth++
+		defer func() { depth-- }()
+
+		var buf bytes.Buffer
+		if err := t.ExecuteTemplate(&buf, name, data); err != nil {
+			if depthErr != nil {
+				return "", depthErr
+			}
+			return "", err
+		}
+		return buf.String(), nil
+	}
+
+	return template.FuncMap{
+		"include": func(name string, data interface{}) (string, error) {
+			return execute(t, name, data)
+		},
+		"tpl": func(text string, data interface{}) (string, error) {
+			tt, err := t.Clone()
+			if err != nil {
+				return "", err
+			}
+			if _, err := tt.New("tpl").Parse(text); err != nil {
+				return "", err
+			}
+			return execute(tt, "tpl", data)
+		},
+		"required": func(msg string, v interface{}) (interface{}, error) {
+			if empty(v) {
+				return nil, errors.New(msg)
+			}
+			return v, nil
+		},
+		"fail": func(msg string) (string, error) {
+			return "", errors.New(msg)
+		},
+
+		// Defaults and conditions
+		"default": func(d interface{}, v ...interface{}) interface{} {
+			if len(v) == 0 || empty(v[0]) {
+				return d
+			}
+			return v[0]
+		},
+		"empty": empty,
+		"coalesce": func(v ...interface{}) interface{} {
+			for _, value := range v {
+				if !empty(value) {
+					return value
+				}
+			}
+			return nil
+		},
+		"ternary": func(t interface{}, f interface{}, cond bool) interface{} {
+			if cond {
+				return t
+			}
+			return f
+		},
+
+		// Strings
+		"toString":   toString,
+		"quote":      func(v interface{}) string { return strconv.Quote(toString(v)) },
+		"squote":     func(v interface{}) string { return "'" + toString(v) + "'" },
+		"upper":      strings.ToUpper,
+		"lower":      strings.ToLower,
+		"title":      strings.Title,
+		"trim":       strings.TrimSpace,
+		"trimSuffix": func(suffix string, s string) string { return strings.TrimSuffix(s, suffix) },
+		"trimPrefix": func(prefix string, s string) string { return strings.TrimPrefix(s, prefix) },
+		"trunc":      trunc,
+		"replace":    func(old string, new string, s string) string { return strings.Replace(s, old, new, -1) },
+		"contains":   func(substr string, s string) bool { return strings.Contains(s, substr) },
+		"hasPrefix":  func(prefix string, s string) bool { return strings.HasPrefix(s, prefix) },
+		"hasSuffix":  func(suffix string, s string) bool { return strings.HasSuffix(s, suffix) },
+		"repeat":     func(count int, s string) string { return strings.Repeat(s, count) },
+		"indent":     indent,
+		"nindent":    func(spaces int, s string) string { return "\n" + indent(spaces, s) },
+		"join":       join,
+		"splitList":  func(sep string, s string) []string { return strings.Split(s, sep) },
+		"b64enc":     func(s string) string { return base64.StdEncoding.EncodeToString([]byte(s)) },
+		"b64dec": func(s string) (string, error) {
+			out, err := base64.StdEncoding.DecodeString(s)
+			return string(out), err
+		},
+		"sha256sum": func(s string) string {
+			sum := sha256.Sum256([]byte(s))
+			return hex.EncodeToString(sum[:])
+		},
+		"randAlphaNum": util.RandomString,
+
+		// Serialization
+		"toYaml": func(v interface{}) (string, error) {
+			out, err := yaml.Marshal(v)
+			return strings.TrimSuffix(string(out), "\n"), err
+		},
+		"toJson": func(v interface{}) (string, error) {
+			out, err := json.Marshal(v)
+			return string(out), err
+		},
+
+		// Lists and dicts
+		"list": func(v ...interface{}) []interface{} { return v },
+		"hasKey": func(d map[string]interface{}, key string) bool {
+			_, ok := d[key]
+			return ok
+		},
+		"dict": func(v ...interface{}) (map[string]interface{}, error) {
+			if len(v)%2 != 0 {
+				return nil, errors.New("dict requires an even number of arguments")
+			}
+			d := make(map[string]interface{})
+			for i := 0; i < len(v); i += 2 {
+				d[toString(v[i])] = v[i+1]
+			}
+			return d, nil
+		},
+
+		// Numbers, which are float64 when they come from YAML
+		"int":   toInt,
+		"int64": func(v interface{}) int64 { return int64(toInt(v)) },
+		"add":   func(a interface{}, b interface{}) int { return toInt(a) + toInt(b) },
+		"sub":   func(a interface{}, b interface{}) int { return toInt(a) - toInt(b) },
+		"mul":   func(a interface{}, b interface{}) int { return toInt(a) * toInt(b) },
+		"div":   func(a interface{}, b interface{}) int { return toInt(a) / toInt(b) },
+	}
+}
+
+// empty returns whether v is nil or the zero value of its type, or an empty
+// collection.
+func empty(v interface{}) bool {
+	if v == nil {
+		return true
+	}
+	value := reflect.ValueOf(v)
+	switch value.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return value.Len() == 0
+	case reflect.Ptr, reflect.Interface:
+		return value.IsNil()
+	}
+	return reflect.DeepEqual(v, reflect.Zero(value.Type()).Interface())
+}
+
+func toString(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case []byte:
+		return string(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	}
+	return fmt.Sprint(v)
+}
+
+func toInt(v interface{}) int {
+	switch v := v.(type) {
+	case int:
+		return v
+	case int64:
+		return int(v)
+	case float64:
+		return int(v)
+	case string:
+		i, _ := strconv.Atoi(v)
+		return i
+	}
+	return 0
+}
+
+func trunc(n int, s string) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n]
+}
+
+func indent(spaces int, s string) string {
+	pad := strings.Repeat(" ", spaces)
+	return pad + strings.Replace(s, "\n", "\n"+pad, -1)
+}
+
+func join(sep string, v interface{}) string {
+	value := reflect.ValueOf(v)
+	if value.Kind() != reflect.Slice && value.Kind() != reflect.Array {
+		return toString(v)
+	}
+	var strs []string
+	for i := 0; i < value.Len(); i++ {
+		strs = append(strs, toString(value.Index(i).Interface()))
+	}
+	return strings.Join(strs, sep)
+}
@@ -0,0 +1,206 @@
+package helm
+
+import (
+	"bytes"
+	"fmt"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/supergiant/supergiant/pkg/kubernetes"
+)
+
+// Release is the release a chart is rendered for, which templates access as
+// .Release.
+type Release struct {
+	Name      string
+	Namespace string
+	Revision  int
+	IsInstall bool
+	IsUpgrade bool
+	Service   string
+}
+
+// Render renders the templates of c for release, with values overriding the
+// defaults of the chart, and returns the manifests in the order they should be
+// created in. Templates whose names start with an underscore only define named
+// templates, for use with include.
+func (c *Chart) Render(release *Release, values map[string]interface{}) ([]map[string]interface{}, error) {
+	mergedValues := MergeValues(c.Values, values)
+
+	t, err := c.parse()
+	if err != nil {
+		return nil, err
+	}
+
+	var manifests []map[string]interface{}
+	for _, name := range c.templateNames() {
+		if strings.HasPrefix(path.Base(name), "_") || !isManifestFile(name) {
+			continue
+		}
+		data := map[string]interface{}{
+			"Values":  mergedValues,
+			"Release": release,
+			"Chart":   c.Metadata,
+			"Files":   c.Files,
+			"Template": map[string]interface{}{
+				"Name":     c.Metadata.Name + "/" + name,
+				"BasePath": c.Metadata.Name + "/templates",
+			},
+		}
+		var buf bytes.Buffer
+		if err := t.ExecuteTemplate(&buf, name, data); err != nil {
+			return nil, err
+		}
+		rendered := strings.Replace(buf.String(), "<no value>", "", -1)
+
+		docs, err := splitManifests(rendered)
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing %s: %s", name, err)
+		}
+		manifests = append(manifests, docs...)
+	}
+
+	sort.SliceStable(manifests, func(i, j int) bool {
+		return installOrder(manifests[i]) < installOrder(manifests[j])
+	})
+	return manifests, nil
+}
+
+// Validate returns an error if a template of c doesn't parse, e.g. because it
+// uses a template function which is not supported.
+func (c *Chart) Validate() error {
+	_, err := c.parse()
+	return err
+}
+
+// MergeValues returns a deep copy of defaults with values merged over it.
+// Maps are merged key by key; anything else in values replaces the default.
+func MergeValues(defaults map[string]interface{}, values map[string]interface{}) map[string]interface{} {
+	merged := make(map[string]interface{})
+	for key, value := range defaults {
+		if valueMap, ok := value.(map[string]interface{}); ok {
+			value = MergeValues(valueMap, nil)
+		}
+		merged[key] = value
+	}
+	for key, value := range values {
+		valueMap, isMap := value.(map[string]interface{})
+		defaultMap, defaultIsMap := merged[key].(map[string]interface{})
+		if isMap && defaultIsMap {
+			merged[key] = MergeValues(defaultMap, valueMap)
+			continue
+		}
+		merged[key] = value
+	}
+	return merged
+}
+
+// Private
+
+// undefinedFunctionError matches the error of text/template for a function
+// which is not in the funcMap.
+var undefinedFunctionError = regexp.MustCompile(`^template: (.*): function "(.*)" not defined$`)
+
+// parse parses the templates of c.
+func (c *Chart) parse() (*template.Template, error) {
+	t := template.New(c.Metadata.Name)
+	t.Funcs(funcMap(t))
+	for _, name := range c.templateNames() {
+		if _, err := t.New(name).Parse(c.Templates[name]); err != nil {
+			if match := undefinedFunctionError.FindStringSubmatch(err.Error()); match != nil {
+				return nil, fmt.Errorf("%s: template function %q is not supported", match[1], match[2])
+			}
+			return nil, err
+		}
+	}
+	return t, nil
+}
+
+// splitManifests parses the YAML documents of a rendered template, skipping
+// empty ones (e.g. of resources disabled by a value).
+func splitManifests(rendered string) ([]map[string]interface{}, error) {
+	manifests, err := kubernetes.DecodeManifests([]byte(rendered))
+	if err != nil {
+		return nil, err
+	}
+	for _, manifest := range manifests {
+		if manifest["apiVersion"] == nil {
+			return nil, fmt.Errorf("%v has no apiVersion", manifest["kind"])
+		}
+	}
+	return manifests, nil
+}
+
+func isManifestFile(name string) bool {
+	switch path.Ext(name) {
+	case ".yaml", ".yml", ".json":
+		return true
+	}
+	return false
+}
+
+// kindInstallOrder is the order Helm installs kinds in, so that e.g. a
+// ConfigMap exists before the Deployment which mounts it. Other kinds come
+// last.
+var kindInstallOrder = []string{
+	"Namespace",
+	"ResourceQuota",
+	"LimitRange",
+	"PodSecurityPolicy",
+	"Secret",
+	"ConfigMap",
+	"StorageClass",
+	"PersistentVolume",
+	"PersistentVolumeClaim",
+	"ServiceAccount",
+	"CustomResourceDefinition",
+	"ClusterRole",
+	"ClusterRoleBinding",
+	"Role",
+	"RoleBinding",
+	"Service",
+	"DaemonSet",
+	"Pod",
+	"ReplicationController",
+	"ReplicaSet",
+	"Deployment",
+	"StatefulSet",
+	"Job",
+	"CronJob",
+	"Ingress",
+}
+
+// clusterScopedKinds are the kinds of resources which are not in a namespace.
+var clusterScopedKinds = map[string]bool{
+	"APIService":                     true,
+	"ClusterRole":                    true,
+	"ClusterRoleBinding":             true,
+	"CustomResourceDefinition":       true,
+	"MutatingWebhookConfiguration":   true,
+	"Namespace":                      true,
+	"Node":                           true,
+	"PersistentVolume":               true,
+	"PodSecurityPolicy":              true,
+	"PriorityClass":                  true,
+	"StorageClass":                   true,
+	"ValidatingWebhookConfiguration": true,
+}
+
+// IsClusterScoped returns whether resources of kind are not in a namespace, so
+// that they are not put in the namespace of a release.
+func IsClusterScoped(kind string) bool {
+	return clusterScopedKinds[kind]
+}
+
+func installOrder(manifest map[string]interface{}) int {
+	kind, _ := manifest["kind"].(string)
+	for i, k := range kindInstallOrder {
+		if k == kind {
+			return i
+		}
+	}
+	return len(kindInstallOrder)
+}
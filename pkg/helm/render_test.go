@@ -0,0 +1,166 @@
+package helm_test
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/supergiant/supergiant/pkg/helm"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestChartRender(t *testing.T) {
+	Convey("Chart Render renders the manifests of a release", t, func() {
+		dir, err := ioutil.TempDir("", "chart")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(dir)
+
+		chart, err := helm.LoadChart(writeTestChart(dir))
+		So(err, ShouldBeNil)
+
+		table := []struct {
+			// Input
+			release *helm.Release
+			values  map[string]interface{}
+			// Expectations
+			kinds     []string
+			name      string
+			image     string
+			replicas  float64
+			resources interface{}
+			revision  string
+			isUpgrade string
+		}{
+			// Defaults
+			{
+				release:   &helm.Release{Name: "blog", Namespace: "sites", Revision: 1, IsInstall: true},
+				kinds:     []string{"ConfigMap", "Service", "Deployment"},
+				name:      "blog-web",
+				image:     "nginx:stable",
+				replicas:  1,
+				revision:  "1",
+				isUpgrade: "false",
+			},
+			// With values, which are merged over the defaults
+			{
+				release: &helm.Release{Name: "a-very-long-release-name", Namespace: "sites", Revision: 2, IsUpgrade: true},
+				values: map[string]interface{}{
+					"replicas":  3,
+					"image":     map[string]interface{}{"tag": ""},
+					"service":   map[string]interface{}{"enabled": false},
+					"resources": map[string]interface{}{"limits": map[string]interface{}{"cpu": "100m"}},
+				},
+				kinds:     []string{"ConfigMap", "Deployment"},
+				name:      "a-very-long-release-name",
+				image:     "nginx:1.13",
+				replicas:  3,
+				resources: map[string]interface{}{"limits": map[string]interface{}{"cpu": "100m"}},
+				revision:  "2",
+				isUpgrade: "true",
+			},
+		}
+
+		for _, item := range table {
+			manifests, err := chart.Render(item.release, item.values)
+			So(err, ShouldBeNil)
+
+			var kinds []string
+			for _, manifest := range manifests {
+				kinds = append(kinds, manifest["kind"].(string))
+			}
+			So(kinds, ShouldResemble, item.kinds)
+
+			config := manifests[0]
+			So(config["data"], ShouldResemble, map[string]interface{}{"revision": item.revision, "upgrade": item.isUpgrade})
+
+			deployment := manifests[len(manifests)-1]
+			metadata := deployment["metadata"].(map[string]interface{})
+			So(metadata["name"], ShouldEqual, item.name)
+			So(metadata["labels"], ShouldResemble, map[string]interface{}{"app": item.name, "release": item.release.Name})
+
+			spec := deployment["spec"].(map[string]interface{})
+			So(spec["replicas"], ShouldEqual, item.replicas)
+			podSpec := spec["template"].(map[string]interface{})["spec"].(map[string]interface{})
+			container := podSpec["containers"].([]interface{})[0].(map[string]interface{})
+			So(container["image"], ShouldEqual, item.image)
+			So(container["resources"], ShouldResemble, item.resources)
+		}
+
+		Convey("The chart defaults are not changed by values", func() {
+			_, err := chart.Render(&helm.Release{Name: "a"}, map[string]interface{}{"image": map[string]interface{}{"tag": "latest"}})
+			So(err, ShouldBeNil)
+			So(chart.Values["image"], ShouldResemble, map[string]interface{}{"repository": "nginx", "tag": "stable"})
+		})
+
+		Convey("Templates can read the other files of the chart", func() {
+			chart.Files = helm.Files{"config/nginx.conf": []byte("worker_processes 1;\n"), "config/mime.types": []byte("types {}")}
+			chart.Templates["templates/files.yaml"] = `apiVersion: v1
+kind: Secret
+metadata:
+  name: files
+data:
+  nginx: {{ .Files.Get "config/nginx.conf" | b64enc }}
+  lines: {{ .Files.Lines "config/nginx.conf" | len | quote }}
+stringData:
+{{ (.Files.Glob "config/*").AsConfig | indent 2 }}
+`
+			manifests, err := chart.Render(&helm.Release{Name: "a"}, nil)
+			So(err, ShouldBeNil)
+
+			var secret map[string]interface{}
+			for _, manifest := range manifests {
+				if manifest["kind"] == "Secret" {
+					secret = manifest
+				}
+			}
+			So(secret["data"], ShouldResemble, map[string]interface{}{"nginx": "d29ya2VyX3Byb2Nlc3NlcyAxOwo=", "lines": "1"})
+			So(secret["stringData"], ShouldResemble, map[string]interface{}{"nginx.conf": "worker_processes 1;\n", "mime.types": "types {}"})
+		})
+
+		Convey("Unsupported template functions are rejected", func() {
+			chart.Templates["templates/lookup.yaml"] = `{{ lookup "v1" "Secret" "" "" }}`
+			err := chart.Validate()
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, `templates/lookup.yaml:1: template function "lookup" is not supported`)
+
+			_, renderErr := chart.Render(&helm.Release{Name: "a"}, nil)
+			So(renderErr, ShouldResemble, err)
+		})
+
+		Convey("Template errors are returned", func() {
+			chart.Templates["templates/broken.yaml"] = `{{ required "a password is required" .Values.password }}`
+			_, err := chart.Render(&helm.Release{Name: "a"}, nil)
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, "a password is required")
+		})
+
+		Convey("Templates which include themselves fail", func() {
+			chart.Templates["templates/_loop.tpl"] = `{{ define "loop" }}{{ include "loop" . }}{{ end }}`
+			chart.Templates["templates/loop.yaml"] = `{{ include "loop" . }}`
+			_, err := chart.Render(&helm.Release{Name: "a"}, nil)
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, `"loop" is nested more than 1000 times`)
+			So(len(err.Error()), ShouldBeLessThan, 200)
+		})
+	})
+}
+
+func TestMergeValues(t *testing.T) {
+	Convey("MergeValues merges maps key by key, and replaces anything else", t, func() {
+		defaults := map[string]interface{}{
+			"a": 1,
+			"b": map[string]interface{}{"c": 2, "d": []interface{}{3}},
+		}
+		values := map[string]interface{}{
+			"b": map[string]interface{}{"d": []interface{}{4}},
+			"e": 5,
+		}
+		So(helm.MergeValues(defaults, values), ShouldResemble, map[string]interface{}{
+			"a": 1,
+			"b": map[string]interface{}{"c": 2, "d": []interface{}{4}},
+			"e": 5,
+		})
+		So(helm.MergeValues(defaults, nil), ShouldResemble, defaults)
+	})
+}
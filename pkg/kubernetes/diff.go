@@ -0,0 +1,162 @@
+package kubernetes
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// serverMetadataFields are the fields of metadata which are set by Kubernetes.
+var serverMetadataFields = []string{"uid", "selfLink", "resourceVersion", "generation", "creationTimestamp", "deletionTimestamp"}
+
+// quantityFields are the fields whose fields are quantities, such as the cpu of
+// the limits of a container, which Kubernetes returns in canonical form.
+var quantityFields = map[string]bool{"limits": true, "requests": true, "capacity": true, "hard": true}
+
+// quantitySuffixes are the multipliers of the suffixes of quantities.
+var quantitySuffixes = map[string]*big.Rat{
+	"n":  big.NewRat(1, 1000000000),
+	"u":  big.NewRat(1, 1000000),
+	"m":  big.NewRat(1, 1000),
+	"":   new(big.Rat).SetInt64(1),
+	"k":  new(big.Rat).SetInt64(1000),
+	"M":  new(big.Rat).SetInt64(1000000),
+	"G":  new(big.Rat).SetInt64(1000000000),
+	"T":  new(big.Rat).SetInt64(1000000000000),
+	"P":  new(big.Rat).SetInt64(1000000000000000),
+	"E":  new(big.Rat).SetInt64(1000000000000000000),
+	"Ki": new(big.Rat).SetInt64(1 << 10),
+	"Mi": new(big.Rat).SetInt64(1 << 20),
+	"Gi": new(big.Rat).SetInt64(1 << 30),
+	"Ti": new(big.Rat).SetInt64(1 << 40),
+	"Pi": new(big.Rat).SetInt64(1 << 50),
+	"Ei": new(big.Rat).SetInt64(1 << 60),
+}
+
+var quantityRegexp = regexp.MustCompile(`^([+-]?(?:[0-9]+\.?[0-9]*|\.[0-9]+)(?:[eE][+-]?[0-9]+)?)([a-zA-Z]*)$`)
+
+// Diff returns the paths of the fields of desired which differ in live (both
+// decoded from JSON), such as "spec.template.spec.containers[0].image", in
+// order. Fields which are only in live are ignored, since Kubernetes populates
+// defaults, as are null fields of desired, status, and the metadata set by
+// Kubernetes. The items of lists merged by key (such as containers by name) are
+// matched by it, and items only in live are ignored too. Quantities, such as
+// "0.5" and "500m" cpu, are compared by value.
+func Diff(desired map[string]interface{}, live map[string]interface{}) []string {
+	desired = copyMap(desired)
+	delete(desired, "status")
+	if metadata, ok := desired["metadata"].(map[string]interface{}); ok {
+		metadata = copyMap(metadata)
+		for _, key := range serverMetadataFields {
+			delete(metadata, key)
+		}
+		desired["metadata"] = metadata
+	}
+
+	paths := diffValue("", desired, live)
+	sort.Strings(paths)
+	return paths
+}
+
+// Private
+
+func diffValue(path string, desired interface{}, live interface{}) (paths []string) {
+	switch desired := desired.(type) {
+	case nil:
+		return nil
+
+	case map[string]interface{}:
+		liveMap, _ := live.(map[string]interface{})
+		quantities := quantityFields[lastPathField(path)]
+		for key, value := range desired {
+			keyPath := joinPath(path, key)
+			if quantities && quantitiesEqual(value, liveMap[key]) {
+				continue
+			}
+			paths = append(paths, diffValue(keyPath, value, liveMap[key])...)
+		}
+		return paths
+
+	case []interface{}:
+		liveList, _ := live.([]interface{})
+		if key := listMergeKey(path, desired, liveList); key != "" {
+			liveItems := listItemsByKey(liveList, key)
+			for i, value := range desired {
+				itemPath := fmt.Sprintf("%s[%d]", path, i)
+				liveItem, ok := liveItems[fmt.Sprint(value.(map[string]interface{})[key])]
+				if !ok {
+					paths = append(paths, itemPath)
+					continue
+				}
+				paths = append(paths, diffValue(itemPath, value, liveItem)...)
+			}
+			return paths
+		}
+		if len(desired) != len(liveList) {
+			return []string{path}
+		}
+		for i, value := range desired {
+			paths = append(paths, diffValue(fmt.Sprintf("%s[%d]", path, i), value, liveList[i])...)
+		}
+		return paths
+	}
+
+	if !reflect.DeepEqual(desired, live) {
+		return []string{path}
+	}
+	return nil
+}
+
+// lastPathField returns the last field of a path, without the index of a list
+// item, e.g. "limits" of "spec.containers[0].resources.limits".
+func lastPathField(path string) string {
+	return path[strings.LastIndex(path, ".")+1:]
+}
+
+// quantitiesEqual returns whether desired and live are quantities (strings or
+// numbers) of the same value, such as "1Gi" and "1024Mi".
+func quantitiesEqual(desired interface{}, live interface{}) bool {
+	desiredValue, ok := parseQuantity(desired)
+	if !ok {
+		return false
+	}
+	liveValue, ok := parseQuantity(live)
+	return ok && desiredValue.Cmp(liveValue) == 0
+}
+
+func parseQuantity(value interface{}) (*big.Rat, bool) {
+	var str string
+	switch value := value.(type) {
+	case string:
+		str = value
+	case float64:
+		str = strconv.FormatFloat(value, 'f', -1, 64)
+	default:
+		return nil, false
+	}
+	match := quantityRegexp.FindStringSubmatch(str)
+	if match == nil {
+		return nil, false
+	}
+	multiplier, ok := quantitySuffixes[match[2]]
+	if !ok {
+		return nil, false
+	}
+	number, ok := new(big.Rat).SetString(match[1])
+	if !ok {
+		return nil, false
+	}
+	return number.Mul(number, multiplier), true
+}
+
+func copyMap(m map[string]interface{}) map[string]interface{} {
+	out := make(map[string]interface{}, len(m))
+	for key, value := range m {
+		out[key] = value
+	}
+	return out
+}
@@ -0,0 +1,87 @@
+package kubernetes_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/supergiant/supergiant/pkg/kubernetes"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestDiff(t *testing.T) {
+	Convey("Diff returns the fields of the desired resource which differ in the live resource", t, func() {
+		table := []struct {
+			// Input
+			desired string
+			live    string
+			// Expectations
+			paths []string
+		}{
+			// Defaults, status, and metadata set by Kubernetes are ignored
+			{
+				desired: `{"kind": "Service", "metadata": {"name": "a", "resourceVersion": "1"}, "spec": {"ports": [{"port": 80}]}}`,
+				live:    `{"kind": "Service", "metadata": {"name": "a", "uid": "x", "resourceVersion": "2"}, "spec": {"type": "ClusterIP", "ports": [{"port": 80, "protocol": "TCP"}]}, "status": {"loadBalancer": {}}}`,
+				paths:   nil,
+			},
+			// Changed and removed fields, and missing list items
+			{
+				desired: `{"metadata": {"labels": {"app": "a", "tier": "web"}}, "spec": {"replicas": 2, "template": {"spec": {"containers": [{"image": "nginx:1.13"}], "volumes": [{"name": "data"}]}}}}`,
+				live:    `{"metadata": {"labels": {"app": "a"}}, "spec": {"replicas": 3, "template": {"spec": {"containers": [{"image": "nginx:1.12"}], "volumes": []}}}}`,
+				paths: []string{
+					"metadata.labels.tier",
+					"spec.replicas",
+					"spec.template.spec.containers[0].image",
+					"spec.template.spec.volumes[0]",
+				},
+			},
+			// Items of lists merged by key are matched by it, and items only in live
+			// are ignored
+			{
+				desired: `{"spec": {"containers": [{"name": "web", "image": "nginx:1.13", "ports": [{"containerPort": 80}], "env": [{"name": "B", "value": "2"}], "volumeMounts": [{"name": "data", "mountPath": "/data"}]}]}}`,
+				live:    `{"spec": {"containers": [{"name": "sidecar", "image": "envoy"}, {"name": "web", "image": "nginx:1.12", "ports": [{"containerPort": 80, "protocol": "TCP"}], "env": [{"name": "A", "value": "1"}, {"name": "B", "value": "2"}], "volumeMounts": [{"name": "token", "mountPath": "/var/run/secrets"}, {"name": "data", "mountPath": "/data"}]}]}}`,
+				paths: []string{
+					"spec.containers[0].image",
+				},
+			},
+			// Items missing from live
+			{
+				desired: `{"spec": {"ports": [{"name": "http", "port": 80}, {"name": "https", "port": 443}]}}`,
+				live:    `{"spec": {"ports": [{"name": "http", "port": 80, "protocol": "TCP"}]}}`,
+				paths: []string{
+					"spec.ports[1]",
+				},
+			},
+			// Lists which aren't merged by key differ if they have other items
+			{
+				desired: `{"kind": "RoleBinding", "subjects": [{"kind": "User", "name": "a"}]}`,
+				live:    `{"kind": "RoleBinding", "subjects": [{"kind": "User", "name": "a"}, {"kind": "User", "name": "b"}]}`,
+				paths: []string{
+					"subjects",
+				},
+			},
+			// Quantities are compared by value
+			{
+				desired: `{"spec": {"containers": [{"name": "web", "resources": {"limits": {"cpu": "0.5", "memory": "1Gi"}, "requests": {"cpu": 0.1, "memory": "512M"}}}]}}`,
+				live:    `{"spec": {"containers": [{"name": "web", "resources": {"limits": {"cpu": "500m", "memory": "1024Mi"}, "requests": {"cpu": "100m", "memory": "500M"}}}]}}`,
+				paths: []string{
+					"spec.containers[0].resources.requests.memory",
+				},
+			},
+			// Null and empty fields
+			{
+				desired: `{"spec": {"nodeName": null, "volumes": [], "selector": {}}}`,
+				live:    `{"spec": {"nodeName": "node-1"}}`,
+				paths:   nil,
+			},
+		}
+
+		for _, item := range table {
+			var desired, live map[string]interface{}
+			So(json.Unmarshal([]byte(item.desired), &desired), ShouldBeNil)
+			So(json.Unmarshal([]byte(item.live), &live), ShouldBeNil)
+
+			So(kubernetes.Diff(desired, live), ShouldResemble, item.paths)
+		}
+	})
+}
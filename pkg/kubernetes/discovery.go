@@ -0,0 +1,100 @@
+package kubernetes
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Discovery caches, per Kube, the resources listed by the discovery endpoint
+// of each API group version (e.g. /apis/extensions/v1beta1), so that request
+// paths can be built for any kind the Kube serves.
+type Discovery struct {
+	// TTL is how long a resource list is cached. A list missing the requested
+	// kind is always refetched, in case it has since been added.
+	TTL time.Duration
+
+	mutex sync.Mutex
+	lists map[string]*discoveredList
+}
+
+type discoveredList struct {
+	fetchedAt time.Time
+	resources []*APIResource
+}
+
+func NewDiscovery(ttl time.Duration) *Discovery {
+	return &Discovery{
+		TTL:   ttl,
+		lists: make(map[string]*discoveredList),
+	}
+}
+
+// Resource returns the APIResource of kind in apiVersion (e.g. "v1" or
+// "apps/v1beta1") served by the Kube of k. A nil *Discovery does not cache.
+func (d *Discovery) Resource(k *Client, apiVersion string, kind string) (*APIResource, error) {
+	key := k.Kube.Name + "/" + apiVersion
+
+	if resources := d.cached(key); resources != nil {
+		if resource := findResource(resources, kind); resource != nil {
+			return resource, nil
+		}
+	}
+
+	list := new(APIResourceList)
+	if err := k.request("GET", apiPath(apiVersion), nil, list); err != nil {
+		return nil, err
+	}
+	d.store(key, list.Resources)
+
+	if resource := findResource(list.Resources, kind); resource != nil {
+		return resource, nil
+	}
+	return nil, fmt.Errorf("K8S kind %s is not served by apiVersion %s", kind, apiVersion)
+}
+
+// Private
+
+func (d *Discovery) cached(key string) []*APIResource {
+	if d == nil {
+		return nil
+	}
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	list := d.lists[key]
+	if list == nil || time.Since(list.fetchedAt) > d.TTL {
+		return nil
+	}
+	return list.resources
+}
+
+func (d *Discovery) store(key string, resources []*APIResource) {
+	if d == nil {
+		return
+	}
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	d.lists[key] = &discoveredList{time.Now(), resources}
+}
+
+func findResource(resources []*APIResource, kind string) *APIResource {
+	for _, resource := range resources {
+		// Skip subresources, which share the Kind of their parent
+		if resource.Kind == kind && !strings.Contains(resource.Name, "/") {
+			return resource
+		}
+	}
+	return nil
+}
+
+// apiPath returns the path of an API group version; the core group (which has
+// no group name, e.g. "v1") is served under /api, and all others under /apis.
+func apiPath(apiVersion string) string {
+	if !strings.Contains(apiVersion, "/") {
+		return "/api/" + apiVersion
+	}
+	return "/apis/" + apiVersion
+}
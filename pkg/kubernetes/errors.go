@@ -0,0 +1,93 @@
+package kubernetes
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// StatusError is an error response from the Kubernetes API.
+type StatusError struct {
+	// Code is the HTTP status code.
+	Code int
+
+	// Status is decoded from the response body. If the body is not a Status,
+	// its Message is the body.
+	Status *Status
+}
+
+func (e *StatusError) Error() string {
+	status := fmt.Sprintf("%d", e.Code)
+	if text := http.StatusText(e.Code); text != "" {
+		status += " " + text
+	}
+	return fmt.Sprintf("K8S %s error: %s", status, e.Status.Message)
+}
+
+// IsNotFound returns whether err is a Kubernetes 404, e.g. of a resource which
+// does not exist.
+func IsNotFound(err error) bool {
+	return statusCode(err) == http.StatusNotFound
+}
+
+// IsConflict returns whether err is a Kubernetes 409, e.g. of a resource which
+// already exists.
+func IsConflict(err error) bool {
+	return statusCode(err) == http.StatusConflict
+}
+
+// IsForbidden returns whether err is a Kubernetes 403, i.e. the credentials of
+// the Kube are not allowed to make the request.
+func IsForbidden(err error) bool {
+	return statusCode(err) == http.StatusForbidden
+}
+
+// IsInvalid returns whether err is a Kubernetes 422, which is how invalid
+// resources, including changes to immutable fields, are rejected.
+func IsInvalid(err error) bool {
+	return statusCode(err) == http.StatusUnprocessableEntity
+}
+
+// IsImmutableFieldChange returns whether err is a Kubernetes 422 rejecting a
+// change to an immutable field, as opposed to an invalid value, which is only
+// possible by deleting and creating the resource again.
+func IsImmutableFieldChange(err error) bool {
+	if !IsInvalid(err) {
+		return false
+	}
+	status := err.(*StatusError).Status
+	if status.Details != nil {
+		for _, cause := range status.Details.Causes {
+			if strings.Contains(cause.Message, immutableFieldMessage) {
+				return true
+			}
+		}
+	}
+	return strings.Contains(status.Message, immutableFieldMessage)
+}
+
+// Private
+
+// immutableFieldMessage is how Kubernetes describes an invalid change to an
+// immutable field.
+const immutableFieldMessage = "field is immutable"
+
+// newStatusError returns a StatusError for a response with code and body.
+func newStatusError(code int, body []byte) *StatusError {
+	status := new(Status)
+	if err := json.Unmarshal(body, status); err != nil || status.Message == "" {
+		status = &Status{Message: string(body)}
+	}
+	if status.Code == 0 {
+		status.Code = code
+	}
+	return &StatusError{Code: code, Status: status}
+}
+
+func statusCode(err error) int {
+	if statusErr, ok := err.(*StatusError); ok {
+		return statusErr.Code
+	}
+	return 0
+}
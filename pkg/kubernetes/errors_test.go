@@ -0,0 +1,82 @@
+package kubernetes_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/supergiant/supergiant/pkg/kubernetes"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestStatusError(t *testing.T) {
+	Convey("StatusError is checked by HTTP code", t, func() {
+		table := []struct {
+			// Input
+			err error
+			// Expectations
+			msg       string
+			notFound  bool
+			conflict  bool
+			forbidden bool
+			invalid   bool
+			immutable bool
+		}{
+			{
+				err:      &kubernetes.StatusError{Code: 404, Status: &kubernetes.Status{Message: `pods "a" not found`}},
+				msg:      `K8S 404 Not Found error: pods "a" not found`,
+				notFound: true,
+			},
+			{
+				err:      &kubernetes.StatusError{Code: 409, Status: &kubernetes.Status{Message: `pods "a" already exists`}},
+				msg:      `K8S 409 Conflict error: pods "a" already exists`,
+				conflict: true,
+			},
+			{
+				err:       &kubernetes.StatusError{Code: 403, Status: &kubernetes.Status{Message: "forbidden"}},
+				msg:       "K8S 403 Forbidden error: forbidden",
+				forbidden: true,
+			},
+			{
+				err:       &kubernetes.StatusError{Code: 422, Status: &kubernetes.Status{Message: "field is immutable"}},
+				msg:       "K8S 422 Unprocessable Entity error: field is immutable",
+				invalid:   true,
+				immutable: true,
+			},
+			{
+				err: &kubernetes.StatusError{Code: 422, Status: &kubernetes.Status{
+					Message: `Deployment.apps "a" is invalid`,
+					Details: &kubernetes.StatusDetails{Causes: []*kubernetes.StatusCause{
+						{Type: "FieldValueInvalid", Message: "Invalid value: map[string]string{\"app\":\"b\"}: field is immutable", Field: "spec.selector"},
+					}},
+				}},
+				msg:       `K8S 422 Unprocessable Entity error: Deployment.apps "a" is invalid`,
+				invalid:   true,
+				immutable: true,
+			},
+			{
+				err:     &kubernetes.StatusError{Code: 422, Status: &kubernetes.Status{Message: `Pod "a" is invalid: spec.containers[0].image: Required value`}},
+				msg:     `K8S 422 Unprocessable Entity error: Pod "a" is invalid: spec.containers[0].image: Required value`,
+				invalid: true,
+			},
+			{
+				err: &kubernetes.StatusError{Code: 666, Status: &kubernetes.Status{Message: "the devil"}},
+				msg: "K8S 666 error: the devil",
+			},
+			// Errors which only look like Kubernetes errors
+			{
+				err: errors.New("K8S 404 Not Found error"),
+				msg: "K8S 404 Not Found error",
+			},
+		}
+
+		for _, item := range table {
+			So(item.err.Error(), ShouldEqual, item.msg)
+			So(kubernetes.IsNotFound(item.err), ShouldEqual, item.notFound)
+			So(kubernetes.IsConflict(item.err), ShouldEqual, item.conflict)
+			So(kubernetes.IsForbidden(item.err), ShouldEqual, item.forbidden)
+			So(kubernetes.IsInvalid(item.err), ShouldEqual, item.invalid)
+			So(kubernetes.IsImmutableFieldChange(item.err), ShouldEqual, item.immutable)
+		}
+	})
+}
@@ -0,0 +1,220 @@
+package kubernetes
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+var errInformerStopped = errors.New("Informer stopped")
+
+// Informer caches every resource of one kind in one Namespace of a Kube (or in
+// all of them, if Namespace is empty). It lists the resources once, and then
+// keeps the cache up to date by watching for changes from the last
+// resourceVersion seen, listing again only if a watch fails.
+type Informer struct {
+	Client     ClientInterface
+	APIVersion string
+	Kind       string
+	Namespace  string
+
+	// OnChange, if set, is called with each resource added, modified or
+	// deleted, including those first seen (or found missing) by a list. obj is
+	// nil if the resource was deleted.
+	OnChange func(namespace string, name string, obj json.RawMessage)
+
+	// OnError, if set, is called with each failed list or watch, after which
+	// the Informer waits RetryInterval before listing again.
+	OnError       func(error)
+	RetryInterval time.Duration
+
+	mutex   sync.RWMutex
+	objects map[string]json.RawMessage
+	synced  bool
+}
+
+func NewInformer(client ClientInterface, apiVersion string, kind string, namespace string) *Informer {
+	return &Informer{
+		Client:        client,
+		APIVersion:    apiVersion,
+		Kind:          kind,
+		Namespace:     namespace,
+		RetryInterval: 10 * time.Second,
+		objects:       make(map[string]json.RawMessage),
+	}
+}
+
+// Run lists and watches until stop is closed. Since a watch in progress can't
+// be interrupted, Run returns up to WatchTimeout after stop is closed.
+func (i *Informer) Run(stop <-chan struct{}) {
+	var resourceVersion string
+	for {
+		select {
+		case <-stop:
+			return
+		default:
+		}
+
+		var err error
+		if resourceVersion == "" {
+			resourceVersion, err = i.list()
+		} else {
+			resourceVersion, err = i.watch(resourceVersion, stop)
+		}
+		if err == nil {
+			continue
+		}
+
+		i.setSynced(false)
+		resourceVersion = ""
+		if i.OnError != nil {
+			i.OnError(err)
+		}
+		select {
+		case <-stop:
+			return
+		case <-time.After(i.RetryInterval):
+		}
+	}
+}
+
+// Get returns a copy of the cached resource, and whether it exists. The cache
+// can only be relied on if synced is true, which it is from the first
+// successful list until a list or watch fails.
+func (i *Informer) Get(namespace string, name string) (obj json.RawMessage, exists bool, synced bool) {
+	i.mutex.RLock()
+	defer i.mutex.RUnlock()
+
+	cached, exists := i.objects[namespace+"/"+name]
+	if exists {
+		obj = make(json.RawMessage, len(cached))
+		copy(obj, cached)
+	}
+	return obj, exists, i.synced
+}
+
+func (i *Informer) Synced() bool {
+	i.mutex.RLock()
+	defer i.mutex.RUnlock()
+	return i.synced
+}
+
+// Private
+
+type informerChange struct {
+	key string
+	obj json.RawMessage
+}
+
+// list replaces the cache with the listed resources, and returns the
+// resourceVersion to watch from.
+func (i *Informer) list() (string, error) {
+	list, err := i.Client.ListResources(i.APIVersion, i.Kind, i.Namespace)
+	if err != nil {
+		return "", err
+	}
+
+	objects := make(map[string]json.RawMessage)
+	for _, item := range list.Items {
+		key, _, err := informerKey(item)
+		if err != nil {
+			return "", err
+		}
+		objects[key] = item
+	}
+
+	i.mutex.Lock()
+	var keys []string
+	for key := range objects {
+		keys = append(keys, key)
+	}
+	for key := range i.objects {
+		if _, exists := objects[key]; !exists {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	var changes []*informerChange
+	for _, key := range keys {
+		if obj := objects[key]; obj == nil || !bytes.Equal(i.objects[key], obj) {
+			changes = append(changes, &informerChange{key, obj})
+		}
+	}
+	i.objects = objects
+	i.synced = true
+	i.mutex.Unlock()
+
+	for _, change := range changes {
+		i.changed(change)
+	}
+	return list.Metadata.ResourceVersion, nil
+}
+
+// watch applies events to the cache until the watch ends, and returns the
+// resourceVersion to resume from.
+func (i *Informer) watch(resourceVersion string, stop <-chan struct{}) (string, error) {
+	err := i.Client.WatchResources(i.APIVersion, i.Kind, i.Namespace, resourceVersion, func(event *WatchEvent) error {
+		key, version, err := informerKey(event.Object)
+		if err != nil {
+			return err
+		}
+		resourceVersion = version
+
+		change := &informerChange{key, event.Object}
+		if event.Type == WatchEventDeleted {
+			change.obj = nil
+		}
+
+		i.mutex.Lock()
+		if change.obj == nil {
+			delete(i.objects, key)
+		} else {
+			i.objects[key] = change.obj
+		}
+		i.mutex.Unlock()
+
+		i.changed(change)
+
+		select {
+		case <-stop:
+			return errInformerStopped
+		default:
+			return nil
+		}
+	})
+	if err == errInformerStopped {
+		return resourceVersion, nil
+	}
+	return resourceVersion, err
+}
+
+func (i *Informer) changed(change *informerChange) {
+	if i.OnChange == nil {
+		return
+	}
+	parts := strings.SplitN(change.key, "/", 2)
+	i.OnChange(parts[0], parts[1], change.obj)
+}
+
+func (i *Informer) setSynced(synced bool) {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+	i.synced = synced
+}
+
+// informerKey returns the "namespace/name" cache key and resourceVersion of a
+// resource.
+func informerKey(obj json.RawMessage) (key string, resourceVersion string, err error) {
+	var resource struct {
+		Metadata Metadata `json:"metadata"`
+	}
+	if err := json.Unmarshal(obj, &resource); err != nil {
+		return "", "", err
+	}
+	return resource.Metadata.Namespace + "/" + resource.Metadata.Name, resource.Metadata.ResourceVersion, nil
+}
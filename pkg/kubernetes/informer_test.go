@@ -0,0 +1,106 @@
+package kubernetes_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/supergiant/supergiant/pkg/kubernetes"
+	"github.com/supergiant/supergiant/test/fake_core"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestInformerRun(t *testing.T) {
+	Convey("Informer lists, then watches from the last resourceVersion, and lists again when a watch fails", t, func() {
+		lists := []*kubernetes.ResourceList{
+			{
+				Metadata: kubernetes.ListMetadata{ResourceVersion: "10"},
+				Items: []json.RawMessage{
+					json.RawMessage(`{"metadata":{"namespace":"test","name":"b","resourceVersion":"2"}}`),
+					json.RawMessage(`{"metadata":{"namespace":"test","name":"a","resourceVersion":"1"}}`),
+				},
+			},
+			{
+				Metadata: kubernetes.ListMetadata{ResourceVersion: "20"},
+				Items: []json.RawMessage{
+					json.RawMessage(`{"metadata":{"namespace":"test","name":"c","resourceVersion":"13"}}`),
+				},
+			},
+		}
+
+		stop := make(chan struct{})
+
+		var listCalls int
+		var watchVersions []string
+		var changes []string
+		var errs []string
+
+		client := &fake_core.KubernetesClient{
+			ListResourcesFn: func(apiVersion string, kind string, namespace string) (*kubernetes.ResourceList, error) {
+				So(apiVersion, ShouldEqual, "extensions/v1beta1")
+				So(kind, ShouldEqual, "Ingress")
+				So(namespace, ShouldEqual, "test")
+
+				list := lists[listCalls]
+				listCalls++
+				return list, nil
+			},
+			WatchResourcesFn: func(apiVersion string, kind string, namespace string, resourceVersion string, fn func(*kubernetes.WatchEvent) error) error {
+				watchVersions = append(watchVersions, resourceVersion)
+
+				switch len(watchVersions) {
+				case 1:
+					fn(&kubernetes.WatchEvent{Type: "MODIFIED", Object: json.RawMessage(`{"metadata":{"namespace":"test","name":"a","resourceVersion":"11"}}`)})
+					fn(&kubernetes.WatchEvent{Type: "DELETED", Object: json.RawMessage(`{"metadata":{"namespace":"test","name":"b","resourceVersion":"12"}}`)})
+					fn(&kubernetes.WatchEvent{Type: "ADDED", Object: json.RawMessage(`{"metadata":{"namespace":"test","name":"c","resourceVersion":"13"}}`)})
+					return nil
+				case 2:
+					return &kubernetes.StatusError{Code: 410, Status: &kubernetes.Status{Code: 410, Reason: "Gone", Message: "too old resource version: 13 (15)"}}
+				}
+				close(stop)
+				return nil
+			},
+		}
+
+		informer := kubernetes.NewInformer(client, "extensions/v1beta1", "Ingress", "test")
+		informer.RetryInterval = 0
+		informer.OnChange = func(namespace string, name string, obj json.RawMessage) {
+			change := namespace + "/" + name
+			if obj == nil {
+				change += " deleted"
+			}
+			changes = append(changes, change)
+		}
+		informer.OnError = func(err error) {
+			So(informer.Synced(), ShouldBeFalse)
+			errs = append(errs, err.Error())
+		}
+
+		So(informer.Synced(), ShouldBeFalse)
+
+		informer.Run(stop)
+
+		So(listCalls, ShouldEqual, 2)
+		So(watchVersions, ShouldResemble, []string{"10", "13", "20"})
+		So(errs, ShouldResemble, []string{"K8S 410 Gone error: too old resource version: 13 (15)"})
+		So(changes, ShouldResemble, []string{
+			// First list
+			"test/a",
+			"test/b",
+			// Watch
+			"test/a",
+			"test/b deleted",
+			"test/c",
+			// Second list, in which c is unchanged
+			"test/a deleted",
+		})
+
+		obj, exists, synced := informer.Get("test", "c")
+		So(string(obj), ShouldEqual, `{"metadata":{"namespace":"test","name":"c","resourceVersion":"13"}}`)
+		So(exists, ShouldBeTrue)
+		So(synced, ShouldBeTrue)
+
+		_, exists, _ = informer.Get("test", "a")
+		So(exists, ShouldBeFalse)
+	})
+}
@@ -0,0 +1,148 @@
+package kubernetes
+
+import (
+	"encoding/json"
+
+	"github.com/ghodss/yaml"
+	"github.com/supergiant/supergiant/pkg/model"
+)
+
+// Kubeconfig is the config file of kubectl (and other Kubernetes clients), as
+// written to ~/.kube/config.
+type Kubeconfig struct {
+	APIVersion     string                    `json:"apiVersion"`
+	Kind           string                    `json:"kind"`
+	Clusters       []*KubeconfigNamedCluster `json:"clusters"`
+	Users          []*KubeconfigNamedUser    `json:"users"`
+	Contexts       []*KubeconfigNamedContext `json:"contexts"`
+	CurrentContext string                    `json:"current-context"`
+}
+
+type KubeconfigNamedCluster struct {
+	Name    string             `json:"name"`
+	Cluster *KubeconfigCluster `json:"cluster"`
+}
+
+type KubeconfigCluster struct {
+	Server                   string `json:"server"`
+	CertificateAuthorityData []byte `json:"certificate-authority-data,omitempty"`
+	InsecureSkipTLSVerify    bool   `json:"insecure-skip-tls-verify,omitempty"`
+}
+
+type KubeconfigNamedUser struct {
+	Name string          `json:"name"`
+	User *KubeconfigUser `json:"user"`
+}
+
+type KubeconfigUser struct {
+	ClientCertificateData []byte `json:"client-certificate-data,omitempty"`
+	ClientKeyData         []byte `json:"client-key-data,omitempty"`
+	Token                 string `json:"token,omitempty"`
+	Username              string `json:"username,omitempty"`
+	Password              string `json:"password,omitempty"`
+}
+
+type KubeconfigNamedContext struct {
+	Name    string             `json:"name"`
+	Context *KubeconfigContext `json:"context"`
+}
+
+type KubeconfigContext struct {
+	Cluster string `json:"cluster"`
+	User    string `json:"user"`
+}
+
+// NewKubeconfig returns a Kubeconfig for kube, with a cluster, user and
+// context all named supergiant-<kube name>. The user has the credentials
+// Supergiant itself uses.
+func NewKubeconfig(kube *model.Kube) *Kubeconfig {
+	name := "supergiant-" + kube.Name
+
+	cluster := &KubeconfigCluster{
+		Server: "https://" + kube.MasterPublicIP,
+	}
+	if kube.CACert != "" {
+		cluster.CertificateAuthorityData = []byte(kube.CACert)
+	} else {
+		cluster.InsecureSkipTLSVerify = kube.InsecureSkipTLSVerify
+	}
+
+	user := new(KubeconfigUser)
+	switch {
+	case kube.BearerToken != "":
+		user.Token = kube.BearerToken
+	case kube.ClientCert != "":
+		user.ClientCertificateData = []byte(kube.ClientCert)
+		user.ClientKeyData = []byte(kube.ClientKey)
+	default:
+		user.Username = kube.Username
+		user.Password = kube.Password
+	}
+
+	return &Kubeconfig{
+		APIVersion: "v1",
+		Kind:       "Config",
+		Clusters:   []*KubeconfigNamedCluster{{name, cluster}},
+		Users:      []*KubeconfigNamedUser{{name, user}},
+		Contexts: []*KubeconfigNamedContext{
+			{name, &KubeconfigContext{Cluster: name, User: name}},
+		},
+		CurrentContext: name,
+	}
+}
+
+// YAML returns the Kubeconfig as YAML.
+func (c *Kubeconfig) YAML() ([]byte, error) {
+	return yaml.Marshal(c)
+}
+
+// MergeKubeconfig adds the clusters, users and contexts of config to the
+// existing kubeconfig YAML (which may be empty), replacing any with the same
+// names, and makes the current context of config current. Other settings in
+// existing are kept.
+func MergeKubeconfig(existing []byte, config *Kubeconfig) ([]byte, error) {
+	merged := make(map[string]interface{})
+	if err := yaml.Unmarshal(existing, &merged); err != nil {
+		return nil, err
+	}
+	if merged == nil {
+		merged = make(map[string]interface{})
+	}
+
+	// Round trip config to the same generic form as existing
+	configJSON, err := json.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+	var additions map[string]interface{}
+	if err := json.Unmarshal(configJSON, &additions); err != nil {
+		return nil, err
+	}
+
+	for _, key := range []string{"clusters", "users", "contexts"} {
+		items, _ := merged[key].([]interface{})
+		for _, addition := range additions[key].([]interface{}) {
+			items = mergeNamed(items, addition.(map[string]interface{}))
+		}
+		merged[key] = items
+	}
+	for _, key := range []string{"apiVersion", "kind"} {
+		if _, ok := merged[key]; !ok {
+			merged[key] = additions[key]
+		}
+	}
+	merged["current-context"] = config.CurrentContext
+
+	return yaml.Marshal(merged)
+}
+
+// mergeNamed replaces the item named like addition, or appends addition.
+func mergeNamed(items []interface{}, addition map[string]interface{}) []interface{} {
+	for i, item := range items {
+		if named, ok := item.(map[string]interface{}); ok && named["name"] == addition["name"] {
+			items[i] = addition
+			return items
+		}
+	}
+	return append(items, addition)
+}
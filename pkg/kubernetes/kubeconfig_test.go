@@ -0,0 +1,145 @@
+package kubernetes_test
+
+import (
+	"testing"
+
+	"github.com/ghodss/yaml"
+	"github.com/supergiant/supergiant/pkg/kubernetes"
+	"github.com/supergiant/supergiant/pkg/model"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestNewKubeconfig(t *testing.T) {
+	Convey("NewKubeconfig returns a kubeconfig with the credentials of the Kube", t, func() {
+		table := []struct {
+			// Input
+			kube *model.Kube
+			// Expectations
+			cluster *kubernetes.KubeconfigCluster
+			user    *kubernetes.KubeconfigUser
+		}{
+			{
+				kube:    &model.Kube{Name: "a", MasterPublicIP: "1.2.3.4", Username: "user", Password: "pass", InsecureSkipTLSVerify: true},
+				cluster: &kubernetes.KubeconfigCluster{Server: "https://1.2.3.4", InsecureSkipTLSVerify: true},
+				user:    &kubernetes.KubeconfigUser{Username: "user", Password: "pass"},
+			},
+			{
+				kube:    &model.Kube{Name: "a", MasterPublicIP: "1.2.3.4", CACert: "ca", ClientCert: "cert", ClientKey: "key"},
+				cluster: &kubernetes.KubeconfigCluster{Server: "https://1.2.3.4", CertificateAuthorityData: []byte("ca")},
+				user:    &kubernetes.KubeconfigUser{ClientCertificateData: []byte("cert"), ClientKeyData: []byte("key")},
+			},
+			{
+				kube:    &model.Kube{Name: "a", MasterPublicIP: "1.2.3.4", CACert: "ca", Username: "user", BearerToken: "token"},
+				cluster: &kubernetes.KubeconfigCluster{Server: "https://1.2.3.4", CertificateAuthorityData: []byte("ca")},
+				user:    &kubernetes.KubeconfigUser{Token: "token"},
+			},
+		}
+
+		for _, item := range table {
+			config := kubernetes.NewKubeconfig(item.kube)
+
+			So(config.CurrentContext, ShouldEqual, "supergiant-a")
+			So(config.Clusters, ShouldResemble, []*kubernetes.KubeconfigNamedCluster{{Name: "supergiant-a", Cluster: item.cluster}})
+			So(config.Users, ShouldResemble, []*kubernetes.KubeconfigNamedUser{{Name: "supergiant-a", User: item.user}})
+			So(config.Contexts, ShouldResemble, []*kubernetes.KubeconfigNamedContext{
+				{Name: "supergiant-a", Context: &kubernetes.KubeconfigContext{Cluster: "supergiant-a", User: "supergiant-a"}},
+			})
+
+			// Certificates are base64 encoded, as kubectl expects
+			out, err := config.YAML()
+			So(err, ShouldBeNil)
+			if item.cluster.CertificateAuthorityData != nil {
+				So(string(out), ShouldContainSubstring, "certificate-authority-data: Y2E=")
+			}
+
+			parsed := new(kubernetes.Kubeconfig)
+			So(yaml.Unmarshal(out, parsed), ShouldBeNil)
+			So(parsed, ShouldResemble, config)
+		}
+	})
+}
+
+func TestMergeKubeconfig(t *testing.T) {
+	Convey("MergeKubeconfig adds or replaces the cluster, user and context, and keeps the rest", t, func() {
+		config := kubernetes.NewKubeconfig(&model.Kube{Name: "a", MasterPublicIP: "1.2.3.4", BearerToken: "token"})
+
+		existing := []byte(`apiVersion: v1
+kind: Config
+preferences:
+  colors: true
+clusters:
+- name: other
+  cluster:
+    server: https://5.6.7.8
+- name: supergiant-a
+  cluster:
+    server: https://old
+users:
+- name: other
+  user:
+    token: other
+contexts:
+- name: other
+  context:
+    cluster: other
+    user: other
+current-context: other
+`)
+
+		table := []struct {
+			// Input
+			existing []byte
+			// Expectations
+			clusters []string
+			users    []string
+		}{
+			{
+				existing: nil,
+				clusters: []string{"supergiant-a"},
+				users:    []string{"supergiant-a"},
+			},
+			{
+				existing: existing,
+				clusters: []string{"other", "supergiant-a"},
+				users:    []string{"other", "supergiant-a"},
+			},
+		}
+
+		for _, item := range table {
+			out, err := kubernetes.MergeKubeconfig(item.existing, config)
+			So(err, ShouldBeNil)
+
+			merged := new(kubernetes.Kubeconfig)
+			So(yaml.Unmarshal(out, merged), ShouldBeNil)
+			So(merged.APIVersion, ShouldEqual, "v1")
+			So(merged.CurrentContext, ShouldEqual, "supergiant-a")
+
+			var clusters []string
+			for _, cluster := range merged.Clusters {
+				clusters = append(clusters, cluster.Name)
+				if cluster.Name == "supergiant-a" {
+					So(cluster.Cluster.Server, ShouldEqual, "https://1.2.3.4")
+				}
+			}
+			So(clusters, ShouldResemble, item.clusters)
+
+			var users []string
+			for _, user := range merged.Users {
+				users = append(users, user.Name)
+			}
+			So(users, ShouldResemble, item.users)
+		}
+
+		Convey("Other settings are kept", func() {
+			out, err := kubernetes.MergeKubeconfig(existing, config)
+			So(err, ShouldBeNil)
+			So(string(out), ShouldContainSubstring, "colors: true")
+		})
+
+		Convey("Invalid kubeconfigs are not overwritten", func() {
+			_, err := kubernetes.MergeKubeconfig([]byte("clusters: ["), config)
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
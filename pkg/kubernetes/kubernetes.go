@@ -2,16 +2,18 @@ package kubernetes
 
 import (
 	"bytes"
-	"crypto/tls"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
+	"net/url"
 	"regexp"
 	"strconv"
-	"strings"
 	"time"
 
+	"github.com/gorilla/websocket"
 	"github.com/supergiant/supergiant/pkg/model"
 )
 
@@ -19,9 +21,37 @@ type ClientInterface interface {
 	// EnsureNamespace creates a Kubernetes Namespace unless it already exists.
 	EnsureNamespace(name string) error
 
-	GetResource(kind string, namespace string, name string, out *json.RawMessage) error
-	CreateResource(kind string, namespace string, objIn map[string]interface{}, out *json.RawMessage) error
-	DeleteResource(kind string, namespace string, name string) error
+	// Resources are requested by apiVersion (e.g. "v1" or "extensions/v1beta1")
+	// and kind, which are resolved to a path through API discovery.
+	GetResource(apiVersion string, kind string, namespace string, name string, out *json.RawMessage) error
+	CreateResource(apiVersion string, kind string, namespace string, objIn map[string]interface{}, out *json.RawMessage) error
+	DeleteResource(apiVersion string, kind string, namespace string, name string) error
+
+	// UpdateResource replaces a resource with objIn, and PatchResource applies
+	// patch to it as a strategic merge patch, or as a JSON merge patch if its
+	// kind doesn't support strategic merge patches (see
+	// SupportsStrategicMergePatch).
+	UpdateResource(apiVersion string, kind string, namespace string, name string, objIn map[string]interface{}, out *json.RawMessage) error
+	PatchResource(apiVersion string, kind string, namespace string, name string, patch map[string]interface{}, out *json.RawMessage) error
+
+	// ListResources and WatchResources are in all namespaces if namespace is
+	// empty. WatchResources resumes from the resourceVersion of a list or of a
+	// previous event, and calls fn with each event until the watch ends.
+	ListResources(apiVersion string, kind string, namespace string) (*ResourceList, error)
+	WatchResources(apiVersion string, kind string, namespace string, resourceVersion string, fn func(*WatchEvent) error) error
+
+	// PodLogs returns the log of a Pod container, which the caller must close.
+	// If query.Follow is set, it's streamed until closed.
+	PodLogs(namespace string, name string, query *model.PodLogQuery) (io.ReadCloser, error)
+
+	// ExecPod runs a command in a Pod container, and returns its connection,
+	// which speaks the ExecProtocol.
+	ExecPod(namespace string, name string, query *model.ExecQuery) (*websocket.Conn, error)
+
+	// ProxyRequest forwards r to path of the Kube API with the credentials of
+	// the Kube, and writes the response to w. Responses are streamed, and
+	// upgrade requests (e.g. exec and port-forward) are tunneled.
+	ProxyRequest(w http.ResponseWriter, r *http.Request, path string)
 
 	ListNamespaces(query string) ([]*Namespace, error)
 	ListEvents(query string) ([]*Event, error)
@@ -34,19 +64,29 @@ type ClientInterface interface {
 
 //------------------------------------------------------------------------------
 
-var DefaultHTTPClient = &http.Client{
-	Timeout: 30 * time.Second,
-	Transport: &http.Transport{
-		TLSHandshakeTimeout: 10 * time.Second,
-		TLSClientConfig: &tls.Config{
-			InsecureSkipVerify: true,
-		},
-	},
-}
+// WatchTimeout is how long the Kube is asked to keep a watch open. It must be
+// shorter than the Timeout of the HTTPClient, which applies to the whole watch.
+var WatchTimeout = 25 * time.Second
+
+// ExecProtocol is the WebSocket subprotocol of ExecPod connections. Each
+// message is prefixed with a byte for its stream: 0 for stdin, 1 for stdout, 2
+// for stderr, and 3 for an error running the command.
+const ExecProtocol = "channel.k8s.io"
+
+const (
+	ExecStdin byte = iota
+	ExecStdout
+	ExecStderr
+	ExecError
+)
 
 type Client struct {
 	Kube       *model.Kube
 	HTTPClient *http.Client
+
+	// Discovery caches resource names by Kube. Optional; if nil, discovery is
+	// requested every time.
+	Discovery *Discovery
 }
 
 // EnsureNamespace implements the ClientInterface.
@@ -55,7 +95,7 @@ func (k *Client) EnsureNamespace(name string) error {
 	err := k.requestInto("GET", "namespaces/"+name, nil, nil)
 	if err == nil {
 		return nil // already exists
-	} else if !strings.Contains(err.Error(), "404") {
+	} else if !IsNotFound(err) {
 		return err // unexpected error
 	}
 	namespace := &Namespace{
@@ -66,24 +106,179 @@ func (k *Client) EnsureNamespace(name string) error {
 	return k.requestInto("POST", "namespaces", namespace, nil)
 }
 
-func (k *Client) GetResource(kind string, namespace string, name string, out *json.RawMessage) error {
-	path := fmt.Sprintf("namespaces/%s/%s/%s", namespace, lowerPlural(kind), name)
-	return k.requestInto("GET", path, nil, out)
+func (k *Client) GetResource(apiVersion string, kind string, namespace string, name string, out *json.RawMessage) error {
+	path, err := k.resourcePath(apiVersion, kind, namespace, name)
+	if err != nil {
+		return err
+	}
+	return k.request("GET", path, nil, out)
 }
 
-func (k *Client) CreateResource(kind string, namespace string, in map[string]interface{}, out *json.RawMessage) error {
-	path := fmt.Sprintf("namespaces/%s/%s", namespace, lowerPlural(kind))
-	err := k.requestInto("POST", path, in, out)
+func (k *Client) CreateResource(apiVersion string, kind string, namespace string, in map[string]interface{}, out *json.RawMessage) error {
+	path, err := k.resourcePath(apiVersion, kind, namespace, "")
+	if err != nil {
+		return err
+	}
+	err = k.request("POST", path, in, out)
 	// Only return error if it's NOT a 409 already exists error
-	if err != nil && !strings.Contains(err.Error(), "409") {
+	if err != nil && !IsConflict(err) {
 		return err
 	}
 	return nil
 }
 
-func (k *Client) DeleteResource(kind string, namespace string, name string) error {
-	path := fmt.Sprintf("namespaces/%s/%s/%s", namespace, lowerPlural(kind), name)
-	return k.requestInto("DELETE", path, nil, nil)
+func (k *Client) DeleteResource(apiVersion string, kind string, namespace string, name string) error {
+	path, err := k.resourcePath(apiVersion, kind, namespace, name)
+	if err != nil {
+		return err
+	}
+	return k.request("DELETE", path, nil, nil)
+}
+
+func (k *Client) UpdateResource(apiVersion string, kind string, namespace string, name string, in map[string]interface{}, out *json.RawMessage) error {
+	path, err := k.resourcePath(apiVersion, kind, namespace, name)
+	if err != nil {
+		return err
+	}
+	return k.request("PUT", path, in, out)
+}
+
+func (k *Client) PatchResource(apiVersion string, kind string, namespace string, name string, patch map[string]interface{}, out *json.RawMessage) error {
+	path, err := k.resourcePath(apiVersion, kind, namespace, name)
+	if err != nil {
+		return err
+	}
+	contentType := "application/merge-patch+json"
+	if SupportsStrategicMergePatch(apiVersion) {
+		contentType = "application/strategic-merge-patch+json"
+	}
+	return k.requestAs("PATCH", path, contentType, patch, out)
+}
+
+func (k *Client) ListResources(apiVersion string, kind string, namespace string) (*ResourceList, error) {
+	path, err := k.resourcePath(apiVersion, kind, namespace, "")
+	if err != nil {
+		return nil, err
+	}
+	list := new(ResourceList)
+	if err := k.request("GET", path, nil, list); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// WatchResources returns nil when the Kube ends the watch, which it does after
+// WatchTimeout. An ERROR event, such as when resourceVersion is too old to
+// resume from, is returned as a StatusError (e.g. a 410 Gone), after which
+// resources must be listed again.
+func (k *Client) WatchResources(apiVersion string, kind string, namespace string, resourceVersion string, fn func(*WatchEvent) error) error {
+	path, err := k.resourcePath(apiVersion, kind, namespace, "")
+	if err != nil {
+		return err
+	}
+	query := url.Values{
+		"watch":           []string{"true"},
+		"resourceVersion": []string{resourceVersion},
+		"timeoutSeconds":  []string{strconv.Itoa(int(WatchTimeout.Seconds()))},
+	}
+	resp, err := k.do("GET", path+"?"+query.Encode(), nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	decoder := json.NewDecoder(resp.Body)
+	for {
+		event := new(WatchEvent)
+		if err := decoder.Decode(event); err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		if event.Type == WatchEventError {
+			status := new(Status)
+			if err := json.Unmarshal(event.Object, status); err != nil {
+				return err
+			}
+			return &StatusError{Code: status.Code, Status: status}
+		}
+
+		if err := fn(event); err != nil {
+			return err
+		}
+	}
+}
+
+func (k *Client) PodLogs(namespace string, name string, query *model.PodLogQuery) (io.ReadCloser, error) {
+	values := make(url.Values)
+	if query.Container != "" {
+		values.Set("container", query.Container)
+	}
+	if query.Tail != 0 {
+		values.Set("tailLines", strconv.Itoa(query.Tail))
+	}
+	if !query.Since.IsZero() {
+		values.Set("sinceTime", query.Since.UTC().Format(time.RFC3339))
+	}
+
+	httpClient := k.HTTPClient
+	if query.Follow {
+		values.Set("follow", "true")
+
+		// Timeout applies to reading the body, which never ends when following
+		streaming := *k.HTTPClient
+		streaming.Timeout = 0
+		httpClient = &streaming
+	}
+
+	path := fmt.Sprintf("/api/v1/namespaces/%s/pods/%s/log?%s", namespace, name, values.Encode())
+	resp, err := k.doWith(httpClient, "GET", path, "application/json", nil)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+func (k *Client) ExecPod(namespace string, name string, query *model.ExecQuery) (*websocket.Conn, error) {
+	values := url.Values{
+		"command": query.Command,
+		"stdout":  []string{"true"},
+		"stderr":  []string{"true"},
+	}
+	if query.Container != "" {
+		values.Set("container", query.Container)
+	}
+	if query.Stdin {
+		values.Set("stdin", "true")
+	}
+	if query.TTY {
+		values.Set("tty", "true")
+	}
+	url := fmt.Sprintf("wss://%s/api/v1/namespaces/%s/pods/%s/exec?%s", k.Kube.MasterPublicIP, namespace, name, values.Encode())
+
+	tlsConfig, err := TLSConfig(k.Kube)
+	if err != nil {
+		return nil, err
+	}
+	dialer := &websocket.Dialer{
+		TLSClientConfig:  tlsConfig,
+		HandshakeTimeout: 10 * time.Second,
+		Subprotocols:     []string{ExecProtocol},
+	}
+	header := make(http.Header)
+	k.authorize(header)
+
+	conn, resp, err := dialer.Dial(url, header)
+	if err == websocket.ErrBadHandshake {
+		defer resp.Body.Close()
+		respBody, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			return nil, err
+		}
+		return nil, newStatusError(resp.StatusCode, respBody)
+	}
+	return conn, err
 }
 
 func (k *Client) ListNamespaces(query string) ([]*Namespace, error) {
@@ -138,8 +333,59 @@ func (k *Client) ListPodHeapsterCPUUsageMetrics(namespace string, name string) (
 
 // Private
 
+// resourcePath returns the path of a resource (or of the collection, if name is
+// empty), e.g. /apis/extensions/v1beta1/namespaces/default/ingresses/name.
+func (k *Client) resourcePath(apiVersion string, kind string, namespace string, name string) (string, error) {
+	if apiVersion == "" {
+		apiVersion = "v1"
+	}
+	resource, err := k.Discovery.Resource(k, apiVersion, kind)
+	if err != nil {
+		return "", err
+	}
+	path := apiPath(apiVersion)
+	if resource.Namespaced && namespace != "" {
+		path += "/namespaces/" + namespace
+	}
+	path += "/" + resource.Name
+	if name != "" {
+		path += "/" + name
+	}
+	return path, nil
+}
+
+// requestInto requests a path of the core v1 API.
 func (k *Client) requestInto(method string, path string, in interface{}, out interface{}) error {
-	url := fmt.Sprintf("https://%s/api/v1/%s", k.Kube.MasterPublicIP, path)
+	return k.request(method, "/api/v1/"+path, in, out)
+}
+
+func (k *Client) request(method string, path string, in interface{}, out interface{}) error {
+	return k.requestAs(method, path, "application/json", in, out)
+}
+
+// requestAs sends in as contentType, e.g. a kind of patch.
+func (k *Client) requestAs(method string, path string, contentType string, in interface{}, out interface{}) error {
+	resp, err := k.doWith(k.HTTPClient, method, path, contentType, in)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if out != nil {
+		if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// do returns the response of a successful request, which the caller must close.
+func (k *Client) do(method string, path string, in interface{}) (*http.Response, error) {
+	return k.doWith(k.HTTPClient, method, path, "application/json", in)
+}
+
+func (k *Client) doWith(httpClient *http.Client, method string, path string, contentType string, in interface{}) (*http.Response, error) {
+	url := fmt.Sprintf("https://%s%s", k.Kube.MasterPublicIP, path)
 
 	// fmt.Println("---------------- REQUESTING: ", method, url)
 
@@ -147,7 +393,7 @@ func (k *Client) requestInto(method string, path string, in interface{}, out int
 	if in != nil {
 		jsonIn, err := json.Marshal(in)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		body = jsonIn
 
@@ -157,30 +403,40 @@ func (k *Client) requestInto(method string, path string, in interface{}, out int
 
 	req, err := http.NewRequest(method, url, bytes.NewBuffer(body))
 	if err != nil {
-		return err
+		return nil, err
+	}
+	k.authorize(req.Header)
+	if in != nil {
+		req.Header.Set("Content-Type", contentType)
 	}
-	req.SetBasicAuth(k.Kube.Username, k.Kube.Password)
 
-	resp, err := k.HTTPClient.Do(req)
+	resp, err := httpClient.Do(req)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if resp.Status[:2] != "20" {
 		defer resp.Body.Close()
 		respBody, err := ioutil.ReadAll(resp.Body)
 		if err != nil {
-			return err
+			return nil, err
 		}
-		return fmt.Errorf("K8S %s error: %s", resp.Status, string(respBody))
+		return nil, newStatusError(resp.StatusCode, respBody)
 	}
+	return resp, nil
+}
 
-	if out != nil {
-		if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
-			return err
-		}
+// authorize sets the Authorization header for the auth mode of the Kube: a
+// bearer token, a client certificate (which is presented by the TLS config
+// instead), or basic auth.
+func (k *Client) authorize(header http.Header) {
+	switch {
+	case k.Kube.BearerToken != "":
+		header.Set("Authorization", "Bearer "+k.Kube.BearerToken)
+	case k.Kube.ClientCert != "":
+	default:
+		header.Set("Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(k.Kube.Username+":"+k.Kube.Password)))
 	}
-	return nil
 }
 
 // Misc ------------------------------------------------------------------------
@@ -239,9 +495,3 @@ func GiBFromMemString(memStr string) (float64, error) {
 
 	return 0, fmt.Errorf("Cannot parse RAM GiB from %s", memStr)
 }
-
-//------------------------------------------------------------------------------
-
-func lowerPlural(str string) string {
-	return strings.ToLower(str) + "s"
-}
@@ -3,12 +3,17 @@ package kubernetes_test
 import (
 	"bytes"
 	"encoding/json"
+	"encoding/pem"
 	"errors"
 	"io/ioutil"
 	"net/http"
+	"net/http/httptest"
 	"strconv"
 	"strings"
 	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
 
 	"github.com/supergiant/supergiant/pkg/kubernetes"
 	"github.com/supergiant/supergiant/pkg/model"
@@ -67,7 +72,7 @@ func TestKubernetesEnsureNamespace(t *testing.T) {
 				mockGetNamespaceResponseCode: 500,
 				mockGetNamespaceResponseBody: "crud",
 				// Expectations
-				err: errors.New("K8S 500 error: crud"),
+				err: &kubernetes.StatusError{Code: 500, Status: &kubernetes.Status{Code: 500, Message: "crud"}},
 			},
 
 			// On unexpected Create error
@@ -81,7 +86,7 @@ func TestKubernetesEnsureNamespace(t *testing.T) {
 				mockCreateNamespaceResponseBody: "the devil",
 				// Expectations
 				namespaceNameCreated: "test",
-				err:                  errors.New("K8S 666 error: the devil"),
+				err:                  &kubernetes.StatusError{Code: 666, Status: &kubernetes.Status{Code: 666, Message: "the devil"}},
 			},
 		}
 
@@ -138,46 +143,160 @@ func TestKubernetesEnsureNamespace(t *testing.T) {
 
 //------------------------------------------------------------------------------
 
+// respondDiscovery responds to API discovery requests for the group versions
+// used in tests, and returns nil for any other request.
+func respondDiscovery(r *http.Request) *http.Response {
+	lists := map[string]string{
+		"/api/v1": `{
+			"groupVersion": "v1",
+			"resources": [
+				{"name": "endpoints", "namespaced": true, "kind": "Endpoints"},
+				{"name": "namespaces", "namespaced": false, "kind": "Namespace"},
+				{"name": "pods", "namespaced": true, "kind": "Pod"},
+				{"name": "pods/log", "namespaced": true, "kind": "Pod"},
+				{"name": "services", "namespaced": true, "kind": "Service"}
+			]
+		}`,
+		"/apis/extensions/v1beta1": `{
+			"groupVersion": "extensions/v1beta1",
+			"resources": [
+				{"name": "daemonsets", "namespaced": true, "kind": "DaemonSet"},
+				{"name": "deployments", "namespaced": true, "kind": "Deployment"},
+				{"name": "ingresses", "namespaced": true, "kind": "Ingress"},
+				{"name": "networkpolicies", "namespaced": true, "kind": "NetworkPolicy"}
+			]
+		}`,
+		"/apis/monitoring.coreos.com/v1": `{
+			"groupVersion": "monitoring.coreos.com/v1",
+			"resources": [
+				{"name": "prometheuses", "namespaced": true, "kind": "Prometheus"}
+			]
+		}`,
+	}
+	list, ok := lists[r.URL.Path]
+	if r.Method != "GET" || !ok {
+		return nil
+	}
+	return &http.Response{
+		Status:     "200",
+		StatusCode: 200,
+		Body:       ioutil.NopCloser(bytes.NewBufferString(list)),
+	}
+}
+
 func TestKubernetesGetResource(t *testing.T) {
 	Convey("Kubernetes GetResource works correctly", t, func() {
 		table := []struct {
 			// Input
-			kube      *model.Kube
-			kind      string
-			namespace string
-			name      string
+			kube       *model.Kube
+			apiVersion string
+			kind       string
+			namespace  string
+			name       string
 			// Mocks
 			mockGetResourceResponseCode int
 			mockGetResourceResponseBody string
 			// Expectations
-			err error
+			path string
+			err  error
 		}{
 			// A successful example
 			{
 				// Input
-				kube:      &model.Kube{},
-				kind:      "Pod",
-				namespace: "test",
-				name:      "testname",
+				kube:       &model.Kube{},
+				apiVersion: "v1",
+				kind:       "Pod",
+				namespace:  "test",
+				name:       "testname",
 				// Mocks
 				mockGetResourceResponseCode: 200,
 				mockGetResourceResponseBody: `{}`,
 				// Expectations
-				err: nil,
+				path: "/api/v1/namespaces/test/pods/testname",
+				err:  nil,
+			},
+
+			// An irregular plural in an API group
+			{
+				// Input
+				kube:       &model.Kube{},
+				apiVersion: "extensions/v1beta1",
+				kind:       "Ingress",
+				namespace:  "test",
+				name:       "testname",
+				// Mocks
+				mockGetResourceResponseCode: 200,
+				mockGetResourceResponseBody: `{}`,
+				// Expectations
+				path: "/apis/extensions/v1beta1/namespaces/test/ingresses/testname",
+				err:  nil,
+			},
+
+			// A kind which is not namespaced
+			{
+				// Input
+				kube:       &model.Kube{},
+				apiVersion: "v1",
+				kind:       "Namespace",
+				namespace:  "test",
+				name:       "testname",
+				// Mocks
+				mockGetResourceResponseCode: 200,
+				mockGetResourceResponseBody: `{}`,
+				// Expectations
+				path: "/api/v1/namespaces/testname",
+				err:  nil,
+			},
+
+			// A kind which is not served
+			{
+				// Input
+				kube:       &model.Kube{},
+				apiVersion: "v1",
+				kind:       "Deployment",
+				namespace:  "test",
+				name:       "testname",
+				// Expectations
+				err: errors.New("K8S kind Deployment is not served by apiVersion v1"),
 			},
 
 			// On error
 			{
 				// Input
-				kube:      &model.Kube{},
-				kind:      "Pod",
-				namespace: "test",
-				name:      "testname",
+				kube:       &model.Kube{},
+				apiVersion: "v1",
+				kind:       "Pod",
+				namespace:  "test",
+				name:       "testname",
 				// Mocks
 				mockGetResourceResponseCode: 404,
 				mockGetResourceResponseBody: `unexpected error`,
 				// Expectations
-				err: errors.New("K8S 404 error: unexpected error"),
+				path: "/api/v1/namespaces/test/pods/testname",
+				err:  &kubernetes.StatusError{Code: 404, Status: &kubernetes.Status{Code: 404, Message: "unexpected error"}},
+			},
+			// On error with a Status
+			{
+				// Input
+				kube:       &model.Kube{},
+				apiVersion: "v1",
+				kind:       "Pod",
+				namespace:  "test",
+				name:       "testname",
+				// Mocks
+				mockGetResourceResponseCode: 404,
+				mockGetResourceResponseBody: `{"kind":"Status","apiVersion":"v1","status":"Failure","message":"pods \"testname\" not found","reason":"NotFound","details":{"name":"testname","kind":"pods"},"code":404}`,
+				// Expectations
+				path: "/api/v1/namespaces/test/pods/testname",
+				err: &kubernetes.StatusError{
+					Code: 404,
+					Status: &kubernetes.Status{
+						Code:    404,
+						Reason:  "NotFound",
+						Message: `pods "testname" not found`,
+						Details: &kubernetes.StatusDetails{Name: "testname", Kind: "pods"},
+					},
+				},
 			},
 		}
 
@@ -189,7 +308,11 @@ func TestKubernetesGetResource(t *testing.T) {
 					Transport: &fake_http.RoundTripper{
 						RoundTripFn: func(r *http.Request) (resp *http.Response, err error) {
 
-							if r.Method == "GET" && r.URL.Path == "/api/v1/namespaces/"+item.namespace+"/"+strings.ToLower(item.kind)+"s/"+item.name {
+							if resp = respondDiscovery(r); resp != nil {
+								return
+							}
+
+							if r.Method == "GET" && r.URL.Path == item.path {
 								resp = &http.Response{
 									Status:     strconv.Itoa(item.mockGetResourceResponseCode),
 									StatusCode: item.mockGetResourceResponseCode,
@@ -206,7 +329,7 @@ func TestKubernetesGetResource(t *testing.T) {
 			}
 
 			out := json.RawMessage([]byte(`{}`))
-			err := kubernetes.GetResource(item.kind, item.namespace, item.name, &out)
+			err := kubernetes.GetResource(item.apiVersion, item.kind, item.namespace, item.name, &out)
 
 			So(err, ShouldResemble, item.err)
 		}
@@ -219,23 +342,26 @@ func TestKubernetesCreateResource(t *testing.T) {
 	Convey("Kubernetes CreateResource works correctly", t, func() {
 		table := []struct {
 			// Input
-			kube      *model.Kube
-			kind      string
-			namespace string
-			in        map[string]interface{}
+			kube       *model.Kube
+			apiVersion string
+			kind       string
+			namespace  string
+			in         map[string]interface{}
 			// Mocks
 			mockCreateResourceResponseCode int
 			mockCreateResourceResponseBody string
 			// Expectations
+			path                string
 			resourceNameCreated string
 			err                 error
 		}{
 			// A successful example
 			{
 				// Input
-				kube:      &model.Kube{},
-				kind:      "Service",
-				namespace: "test",
+				kube:       &model.Kube{},
+				apiVersion: "v1",
+				kind:       "Service",
+				namespace:  "test",
 				in: map[string]interface{}{
 					"metadata": map[string]interface{}{
 						"name": "my-resource",
@@ -248,6 +374,28 @@ func TestKubernetesCreateResource(t *testing.T) {
 				mockCreateResourceResponseCode: 201,
 				mockCreateResourceResponseBody: `{}`,
 				// Expectations
+				path:                "/api/v1/namespaces/test/services",
+				resourceNameCreated: "my-resource",
+				err:                 nil,
+			},
+
+			// A kind in an API group
+			{
+				// Input
+				kube:       &model.Kube{},
+				apiVersion: "extensions/v1beta1",
+				kind:       "NetworkPolicy",
+				namespace:  "test",
+				in: map[string]interface{}{
+					"metadata": map[string]interface{}{
+						"name": "my-resource",
+					},
+				},
+				// Mocks
+				mockCreateResourceResponseCode: 201,
+				mockCreateResourceResponseBody: `{}`,
+				// Expectations
+				path:                "/apis/extensions/v1beta1/namespaces/test/networkpolicies",
 				resourceNameCreated: "my-resource",
 				err:                 nil,
 			},
@@ -255,9 +403,10 @@ func TestKubernetesCreateResource(t *testing.T) {
 			// On 409 error (already exists)
 			{
 				// Input
-				kube:      &model.Kube{},
-				kind:      "Service",
-				namespace: "test",
+				kube:       &model.Kube{},
+				apiVersion: "v1",
+				kind:       "Service",
+				namespace:  "test",
 				in: map[string]interface{}{
 					"metadata": map[string]interface{}{
 						"name": "my-resource",
@@ -270,6 +419,7 @@ func TestKubernetesCreateResource(t *testing.T) {
 				mockCreateResourceResponseCode: 409,
 				mockCreateResourceResponseBody: `{}`,
 				// Expectations
+				path:                "/api/v1/namespaces/test/services",
 				resourceNameCreated: "my-resource",
 				err:                 nil,
 			},
@@ -277,9 +427,10 @@ func TestKubernetesCreateResource(t *testing.T) {
 			// On other error
 			{
 				// Input
-				kube:      &model.Kube{},
-				kind:      "Service",
-				namespace: "test",
+				kube:       &model.Kube{},
+				apiVersion: "v1",
+				kind:       "Service",
+				namespace:  "test",
 				in: map[string]interface{}{
 					"metadata": map[string]interface{}{
 						"name": "my-resource",
@@ -292,8 +443,9 @@ func TestKubernetesCreateResource(t *testing.T) {
 				mockCreateResourceResponseCode: 500,
 				mockCreateResourceResponseBody: `bad thing`,
 				// Expectations
+				path:                "/api/v1/namespaces/test/services",
 				resourceNameCreated: "my-resource",
-				err:                 errors.New("K8S 500 error: bad thing"),
+				err:                 &kubernetes.StatusError{Code: 500, Status: &kubernetes.Status{Code: 500, Message: "bad thing"}},
 			},
 		}
 
@@ -307,7 +459,11 @@ func TestKubernetesCreateResource(t *testing.T) {
 					Transport: &fake_http.RoundTripper{
 						RoundTripFn: func(r *http.Request) (resp *http.Response, err error) {
 
-							if r.Method == "POST" && r.URL.Path == "/api/v1/namespaces/"+item.namespace+"/"+strings.ToLower(item.kind)+"s" {
+							if resp = respondDiscovery(r); resp != nil {
+								return
+							}
+
+							if r.Method == "POST" && r.URL.Path == item.path {
 
 								reqBody, _ := ioutil.ReadAll(r.Body)
 								defer r.Body.Close()
@@ -332,7 +488,7 @@ func TestKubernetesCreateResource(t *testing.T) {
 			}
 
 			out := json.RawMessage([]byte(`{}`))
-			err := kubernetes.CreateResource(item.kind, item.namespace, item.in, &out)
+			err := kubernetes.CreateResource(item.apiVersion, item.kind, item.namespace, item.in, &out)
 
 			So(err, ShouldResemble, item.err)
 			So(resourceNameCreated, ShouldEqual, item.resourceNameCreated)
@@ -346,42 +502,64 @@ func TestKubernetesDeleteResource(t *testing.T) {
 	Convey("Kubernetes DeleteResource works correctly", t, func() {
 		table := []struct {
 			// Input
-			kube      *model.Kube
-			kind      string
-			namespace string
-			name      string
+			kube       *model.Kube
+			apiVersion string
+			kind       string
+			namespace  string
+			name       string
 			// Mocks
 			mockDeleteResourceResponseCode int
 			mockDeleteResourceResponseBody string
 			// Expectations
-			err error
+			path string
+			err  error
 		}{
 			// A successful example
 			{
 				// Input
-				kube:      &model.Kube{},
-				kind:      "Pod",
-				namespace: "test",
-				name:      "testname",
+				kube:       &model.Kube{},
+				apiVersion: "v1",
+				kind:       "Pod",
+				namespace:  "test",
+				name:       "testname",
 				// Mocks
 				mockDeleteResourceResponseCode: 202,
 				mockDeleteResourceResponseBody: `{}`,
 				// Expectations
-				err: nil,
+				path: "/api/v1/namespaces/test/pods/testname",
+				err:  nil,
+			},
+
+			// A kind which is its own plural
+			{
+				// Input
+				kube:       &model.Kube{},
+				apiVersion: "v1",
+				kind:       "Endpoints",
+				namespace:  "test",
+				name:       "testname",
+				// Mocks
+				mockDeleteResourceResponseCode: 200,
+				mockDeleteResourceResponseBody: `{}`,
+				// Expectations
+				path: "/api/v1/namespaces/test/endpoints/testname",
+				err:  nil,
 			},
 
 			// On error
 			{
 				// Input
-				kube:      &model.Kube{},
-				kind:      "Pod",
-				namespace: "test",
-				name:      "testname",
+				kube:       &model.Kube{},
+				apiVersion: "extensions/v1beta1",
+				kind:       "DaemonSet",
+				namespace:  "test",
+				name:       "testname",
 				// Mocks
 				mockDeleteResourceResponseCode: 404,
 				mockDeleteResourceResponseBody: `not found`,
 				// Expectations
-				err: errors.New("K8S 404 error: not found"),
+				path: "/apis/extensions/v1beta1/namespaces/test/daemonsets/testname",
+				err:  &kubernetes.StatusError{Code: 404, Status: &kubernetes.Status{Code: 404, Message: "not found"}},
 			},
 		}
 
@@ -393,7 +571,11 @@ func TestKubernetesDeleteResource(t *testing.T) {
 					Transport: &fake_http.RoundTripper{
 						RoundTripFn: func(r *http.Request) (resp *http.Response, err error) {
 
-							if r.Method == "DELETE" && r.URL.Path == "/api/v1/namespaces/"+item.namespace+"/"+strings.ToLower(item.kind)+"s/"+item.name {
+							if resp = respondDiscovery(r); resp != nil {
+								return
+							}
+
+							if r.Method == "DELETE" && r.URL.Path == item.path {
 								resp = &http.Response{
 									Status:     strconv.Itoa(item.mockDeleteResourceResponseCode),
 									StatusCode: item.mockDeleteResourceResponseCode,
@@ -409,7 +591,7 @@ func TestKubernetesDeleteResource(t *testing.T) {
 				},
 			}
 
-			err := kubernetes.DeleteResource(item.kind, item.namespace, item.name)
+			err := kubernetes.DeleteResource(item.apiVersion, item.kind, item.namespace, item.name)
 
 			So(err, ShouldResemble, item.err)
 		}
@@ -418,6 +600,538 @@ func TestKubernetesDeleteResource(t *testing.T) {
 
 //------------------------------------------------------------------------------
 
+func TestKubernetesUpdateResource(t *testing.T) {
+	Convey("Kubernetes UpdateResource and PatchResource work correctly", t, func() {
+		table := []struct {
+			// Input
+			kube       *model.Kube
+			patch      bool
+			apiVersion string
+			kind       string
+			namespace  string
+			name       string
+			in         map[string]interface{}
+			// Mocks
+			mockResponseCode int
+			mockResponseBody string
+			// Expectations
+			method      string
+			path        string
+			contentType string
+			body        string
+			out         json.RawMessage
+			err         error
+		}{
+			// A successful update
+			{
+				// Input
+				kube:       &model.Kube{},
+				apiVersion: "v1",
+				kind:       "Service",
+				namespace:  "test",
+				name:       "testname",
+				in:         map[string]interface{}{"spec": map[string]interface{}{"type": "NodePort"}},
+				// Mocks
+				mockResponseCode: 200,
+				mockResponseBody: `{"updated":true}`,
+				// Expectations
+				method:      "PUT",
+				path:        "/api/v1/namespaces/test/services/testname",
+				contentType: "application/json",
+				body:        `{"spec":{"type":"NodePort"}}`,
+				out:         json.RawMessage(`{"updated":true}`),
+				err:         nil,
+			},
+
+			// A successful patch
+			{
+				// Input
+				kube:       &model.Kube{},
+				patch:      true,
+				apiVersion: "extensions/v1beta1",
+				kind:       "DaemonSet",
+				namespace:  "test",
+				name:       "testname",
+				in:         map[string]interface{}{"metadata": map[string]interface{}{"labels": map[string]interface{}{"a": "b"}}},
+				// Mocks
+				mockResponseCode: 200,
+				mockResponseBody: `{"patched":true}`,
+				// Expectations
+				method:      "PATCH",
+				path:        "/apis/extensions/v1beta1/namespaces/test/daemonsets/testname",
+				contentType: "application/strategic-merge-patch+json",
+				body:        `{"metadata":{"labels":{"a":"b"}}}`,
+				out:         json.RawMessage(`{"patched":true}`),
+				err:         nil,
+			},
+
+			// A custom resource is patched with a JSON merge patch
+			{
+				// Input
+				kube:       &model.Kube{},
+				patch:      true,
+				apiVersion: "monitoring.coreos.com/v1",
+				kind:       "Prometheus",
+				namespace:  "test",
+				name:       "testname",
+				in:         map[string]interface{}{"spec": map[string]interface{}{"replicas": nil}},
+				// Mocks
+				mockResponseCode: 200,
+				mockResponseBody: `{"patched":true}`,
+				// Expectations
+				method:      "PATCH",
+				path:        "/apis/monitoring.coreos.com/v1/namespaces/test/prometheuses/testname",
+				contentType: "application/merge-patch+json",
+				body:        `{"spec":{"replicas":null}}`,
+				out:         json.RawMessage(`{"patched":true}`),
+				err:         nil,
+			},
+
+			// On error
+			{
+				// Input
+				kube:       &model.Kube{},
+				patch:      true,
+				apiVersion: "v1",
+				kind:       "Pod",
+				namespace:  "test",
+				name:       "testname",
+				in:         map[string]interface{}{},
+				// Mocks
+				mockResponseCode: 422,
+				mockResponseBody: `field is immutable`,
+				// Expectations
+				method:      "PATCH",
+				path:        "/api/v1/namespaces/test/pods/testname",
+				contentType: "application/strategic-merge-patch+json",
+				body:        `{}`,
+				out:         json.RawMessage(""),
+				err:         &kubernetes.StatusError{Code: 422, Status: &kubernetes.Status{Code: 422, Message: "field is immutable"}},
+			},
+		}
+
+		for _, item := range table {
+			var contentType string
+			var body string
+
+			kubernetes := &kubernetes.Client{
+				Kube: item.kube,
+				HTTPClient: &http.Client{
+					Transport: &fake_http.RoundTripper{
+						RoundTripFn: func(r *http.Request) (resp *http.Response, err error) {
+
+							if resp = respondDiscovery(r); resp != nil {
+								return
+							}
+
+							if r.Method == item.method && r.URL.Path == item.path {
+								contentType = r.Header.Get("Content-Type")
+								reqBody, _ := ioutil.ReadAll(r.Body)
+								body = string(reqBody)
+
+								resp = &http.Response{
+									Status:     strconv.Itoa(item.mockResponseCode),
+									StatusCode: item.mockResponseCode,
+									Body:       ioutil.NopCloser(bytes.NewBufferString(item.mockResponseBody)),
+								}
+							} else {
+								panic("Did not recognize request Method / URL Path: " + r.Method + " " + r.URL.Path)
+							}
+							return
+
+						},
+					},
+				},
+			}
+
+			out := make(json.RawMessage, 0)
+			var err error
+			if item.patch {
+				err = kubernetes.PatchResource(item.apiVersion, item.kind, item.namespace, item.name, item.in, &out)
+			} else {
+				err = kubernetes.UpdateResource(item.apiVersion, item.kind, item.namespace, item.name, item.in, &out)
+			}
+
+			So(err, ShouldResemble, item.err)
+			So(contentType, ShouldEqual, item.contentType)
+			So(body, ShouldEqual, item.body)
+			So(string(out), ShouldEqual, string(item.out))
+		}
+	})
+}
+
+//------------------------------------------------------------------------------
+
+func TestKubernetesListResources(t *testing.T) {
+	Convey("Kubernetes ListResources works correctly", t, func() {
+		table := []struct {
+			// Input
+			apiVersion string
+			kind       string
+			namespace  string
+			// Mocks
+			mockResponseCode int
+			mockResponseBody string
+			// Expectations
+			path string
+			list *kubernetes.ResourceList
+			err  error
+		}{
+			// In all namespaces
+			{
+				apiVersion:       "v1",
+				kind:             "Pod",
+				namespace:        "",
+				mockResponseCode: 200,
+				mockResponseBody: `{"metadata":{"resourceVersion":"10"},"items":[{"metadata":{"name":"a"}}]}`,
+				path:             "/api/v1/pods",
+				list: &kubernetes.ResourceList{
+					Metadata: kubernetes.ListMetadata{ResourceVersion: "10"},
+					Items:    []json.RawMessage{json.RawMessage(`{"metadata":{"name":"a"}}`)},
+				},
+				err: nil,
+			},
+			// In a namespace
+			{
+				apiVersion:       "extensions/v1beta1",
+				kind:             "Ingress",
+				namespace:        "test",
+				mockResponseCode: 200,
+				mockResponseBody: `{"metadata":{"resourceVersion":"11"},"items":[]}`,
+				path:             "/apis/extensions/v1beta1/namespaces/test/ingresses",
+				list: &kubernetes.ResourceList{
+					Metadata: kubernetes.ListMetadata{ResourceVersion: "11"},
+					Items:    []json.RawMessage{},
+				},
+				err: nil,
+			},
+			// On error
+			{
+				apiVersion:       "v1",
+				kind:             "Service",
+				namespace:        "",
+				mockResponseCode: 403,
+				mockResponseBody: `forbidden`,
+				path:             "/api/v1/services",
+				list:             nil,
+				err:              &kubernetes.StatusError{Code: 403, Status: &kubernetes.Status{Code: 403, Message: "forbidden"}},
+			},
+		}
+
+		for _, item := range table {
+			kubernetes := &kubernetes.Client{
+				Kube: &model.Kube{},
+				HTTPClient: &http.Client{
+					Transport: &fake_http.RoundTripper{
+						RoundTripFn: func(r *http.Request) (resp *http.Response, err error) {
+							if resp = respondDiscovery(r); resp != nil {
+								return
+							}
+							if r.Method == "GET" && r.URL.Path == item.path {
+								resp = &http.Response{
+									Status:     strconv.Itoa(item.mockResponseCode),
+									StatusCode: item.mockResponseCode,
+									Body:       ioutil.NopCloser(bytes.NewBufferString(item.mockResponseBody)),
+								}
+							} else {
+								panic("Did not recognize request Method / URL Path: " + r.Method + " " + r.URL.Path)
+							}
+							return
+						},
+					},
+				},
+			}
+
+			list, err := kubernetes.ListResources(item.apiVersion, item.kind, item.namespace)
+
+			So(err, ShouldResemble, item.err)
+			So(list, ShouldResemble, item.list)
+		}
+	})
+}
+
+//------------------------------------------------------------------------------
+
+func TestKubernetesWatchResources(t *testing.T) {
+	Convey("Kubernetes WatchResources works correctly", t, func() {
+		table := []struct {
+			// Input
+			resourceVersion string
+			// Mocks
+			mockResponseBody string
+			// Expectations
+			query  string
+			events []*kubernetes.WatchEvent
+			err    error
+		}{
+			// Events until the watch ends
+			{
+				resourceVersion: "10",
+				mockResponseBody: `{"type":"ADDED","object":{"metadata":{"name":"a","resourceVersion":"11"}}}
+{"type":"DELETED","object":{"metadata":{"name":"a","resourceVersion":"12"}}}
+`,
+				query: "resourceVersion=10&timeoutSeconds=25&watch=true",
+				events: []*kubernetes.WatchEvent{
+					{Type: "ADDED", Object: json.RawMessage(`{"metadata":{"name":"a","resourceVersion":"11"}}`)},
+					{Type: "DELETED", Object: json.RawMessage(`{"metadata":{"name":"a","resourceVersion":"12"}}`)},
+				},
+				err: nil,
+			},
+			// An expired resourceVersion
+			{
+				resourceVersion: "1",
+				mockResponseBody: `{"type":"MODIFIED","object":{"metadata":{"name":"a","resourceVersion":"2"}}}
+{"type":"ERROR","object":{"kind":"Status","code":410,"reason":"Gone","message":"too old resource version: 1 (5)"}}
+{"type":"MODIFIED","object":{"metadata":{"name":"a","resourceVersion":"3"}}}
+`,
+				query: "resourceVersion=1&timeoutSeconds=25&watch=true",
+				events: []*kubernetes.WatchEvent{
+					{Type: "MODIFIED", Object: json.RawMessage(`{"metadata":{"name":"a","resourceVersion":"2"}}`)},
+				},
+				err: &kubernetes.StatusError{Code: 410, Status: &kubernetes.Status{Code: 410, Reason: "Gone", Message: "too old resource version: 1 (5)"}},
+			},
+		}
+
+		for _, item := range table {
+			var query string
+			var events []*kubernetes.WatchEvent
+			fn := func(event *kubernetes.WatchEvent) error {
+				events = append(events, event)
+				return nil
+			}
+
+			kubernetes := &kubernetes.Client{
+				Kube: &model.Kube{},
+				HTTPClient: &http.Client{
+					Transport: &fake_http.RoundTripper{
+						RoundTripFn: func(r *http.Request) (resp *http.Response, err error) {
+							if resp = respondDiscovery(r); resp != nil {
+								return
+							}
+							if r.Method == "GET" && r.URL.Path == "/api/v1/pods" {
+								query = r.URL.RawQuery
+								resp = &http.Response{
+									Status:     "200",
+									StatusCode: 200,
+									Body:       ioutil.NopCloser(bytes.NewBufferString(item.mockResponseBody)),
+								}
+							} else {
+								panic("Did not recognize request Method / URL Path: " + r.Method + " " + r.URL.Path)
+							}
+							return
+						},
+					},
+				},
+			}
+
+			err := kubernetes.WatchResources("v1", "Pod", "", item.resourceVersion, fn)
+
+			So(err, ShouldResemble, item.err)
+			So(query, ShouldEqual, item.query)
+			So(events, ShouldResemble, item.events)
+		}
+	})
+}
+
+func TestKubernetesPodLogs(t *testing.T) {
+	Convey("Kubernetes PodLogs works correctly", t, func() {
+		table := []struct {
+			// Input
+			query *model.PodLogQuery
+			// Mocks
+			mockResponseStatus string
+			mockResponseBody   string
+			// Expectations
+			rawQuery string
+			logs     string
+			err      error
+		}{
+			// All lines
+			{
+				query:              &model.PodLogQuery{},
+				mockResponseStatus: "200",
+				mockResponseBody:   "line 1\nline 2\n",
+				rawQuery:           "",
+				logs:               "line 1\nline 2\n",
+				err:                nil,
+			},
+			// Selected lines
+			{
+				query: &model.PodLogQuery{
+					Container: "app",
+					Tail:      10,
+					Since:     time.Date(2017, 6, 1, 12, 0, 0, 0, time.UTC),
+					Follow:    true,
+				},
+				mockResponseStatus: "200",
+				mockResponseBody:   "line 2\n",
+				rawQuery:           "container=app&follow=true&sinceTime=2017-06-01T12%3A00%3A00Z&tailLines=10",
+				logs:               "line 2\n",
+				err:                nil,
+			},
+			// An error
+			{
+				query:              &model.PodLogQuery{Container: "nope"},
+				mockResponseStatus: "400",
+				mockResponseBody:   `{"message":"container nope is not valid for pod a"}`,
+				rawQuery:           "container=nope",
+				logs:               "",
+				err:                &kubernetes.StatusError{Code: 400, Status: &kubernetes.Status{Code: 400, Message: "container nope is not valid for pod a"}},
+			},
+		}
+
+		for _, item := range table {
+			var rawQuery string
+
+			kubernetes := &kubernetes.Client{
+				Kube: &model.Kube{},
+				HTTPClient: &http.Client{
+					Transport: &fake_http.RoundTripper{
+						RoundTripFn: func(r *http.Request) (resp *http.Response, err error) {
+							if r.Method == "GET" && r.URL.Path == "/api/v1/namespaces/ns/pods/a/log" {
+								rawQuery = r.URL.RawQuery
+								statusCode, _ := strconv.Atoi(item.mockResponseStatus)
+								resp = &http.Response{
+									Status:     item.mockResponseStatus,
+									StatusCode: statusCode,
+									Body:       ioutil.NopCloser(bytes.NewBufferString(item.mockResponseBody)),
+								}
+							} else {
+								panic("Did not recognize request Method / URL Path: " + r.Method + " " + r.URL.Path)
+							}
+							return
+						},
+					},
+				},
+			}
+
+			var logs string
+			body, err := kubernetes.PodLogs("ns", "a", item.query)
+			if err == nil {
+				out, _ := ioutil.ReadAll(body)
+				body.Close()
+				logs = string(out)
+			}
+
+			So(err, ShouldResemble, item.err)
+			So(rawQuery, ShouldEqual, item.rawQuery)
+			So(logs, ShouldEqual, item.logs)
+		}
+	})
+}
+
+func TestKubernetesExecPod(t *testing.T) {
+	Convey("Kubernetes ExecPod works correctly", t, func() {
+		var request *http.Request
+		upgrader := &websocket.Upgrader{Subprotocols: []string{kubernetes.ExecProtocol}}
+
+		server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			request = r
+			if r.URL.Query().Get("container") == "nope" {
+				w.WriteHeader(400)
+				w.Write([]byte(`{"message":"container nope is not valid for pod a"}`))
+				return
+			}
+			conn, err := upgrader.Upgrade(w, r, nil)
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+			conn.WriteMessage(websocket.BinaryMessage, append([]byte{kubernetes.ExecStdout}, "hello\n"...))
+		}))
+		defer server.Close()
+
+		kube := &model.Kube{
+			MasterPublicIP: strings.TrimPrefix(server.URL, "https://"),
+			CACert:         string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})),
+			Username:       "user",
+			Password:       "pass",
+		}
+		client := &kubernetes.Client{Kube: kube}
+
+		Convey("It streams the output of the command", func() {
+			conn, err := client.ExecPod("ns", "a", &model.ExecQuery{
+				Container: "app",
+				Command:   []string{"sh", "-c", "echo hello"},
+				TTY:       true,
+			})
+			So(err, ShouldBeNil)
+			defer conn.Close()
+
+			So(request.URL.Path, ShouldEqual, "/api/v1/namespaces/ns/pods/a/exec")
+			So(request.URL.RawQuery, ShouldEqual, "command=sh&command=-c&command=echo+hello&container=app&stderr=true&stdout=true&tty=true")
+			username, password, _ := request.BasicAuth()
+			So(username, ShouldEqual, "user")
+			So(password, ShouldEqual, "pass")
+			So(conn.Subprotocol(), ShouldEqual, kubernetes.ExecProtocol)
+
+			_, msg, err := conn.ReadMessage()
+			So(err, ShouldBeNil)
+			So(string(msg), ShouldEqual, "\x01hello\n")
+		})
+
+		Convey("It returns the error of a failed handshake", func() {
+			_, err := client.ExecPod("ns", "a", &model.ExecQuery{
+				Container: "nope",
+				Command:   []string{"ls"},
+			})
+			So(err, ShouldResemble, &kubernetes.StatusError{Code: 400, Status: &kubernetes.Status{Code: 400, Message: "container nope is not valid for pod a"}})
+		})
+	})
+}
+
+//------------------------------------------------------------------------------
+
+func TestKubernetesDiscovery(t *testing.T) {
+	Convey("Kubernetes Discovery caches resources per Kube", t, func() {
+		discoveryRequests := make(map[string]int)
+
+		newClient := func(kubeName string, discovery *kubernetes.Discovery) *kubernetes.Client {
+			return &kubernetes.Client{
+				Kube:      &model.Kube{Name: kubeName},
+				Discovery: discovery,
+				HTTPClient: &http.Client{
+					Transport: &fake_http.RoundTripper{
+						RoundTripFn: func(r *http.Request) (*http.Response, error) {
+							if resp := respondDiscovery(r); resp != nil {
+								discoveryRequests[kubeName+" "+r.URL.Path]++
+								return resp, nil
+							}
+							return &http.Response{
+								Status:     "200",
+								StatusCode: 200,
+								Body:       ioutil.NopCloser(bytes.NewBufferString(`{}`)),
+							}, nil
+						},
+					},
+				},
+			}
+		}
+
+		discovery := kubernetes.NewDiscovery(time.Hour)
+		out := json.RawMessage([]byte(`{}`))
+
+		newClient("a", discovery).GetResource("extensions/v1beta1", "Ingress", "test", "one", &out)
+		newClient("a", discovery).GetResource("extensions/v1beta1", "Deployment", "test", "two", &out)
+		newClient("b", discovery).GetResource("extensions/v1beta1", "Ingress", "test", "one", &out)
+
+		// A missing kind is always refetched
+		newClient("a", discovery).GetResource("extensions/v1beta1", "Missing", "test", "one", &out)
+
+		// Without Discovery, nothing is cached
+		newClient("c", nil).GetResource("v1", "Pod", "test", "one", &out)
+		newClient("c", nil).GetResource("v1", "Pod", "test", "one", &out)
+
+		So(discoveryRequests, ShouldResemble, map[string]int{
+			"a /apis/extensions/v1beta1": 2,
+			"b /apis/extensions/v1beta1": 1,
+			"c /api/v1":                  2,
+		})
+	})
+}
+
+//------------------------------------------------------------------------------
+
 func TestKubernetesListNamespaces(t *testing.T) {
 	Convey("Kubernetes ListNamespaces works correctly", t, func() {
 		table := []struct {
@@ -466,7 +1180,7 @@ func TestKubernetesListNamespaces(t *testing.T) {
 				mockListNamespacesResponseCode: 500,
 				mockListNamespacesResponseBody: `something bad`,
 				// Expectations
-				err: errors.New("K8S 500 error: something bad"),
+				err: &kubernetes.StatusError{Code: 500, Status: &kubernetes.Status{Code: 500, Message: "something bad"}},
 			},
 		}
 
@@ -552,7 +1266,7 @@ func TestKubernetesListNodes(t *testing.T) {
 				mockListNodesResponseCode: 500,
 				mockListNodesResponseBody: `something bad`,
 				// Expectations
-				err: errors.New("K8S 500 error: something bad"),
+				err: &kubernetes.StatusError{Code: 500, Status: &kubernetes.Status{Code: 500, Message: "something bad"}},
 			},
 		}
 
@@ -638,7 +1352,7 @@ func TestKubernetesListPods(t *testing.T) {
 				mockListPodsResponseCode: 500,
 				mockListPodsResponseBody: `something bad`,
 				// Expectations
-				err: errors.New("K8S 500 error: something bad"),
+				err: &kubernetes.StatusError{Code: 500, Status: &kubernetes.Status{Code: 500, Message: "something bad"}},
 			},
 		}
 
@@ -724,7 +1438,7 @@ func TestKubernetesListEvents(t *testing.T) {
 				mockListEventsResponseCode: 500,
 				mockListEventsResponseBody: `something bad`,
 				// Expectations
-				err: errors.New("K8S 500 error: something bad"),
+				err: &kubernetes.StatusError{Code: 500, Status: &kubernetes.Status{Code: 500, Message: "something bad"}},
 			},
 		}
 
@@ -806,7 +1520,7 @@ func TestKubernetesListNodeHeapsterStats(t *testing.T) {
 				mockListNodeHeapsterStatsResponseCode: 500,
 				mockListNodeHeapsterStatsResponseBody: `something bad`,
 				// Expectations
-				err: errors.New("K8S 500 error: something bad"),
+				err: &kubernetes.StatusError{Code: 500, Status: &kubernetes.Status{Code: 500, Message: "something bad"}},
 			},
 		}
 
@@ -890,7 +1604,7 @@ func TestKubernetesListPodHeapsterCPUUsageMetrics(t *testing.T) {
 				mockListPodHeapsterCPUUsageMetricsResponseCode: 500,
 				mockListPodHeapsterCPUUsageMetricsResponseBody: `something bad`,
 				// Expectations
-				err: errors.New("K8S 500 error: something bad"),
+				err: &kubernetes.StatusError{Code: 500, Status: &kubernetes.Status{Code: 500, Message: "something bad"}},
 			},
 		}
 
@@ -974,7 +1688,7 @@ func TestKubernetesListPodHeapsterRAMUsageMetrics(t *testing.T) {
 				mockListPodHeapsterRAMUsageMetricsResponseCode: 500,
 				mockListPodHeapsterRAMUsageMetricsResponseBody: `something bad`,
 				// Expectations
-				err: errors.New("K8S 500 error: something bad"),
+				err: &kubernetes.StatusError{Code: 500, Status: &kubernetes.Status{Code: 500, Message: "something bad"}},
 			},
 		}
 
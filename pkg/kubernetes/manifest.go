@@ -0,0 +1,70 @@
+package kubernetes
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/ghodss/yaml"
+)
+
+var manifestSeparator = regexp.MustCompile(`(?m)^---\s*$`)
+
+// DecodeManifests decodes the resources of a manifest, which is YAML or JSON,
+// and may contain multiple documents separated by "---". Lists (kind List, or
+// a JSON array) are expanded into their items. Empty documents are skipped,
+// and every resource must have a kind.
+func DecodeManifests(data []byte) ([]map[string]interface{}, error) {
+	var manifests []map[string]interface{}
+	for i, doc := range manifestSeparator.Split(string(data), -1) {
+		if strings.TrimSpace(doc) == "" {
+			continue
+		}
+		var obj interface{}
+		if err := yaml.Unmarshal([]byte(doc), &obj); err != nil {
+			return nil, fmt.Errorf("document %d: %s", i+1, err)
+		}
+		docManifests, err := listItems(obj)
+		if err != nil {
+			return nil, fmt.Errorf("document %d: %s", i+1, err)
+		}
+		manifests = append(manifests, docManifests...)
+	}
+	return manifests, nil
+}
+
+// Private
+
+// listItems returns obj as resources, expanding lists.
+func listItems(obj interface{}) ([]map[string]interface{}, error) {
+	var items []interface{}
+	switch obj := obj.(type) {
+	case nil:
+		// A document with only comments
+		return nil, nil
+	case []interface{}:
+		items = obj
+	case map[string]interface{}:
+		kind, _ := obj["kind"].(string)
+		if kind == "" {
+			return nil, fmt.Errorf("resource has no kind")
+		}
+		var isList bool
+		items, isList = obj["items"].([]interface{})
+		if !isList || !strings.HasSuffix(kind, "List") {
+			return []map[string]interface{}{obj}, nil
+		}
+	default:
+		return nil, fmt.Errorf("expected a resource or list, got %v", obj)
+	}
+
+	var manifests []map[string]interface{}
+	for _, item := range items {
+		itemManifests, err := listItems(item)
+		if err != nil {
+			return nil, err
+		}
+		manifests = append(manifests, itemManifests...)
+	}
+	return manifests, nil
+}
@@ -0,0 +1,62 @@
+package kubernetes_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/supergiant/supergiant/pkg/kubernetes"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestDecodeManifests(t *testing.T) {
+	Convey("DecodeManifests decodes YAML and JSON manifests into their resources", t, func() {
+		table := []struct {
+			// Input
+			manifest string
+			// Expectations
+			names []string
+			err   error
+		}{
+			// A JSON resource
+			{
+				manifest: `{"kind": "Pod", "metadata": {"name": "a"}}`,
+				names:    []string{"Pod a"},
+			},
+			// YAML documents, including empty ones
+			{
+				manifest: "---\nkind: Pod\nmetadata:\n  name: a\n---\n# Nothing here\n---\nkind: Service\nmetadata:\n  name: b\n",
+				names:    []string{"Pod a", "Service b"},
+			},
+			// Lists, and JSON arrays
+			{
+				manifest: `{"kind": "List", "items": [{"kind": "Pod", "metadata": {"name": "a"}}, {"kind": "ServiceList", "items": [{"kind": "Service", "metadata": {"name": "b"}}]}]}
+---
+[{"kind": "Secret", "metadata": {"name": "c"}}]`,
+				names: []string{"Pod a", "Service b", "Secret c"},
+			},
+			// Missing kind
+			{
+				manifest: "kind: Pod\n---\nmetadata:\n  name: a\n",
+				err:      errors.New("document 2: resource has no kind"),
+			},
+			// Not a resource
+			{
+				manifest: "just words",
+				err:      errors.New("document 1: expected a resource or list, got just words"),
+			},
+		}
+
+		for _, item := range table {
+			manifests, err := kubernetes.DecodeManifests([]byte(item.manifest))
+			So(err, ShouldResemble, item.err)
+
+			var names []string
+			for _, manifest := range manifests {
+				metadata := manifest["metadata"].(map[string]interface{})
+				names = append(names, manifest["kind"].(string)+" "+metadata["name"].(string))
+			}
+			So(names, ShouldResemble, item.names)
+		}
+	})
+}
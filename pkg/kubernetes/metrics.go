@@ -0,0 +1,402 @@
+package kubernetes
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/supergiant/supergiant/pkg/model"
+)
+
+// MetricsSource provides the resource usage of the Nodes and Pods of a Kube.
+// CPU is measured in millicores, and RAM in bytes.
+type MetricsSource interface {
+	// NodeUsage returns the current usage of each Node.
+	NodeUsage() ([]*NodeUsage, error)
+
+	// PodUsage returns the recent usage of a Pod, oldest first. Sources without
+	// history return only the current usage.
+	PodUsage(namespace string, name string) (*PodUsage, error)
+}
+
+type NodeUsage struct {
+	Name     string
+	CPUUsage int64
+	RAMUsage int64
+}
+
+type PodUsage struct {
+	CPUUsage []*Metric `json:"cpu_usage"`
+	RAMUsage []*Metric `json:"ram_usage"`
+}
+
+type Metric struct {
+	Timestamp time.Time `json:"timestamp"`
+	Value     int64     `json:"value"`
+}
+
+// PrometheusHTTPClient is used by PrometheusMetricsSource.
+var PrometheusHTTPClient = &http.Client{Timeout: 10 * time.Second}
+
+// NewMetricsSource returns the MetricsSource chosen by kube.MetricsSource,
+// which defaults to Heapster.
+func NewMetricsSource(kube *model.Kube, client ClientInterface) MetricsSource {
+	switch kube.MetricsSource {
+	case model.MetricsSourceMetricsServer:
+		return &MetricsServerSource{client}
+	case model.MetricsSourcePrometheus:
+		return &PrometheusMetricsSource{URL: kube.PrometheusURL, HTTPClient: PrometheusHTTPClient}
+	default:
+		return &HeapsterMetricsSource{client}
+	}
+}
+
+// Heapster --------------------------------------------------------------------
+
+// HeapsterMetricsSource reads usage from the Heapster service in kube-system.
+type HeapsterMetricsSource struct {
+	Client ClientInterface
+}
+
+func (s *HeapsterMetricsSource) NodeUsage() ([]*NodeUsage, error) {
+	stats, err := s.Client.ListNodeHeapsterStats()
+	if err != nil {
+		return nil, err
+	}
+	var usage []*NodeUsage
+	for _, stat := range stats {
+		usage = append(usage, &NodeUsage{
+			Name:     stat.Name,
+			CPUUsage: stat.CPUUsage,
+			RAMUsage: stat.RAMUsage,
+		})
+	}
+	return usage, nil
+}
+
+func (s *HeapsterMetricsSource) PodUsage(namespace string, name string) (*PodUsage, error) {
+	cpuMetrics, err := s.Client.ListPodHeapsterCPUUsageMetrics(namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	ramMetrics, err := s.Client.ListPodHeapsterRAMUsageMetrics(namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	return &PodUsage{
+		CPUUsage: metricsFromHeapster(cpuMetrics),
+		RAMUsage: metricsFromHeapster(ramMetrics),
+	}, nil
+}
+
+func metricsFromHeapster(heapsterMetrics []*HeapsterMetric) []*Metric {
+	metrics := make([]*Metric, len(heapsterMetrics))
+	for i, metric := range heapsterMetrics {
+		metrics[i] = &Metric{metric.Timestamp, metric.Value}
+	}
+	return metrics
+}
+
+// metrics.k8s.io --------------------------------------------------------------
+
+const metricsAPIVersion = "metrics.k8s.io/v1beta1"
+
+// MetricsServerSource reads usage from the metrics.k8s.io API, which is served
+// by metrics-server.
+type MetricsServerSource struct {
+	Client ClientInterface
+}
+
+type resourceUsage struct {
+	CPU    string `json:"cpu"`
+	Memory string `json:"memory"`
+}
+
+type nodeMetrics struct {
+	Metadata  Metadata      `json:"metadata"`
+	Timestamp time.Time     `json:"timestamp"`
+	Usage     resourceUsage `json:"usage"`
+}
+
+type podMetrics struct {
+	Metadata   Metadata  `json:"metadata"`
+	Timestamp  time.Time `json:"timestamp"`
+	Containers []struct {
+		Name  string        `json:"name"`
+		Usage resourceUsage `json:"usage"`
+	} `json:"containers"`
+}
+
+func (s *MetricsServerSource) NodeUsage() ([]*NodeUsage, error) {
+	list, err := s.Client.ListResources(metricsAPIVersion, "NodeMetrics", "")
+	if err != nil {
+		return nil, err
+	}
+	var usage []*NodeUsage
+	for _, item := range list.Items {
+		metrics := new(nodeMetrics)
+		if err := json.Unmarshal(item, metrics); err != nil {
+			return nil, err
+		}
+		cpu, ram, err := metrics.Usage.values()
+		if err != nil {
+			return nil, err
+		}
+		usage = append(usage, &NodeUsage{
+			Name:     metrics.Metadata.Name,
+			CPUUsage: cpu,
+			RAMUsage: ram,
+		})
+	}
+	return usage, nil
+}
+
+func (s *MetricsServerSource) PodUsage(namespace string, name string) (*PodUsage, error) {
+	raw := new(json.RawMessage)
+	if err := s.Client.GetResource(metricsAPIVersion, "PodMetrics", namespace, name, raw); err != nil {
+		return nil, err
+	}
+	metrics := new(podMetrics)
+	if err := json.Unmarshal(*raw, metrics); err != nil {
+		return nil, err
+	}
+
+	var totalCPU, totalRAM int64
+	for _, container := range metrics.Containers {
+		cpu, ram, err := container.Usage.values()
+		if err != nil {
+			return nil, err
+		}
+		totalCPU += cpu
+		totalRAM += ram
+	}
+	return &PodUsage{
+		CPUUsage: []*Metric{{metrics.Timestamp, totalCPU}},
+		RAMUsage: []*Metric{{metrics.Timestamp, totalRAM}},
+	}, nil
+}
+
+// values returns CPU in millicores and memory in bytes.
+func (u resourceUsage) values() (cpu int64, ram int64, err error) {
+	cores, err := quantityValue(u.CPU)
+	if err != nil {
+		return 0, 0, err
+	}
+	bytes, err := quantityValue(u.Memory)
+	if err != nil {
+		return 0, 0, err
+	}
+	return int64(cores * 1000), int64(bytes), nil
+}
+
+var (
+	rxpQuantity        = regexp.MustCompile(`^([0-9]+(?:\.[0-9]+)?)([a-zA-Z]*)$`)
+	quantityMultiplier = map[string]float64{
+		"n":  1e-9,
+		"u":  1e-6,
+		"m":  1e-3,
+		"":   1,
+		"k":  1e3,
+		"M":  1e6,
+		"G":  1e9,
+		"T":  1e12,
+		"Ki": 1 << 10,
+		"Mi": 1 << 20,
+		"Gi": 1 << 30,
+		"Ti": 1 << 40,
+	}
+)
+
+// NodeCapacity returns the CPU (millicores) and RAM (bytes) capacity of node.
+func NodeCapacity(node *Node) (cpu int64, ram int64, err error) {
+	cores, err := quantityValue(node.Status.Capacity.CPU)
+	if err != nil {
+		return 0, 0, err
+	}
+	bytes, err := quantityValue(node.Status.Capacity.Memory)
+	if err != nil {
+		return 0, 0, err
+	}
+	return int64(cores * 1000), int64(bytes), nil
+}
+
+// quantityValue parses a Kubernetes quantity such as 250m, 250000000n or 512Mi.
+func quantityValue(str string) (float64, error) {
+	match := rxpQuantity.FindStringSubmatch(str)
+	if match == nil {
+		return 0, fmt.Errorf("Could not parse quantity %s", str)
+	}
+	multiplier, ok := quantityMultiplier[match[2]]
+	if !ok {
+		return 0, fmt.Errorf("Could not parse quantity %s", str)
+	}
+	num, _ := strconv.ParseFloat(match[1], 64)
+	return num * multiplier, nil
+}
+
+// Prometheus ------------------------------------------------------------------
+
+// Node queries are grouped by the kubernetes_io_hostname label, which is
+// mapped from the Node labels by the example Kubernetes scrape config for
+// cAdvisor. Pod queries select cAdvisor container metrics by pod_name.
+const (
+	prometheusNodeCPUQuery = `sum by (kubernetes_io_hostname) (rate(container_cpu_usage_seconds_total{id="/"}[5m])) * 1000`
+	prometheusNodeRAMQuery = `sum by (kubernetes_io_hostname) (container_memory_working_set_bytes{id="/"})`
+	prometheusPodCPUQuery  = `sum(rate(container_cpu_usage_seconds_total{namespace=%q,pod_name=%q,container_name!="",container_name!="POD"}[5m])) * 1000`
+	prometheusPodRAMQuery  = `sum(container_memory_working_set_bytes{namespace=%q,pod_name=%q,container_name!="",container_name!="POD"})`
+
+	// PodUsage returns the last 15 minutes, as Heapster does.
+	prometheusPodRange = 15 * time.Minute
+	prometheusPodStep  = time.Minute
+)
+
+// PrometheusMetricsSource reads usage from the HTTP API of a Prometheus server
+// scraping the cAdvisor metrics of the Kube.
+type PrometheusMetricsSource struct {
+	// URL is the base URL of the Prometheus server, e.g. http://prometheus:9090
+	URL        string
+	HTTPClient *http.Client
+}
+
+type prometheusResponse struct {
+	Status string `json:"status"`
+	Error  string `json:"error"`
+	Data   struct {
+		Result []*prometheusSeries `json:"result"`
+	} `json:"data"`
+}
+
+type prometheusSeries struct {
+	Metric map[string]string `json:"metric"`
+
+	// [unix time, "value"] samples of vector and matrix results respectively
+	Value  []interface{}   `json:"value"`
+	Values [][]interface{} `json:"values"`
+}
+
+func (s *PrometheusMetricsSource) NodeUsage() ([]*NodeUsage, error) {
+	cpuSeries, err := s.query("/api/v1/query", url.Values{"query": {prometheusNodeCPUQuery}})
+	if err != nil {
+		return nil, err
+	}
+	ramSeries, err := s.query("/api/v1/query", url.Values{"query": {prometheusNodeRAMQuery}})
+	if err != nil {
+		return nil, err
+	}
+
+	usageByName := make(map[string]*NodeUsage)
+	var usage []*NodeUsage
+	nodeUsage := func(series *prometheusSeries) *NodeUsage {
+		name := series.Metric["kubernetes_io_hostname"]
+		if usageByName[name] == nil {
+			usageByName[name] = &NodeUsage{Name: name}
+			usage = append(usage, usageByName[name])
+		}
+		return usageByName[name]
+	}
+
+	for _, series := range cpuSeries {
+		metric, err := prometheusSample(series.Value)
+		if err != nil {
+			return nil, err
+		}
+		nodeUsage(series).CPUUsage = metric.Value
+	}
+	for _, series := range ramSeries {
+		metric, err := prometheusSample(series.Value)
+		if err != nil {
+			return nil, err
+		}
+		nodeUsage(series).RAMUsage = metric.Value
+	}
+	return usage, nil
+}
+
+func (s *PrometheusMetricsSource) PodUsage(namespace string, name string) (*PodUsage, error) {
+	cpuMetrics, err := s.queryRange(fmt.Sprintf(prometheusPodCPUQuery, namespace, name))
+	if err != nil {
+		return nil, err
+	}
+	ramMetrics, err := s.queryRange(fmt.Sprintf(prometheusPodRAMQuery, namespace, name))
+	if err != nil {
+		return nil, err
+	}
+	return &PodUsage{
+		CPUUsage: cpuMetrics,
+		RAMUsage: ramMetrics,
+	}, nil
+}
+
+func (s *PrometheusMetricsSource) queryRange(query string) ([]*Metric, error) {
+	end := time.Now()
+	series, err := s.query("/api/v1/query_range", url.Values{
+		"query": {query},
+		"start": {strconv.FormatInt(end.Add(-prometheusPodRange).Unix(), 10)},
+		"end":   {strconv.FormatInt(end.Unix(), 10)},
+		"step":  {strconv.Itoa(int(prometheusPodStep.Seconds()))},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	metrics := []*Metric{}
+	if len(series) == 0 {
+		return metrics, nil
+	}
+	for _, sample := range series[0].Values {
+		metric, err := prometheusSample(sample)
+		if err != nil {
+			return nil, err
+		}
+		metrics = append(metrics, metric)
+	}
+	return metrics, nil
+}
+
+func (s *PrometheusMetricsSource) query(path string, values url.Values) ([]*prometheusSeries, error) {
+	if s.URL == "" {
+		return nil, errors.New("Prometheus URL is not set")
+	}
+	resp, err := s.HTTPClient.Get(s.URL + path + "?" + values.Encode())
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	out := new(prometheusResponse)
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return nil, fmt.Errorf("Prometheus %s error: %s", resp.Status, err)
+	}
+	if out.Status != "success" {
+		return nil, fmt.Errorf("Prometheus %s error: %s", resp.Status, out.Error)
+	}
+	return out.Data.Result, nil
+}
+
+// prometheusSample converts a [unix time, "value"] sample.
+func prometheusSample(sample []interface{}) (*Metric, error) {
+	if len(sample) != 2 {
+		return nil, fmt.Errorf("Could not parse Prometheus sample %v", sample)
+	}
+	timestamp, ok := sample[0].(float64)
+	if !ok {
+		return nil, fmt.Errorf("Could not parse Prometheus sample %v", sample)
+	}
+	str, ok := sample[1].(string)
+	if !ok {
+		return nil, fmt.Errorf("Could not parse Prometheus sample %v", sample)
+	}
+	value, err := strconv.ParseFloat(str, 64)
+	if err != nil {
+		return nil, err
+	}
+	return &Metric{
+		Timestamp: time.Unix(0, int64(timestamp*float64(time.Second))).UTC(),
+		Value:     int64(value),
+	}, nil
+}
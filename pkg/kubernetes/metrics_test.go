@@ -0,0 +1,170 @@
+package kubernetes_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/supergiant/supergiant/pkg/kubernetes"
+	"github.com/supergiant/supergiant/pkg/model"
+	"github.com/supergiant/supergiant/test/fake_core"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestNewMetricsSource(t *testing.T) {
+	Convey("NewMetricsSource returns the MetricsSource of the Kube", t, func() {
+		client := new(fake_core.KubernetesClient)
+
+		_, ok := kubernetes.NewMetricsSource(&model.Kube{}, client).(*kubernetes.HeapsterMetricsSource)
+		So(ok, ShouldBeTrue)
+
+		_, ok = kubernetes.NewMetricsSource(&model.Kube{MetricsSource: "metrics_server"}, client).(*kubernetes.MetricsServerSource)
+		So(ok, ShouldBeTrue)
+
+		source, ok := kubernetes.NewMetricsSource(&model.Kube{MetricsSource: "prometheus", PrometheusURL: "http://prometheus:9090"}, client).(*kubernetes.PrometheusMetricsSource)
+		So(ok, ShouldBeTrue)
+		So(source.URL, ShouldEqual, "http://prometheus:9090")
+	})
+}
+
+func TestHeapsterMetricsSource(t *testing.T) {
+	Convey("HeapsterMetricsSource converts Heapster stats and metrics", t, func() {
+		timestamp := time.Date(2017, 6, 1, 12, 0, 0, 0, time.UTC)
+		source := &kubernetes.HeapsterMetricsSource{
+			Client: &fake_core.KubernetesClient{
+				ListNodeHeapsterStatsFn: func() ([]*kubernetes.HeapsterStats, error) {
+					return []*kubernetes.HeapsterStats{{Name: "node-1", CPUUsage: 250, RAMUsage: 1024}}, nil
+				},
+				ListPodHeapsterCPUUsageMetricsFn: func(namespace string, name string) ([]*kubernetes.HeapsterMetric, error) {
+					return []*kubernetes.HeapsterMetric{{Timestamp: timestamp, Value: 5}}, nil
+				},
+				ListPodHeapsterRAMUsageMetricsFn: func(namespace string, name string) ([]*kubernetes.HeapsterMetric, error) {
+					return nil, errors.New("K8S 503 Service Unavailable error: no endpoints available for service \"heapster\"")
+				},
+			},
+		}
+
+		usage, err := source.NodeUsage()
+		So(err, ShouldBeNil)
+		So(usage, ShouldResemble, []*kubernetes.NodeUsage{{Name: "node-1", CPUUsage: 250, RAMUsage: 1024}})
+
+		_, err = source.PodUsage("ns", "a")
+		So(err, ShouldResemble, errors.New("K8S 503 Service Unavailable error: no endpoints available for service \"heapster\""))
+	})
+}
+
+func TestMetricsServerSource(t *testing.T) {
+	Convey("MetricsServerSource reads the metrics.k8s.io API", t, func() {
+		var requested []string
+		source := &kubernetes.MetricsServerSource{
+			Client: &fake_core.KubernetesClient{
+				ListResourcesFn: func(apiVersion string, kind string, namespace string) (*kubernetes.ResourceList, error) {
+					requested = append(requested, apiVersion+" "+kind)
+					return &kubernetes.ResourceList{
+						Items: []json.RawMessage{
+							json.RawMessage(`{"metadata":{"name":"node-1"},"usage":{"cpu":"250750000n","memory":"1Ki"}}`),
+							json.RawMessage(`{"metadata":{"name":"node-2"},"usage":{"cpu":"1","memory":"2Mi"}}`),
+						},
+					}, nil
+				},
+				GetResourceFn: func(apiVersion string, kind string, namespace string, name string, out *json.RawMessage) error {
+					requested = append(requested, apiVersion+" "+kind+" "+namespace+"/"+name)
+					*out = json.RawMessage(`{
+						"metadata": {"namespace": "ns", "name": "a"},
+						"timestamp": "2017-06-01T12:00:00Z",
+						"containers": [
+							{"name": "app", "usage": {"cpu": "100m", "memory": "10Mi"}},
+							{"name": "sidecar", "usage": {"cpu": "5m", "memory": "512Ki"}}
+						]
+					}`)
+					return nil
+				},
+			},
+		}
+
+		usage, err := source.NodeUsage()
+		So(err, ShouldBeNil)
+		So(usage, ShouldResemble, []*kubernetes.NodeUsage{
+			{Name: "node-1", CPUUsage: 250, RAMUsage: 1024},
+			{Name: "node-2", CPUUsage: 1000, RAMUsage: 2097152},
+		})
+
+		podUsage, err := source.PodUsage("ns", "a")
+		So(err, ShouldBeNil)
+		timestamp := time.Date(2017, 6, 1, 12, 0, 0, 0, time.UTC)
+		So(podUsage, ShouldResemble, &kubernetes.PodUsage{
+			CPUUsage: []*kubernetes.Metric{{Timestamp: timestamp, Value: 105}},
+			RAMUsage: []*kubernetes.Metric{{Timestamp: timestamp, Value: 11010048}},
+		})
+
+		So(requested, ShouldResemble, []string{
+			"metrics.k8s.io/v1beta1 NodeMetrics",
+			"metrics.k8s.io/v1beta1 PodMetrics ns/a",
+		})
+	})
+}
+
+func TestPrometheusMetricsSource(t *testing.T) {
+	Convey("PrometheusMetricsSource queries the Prometheus HTTP API", t, func() {
+		var queries []string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			query := r.URL.Query().Get("query")
+			queries = append(queries, r.URL.Path+" "+query)
+
+			switch {
+			case r.URL.Path == "/api/v1/query" && query == `sum by (kubernetes_io_hostname) (rate(container_cpu_usage_seconds_total{id="/"}[5m])) * 1000`:
+				w.Write([]byte(`{"status":"success","data":{"resultType":"vector","result":[
+					{"metric":{"kubernetes_io_hostname":"node-1"},"value":[1496318400,"250.5"]}
+				]}}`))
+			case r.URL.Path == "/api/v1/query":
+				w.Write([]byte(`{"status":"success","data":{"resultType":"vector","result":[
+					{"metric":{"kubernetes_io_hostname":"node-1"},"value":[1496318400,"1024"]},
+					{"metric":{"kubernetes_io_hostname":"node-2"},"value":[1496318400,"2048"]}
+				]}}`))
+			case r.URL.Path == "/api/v1/query_range" && r.URL.Query().Get("step") == "60":
+				w.Write([]byte(`{"status":"success","data":{"resultType":"matrix","result":[
+					{"metric":{},"values":[[1496318400,"5"],[1496318460,"6"]]}
+				]}}`))
+			default:
+				w.WriteHeader(400)
+				w.Write([]byte(`{"status":"error","errorType":"bad_data","error":"parse error"}`))
+			}
+		}))
+		defer server.Close()
+
+		source := &kubernetes.PrometheusMetricsSource{URL: server.URL, HTTPClient: http.DefaultClient}
+
+		usage, err := source.NodeUsage()
+		So(err, ShouldBeNil)
+		So(usage, ShouldResemble, []*kubernetes.NodeUsage{
+			{Name: "node-1", CPUUsage: 250, RAMUsage: 1024},
+			{Name: "node-2", CPUUsage: 0, RAMUsage: 2048},
+		})
+
+		podUsage, err := source.PodUsage("ns", "a")
+		So(err, ShouldBeNil)
+		metrics := []*kubernetes.Metric{
+			{Timestamp: time.Date(2017, 6, 1, 12, 0, 0, 0, time.UTC), Value: 5},
+			{Timestamp: time.Date(2017, 6, 1, 12, 1, 0, 0, time.UTC), Value: 6},
+		}
+		So(podUsage, ShouldResemble, &kubernetes.PodUsage{CPUUsage: metrics, RAMUsage: metrics})
+		So(queries[2:], ShouldResemble, []string{
+			`/api/v1/query_range sum(rate(container_cpu_usage_seconds_total{namespace="ns",pod_name="a",container_name!="",container_name!="POD"}[5m])) * 1000`,
+			`/api/v1/query_range sum(container_memory_working_set_bytes{namespace="ns",pod_name="a",container_name!="",container_name!="POD"})`,
+		})
+
+		Convey("Errors are returned", func() {
+			source.URL = server.URL + "/nope"
+			_, err := source.NodeUsage()
+			So(err, ShouldResemble, errors.New("Prometheus 400 Bad Request error: parse error"))
+
+			source.URL = ""
+			_, err = source.NodeUsage()
+			So(err, ShouldResemble, errors.New("Prometheus URL is not set"))
+		})
+	})
+}
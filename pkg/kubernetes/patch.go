@@ -0,0 +1,154 @@
+package kubernetes
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// listMergeKeys are the fields the items of lists are merged by in a strategic
+// merge patch, by the path of the list, with "[]" for the items of lists, e.g.
+// containers by name. A path also matches the lists it ends, such as the
+// containers of the Pod template of a Deployment. Other lists, such as the
+// ports of the rules of a NetworkPolicy or the subjects of a RoleBinding, are
+// replaced.
+var listMergeKeys = map[string]string{
+	"spec.containers":                     "name",
+	"spec.containers[].env":               "name",
+	"spec.containers[].ports":             "containerPort",
+	"spec.containers[].volumeMounts":      "mountPath",
+	"spec.containers[].volumeDevices":     "devicePath",
+	"spec.initContainers":                 "name",
+	"spec.initContainers[].env":           "name",
+	"spec.initContainers[].ports":         "containerPort",
+	"spec.initContainers[].volumeMounts":  "mountPath",
+	"spec.initContainers[].volumeDevices": "devicePath",
+	"spec.volumes":                        "name",
+	"spec.imagePullSecrets":               "name",
+	"spec.hostAliases":                    "ip",
+	"spec.ports":                          "port",
+	"metadata.ownerReferences":            "uid",
+}
+
+var listIndexRegexp = regexp.MustCompile(`\[[0-9]+\]`)
+
+// SupportsStrategicMergePatch returns whether resources of apiVersion can be
+// patched with a strategic merge patch, which only the built-in API groups
+// support. Custom resources are patched with a JSON merge patch.
+func SupportsStrategicMergePatch(apiVersion string) bool {
+	parts := strings.SplitN(apiVersion, "/", 2)
+	if len(parts) == 1 {
+		return true
+	}
+	group := parts[0]
+	return !strings.Contains(group, ".") || strings.HasSuffix(group, ".k8s.io")
+}
+
+// MergePatch returns the patch which updates a resource last applied as
+// previous to desired (both decoded from JSON). It is desired, with null for
+// each field of previous which desired no longer has. If strategic, the items
+// removed from lists which are merged by key (such as the ports of a Service)
+// are deleted with a "$patch: delete" directive; otherwise lists are replaced,
+// as in a JSON merge patch.
+func MergePatch(previous map[string]interface{}, desired map[string]interface{}, strategic bool) map[string]interface{} {
+	return mergePatch("", previous, desired, strategic)
+}
+
+// Private
+
+func mergePatch(path string, previous map[string]interface{}, desired map[string]interface{}, strategic bool) map[string]interface{} {
+	patch := make(map[string]interface{}, len(desired))
+	for key, value := range desired {
+		patch[key] = mergePatchValue(joinPath(path, key), previous[key], value, strategic)
+	}
+	for key := range previous {
+		if _, ok := desired[key]; !ok {
+			patch[key] = nil
+		}
+	}
+	return patch
+}
+
+func mergePatchValue(path string, previous interface{}, desired interface{}, strategic bool) interface{} {
+	switch desired := desired.(type) {
+	case map[string]interface{}:
+		if previous, ok := previous.(map[string]interface{}); ok {
+			return mergePatch(path, previous, desired, strategic)
+		}
+	case []interface{}:
+		if previous, ok := previous.([]interface{}); ok && strategic {
+			return mergePatchList(path, previous, desired)
+		}
+	}
+	return desired
+}
+
+func mergePatchList(path string, previous []interface{}, desired []interface{}) []interface{} {
+	key := listMergeKey(path, previous, desired)
+	if key == "" {
+		return desired
+	}
+	previousItems := listItemsByKey(previous, key)
+	desiredItems := listItemsByKey(desired, key)
+
+	patch := make([]interface{}, 0, len(desired))
+	for _, item := range desired {
+		item := item.(map[string]interface{})
+		patch = append(patch, mergePatch(path+"[]", previousItems[fmt.Sprint(item[key])], item, true))
+	}
+	for _, item := range previous {
+		item := item.(map[string]interface{})
+		if _, ok := desiredItems[fmt.Sprint(item[key])]; !ok {
+			patch = append(patch, map[string]interface{}{"$patch": "delete", key: item[key]})
+		}
+	}
+	return patch
+}
+
+// listMergeKey returns the field the items of the lists at path are merged by
+// (see listMergeKeys), or "" if they are replaced, or aren't all objects with
+// it.
+func listMergeKey(path string, lists ...[]interface{}) string {
+	path = listIndexRegexp.ReplaceAllString(path, "[]")
+	for listPath, key := range listMergeKeys {
+		if (path == listPath || strings.HasSuffix(path, "."+listPath)) && listsHaveKey(key, lists) {
+			return key
+		}
+	}
+	return ""
+}
+
+func joinPath(path string, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+func listsHaveKey(key string, lists [][]interface{}) bool {
+	found := false
+	for _, list := range lists {
+		for _, item := range list {
+			obj, ok := item.(map[string]interface{})
+			if !ok {
+				return false
+			}
+			switch obj[key].(type) {
+			case string, float64:
+				found = true
+			default:
+				return false
+			}
+		}
+	}
+	return found
+}
+
+func listItemsByKey(list []interface{}, key string) map[string]map[string]interface{} {
+	items := make(map[string]map[string]interface{}, len(list))
+	for _, item := range list {
+		obj := item.(map[string]interface{})
+		items[fmt.Sprint(obj[key])] = obj
+	}
+	return items
+}
@@ -0,0 +1,101 @@
+package kubernetes_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/supergiant/supergiant/pkg/kubernetes"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestMergePatch(t *testing.T) {
+	Convey("MergePatch returns the desired resource, removing what is no longer in it", t, func() {
+		table := []struct {
+			// Input
+			previous  string
+			desired   string
+			strategic bool
+			// Expectations
+			patch string
+		}{
+			// Without a previous resource, the patch is the desired resource
+			{
+				previous:  `null`,
+				desired:   `{"metadata": {"labels": {"app": "a"}}, "spec": {"ports": [{"port": 80}]}}`,
+				strategic: true,
+				patch:     `{"metadata":{"labels":{"app":"a"}},"spec":{"ports":[{"port":80}]}}`,
+			},
+			// Removed fields are null, and removed items of lists merged by key are
+			// deleted
+			{
+				previous:  `{"metadata": {"labels": {"app": "a", "tier": "web"}}, "spec": {"ports": [{"name": "http", "port": 80}, {"name": "https", "port": 443}]}}`,
+				desired:   `{"metadata": {"labels": {"app": "a"}}, "spec": {"ports": [{"name": "http", "port": 80}]}}`,
+				strategic: true,
+				patch:     `{"metadata":{"labels":{"app":"a","tier":null}},"spec":{"ports":[{"name":"http","port":80},{"$patch":"delete","port":443}]}}`,
+			},
+			// Items are matched by key, so fields removed from them are null
+			{
+				previous:  `{"spec": {"containers": [{"name": "web", "env": [{"name": "A", "value": "1"}, {"name": "B", "value": "2"}], "volumeMounts": [{"name": "data", "mountPath": "/data"}], "args": ["-v"]}]}}`,
+				desired:   `{"spec": {"containers": [{"name": "web", "env": [{"name": "B", "value": "2"}], "volumeMounts": [{"name": "data", "mountPath": "/var/data"}]}]}}`,
+				strategic: true,
+				patch:     `{"spec":{"containers":[{"args":null,"env":[{"name":"B","value":"2"},{"$patch":"delete","name":"A"}],"name":"web","volumeMounts":[{"mountPath":"/var/data","name":"data"},{"$patch":"delete","mountPath":"/data"}]}]}}`,
+			},
+			// Lists without a merge key are replaced
+			{
+				previous:  `{"spec": {"rules": [{"host": "a.com"}, {"host": "b.com"}]}}`,
+				desired:   `{"spec": {"rules": [{"host": "a.com"}]}}`,
+				strategic: true,
+				patch:     `{"spec":{"rules":[{"host":"a.com"}]}}`,
+			},
+			// Lists which aren't merged by key are replaced, even if their items have
+			// a field of one, such as the ports of a NetworkPolicy ...
+			{
+				previous:  `{"kind": "NetworkPolicy", "spec": {"ingress": [{"ports": [{"port": 80}, {"port": 443}]}]}}`,
+				desired:   `{"kind": "NetworkPolicy", "spec": {"ingress": [{"ports": [{"port": 80}]}]}}`,
+				strategic: true,
+				patch:     `{"kind":"NetworkPolicy","spec":{"ingress":[{"ports":[{"port":80}]}]}}`,
+			},
+			// ... or the subjects of a RoleBinding
+			{
+				previous:  `{"kind": "RoleBinding", "subjects": [{"kind": "User", "name": "a"}, {"kind": "User", "name": "b"}]}`,
+				desired:   `{"kind": "RoleBinding", "subjects": [{"kind": "User", "name": "a"}]}`,
+				strategic: true,
+				patch:     `{"kind":"RoleBinding","subjects":[{"kind":"User","name":"a"}]}`,
+			},
+			// Lists merged by key are matched by path, also in Pod templates
+			{
+				previous:  `{"spec": {"template": {"spec": {"containers": [{"name": "web", "ports": [{"containerPort": 80}, {"containerPort": 443}]}]}}}}`,
+				desired:   `{"spec": {"template": {"spec": {"containers": [{"name": "web", "ports": [{"containerPort": 80}]}]}}}}`,
+				strategic: true,
+				patch:     `{"spec":{"template":{"spec":{"containers":[{"name":"web","ports":[{"containerPort":80},{"$patch":"delete","containerPort":443}]}]}}}}`,
+			},
+			// As a JSON merge patch, lists are replaced
+			{
+				previous:  `{"spec": {"size": 3, "ports": [{"port": 80}, {"port": 443}]}}`,
+				desired:   `{"spec": {"ports": [{"port": 80}]}}`,
+				strategic: false,
+				patch:     `{"spec":{"ports":[{"port":80}],"size":null}}`,
+			},
+		}
+
+		for _, item := range table {
+			var previous, desired map[string]interface{}
+			So(json.Unmarshal([]byte(item.previous), &previous), ShouldBeNil)
+			So(json.Unmarshal([]byte(item.desired), &desired), ShouldBeNil)
+
+			patch, err := json.Marshal(kubernetes.MergePatch(previous, desired, item.strategic))
+			So(err, ShouldBeNil)
+			So(string(patch), ShouldEqual, item.patch)
+		}
+	})
+}
+
+func TestSupportsStrategicMergePatch(t *testing.T) {
+	Convey("SupportsStrategicMergePatch is true for the built-in API groups", t, func() {
+		So(kubernetes.SupportsStrategicMergePatch("v1"), ShouldBeTrue)
+		So(kubernetes.SupportsStrategicMergePatch("extensions/v1beta1"), ShouldBeTrue)
+		So(kubernetes.SupportsStrategicMergePatch("rbac.authorization.k8s.io/v1beta1"), ShouldBeTrue)
+		So(kubernetes.SupportsStrategicMergePatch("monitoring.coreos.com/v1"), ShouldBeFalse)
+	})
+}
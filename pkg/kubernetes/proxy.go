@@ -0,0 +1,44 @@
+package kubernetes
+
+import (
+	"net/http"
+	"net/http/httputil"
+	"strings"
+)
+
+// ProxyRequest implements the ClientInterface.
+func (k *Client) ProxyRequest(w http.ResponseWriter, r *http.Request, path string) {
+	transport := k.HTTPClient.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	proxy := &httputil.ReverseProxy{
+		Director: func(req *http.Request) {
+			// The API of an imported Kube may be under a path
+			host, basePath := k.Kube.MasterPublicIP, ""
+			if i := strings.Index(host, "/"); i >= 0 {
+				host, basePath = host[:i], host[i:]
+			}
+			req.URL.Scheme = "https"
+			req.URL.Host = host
+			req.URL.Path = basePath + path
+			req.URL.RawPath = ""
+			req.Host = host
+
+			// The credentials of the caller are for Supergiant, not the Kube
+			req.Header.Del("Authorization")
+			req.Header.Del("Cookie")
+			k.authorize(req.Header)
+		},
+		Transport: transport,
+
+		// Flush immediately, so that watches and followed logs are streamed
+		FlushInterval: -1,
+
+		ErrorHandler: func(w http.ResponseWriter, req *http.Request, err error) {
+			http.Error(w, "K8S proxy error: "+err.Error(), http.StatusBadGateway)
+		},
+	}
+	proxy.ServeHTTP(w, r)
+}
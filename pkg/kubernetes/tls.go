@@ -0,0 +1,230 @@
+package kubernetes
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/supergiant/supergiant/pkg/model"
+)
+
+// ErrorNoCACert is returned for a Kube which has no CACert to verify its
+// master against, and doesn't skip verification.
+var ErrorNoCACert = errors.New("Kube has no CACert to verify its master against; set insecure_skip_tls_verify to connect without verification")
+
+// TLSConfig returns the TLS config for connecting to the master of kube.
+//
+// The certificate of the master is verified against kube.CACert, and must be
+// valid for the host of kube.MasterPublicIP (an IP or a host name). A Kube
+// without a CACert is only connected to if kube.InsecureSkipTLSVerify is set,
+// in which case the certificate is not verified at all.
+//
+// If kube.ClientCert and kube.ClientKey are set, they are presented as the
+// client certificate.
+func TLSConfig(kube *model.Kube) (*tls.Config, error) {
+	config := &tls.Config{
+		ServerName: masterHost(kube),
+	}
+
+	switch {
+	case kube.CACert != "":
+		roots := x509.NewCertPool()
+		if !roots.AppendCertsFromPEM([]byte(kube.CACert)) {
+			return nil, errors.New("CACert does not contain a PEM certificate")
+		}
+		config.RootCAs = roots
+	case kube.InsecureSkipTLSVerify:
+		config.InsecureSkipVerify = true
+	default:
+		return nil, ErrorNoCACert
+	}
+
+	if kube.ClientCert != "" || kube.ClientKey != "" {
+		cert, err := tls.X509KeyPair([]byte(kube.ClientCert), []byte(kube.ClientKey))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid ClientCert or ClientKey: %s", err)
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+
+	return config, nil
+}
+
+// HTTPClients caches an *http.Client for each Kube, so that connections are
+// reused. A client is replaced when the TLS settings of its Kube change.
+type HTTPClients struct {
+	Timeout time.Duration
+
+	mutex   sync.Mutex
+	clients map[string]*cachedHTTPClient
+}
+
+type cachedHTTPClient struct {
+	fingerprint string
+	client      *http.Client
+}
+
+func NewHTTPClients(timeout time.Duration) *HTTPClients {
+	return &HTTPClients{
+		Timeout: timeout,
+		clients: make(map[string]*cachedHTTPClient),
+	}
+}
+
+// Get returns the *http.Client of kube. If the TLS settings of kube are
+// invalid, requests made with the client return the error.
+func (c *HTTPClients) Get(kube *model.Kube) *http.Client {
+	fingerprint := tlsFingerprint(kube)
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	cached := c.clients[kube.Name]
+	if cached != nil && cached.fingerprint == fingerprint {
+		return cached.client
+	}
+	if cached != nil {
+		if transport, ok := cached.client.Transport.(*http.Transport); ok {
+			transport.CloseIdleConnections()
+		}
+	}
+
+	var transport http.RoundTripper
+	tlsConfig, err := TLSConfig(kube)
+	if err != nil {
+		transport = errorRoundTripper{err}
+	} else {
+		transport = &http.Transport{
+			Proxy:               http.ProxyFromEnvironment,
+			TLSHandshakeTimeout: 10 * time.Second,
+			TLSClientConfig:     tlsConfig,
+		}
+	}
+
+	client := &http.Client{
+		Timeout:   c.Timeout,
+		Transport: transport,
+	}
+	c.clients[kube.Name] = &cachedHTTPClient{fingerprint, client}
+	return client
+}
+
+// CAValidity is how long generated CAs are valid. The client certificate
+// Supergiant uses for a Kube is valid as long, so that it doesn't lose access.
+const CAValidity = 10 * 365 * 24 * time.Hour
+
+// GenerateCA returns a new self-signed CA certificate and its RSA key, PEM
+// encoded.
+func GenerateCA(commonName string) (certPEM string, keyPEM string, err error) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return "", "", err
+	}
+	template, err := certificateTemplate(commonName, nil, CAValidity)
+	if err != nil {
+		return "", "", err
+	}
+	template.IsCA = true
+	template.BasicConstraintsValid = true
+	template.KeyUsage = x509.KeyUsageCertSign | x509.KeyUsageCRLSign | x509.KeyUsageDigitalSignature
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return "", "", err
+	}
+	return encodeCertificate(der), encodeKey(key), nil
+}
+
+// GenerateClientCert returns a new client certificate signed by the CA, valid
+// for validFor, and its RSA key, PEM encoded. Kubernetes takes the user from
+// commonName and the groups from organizations.
+func GenerateClientCert(caCertPEM string, caKeyPEM string, commonName string, organizations []string, validFor time.Duration) (certPEM string, keyPEM string, err error) {
+	ca, err := tls.X509KeyPair([]byte(caCertPEM), []byte(caKeyPEM))
+	if err != nil {
+		return "", "", err
+	}
+	caCert, err := x509.ParseCertificate(ca.Certificate[0])
+	if err != nil {
+		return "", "", err
+	}
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return "", "", err
+	}
+	template, err := certificateTemplate(commonName, organizations, validFor)
+	if err != nil {
+		return "", "", err
+	}
+	template.KeyUsage = x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment
+	template.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, caCert, &key.PublicKey, ca.PrivateKey)
+	if err != nil {
+		return "", "", err
+	}
+	return encodeCertificate(der), encodeKey(key), nil
+}
+
+// Private
+
+// masterHost returns the host of the MasterPublicIP of kube, which is
+// host[:port][/path].
+func masterHost(kube *model.Kube) string {
+	host := strings.SplitN(kube.MasterPublicIP, "/", 2)[0]
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		return h
+	}
+	return host
+}
+
+func certificateTemplate(commonName string, organizations []string, validFor time.Duration) (*x509.Certificate, error) {
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	return &x509.Certificate{
+		SerialNumber: serial,
+		Subject: pkix.Name{
+			CommonName:   commonName,
+			Organization: organizations,
+		},
+		NotBefore: now.Add(-time.Hour),
+		NotAfter:  now.Add(validFor),
+	}, nil
+}
+
+func encodeCertificate(der []byte) string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func encodeKey(key *rsa.PrivateKey) string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}))
+}
+
+func tlsFingerprint(kube *model.Kube) string {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%s\x00%s\x00%s\x00%s\x00%t", kube.MasterPublicIP, kube.CACert, kube.ClientCert, kube.ClientKey, kube.InsecureSkipTLSVerify)))
+	return string(sum[:])
+}
+
+// errorRoundTripper fails every request, for Kubes with invalid TLS settings.
+type errorRoundTripper struct {
+	err error
+}
+
+func (t errorRoundTripper) RoundTrip(*http.Request) (*http.Response, error) {
+	return nil, t.err
+}
@@ -0,0 +1,182 @@
+package kubernetes_test
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/supergiant/supergiant/pkg/kubernetes"
+	"github.com/supergiant/supergiant/pkg/model"
+	"github.com/supergiant/supergiant/test/fake_http"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestKubernetesTLS(t *testing.T) {
+	Convey("Kubernetes verifies the master against the CACert of the Kube, and presents its ClientCert", t, func() {
+		caCert, caKey, err := kubernetes.GenerateCA("kube-ca")
+		So(err, ShouldBeNil)
+		clientCert, clientKey, err := kubernetes.GenerateClientCert(caCert, caKey, "supergiant", []string{"system:masters"}, time.Hour)
+		So(err, ShouldBeNil)
+
+		clientCAs := x509.NewCertPool()
+		clientCAs.AppendCertsFromPEM([]byte(caCert))
+
+		var user string
+		server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			user = ""
+			if len(r.TLS.PeerCertificates) > 0 {
+				user = r.TLS.PeerCertificates[0].Subject.CommonName
+			}
+			w.Write([]byte(`{"items":[]}`))
+		}))
+		server.TLS = &tls.Config{
+			ClientAuth: tls.VerifyClientCertIfGiven,
+			ClientCAs:  clientCAs,
+		}
+		server.StartTLS()
+		defer server.Close()
+
+		serverCert := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.TLS.Certificates[0].Certificate[0]}))
+		host := strings.TrimPrefix(server.URL, "https://")
+
+		table := []struct {
+			// Input
+			kube *model.Kube
+			// Expectations
+			user string
+			err  string
+		}{
+			// Not connected to without a CACert
+			{
+				kube: &model.Kube{Name: "a", MasterPublicIP: host},
+				user: "",
+				err:  kubernetes.ErrorNoCACert.Error(),
+			},
+			// Unless verification is skipped explicitly
+			{
+				kube: &model.Kube{Name: "a2", MasterPublicIP: host, InsecureSkipTLSVerify: true},
+				user: "",
+				err:  "",
+			},
+			// Verified
+			{
+				kube: &model.Kube{Name: "b", MasterPublicIP: host, CACert: serverCert},
+				user: "",
+				err:  "",
+			},
+			// Not valid for the host of the master
+			{
+				kube: &model.Kube{Name: "b2", MasterPublicIP: strings.Replace(host, "127.0.0.1", "localhost", 1), CACert: serverCert},
+				user: "",
+				err:  "certificate is valid for",
+			},
+			// Signed by another CA
+			{
+				kube: &model.Kube{Name: "c", MasterPublicIP: host, CACert: caCert},
+				user: "",
+				err:  "certificate signed by unknown authority",
+			},
+			// Client certificate
+			{
+				kube: &model.Kube{Name: "d", MasterPublicIP: host, CACert: serverCert, ClientCert: clientCert, ClientKey: clientKey},
+				user: "supergiant",
+				err:  "",
+			},
+			// Invalid client certificate
+			{
+				kube: &model.Kube{Name: "e", MasterPublicIP: host, CACert: serverCert, ClientCert: clientCert},
+				user: "",
+				err:  "Invalid ClientCert or ClientKey",
+			},
+		}
+
+		httpClients := kubernetes.NewHTTPClients(10 * time.Second)
+
+		for _, item := range table {
+			user = ""
+			client := &kubernetes.Client{
+				Kube:       item.kube,
+				HTTPClient: httpClients.Get(item.kube),
+			}
+
+			_, err := client.ListNamespaces("")
+
+			if item.err == "" {
+				So(err, ShouldBeNil)
+			} else {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, item.err)
+			}
+			So(user, ShouldEqual, item.user)
+		}
+
+		Convey("HTTP clients are reused until the TLS settings of the Kube change", func() {
+			kube := &model.Kube{Name: "a"}
+			httpClient := httpClients.Get(kube)
+			So(httpClients.Get(kube), ShouldEqual, httpClient)
+
+			kube.CACert = serverCert
+			So(httpClients.Get(kube), ShouldNotEqual, httpClient)
+
+			httpClient = httpClients.Get(kube)
+			kube.InsecureSkipTLSVerify = true
+			So(httpClients.Get(kube), ShouldNotEqual, httpClient)
+		})
+	})
+}
+
+func TestKubernetesAuthorization(t *testing.T) {
+	Convey("Kubernetes authenticates with a bearer token, client certificate or basic auth", t, func() {
+		table := []struct {
+			// Input
+			kube *model.Kube
+			// Expectations
+			authorization string
+		}{
+			{
+				kube:          &model.Kube{Username: "user", Password: "pass", BearerToken: "token"},
+				authorization: "Bearer token",
+			},
+			{
+				kube:          &model.Kube{Username: "user", Password: "pass", ClientCert: "cert"},
+				authorization: "",
+			},
+			{
+				kube:          &model.Kube{Username: "user", Password: "pass"},
+				authorization: "Basic dXNlcjpwYXNz",
+			},
+		}
+
+		for _, item := range table {
+			var authorization string
+
+			client := &kubernetes.Client{
+				Kube: item.kube,
+				HTTPClient: &http.Client{
+					Transport: &fake_http.RoundTripper{
+						RoundTripFn: func(r *http.Request) (*http.Response, error) {
+							authorization = r.Header.Get("Authorization")
+							return &http.Response{
+								Status:     "200",
+								StatusCode: 200,
+								Body:       ioutil.NopCloser(bytes.NewBufferString(`{"items":[]}`)),
+							}, nil
+						},
+					},
+				},
+			}
+
+			_, err := client.ListNamespaces("")
+			So(err, ShouldBeNil)
+			So(authorization, ShouldEqual, item.authorization)
+		}
+	})
+}
@@ -1,6 +1,9 @@
 package kubernetes
 
-import "time"
+import (
+	"encoding/json"
+	"time"
+)
 
 type HeapsterStats struct {
 	Name     string `json:"name"`
@@ -22,6 +25,13 @@ type Metadata struct {
 	Namespace         string            `json:"namespace,omitempty"`
 	Labels            map[string]string `json:"labels,omitempty"`
 	CreationTimestamp string            `json:"creationTimestamp,omitempty"`
+	ResourceVersion   string            `json:"resourceVersion,omitempty"`
+	OwnerReferences   []OwnerReference  `json:"ownerReferences,omitempty"`
+}
+
+type OwnerReference struct {
+	Kind string `json:"kind"`
+	Name string `json:"name"`
 }
 
 //------------------------------------------------------------------------------
@@ -211,3 +221,70 @@ type Event struct {
 type Source struct {
 	Host string `json:"host"`
 }
+
+//------------------------------------------------------------------------------
+
+// APIResourceList is returned by the discovery endpoint of an API group
+// version, e.g. /api/v1 or /apis/extensions/v1beta1.
+type APIResourceList struct {
+	GroupVersion string         `json:"groupVersion"`
+	Resources    []*APIResource `json:"resources"`
+}
+
+type APIResource struct {
+	// Name is the plural used in request paths, e.g. "ingresses". Subresources
+	// are listed as well, e.g. "pods/log".
+	Name       string `json:"name"`
+	Namespaced bool   `json:"namespaced"`
+	Kind       string `json:"kind"`
+}
+
+//------------------------------------------------------------------------------
+
+// ResourceList holds resources of any kind, as returned by ListResources.
+type ResourceList struct {
+	Metadata ListMetadata      `json:"metadata"`
+	Items    []json.RawMessage `json:"items"`
+}
+
+type ListMetadata struct {
+	ResourceVersion string `json:"resourceVersion"`
+}
+
+const (
+	WatchEventAdded    = "ADDED"
+	WatchEventModified = "MODIFIED"
+	WatchEventDeleted  = "DELETED"
+	WatchEventError    = "ERROR"
+)
+
+// WatchEvent is a change to a watched resource. Object is the resource after
+// the change (or before it, if deleted), except for ERROR events, where it's
+// a Status.
+type WatchEvent struct {
+	Type   string          `json:"type"`
+	Object json.RawMessage `json:"object"`
+}
+
+type Status struct {
+	Code    int            `json:"code"`
+	Reason  string         `json:"reason"`
+	Message string         `json:"message"`
+	Details *StatusDetails `json:"details,omitempty"`
+}
+
+// StatusDetails identifies the resource a Status is about, and for invalid
+// resources, the fields which are invalid.
+type StatusDetails struct {
+	Name              string         `json:"name,omitempty"`
+	Group             string         `json:"group,omitempty"`
+	Kind              string         `json:"kind,omitempty"`
+	Causes            []*StatusCause `json:"causes,omitempty"`
+	RetryAfterSeconds int            `json:"retryAfterSeconds,omitempty"`
+}
+
+type StatusCause struct {
+	Type    string `json:"reason"`
+	Message string `json:"message"`
+	Field   string `json:"field"`
+}
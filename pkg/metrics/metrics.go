@@ -0,0 +1,299 @@
+package metrics
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// DefaultBuckets are the histogram buckets (in seconds) used when none are
+// provided. They match the Prometheus client defaults.
+var DefaultBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+
+// ContentType is the Content-Type of the Prometheus text exposition format.
+const ContentType = "text/plain; version=0.0.4"
+
+// Registry holds metric families and renders them in the Prometheus text
+// exposition format.
+type Registry struct {
+	mu        sync.Mutex
+	families  []*family
+	collectFn []func()
+}
+
+func NewRegistry() *Registry {
+	return new(Registry)
+}
+
+// OnCollect registers a func that is called before every render. It is used
+// to set gauges whose values are pulled (e.g. from the DB) instead of pushed.
+func (r *Registry) OnCollect(fn func()) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.collectFn = append(r.collectFn, fn)
+}
+
+func (r *Registry) NewCounterVec(name string, help string, labels ...string) *CounterVec {
+	return &CounterVec{r.register(name, help, "counter", labels, nil)}
+}
+
+func (r *Registry) NewGaugeVec(name string, help string, labels ...string) *GaugeVec {
+	return &GaugeVec{r.register(name, help, "gauge", labels, nil)}
+}
+
+func (r *Registry) NewHistogramVec(name string, help string, buckets []float64, labels ...string) *HistogramVec {
+	if buckets == nil {
+		buckets = DefaultBuckets
+	}
+	return &HistogramVec{r.register(name, help, "histogram", labels, buckets)}
+}
+
+// WriteTo renders all registered metrics to w.
+func (r *Registry) WriteTo(w io.Writer) (int64, error) {
+	r.mu.Lock()
+	collectFns := r.collectFn
+	r.mu.Unlock()
+
+	for _, fn := range collectFns {
+		fn()
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	buf := new(bytes.Buffer)
+	for _, f := range r.families {
+		f.writeTo(buf)
+	}
+	return buf.WriteTo(w)
+}
+
+// ServeHTTP implements http.Handler.
+func (r *Registry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	w.Header().Set("Content-Type", ContentType)
+	r.WriteTo(w)
+}
+
+//------------------------------------------------------------------------------
+
+type CounterVec struct {
+	*family
+}
+
+// Inc increments the counter with the given label values by 1.
+func (v *CounterVec) Inc(labelValues ...string) {
+	v.Add(1, labelValues...)
+}
+
+// Add increments the counter with the given label values by n. Counters can
+// only go up, so negative values are ignored.
+func (v *CounterVec) Add(n float64, labelValues ...string) {
+	if n < 0 {
+		return
+	}
+	v.update(labelValues, func(s *series) { s.value += n })
+}
+
+type GaugeVec struct {
+	*family
+}
+
+func (v *GaugeVec) Set(n float64, labelValues ...string) {
+	v.update(labelValues, func(s *series) { s.value = n })
+}
+
+func (v *GaugeVec) Inc(labelValues ...string) {
+	v.update(labelValues, func(s *series) { s.value++ })
+}
+
+func (v *GaugeVec) Dec(labelValues ...string) {
+	v.update(labelValues, func(s *series) { s.value-- })
+}
+
+// GaugeValue is the value of the series of a GaugeVec with LabelValues.
+type GaugeValue struct {
+	LabelValues []string
+	Value       float64
+}
+
+// Replace replaces all series with values at once, so that label values which
+// no longer exist are not rendered, and a concurrent render sees either the
+// old or the new series, never a partial set.
+func (v *GaugeVec) Replace(values []GaugeValue) {
+	replacement := make(map[string]*series, len(values))
+	for _, value := range values {
+		v.checkLabelValues(value.LabelValues)
+		replacement[seriesKey(value.LabelValues)] = &series{
+			labelValues: append([]string(nil), value.LabelValues...),
+			value:       value.Value,
+		}
+	}
+
+	v.registry.mu.Lock()
+	defer v.registry.mu.Unlock()
+	v.series = replacement
+}
+
+type HistogramVec struct {
+	*family
+}
+
+func (v *HistogramVec) Observe(n float64, labelValues ...string) {
+	v.update(labelValues, func(s *series) {
+		for i, upper := range v.buckets {
+			if n <= upper {
+				s.bucketCounts[i]++
+			}
+		}
+		s.count++
+		s.value += n
+	})
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// Private                                                                    //
+////////////////////////////////////////////////////////////////////////////////
+
+type family struct {
+	registry *Registry
+
+	name       string
+	help       string
+	metricType string
+	labels     []string
+	buckets    []float64
+
+	series map[string]*series
+}
+
+type series struct {
+	labelValues []string
+
+	// value is the sum for histograms
+	value        float64
+	count        uint64
+	bucketCounts []uint64
+}
+
+func (r *Registry) register(name string, help string, metricType string, labels []string, buckets []float64) *family {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, f := range r.families {
+		if f.name == name {
+			panic("metrics: duplicate registration of " + name)
+		}
+	}
+
+	f := &family{
+		registry:   r,
+		name:       name,
+		help:       help,
+		metricType: metricType,
+		labels:     labels,
+		buckets:    buckets,
+		series:     make(map[string]*series),
+	}
+	r.families = append(r.families, f)
+	return f
+}
+
+func (f *family) update(labelValues []string, fn func(*series)) {
+	f.checkLabelValues(labelValues)
+
+	f.registry.mu.Lock()
+	defer f.registry.mu.Unlock()
+
+	key := seriesKey(labelValues)
+	s := f.series[key]
+	if s == nil {
+		s = &series{
+			labelValues:  append([]string(nil), labelValues...),
+			bucketCounts: make([]uint64, len(f.buckets)),
+		}
+		f.series[key] = s
+	}
+	fn(s)
+}
+
+func (f *family) checkLabelValues(labelValues []string) {
+	if len(labelValues) != len(f.labels) {
+		panic(fmt.Sprintf("metrics: %s expects %d label values, got %d", f.name, len(f.labels), len(labelValues)))
+	}
+}
+
+func seriesKey(labelValues []string) string {
+	return strings.Join(labelValues, "\xff")
+}
+
+func (f *family) writeTo(buf *bytes.Buffer) {
+	fmt.Fprintf(buf, "# HELP %s %s\n", f.name, escapeHelp(f.help))
+	fmt.Fprintf(buf, "# TYPE %s %s\n", f.name, f.metricType)
+
+	keys := make([]string, 0, len(f.series))
+	for key := range f.series {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		s := f.series[key]
+
+		if f.metricType != "histogram" {
+			fmt.Fprintf(buf, "%s%s %s\n", f.name, f.labelString(s.labelValues, ""), formatFloat(s.value))
+			continue
+		}
+
+		for i, upper := range f.buckets {
+			fmt.Fprintf(buf, "%s_bucket%s %d\n", f.name, f.labelString(s.labelValues, formatFloat(upper)), s.bucketCounts[i])
+		}
+		fmt.Fprintf(buf, "%s_bucket%s %d\n", f.name, f.labelString(s.labelValues, "+Inf"), s.count)
+		fmt.Fprintf(buf, "%s_sum%s %s\n", f.name, f.labelString(s.labelValues, ""), formatFloat(s.value))
+		fmt.Fprintf(buf, "%s_count%s %d\n", f.name, f.labelString(s.labelValues, ""), s.count)
+	}
+}
+
+func (f *family) labelString(labelValues []string, le string) string {
+	var pairs []string
+	for i, label := range f.labels {
+		pairs = append(pairs, fmt.Sprintf(`%s="%s"`, label, escapeLabelValue(labelValues[i])))
+	}
+	if le != "" {
+		pairs = append(pairs, fmt.Sprintf(`le="%s"`, le))
+	}
+	if len(pairs) == 0 {
+		return ""
+	}
+	return "{" + strings.Join(pairs, ",") + "}"
+}
+
+func formatFloat(f float64) string {
+	switch {
+	case math.IsInf(f, 1):
+		return "+Inf"
+	case math.IsInf(f, -1):
+		return "-Inf"
+	case math.IsNaN(f):
+		return "NaN"
+	}
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+var (
+	helpEscaper       = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+	labelValueEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+)
+
+func escapeHelp(s string) string {
+	return helpEscaper.Replace(s)
+}
+
+func escapeLabelValue(s string) string {
+	return labelValueEscaper.Replace(s)
+}
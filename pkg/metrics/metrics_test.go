@@ -0,0 +1,127 @@
+package metrics_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"testing"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"github.com/supergiant/supergiant/pkg/metrics"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// parseTextFormat parses out with the Prometheus text format parser, and
+// returns the declared type of every family, and the value of every counter
+// and gauge sample, keyed by name and labels (sorted by name).
+func parseTextFormat(out string) (families map[string]*dto.MetricFamily, types map[string]string, samples map[string]float64) {
+	families, err := new(expfmt.TextParser).TextToMetricFamilies(strings.NewReader(out))
+	So(err, ShouldBeNil)
+
+	types = make(map[string]string)
+	samples = make(map[string]float64)
+	for name, family := range families {
+		types[name] = strings.ToLower(family.GetType().String())
+		for _, metric := range family.Metric {
+			switch {
+			case metric.Counter != nil:
+				samples[sampleKey(name, metric)] = metric.Counter.GetValue()
+			case metric.Gauge != nil:
+				samples[sampleKey(name, metric)] = metric.Gauge.GetValue()
+			}
+		}
+	}
+	return
+}
+
+func sampleKey(name string, metric *dto.Metric) string {
+	var labels []string
+	for _, pair := range metric.Label {
+		labels = append(labels, pair.GetName()+"="+pair.GetValue())
+	}
+	sort.Strings(labels)
+	return name + "{" + strings.Join(labels, ",") + "}"
+}
+
+func TestRegistryWriteTo(t *testing.T) {
+	Convey("Registry renders valid text exposition format", t, func() {
+		registry := metrics.NewRegistry()
+
+		counter := registry.NewCounterVec("test_requests_total", "Requests.", "route", "code")
+		gauge := registry.NewGaugeVec("test_things", "Things by status.", "status")
+		histogram := registry.NewHistogramVec("test_duration_seconds", "Durations.", []float64{0.1, 1}, "op")
+
+		counter.Inc("/a", "200")
+		counter.Add(2, "/a", "200")
+		counter.Add(-5, "/a", "200") // ignored
+		counter.Inc(`/b"quoted"`, "500")
+
+		gauge.Set(3, "started")
+		gauge.Inc("stopped")
+		gauge.Dec("stopped")
+
+		histogram.Observe(0.05, "find")
+		histogram.Observe(0.5, "find")
+		histogram.Observe(5, "find")
+
+		collected := false
+		registry.OnCollect(func() { collected = true })
+
+		buf := new(bytes.Buffer)
+		_, err := registry.WriteTo(buf)
+		So(err, ShouldBeNil)
+		So(collected, ShouldBeTrue)
+
+		families, types, samples := parseTextFormat(buf.String())
+
+		So(types, ShouldResemble, map[string]string{
+			"test_requests_total":   "counter",
+			"test_things":           "gauge",
+			"test_duration_seconds": "histogram",
+		})
+
+		So(samples, ShouldResemble, map[string]float64{
+			`test_requests_total{code=200,route=/a}`:         3,
+			`test_requests_total{code=500,route=/b"quoted"}`: 1,
+			`test_things{status=started}`:                    3,
+			`test_things{status=stopped}`:                    0,
+		})
+
+		series := families["test_duration_seconds"].Metric
+		So(series, ShouldHaveLength, 1)
+		So(sampleKey("test_duration_seconds", series[0]), ShouldEqual, "test_duration_seconds{op=find}")
+		var buckets []uint64
+		for _, bucket := range series[0].Histogram.Bucket {
+			buckets = append(buckets, bucket.GetCumulativeCount())
+		}
+		So(buckets, ShouldResemble, []uint64{1, 2, 3})
+		So(series[0].Histogram.GetSampleSum(), ShouldEqual, 5.55)
+		So(series[0].Histogram.GetSampleCount(), ShouldEqual, 3)
+
+		Convey("Replace replaces all gauge series", func() {
+			gauge.Replace([]metrics.GaugeValue{
+				{LabelValues: []string{"started"}, Value: 1},
+			})
+
+			buf := new(bytes.Buffer)
+			registry.WriteTo(buf)
+			_, _, samples := parseTextFormat(buf.String())
+
+			So(samples[`test_things{status=started}`], ShouldEqual, 1)
+			So(samples, ShouldNotContainKey, `test_things{status=stopped}`)
+		})
+
+		Convey("ServeHTTP sets the exposition Content-Type", func() {
+			req, _ := http.NewRequest("GET", "/metrics", nil)
+			rec := httptest.NewRecorder()
+			registry.ServeHTTP(rec, req)
+
+			So(rec.Header().Get("Content-Type"), ShouldEqual, metrics.ContentType)
+			So(rec.Body.String(), ShouldContainSubstring, "# TYPE test_things gauge")
+		})
+	})
+}
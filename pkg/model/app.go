@@ -0,0 +1,64 @@
+package model
+
+import "fmt"
+
+type AppList struct {
+	BaseList
+	Items []*App `json:"items"`
+}
+
+// App is a group of KubeResources in one Namespace of a Kube, e.g. the
+// Services and Pods of a database replica set, which are started, stopped, and
+// deleted together. KubeResources are started after those they declare in
+// DependsOn, and stopped in reverse.
+type App struct {
+	BaseModel
+
+	// belongs_to Kube
+	Kube     *Kube  `json:"kube,omitempty" gorm:"ForeignKey:KubeName;AssociationForeignKey:Name"`
+	KubeName string `json:"kube_name" validate:"nonzero" gorm:"not null;index" sg:"immutable"`
+
+	// has_many KubeResources
+	KubeResources []*KubeResource `json:"kube_resources,omitempty" gorm:"ForeignKey:AppName;AssociationForeignKey:Name"`
+
+	Name string `json:"name" validate:"nonzero,max=24,regexp=^[a-z]([-a-z0-9]*[a-z0-9])?$" gorm:"not null;unique_index" sg:"immutable"`
+
+	// Namespace is the Kubernetes namespace of the KubeResources of the App.
+	Namespace string `json:"namespace" validate:"nonzero,max=24" gorm:"not null" sg:"immutable"`
+
+	// Started is whether the App was last started (rather than stopped). New
+	// KubeResources of a started App are started when they are created.
+	Started bool `json:"started" sg:"readonly"`
+
+	// Schedule starts and stops the App at set times.
+	Schedule     *Schedule `json:"schedule,omitempty" gorm:"-" sg:"store_as_json_in=ScheduleJSON"`
+	ScheduleJSON []byte    `json:"-"`
+}
+
+// SetPassiveStatus aggregates the status of the KubeResources of the App,
+// which must be loaded.
+func (m *App) SetPassiveStatus() {
+	started, drifted := 0, false
+	for _, kubeResource := range m.KubeResources {
+		if kubeResource.Ready {
+			started++
+		}
+		if kubeResource.Drift != nil {
+			drifted = true
+		}
+	}
+	m.PassiveStatusOkay = m.Started && !drifted && started == len(m.KubeResources)
+
+	switch {
+	case drifted:
+		m.PassiveStatus = "drifted"
+	case len(m.KubeResources) == 0:
+		m.PassiveStatus = "empty"
+	case started == len(m.KubeResources):
+		m.PassiveStatus = "started"
+	case started == 0:
+		m.PassiveStatus = "stopped"
+	default:
+		m.PassiveStatus = fmt.Sprintf("%d of %d started", started, len(m.KubeResources))
+	}
+}
@@ -13,9 +13,11 @@ type CloudAccount struct {
 
 	Name string `json:"name" validate:"nonzero" gorm:"not null;unique_index" sg:"immutable"`
 
-	Provider string `json:"provider" validate:"regexp=^(aws|digitalocean)$" gorm:"not null" sg:"immutable"`
+	// Provider existing is for Kubes imported from existing clusters, which
+	// need no Credentials.
+	Provider string `json:"provider" validate:"regexp=^(aws|digitalocean|existing)$" gorm:"not null" sg:"immutable"`
 
 	// NOTE this is loose map to allow for multiple clouds (eventually)
-	Credentials     map[string]string `json:"credentials,omitempty" validate:"nonzero" gorm:"-" sg:"store_as_json_in=CredentialsJSON,private,immutable"`
+	Credentials     map[string]string `json:"credentials,omitempty" gorm:"-" sg:"store_as_json_in=CredentialsJSON,private,immutable"`
 	CredentialsJSON []byte            `json:"-" gorm:"not null"`
 }
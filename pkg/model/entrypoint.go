@@ -14,6 +14,12 @@ type Entrypoint struct {
 
 	Name string `json:"name" validate:"nonzero,max=21,regexp=^[\\w-]+$" gorm:"not null;unique_index" sg:"immutable"`
 
+	// Type is "TCP" (the default), for an Entrypoint which maps its ports to
+	// NodePorts, or "HTTP", for one which routes requests by host and path to
+	// Services, through an ingress controller in the Kube. A Kube can have only
+	// one HTTP Entrypoint.
+	Type string `json:"type" validate:"regexp=^(TCP|HTTP)$" sg:"default=TCP,immutable"`
+
 	// has_many EntrypointListeners
 	EntrypointListeners []*EntrypointListener `json:"entrypoint_listeners,omitempty" gorm:"ForeignKey:EntrypointName;AssociationForeignKey:Name"`
 
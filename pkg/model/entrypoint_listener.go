@@ -10,7 +10,7 @@ type EntrypointListener struct {
 
 	// belongs_to Entrypoint
 	Entrypoint     *Entrypoint `json:"entrypoint,omitempty" gorm:"ForeignKey:EntrypointName;AssociationForeignKey:Name"`
-	EntrypointName string      `json:"entrypoint_name" validate:"nonzero" gorm:"not null;index;unique_index:entrypoint_port;unique_index:port_name_within_entrypoint" sg:"immutable"`
+	EntrypointName string      `json:"entrypoint_name" validate:"nonzero" gorm:"not null;index;unique_index:entrypoint_port_host_path;unique_index:port_name_within_entrypoint" sg:"immutable"`
 
 	// belongs_to KubeResource
 	KubeResource   *KubeResource `json:"kube_resource,omitempty"`
@@ -20,10 +20,32 @@ type EntrypointListener struct {
 	Name string `json:"name" validate:"nonzero,max=24,regexp=^[a-z]([-a-z0-9]*[a-z0-9])?$" gorm:"not null;index;unique_index:port_name_within_entrypoint" sg:"immutable"`
 
 	// EntrypointPort is the external port the user connects to
-	EntrypointPort     int64  `json:"entrypoint_port" validate:"nonzero" gorm:"not null;unique_index:entrypoint_port" sg:"immutable"`
+	EntrypointPort     int64  `json:"entrypoint_port" validate:"nonzero" gorm:"not null;unique_index:entrypoint_port_host_path" sg:"immutable"`
 	EntrypointProtocol string `json:"entrypoint_protocol" validate:"nonzero" sg:"default=TCP,immutable"`
 
-	// NodePort is the target port, what EntrypointPort maps to
-	NodePort     int64  `json:"node_port" validate:"nonzero" sg:"immutable"`
+	// NodePort is the target port, what EntrypointPort maps to. It is required
+	// on TCP Entrypoints, and unused on HTTP Entrypoints.
+	NodePort     int64  `json:"node_port" sg:"immutable"`
 	NodeProtocol string `json:"node_protocol" validate:"nonzero" sg:"default=TCP,immutable"`
+
+	// Host and Path are the rule of a listener of an HTTP Entrypoint, which
+	// routes the requests matching it to port ServicePort of the Service
+	// ServiceName in Namespace. Host is any host if empty, and Path is
+	// required, e.g. "/". EntrypointPort is set to 80, or to 443 if
+	// TLSSecretName is set.
+	Host string `json:"host,omitempty" gorm:"unique_index:entrypoint_port_host_path" sg:"immutable"`
+	Path string `json:"path,omitempty" gorm:"unique_index:entrypoint_port_host_path" sg:"immutable"`
+
+	Namespace   string `json:"namespace,omitempty" sg:"immutable"`
+	ServiceName string `json:"service_name,omitempty" sg:"immutable"`
+	ServicePort int64  `json:"service_port,omitempty" sg:"immutable"`
+
+	// TLSSecretName is the name of a Kubernetes Secret in Namespace with the
+	// certificate (tls.crt) and key (tls.key) of Host, e.g. a managed Secret.
+	TLSSecretName string `json:"tls_secret_name,omitempty" sg:"immutable"`
+}
+
+// IsHTTP returns whether the listener is the rule of an HTTP Entrypoint.
+func (m *EntrypointListener) IsHTTP() bool {
+	return m.Host != "" || m.Path != ""
 }
@@ -0,0 +1,34 @@
+package model
+
+// HealthCheck is the result of a single readiness check.
+type HealthCheck struct {
+	Name    string `json:"name"`
+	Healthy bool   `json:"healthy"`
+	Error   string `json:"error,omitempty"`
+}
+
+// Readiness is rendered by the /readyz endpoint.
+type Readiness struct {
+	Ready  bool           `json:"ready"`
+	Checks []*HealthCheck `json:"checks"`
+}
+
+// KubeStatus reports whether Supergiant can reach a Kube's API and
+// MetricsSource.
+type KubeStatus struct {
+	KubeName  string `json:"kube_name"`
+	Ready     bool   `json:"ready"`
+	Reachable bool   `json:"reachable"`
+	// TLSVerified is false for Kubes which are connected to without verifying
+	// their certificate (InsecureSkipTLSVerify).
+	TLSVerified      bool   `json:"tls_verified"`
+	Error            string `json:"error,omitempty"`
+	MetricsAvailable bool   `json:"metrics_available"`
+	MetricsError     string `json:"metrics_error,omitempty"`
+}
+
+// Status is rendered by the /api/v0/status endpoint.
+type Status struct {
+	Version string        `json:"version"`
+	Kubes   []*KubeStatus `json:"kubes"`
+}
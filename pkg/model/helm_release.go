@@ -0,0 +1,42 @@
+package model
+
+// HelmReleaseKind is the Kind of KubeResources which are releases of Helm
+// charts. Supergiant renders the chart and manages its resources itself; Tiller
+// is not needed.
+const HelmReleaseKind = "HelmRelease"
+
+// HelmRelease is the Template of a HelmRelease KubeResource. The Namespace and
+// Name of the KubeResource are those of the release.
+type HelmRelease struct {
+	// Chart is the path of a chart directory or tarball (.tgz) in the Helm
+	// chart directory of the Supergiant server (--helm-chart-dir).
+	Chart string `json:"chart" validate:"nonzero"`
+
+	// Values override the defaults of the chart, as with `helm install -f`.
+	Values map[string]interface{} `json:"values,omitempty"`
+}
+
+// HelmReleaseStatus is the Artifact of a HelmRelease KubeResource.
+type HelmReleaseStatus struct {
+	Chart   string `json:"chart"`
+	Version string `json:"version"`
+
+	// Revision is 1 when the release is installed, and is incremented by every
+	// upgrade.
+	Revision int `json:"revision"`
+
+	// Resources are the resources of the release, in the order they were
+	// created.
+	Resources []*HelmReleaseResource `json:"resources"`
+
+	// Ready is true when all Resources are ready.
+	Ready bool `json:"ready"`
+}
+
+// HelmReleaseResource is a resource in the Namespace of a HelmRelease.
+type HelmReleaseResource struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Name       string `json:"name"`
+	Ready      bool   `json:"ready"`
+}
@@ -1,5 +1,11 @@
 package model
 
+const (
+	MetricsSourceHeapster      = "heapster"
+	MetricsSourceMetricsServer = "metrics_server"
+	MetricsSourcePrometheus    = "prometheus"
+)
+
 type KubeList struct {
 	BaseList
 	Items []*Kube `json:"items"`
@@ -25,6 +31,12 @@ type Kube struct {
 	// has_many KubeResources
 	KubeResources []*KubeResource `json:"kube_resources,omitempty" gorm:"ForeignKey:KubeName;AssociationForeignKey:Name"`
 
+	// has_many Apps
+	Apps []*App `json:"apps,omitempty" gorm:"ForeignKey:KubeName;AssociationForeignKey:Name"`
+
+	// has_many Secrets
+	Secrets []*Secret `json:"secrets,omitempty" gorm:"ForeignKey:KubeName;AssociationForeignKey:Name"`
+
 	Name string `json:"name" validate:"nonzero,max=12,regexp=^[a-z]([-a-z0-9]*[a-z0-9])?$" gorm:"not null;unique_index" sg:"immutable"`
 
 	MasterNodeSize string `json:"master_node_size" validate:"nonzero" sg:"immutable"`
@@ -35,9 +47,30 @@ type Kube struct {
 	Username string `json:"username" validate:"nonzero" sg:"immutable"`
 	Password string `json:"password" validate:"nonzero" sg:"immutable"`
 
+	// CACert is the PEM bundle that the certificate of the master is verified
+	// against. It is generated (along with CAKey) when the Kube is provisioned.
+	CACert string `json:"ca_cert,omitempty" gorm:"type:text" sg:"readonly"`
+	CAKey  string `json:"ca_key,omitempty" gorm:"type:text" sg:"readonly,private"`
+
+	// InsecureSkipTLSVerify must be set for Supergiant to connect to a Kube
+	// without a CACert, whose certificate is then not verified.
+	InsecureSkipTLSVerify bool `json:"insecure_skip_tls_verify"`
+
+	// Requests to the Kubernetes API are authenticated with BearerToken if set,
+	// else with the ClientCert and ClientKey (PEM) if set, else with Username
+	// and Password.
+	ClientCert  string `json:"client_cert,omitempty" gorm:"type:text"`
+	ClientKey   string `json:"client_key,omitempty" gorm:"type:text" sg:"private"`
+	BearerToken string `json:"bearer_token,omitempty" sg:"private"`
+
 	HeapsterVersion          string `json:"heapster_version" validate:"nonzero" sg:"default=v1.1.0,immutable"`
 	HeapsterMetricResolution string `json:"heapster_metric_resolution" validate:"regexp=^([0-9]+[smhd])+$" sg:"default=20s,immutable"`
 
+	// MetricsSource is where Node and Pod usage is read from. PrometheusURL is
+	// required for the prometheus source.
+	MetricsSource string `json:"metrics_source" validate:"regexp=^(heapster|metrics_server|prometheus)?$" sg:"default=heapster"`
+	PrometheusURL string `json:"prometheus_url,omitempty"`
+
 	// NOTE due to how we marshal this as JSON, it's difficult to have this stored
 	// as an interface, because unmarshalling causes us to lose the underlying
 	// type. So, this is kindof like a whacky form of single-table inheritance.
@@ -47,9 +80,19 @@ type Kube struct {
 	DigitalOceanConfig     *DOKubeConfig `json:"digitalocean_config,omitempty" gorm:"-" sg:"store_as_json_in=DigitalOceanConfigJSON,immutable"`
 	DigitalOceanConfigJSON []byte        `json:"-"`
 
+	ExistingConfig     *ExistingKubeConfig `json:"existing_config,omitempty" gorm:"-" sg:"store_as_json_in=ExistingConfigJSON,immutable"`
+	ExistingConfigJSON []byte              `json:"-"`
+
 	MasterPublicIP string `json:"master_public_ip" sg:"readonly"`
 
 	Ready bool `json:"ready" sg:"readonly" gorm:"index"`
+
+	// NodeSchedule stops the Nodes of the Kube at set times, e.g. overnight:
+	// while NodesStopped, the capacity service deletes Nodes which only run
+	// DaemonSet and kube-system Pods, so that the Kube can scale to zero Nodes.
+	NodeSchedule     *Schedule `json:"node_schedule,omitempty" gorm:"-" sg:"store_as_json_in=NodeScheduleJSON"`
+	NodeScheduleJSON []byte    `json:"-"`
+	NodesStopped     bool      `json:"nodes_stopped" sg:"readonly"`
 }
 
 // AWSKubeConfig holds aws specific information about AWS based KUbernetes clusters.
@@ -78,3 +121,15 @@ type DOKubeConfig struct {
 
 	MasterID int `json:"master_id" sg:"readonly"`
 }
+
+// ExistingKubeConfig holds the API endpoint of Kubes imported from existing
+// clusters, which Supergiant does not provision. Credentials are set on the
+// Kube as usual (BearerToken, ClientCert and ClientKey, or Username and
+// Password).
+type ExistingKubeConfig struct {
+	// Server is the host and port of the Kubernetes API, e.g. 10.0.0.1:6443, or
+	// its https URL, e.g. https://rancher.example.com/k8s/clusters/c-1.
+	Server string `json:"server" validate:"nonzero"`
+	// CACert is the PEM bundle the certificate of the API is verified against.
+	CACert string `json:"ca_cert,omitempty"`
+}
@@ -1,6 +1,9 @@
 package model
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"time"
+)
 
 type KubeResourceList struct {
 	BaseList
@@ -16,6 +19,19 @@ type KubeResource struct {
 	Kube     *Kube  `json:"kube,omitempty" gorm:"ForeignKey:KubeName;AssociationForeignKey:Name"`
 	KubeName string `json:"kube_name" validate:"nonzero" gorm:"not null;unique_index:kube_namespace_kind_name"`
 
+	// belongs_to KubeResourceTemplate (optional)
+	KubeResourceTemplate     *KubeResourceTemplate `json:"kube_resource_template,omitempty" gorm:"ForeignKey:KubeResourceTemplateName;AssociationForeignKey:Name"`
+	KubeResourceTemplateName string                `json:"kube_resource_template_name,omitempty" gorm:"index" sg:"immutable"`
+
+	// belongs_to App (optional)
+	App     *App   `json:"app,omitempty" gorm:"ForeignKey:AppName;AssociationForeignKey:Name"`
+	AppName string `json:"app_name,omitempty" gorm:"index" sg:"immutable"`
+
+	// DependsOn are the other KubeResources of the App which this one is started
+	// after, as "Kind/name", e.g. "Service/member-0".
+	DependsOn     []string `json:"depends_on,omitempty" gorm:"-" sg:"store_as_json_in=DependsOnJSON"`
+	DependsOnJSON []byte   `json:"-"`
+
 	// TODO get actual Kubernetes name regex for validation
 
 	// Kind corresponds directly to the Kind of Kubernetes resource (e.g. Pod, Service, etc.)
@@ -34,30 +50,123 @@ type KubeResource struct {
 	Template     *json.RawMessage `json:"template" gorm:"-" validate:"nonzero" sg:"store_as_json_in=TemplateJSON"`
 	TemplateJSON []byte           `json:"-"`
 
-	// Definition is where the finalized Kubernetes resource is stored, used to
-	// actually create the entity. The User can provide this directly, which will
-	// use the DefaultProvisioner.
-	Definition     *json.RawMessage `json:"definition" gorm:"-"` // We aren't actually storing this anymore
+	// Parameters are the values of the parameters of the KubeResourceTemplate,
+	// if there is one, which Template is rendered from. The values of secret
+	// parameters are moved to SecretParameters, which is never returned by the
+	// API.
+	Parameters     map[string]interface{} `json:"parameters,omitempty" gorm:"-" sg:"store_as_json_in=ParametersJSON"`
+	ParametersJSON []byte                 `json:"-"`
+
+	// SecretParameters are only stored encrypted, in EncryptedSecretParameters.
+	SecretParameters          map[string]interface{} `json:"-" gorm:"-" sg:"private"`
+	EncryptedSecretParameters []byte                 `json:"-"`
+
+	// RevisionAuthor is the username of the User making a change, which is
+	// recorded in its KubeResourceRevision. It is set by the API.
+	RevisionAuthor string `json:"-" gorm:"-"`
+
+	// Definition is the finalized Kubernetes resource, as last sent to
+	// Kubernetes by the Provisioner (without secret parameters). Drift is
+	// detected against it.
+	Definition     *json.RawMessage `json:"definition" gorm:"-" sg:"store_as_json_in=DefinitionJSON,readonly"`
 	DefinitionJSON []byte           `json:"-"`
 
-	// Artifact is where the full resource response from Kubernetes is stored.
+	// PreviousDefinition is the Definition a started resource was provisioned
+	// with, while it is provisioned again. The fields it has which Definition no
+	// longer has are removed from the resource.
+	PreviousDefinition *json.RawMessage `json:"-" gorm:"-"`
+
+	// Artifact is where the full resource response from Kubernetes is stored,
+	// with the values of secret parameters replaced by their placeholders.
 	Artifact     *json.RawMessage `json:"artifact" gorm:"-" sg:"store_as_json_in=ArtifactJSON,readonly"`
 	ArtifactJSON []byte           `json:"-"`
 
-	// Started represents whether the resource exists in Kubernetes or not. If it
-	// is a Pod, it also means the Pod is running.
+	// Started represents whether the resource exists in Kubernetes, whether or
+	// not it's ready. A started KubeResource is updated and stopped as usual.
 	Started bool `json:"started" sg:"readonly"`
 
+	// Ready represents whether the resource is ready, according to the
+	// readiness check of its Kind (e.g. a Pod is Ready, and a Job has
+	// succeeded).
+	Ready bool `json:"ready" sg:"readonly"`
+
+	// Readiness is the state of the resource according to the readiness check
+	// of its Kind, e.g. "1 of 3 ready", "pending load balancer", "succeeded",
+	// or "failed: BackoffLimitExceeded". It is empty when the resource doesn't
+	// exist, or is ready with nothing more to say.
+	Readiness string `json:"readiness,omitempty" sg:"readonly"`
+
+	// Schedule starts and stops the KubeResource at set times.
+	Schedule     *Schedule `json:"schedule,omitempty" gorm:"-" sg:"store_as_json_in=ScheduleJSON"`
+	ScheduleJSON []byte    `json:"-"`
+
+	// DriftPolicy is what Supergiant does when the resource of a started
+	// KubeResource drifts from Definition, e.g. when it is edited or deleted
+	// with kubectl. "report" (the default) only sets Drift; "reconcile" also
+	// applies Definition again.
+	DriftPolicy string `json:"drift_policy,omitempty" validate:"regexp=^(report|reconcile)?$"`
+
+	// Drift is set while the resource of a started KubeResource differs from
+	// Definition.
+	Drift     *KubeResourceDrift `json:"drift" gorm:"-" sg:"store_as_json_in=DriftJSON,readonly"`
+	DriftJSON []byte             `json:"-"`
+
 	// This is used to store unstructured data such as metrics from Heapster.
 	ExtraData     map[string]interface{} `json:"extra_data" gorm:"-" sg:"store_as_json_in=ExtraDataJSON,readonly"`
 	ExtraDataJSON []byte                 `json:"-"`
 }
 
+// KubeResourceDrift is how the resource of a KubeResource differs from its
+// Definition.
+type KubeResourceDrift struct {
+	// Missing is true if the resource has been deleted.
+	Missing bool `json:"missing"`
+
+	// Fields are the paths of the fields of Definition which differ in the
+	// resource, e.g. "spec.replicas".
+	Fields []string `json:"fields,omitempty"`
+
+	DetectedAt *time.Time `json:"detected_at"`
+
+	// ReconciledAt is when Definition was last applied again, if DriftPolicy is
+	// reconcile.
+	ReconciledAt *time.Time `json:"reconciled_at,omitempty"`
+}
+
 func (m *KubeResource) SetPassiveStatus() {
-	m.PassiveStatusOkay = m.Started
-	if m.Started {
-		m.PassiveStatus = "started"
+	if m.Drift != nil {
+		m.PassiveStatus = "drifted"
+		return
+	}
+	m.PassiveStatusOkay = m.Ready
+	if m.Readiness != "" {
+		m.PassiveStatus = m.Readiness
 		return
 	}
-	m.PassiveStatus = "stopped"
+	switch {
+	case m.Ready:
+		m.PassiveStatus = "started"
+	case m.Started:
+		m.PassiveStatus = "starting"
+	default:
+		m.PassiveStatus = "stopped"
+	}
+}
+
+// APIVersion returns the apiVersion of Definition (or Template, if Definition
+// is empty), defaulting to "v1".
+func (m *KubeResource) APIVersion() string {
+	raw := m.Definition
+	if raw == nil || len(*raw) == 0 {
+		raw = m.Template
+	}
+	if raw != nil {
+		var resource struct {
+			APIVersion string `json:"apiVersion"`
+		}
+		if err := json.Unmarshal(*raw, &resource); err == nil && resource.APIVersion != "" {
+			return resource.APIVersion
+		}
+	}
+	return "v1"
 }
@@ -0,0 +1,37 @@
+package model
+
+// KubeResourceImport is the request and response of the
+// /api/v0/kube_resources/import endpoint, which adopts resources that already
+// exist in a Kube as started KubeResources, without re-creating them.
+type KubeResourceImport struct {
+	KubeName string `json:"kube_name" validate:"nonzero"`
+
+	// Kinds are the kinds of resources to import. Kinds outside the core API
+	// are prefixed with their apiVersion, e.g. Service or
+	// extensions/v1beta1/Deployment.
+	Kinds []string `json:"kinds" validate:"min=1"`
+
+	// Namespaces are the namespaces to import from. Empty is all of them,
+	// except the system namespaces (kube-system, kube-public and
+	// kube-node-lease), which are only imported if listed.
+	Namespaces []string `json:"namespaces,omitempty"`
+
+	// Imported are the KubeResources created.
+	Imported []*KubeResource `json:"imported"`
+
+	// Skipped are the resources which were not imported, and why.
+	Skipped []*SkippedKubeResource `json:"skipped"`
+
+	// Failed are the resources (or, without a Name, the kinds of a namespace)
+	// which could not be listed or saved, with the error as the Reason.
+	Failed []*SkippedKubeResource `json:"failed"`
+}
+
+// SkippedKubeResource is a resource which could not be imported, e.g. because
+// it is already a KubeResource, or is managed by another resource.
+type SkippedKubeResource struct {
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	Reason    string `json:"reason"`
+}
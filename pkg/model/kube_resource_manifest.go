@@ -0,0 +1,31 @@
+package model
+
+// KubeResourceManifest is the request and response of the
+// /api/v0/kube_resources/manifest endpoint, which creates a KubeResource for
+// each resource of a YAML or JSON manifest, as used with `kubectl create -f`.
+type KubeResourceManifest struct {
+	KubeName string `json:"kube_name" validate:"nonzero"`
+
+	// Namespace is the namespace of resources which don't set
+	// metadata.namespace. It defaults to "default".
+	Namespace string `json:"namespace,omitempty"`
+
+	// Manifest is one or more resources in YAML or JSON. Documents are
+	// separated by "---", and Lists are expanded into their items.
+	Manifest string `json:"manifest" validate:"nonzero"`
+
+	// Results are the result of creating each resource, in the order of
+	// Manifest.
+	Results []*KubeResourceManifestResult `json:"results"`
+}
+
+// KubeResourceManifestResult is the result of creating a KubeResource for a
+// resource of a KubeResourceManifest. Either KubeResource or Error is set.
+type KubeResourceManifestResult struct {
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+
+	KubeResource *KubeResource `json:"kube_resource,omitempty"`
+	Error        string        `json:"error,omitempty"`
+}
@@ -0,0 +1,46 @@
+package model
+
+import "encoding/json"
+
+type KubeResourceRevisionList struct {
+	BaseList
+	Items []*KubeResourceRevision `json:"items"`
+}
+
+// KubeResourceRevision is a numbered revision of the Template (and Parameters)
+// of a KubeResource, with the Definition it was provisioned with. A revision is
+// recorded each time either changes.
+type KubeResourceRevision struct {
+	BaseModel
+
+	// NOTE there is a 2-way unique index on kube_resource_id and revision.
+
+	// belongs_to KubeResource
+	KubeResource   *KubeResource `json:"kube_resource,omitempty"`
+	KubeResourceID *int64        `json:"kube_resource_id" gorm:"not null;unique_index:kube_resource_id_revision"`
+
+	// Revision numbers start at 1 for each KubeResource.
+	Revision int `json:"revision" gorm:"not null;unique_index:kube_resource_id_revision"`
+
+	// Author is the username of the User who made the change, if it was made
+	// through the API.
+	Author string `json:"author,omitempty"`
+
+	// RollbackTo is the revision this revision rolled back to, if it is a
+	// rollback.
+	RollbackTo int `json:"rollback_to,omitempty"`
+
+	Template     *json.RawMessage `json:"template" gorm:"-" sg:"store_as_json_in=TemplateJSON"`
+	TemplateJSON []byte           `json:"-"`
+
+	Parameters     map[string]interface{} `json:"parameters,omitempty" gorm:"-" sg:"store_as_json_in=ParametersJSON"`
+	ParametersJSON []byte                 `json:"-"`
+
+	// EncryptedSecretParameters are those of the KubeResource, which are
+	// restored with Parameters on rollback.
+	EncryptedSecretParameters []byte `json:"-"`
+
+	// Definition is set once the revision is provisioned.
+	Definition     *json.RawMessage `json:"definition" gorm:"-" sg:"store_as_json_in=DefinitionJSON"`
+	DefinitionJSON []byte           `json:"-"`
+}
@@ -0,0 +1,52 @@
+package model
+
+import "encoding/json"
+
+type KubeResourceTemplateList struct {
+	BaseList
+	Items []*KubeResourceTemplate `json:"items"`
+}
+
+// KubeResourceTemplate is a reusable Template for KubeResources of Kind, e.g.
+// to deploy the same app to staging and production. Strings in Template of the
+// form "{{ name }}" are placeholders for its Parameters, which KubeResources
+// set with their own Parameters.
+type KubeResourceTemplate struct {
+	BaseModel
+
+	// has_many KubeResources
+	KubeResources []*KubeResource `json:"kube_resources,omitempty" gorm:"ForeignKey:KubeResourceTemplateName;AssociationForeignKey:Name"`
+
+	Name string `json:"name" validate:"nonzero,max=32,regexp=^[a-z]([-a-z0-9]*[a-z0-9])?$" gorm:"not null;unique_index" sg:"immutable"`
+
+	Kind string `json:"kind" validate:"nonzero" gorm:"not null"`
+
+	Parameters     []*TemplateParameter `json:"parameters" gorm:"-" sg:"store_as_json_in=ParametersJSON"`
+	ParametersJSON []byte               `json:"-"`
+
+	Template     *json.RawMessage `json:"template" gorm:"-" validate:"nonzero" sg:"store_as_json_in=TemplateJSON"`
+	TemplateJSON []byte           `json:"-"`
+}
+
+// TemplateParameter is a parameter of a KubeResourceTemplate.
+type TemplateParameter struct {
+	Name        string `json:"name" validate:"nonzero,regexp=^[A-Za-z_][A-Za-z0-9_]*$"`
+	Description string `json:"description,omitempty"`
+
+	// Type is string (the default), number, integer, or boolean. A placeholder
+	// which is a whole string is replaced with a value of this type, so that
+	// e.g. "{{ replicas }}" can be a number.
+	Type string `json:"type" validate:"regexp=^(string|number|integer|boolean)?$"`
+
+	// Default is the value of the parameter if a KubeResource doesn't set it.
+	Default interface{} `json:"default,omitempty"`
+
+	// Required parameters must be set by KubeResources if there is no Default.
+	Required bool `json:"required"`
+
+	// Secret parameters are strings which are kept (encrypted) in the
+	// SecretParameters of KubeResources rather than their Template, and are
+	// never returned by the API. They are only substituted when the resource is sent to Kubernetes,
+	// and are replaced with their placeholders again in the Artifact.
+	Secret bool `json:"secret"`
+}
@@ -0,0 +1,67 @@
+package model
+
+import (
+	"strconv"
+	"time"
+)
+
+// LogEntry is a single line of the server log file. Lines that are not JSON
+// (written before structured logging was enabled) have only a Message.
+type LogEntry struct {
+	Time    time.Time              `json:"time"`
+	Level   string                 `json:"level"`
+	Message string                 `json:"msg"`
+	Fields  map[string]interface{} `json:"fields,omitempty"`
+}
+
+type LogEntryList struct {
+	Items []*LogEntry `json:"items"`
+}
+
+// LogQuery filters the entries returned by the /api/v0/log endpoint. Zero
+// values do not filter.
+type LogQuery struct {
+	// Level is the minimum severity, e.g. "warning" includes warning, error,
+	// fatal and panic entries.
+	Level string
+
+	Since time.Time
+	Until time.Time
+
+	// Model and ModelID match the "model" and "model_id" fields logged by
+	// Actions, e.g. "KubeResource" and "5".
+	Model   string
+	ModelID string
+
+	// Text is a case-insensitive substring of the message.
+	Text string
+
+	// Limit is the number of most recent matching entries returned.
+	Limit int
+}
+
+func (q *LogQuery) QueryValues() map[string][]string {
+	qv := make(map[string][]string)
+	if q.Level != "" {
+		qv["level"] = []string{q.Level}
+	}
+	if !q.Since.IsZero() {
+		qv["since"] = []string{q.Since.Format(time.RFC3339)}
+	}
+	if !q.Until.IsZero() {
+		qv["until"] = []string{q.Until.Format(time.RFC3339)}
+	}
+	if q.Model != "" {
+		qv["model"] = []string{q.Model}
+	}
+	if q.ModelID != "" {
+		qv["model_id"] = []string{q.ModelID}
+	}
+	if q.Text != "" {
+		qv["q"] = []string{q.Text}
+	}
+	if q.Limit != 0 {
+		qv["limit"] = []string{strconv.Itoa(q.Limit)}
+	}
+	return qv
+}
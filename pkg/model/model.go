@@ -36,6 +36,8 @@ type BaseModel struct {
 // ActionStatus holds all the information pertaining to any running or failed
 // Async Actions, and is rendered on the model on display (not persisted).
 type ActionStatus struct {
+	// ID identifies the Action in log entries (as the "action_id" field).
+	ID             string `json:"id,omitempty"`
 	Description    string `json:"description"`
 	MaxRetries     int    `json:"max_retries"`
 	Retries        int    `json:"retries"`
@@ -0,0 +1,72 @@
+package model
+
+import (
+	"strconv"
+	"time"
+)
+
+// PodLogQuery selects the log lines returned by the
+// /api/v0/kube_resources/{id}/logs endpoint of a Pod KubeResource.
+type PodLogQuery struct {
+	// Container is required if the Pod has more than one container.
+	Container string
+
+	// Tail is the number of most recent lines. Zero is all.
+	Tail int
+
+	// Since excludes lines logged before it, unless zero.
+	Since time.Time
+
+	// Follow streams new lines until the connection is closed.
+	Follow bool
+}
+
+func (q *PodLogQuery) QueryValues() map[string][]string {
+	qv := make(map[string][]string)
+	if q.Container != "" {
+		qv["container"] = []string{q.Container}
+	}
+	if q.Tail != 0 {
+		qv["tail"] = []string{strconv.Itoa(q.Tail)}
+	}
+	if !q.Since.IsZero() {
+		qv["since"] = []string{q.Since.Format(time.RFC3339)}
+	}
+	if q.Follow {
+		qv["follow"] = []string{"true"}
+	}
+	return qv
+}
+
+// ExecQuery is the command run by the /api/v0/kube_resources/{id}/exec
+// endpoint of a Pod KubeResource.
+type ExecQuery struct {
+	// Container is required if the Pod has more than one container.
+	Container string
+
+	// Command is the executable and its arguments, e.g. ["ls", "-l", "/"].
+	Command []string
+
+	// Stdin attaches the standard input of the command.
+	Stdin bool
+
+	// TTY allocates a terminal for the command.
+	TTY bool
+}
+
+func (q *ExecQuery) QueryValues() map[string][]string {
+	qv := make(map[string][]string)
+	if q.Container != "" {
+		qv["container"] = []string{q.Container}
+	}
+	if len(q.Command) > 0 {
+		qv["command"] = q.Command
+	}
+	if q.Stdin {
+		qv["stdin"] = []string{"true"}
+	}
+	if q.TTY {
+		qv["tty"] = []string{"true"}
+	}
+	return qv
+}
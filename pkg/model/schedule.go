@@ -0,0 +1,10 @@
+package model
+
+// Schedule is when something is started and stopped, as cron expressions
+// (e.g. "0 8 * * mon-fri") in TimeZone (UTC by default). Either can be empty,
+// e.g. to stop every night and only start by hand.
+type Schedule struct {
+	Start    string `json:"start,omitempty" validate:"cron"`
+	Stop     string `json:"stop,omitempty" validate:"cron"`
+	TimeZone string `json:"time_zone,omitempty" validate:"timezone"`
+}
@@ -0,0 +1,59 @@
+package model
+
+import "time"
+
+type SecretList struct {
+	BaseList
+	Items []*Secret `json:"items"`
+}
+
+// Secret is a Kubernetes Secret (or ConfigMap) in a Namespace of a Kube, which
+// Supergiant manages, so that credentials don't have to be written in the
+// Templates of KubeResources. Templates refer to it by name, as to any Secret
+// (e.g. with secretKeyRef). Its Data is stored encrypted, and is never
+// returned.
+type Secret struct {
+	BaseModel
+
+	// NOTE there is a 4-way unique index on kube_name, namespace, kind, and name.
+
+	// belongs_to Kube
+	Kube     *Kube  `json:"kube,omitempty" gorm:"ForeignKey:KubeName;AssociationForeignKey:Name"`
+	KubeName string `json:"kube_name" validate:"nonzero" gorm:"not null;unique_index:secret_kube_namespace_kind_name" sg:"immutable"`
+
+	Namespace string `json:"namespace" validate:"nonzero,max=24" gorm:"not null;unique_index:secret_kube_namespace_kind_name" sg:"immutable"`
+
+	// Kind is the kind of resource the Secret is provisioned as, "Secret" (the
+	// default) or "ConfigMap".
+	Kind string `json:"kind" validate:"regexp=^(Secret|ConfigMap)$" gorm:"not null;unique_index:secret_kube_namespace_kind_name" sg:"default=Secret,immutable"`
+
+	Name string `json:"name" validate:"nonzero,max=24,regexp=^[a-z0-9]([-a-z0-9]*[a-z0-9])?$" gorm:"not null;unique_index:secret_kube_namespace_kind_name" sg:"immutable"`
+
+	// Data are the values of the Secret by key. Data can only be set; it is
+	// stored encrypted in EncryptedData. Setting it on update rotates the
+	// Secret.
+	Data          map[string]string `json:"data,omitempty" gorm:"-"`
+	EncryptedData []byte            `json:"-"`
+
+	// Keys are the keys of Data.
+	Keys     []string `json:"keys" gorm:"-" sg:"store_as_json_in=KeysJSON,readonly"`
+	KeysJSON []byte   `json:"-"`
+
+	// Version is incremented each time the Secret is rotated.
+	Version int `json:"version" sg:"readonly"`
+
+	RotatedAt *time.Time `json:"rotated_at,omitempty" sg:"readonly"`
+
+	// Ready is whether the current version of the Secret is provisioned in the
+	// Kube.
+	Ready bool `json:"ready" sg:"readonly"`
+}
+
+func (m *Secret) SetPassiveStatus() {
+	m.PassiveStatusOkay = m.Ready
+	if m.Ready {
+		m.PassiveStatus = "ready"
+		return
+	}
+	m.PassiveStatus = "not ready"
+}
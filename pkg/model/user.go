@@ -25,10 +25,16 @@ type User struct {
 	EncryptedPassword []byte `json:"-" gorm:"not null"`
 
 	APIToken string `json:"api_token" gorm:"not null;index" sg:"readonly"`
+
+	// ProxyToken is the bearer token of the proxy kubeconfigs of the User, which
+	// only authenticates requests to the Kube API proxy, so that the API token
+	// is not written to kubeconfigs. It is regenerated to revoke them.
+	ProxyToken string `json:"proxy_token" gorm:"index" sg:"readonly"`
 }
 
 func (m *User) BeforeCreate() error {
 	m.GenerateAPIToken()
+	m.GenerateProxyToken()
 	return nil
 }
 
@@ -43,6 +49,10 @@ func (m *User) GenerateAPIToken() {
 	m.APIToken = util.RandomString(32)
 }
 
+func (m *User) GenerateProxyToken() {
+	m.ProxyToken = util.RandomString(32)
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 // Private                                                                    //
 ////////////////////////////////////////////////////////////////////////////////
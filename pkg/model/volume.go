@@ -21,4 +21,10 @@ type Volume struct {
 	Size int    `json:"size" validate:"nonzero"`
 
 	ProviderID string `json:"provider_id" sg:"readonly"`
+
+	// ReclaimPolicy is what happens to the Volume of a SUPERGIANT_EXTERNAL_VOLUME
+	// when its KubeResource is deleted. "delete" (the default) deletes it;
+	// "retain" keeps it, detached, to be reused by the next KubeResource of the
+	// Kube which defines a volume of the same name.
+	ReclaimPolicy string `json:"reclaim_policy,omitempty" validate:"regexp=^(delete|retain)?$"`
 }
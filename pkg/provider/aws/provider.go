@@ -646,8 +646,20 @@ func (p *Provider) createKube(m *model.Kube, action *core.Action) error {
 		if err != nil {
 			return err
 		}
+		// The master signs its certificate with a CA generated for the Kube. Its
+		// key is only given to the master.
+		caCert, caKey, err := kubernetes.GenerateCA("kube-ca")
+		if err != nil {
+			return err
+		}
+		m.CACert = caCert
+		templateData := struct {
+			*model.Kube
+			CAKey string
+		}{m, caKey}
+
 		var userdata bytes.Buffer
-		if err = template.Execute(&userdata, m); err != nil {
+		if err = template.Execute(&userdata, templateData); err != nil {
 			return err
 		}
 		encodedUserdata := base64.StdEncoding.EncodeToString(userdata.Bytes())
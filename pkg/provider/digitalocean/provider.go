@@ -2,6 +2,7 @@ package digitalocean
 
 import (
 	"bytes"
+	"encoding/base64"
 	"strconv"
 	"strings"
 	"text/template"
@@ -60,6 +61,22 @@ func (p *Provider) CreateKube(m *model.Kube, action *core.Action) error {
 		return nil
 	})
 
+	procedure.AddStep("generating Kubernetes certificates", func() error {
+		if m.CAKey != "" {
+			return nil
+		}
+		var err error
+		if m.CACert, m.CAKey, err = kubernetes.GenerateCA("kube-ca"); err != nil {
+			return err
+		}
+		if m.ClientCert != "" || m.BearerToken != "" {
+			return nil
+		}
+		// Supergiant authenticates as a cluster admin with a client certificate
+		m.ClientCert, m.ClientKey, err = kubernetes.GenerateClientCert(m.CACert, m.CAKey, "supergiant", []string{"system:masters"}, kubernetes.CAValidity)
+		return err
+	})
+
 	procedure.AddStep("creating master", func() error {
 		if m.MasterPublicIP != "" {
 			return nil
@@ -74,8 +91,17 @@ func (p *Provider) CreateKube(m *model.Kube, action *core.Action) error {
 		if err != nil {
 			return err
 		}
+		data := struct {
+			*model.Kube
+			CACertBase64 string
+			CAKeyBase64  string
+		}{
+			m,
+			base64.StdEncoding.EncodeToString([]byte(m.CACert)),
+			base64.StdEncoding.EncodeToString([]byte(m.CAKey)),
+		}
 		var masterUserdata bytes.Buffer
-		if err = masterTemplate.Execute(&masterUserdata, m); err != nil {
+		if err = masterTemplate.Execute(&masterUserdata, data); err != nil {
 			return err
 		}
 
@@ -0,0 +1,104 @@
+package existing
+
+import (
+	"errors"
+
+	"github.com/supergiant/supergiant/pkg/core"
+	"github.com/supergiant/supergiant/pkg/kubernetes"
+	"github.com/supergiant/supergiant/pkg/model"
+)
+
+var (
+	errNodesNotSupported       = errors.New("Nodes of existing Kubes are discovered from Kubernetes, and cannot be created")
+	errVolumesNotSupported     = errors.New("Volumes are not supported on existing Kubes")
+	errEntrypointsNotSupported = errors.New("Entrypoints are not supported on existing Kubes")
+)
+
+// Provider imports existing Kubernetes clusters. Supergiant does not create or
+// delete anything in the cloud for them.
+type Provider struct {
+	Core *core.Core
+}
+
+// ValidateAccount does nothing, since existing Kubes have their own
+// credentials.
+func (p *Provider) ValidateAccount(m *model.CloudAccount) error {
+	return nil
+}
+
+// CreateKube checks that the Kubernetes API of the Kube can be reached, and
+// discovers its Nodes.
+func (p *Provider) CreateKube(m *model.Kube, action *core.Action) error {
+	if m.ExistingConfig == nil {
+		return errors.New("existing_config is required for existing Kubes")
+	}
+
+	procedure := &core.Procedure{
+		Core:   p.Core,
+		Name:   "Create Kube",
+		Model:  m,
+		Action: action,
+	}
+
+	procedure.AddStep("connecting to Kubernetes", func() error {
+		_, err := p.Core.K8S(m).ListNamespaces("")
+		return err
+	})
+
+	procedure.AddStep("discovering nodes", func() error {
+		return p.Core.Kubes.DiscoverNodes(m)
+	})
+
+	return procedure.Run()
+}
+
+// DeleteKube does nothing; the cluster is left as is.
+func (p *Provider) DeleteKube(m *model.Kube, action *core.Action) error {
+	return nil
+}
+
+func (p *Provider) CreateNode(m *model.Node, action *core.Action) error {
+	return errNodesNotSupported
+}
+
+// DeleteNode does nothing; the Node is forgotten, and discovered again if it
+// is still in Kubernetes.
+func (p *Provider) DeleteNode(m *model.Node, action *core.Action) error {
+	return nil
+}
+
+func (p *Provider) CreateVolume(m *model.Volume, action *core.Action) error {
+	return errVolumesNotSupported
+}
+
+func (p *Provider) KubernetesVolumeDefinition(m *model.Volume) *kubernetes.Volume {
+	return nil
+}
+
+func (p *Provider) ResizeVolume(m *model.Volume, action *core.Action) error {
+	return errVolumesNotSupported
+}
+
+func (p *Provider) WaitForVolumeAvailable(m *model.Volume, action *core.Action) error {
+	return errVolumesNotSupported
+}
+
+func (p *Provider) DeleteVolume(m *model.Volume, action *core.Action) error {
+	return nil
+}
+
+func (p *Provider) CreateEntrypoint(m *model.Entrypoint, action *core.Action) error {
+	return errEntrypointsNotSupported
+}
+
+func (p *Provider) DeleteEntrypoint(m *model.Entrypoint, action *core.Action) error {
+	return nil
+}
+
+func (p *Provider) CreateEntrypointListener(m *model.EntrypointListener, action *core.Action) error {
+	return errEntrypointsNotSupported
+}
+
+func (p *Provider) DeleteEntrypointListener(m *model.EntrypointListener, action *core.Action) error {
+	return nil
+}
@@ -0,0 +1,146 @@
+package existing_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/supergiant/supergiant/pkg/core"
+	"github.com/supergiant/supergiant/pkg/kubernetes"
+	"github.com/supergiant/supergiant/pkg/model"
+	"github.com/supergiant/supergiant/pkg/provider/existing"
+	"github.com/supergiant/supergiant/test/fake_core"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestExistingProviderCreateKube(t *testing.T) {
+	Convey("Existing Provider CreateKube works correctly", t, func() {
+		table := []struct {
+			// Input
+			kube *model.Kube
+			// Mocks
+			existingNodes       []*model.Node
+			k8sNodes            []*kubernetes.Node
+			mockNamespacesError error
+			// Expectations
+			nodesCreated []*model.Node
+			nodesDeleted []string
+			nodes        []string
+			err          error
+		}{
+			// A successful example
+			{
+				kube: &model.Kube{
+					Name:           "test",
+					ExistingConfig: &model.ExistingKubeConfig{Server: "10.0.0.1:6443"},
+				},
+				existingNodes: []*model.Node{
+					{KubeName: "test", Name: "node-1", Size: "existing", ProviderID: "node-1"},
+					{KubeName: "test", Name: "removed", Size: "existing", ProviderID: "removed"},
+				},
+				k8sNodes: []*kubernetes.Node{
+					{Metadata: kubernetes.Metadata{Name: "node-1"}},
+					{
+						Metadata: kubernetes.Metadata{
+							Name:              "node-2",
+							Labels:            map[string]string{"beta.kubernetes.io/instance-type": "m4.large"},
+							CreationTimestamp: "2017-06-01T12:00:00Z",
+						},
+						Spec: kubernetes.NodeSpec{ExternalID: "i-12345"},
+					},
+				},
+				nodesCreated: []*model.Node{
+					{KubeName: "test", Name: "node-2", Size: "m4.large", ProviderID: "i-12345"},
+				},
+				nodesDeleted: []string{"removed"},
+				nodes:        []string{"node-1", "node-2"},
+			},
+			// Without ExistingConfig
+			{
+				kube: &model.Kube{Name: "test"},
+				err:  errors.New("existing_config is required for existing Kubes"),
+			},
+			// When the API can't be reached
+			{
+				kube: &model.Kube{
+					Name:           "test",
+					ExistingConfig: &model.ExistingKubeConfig{Server: "10.0.0.1:6443"},
+				},
+				mockNamespacesError: errors.New("K8S 401 Unauthorized error: Unauthorized"),
+				err:                 errors.New("K8S 401 Unauthorized error: Unauthorized"),
+			},
+		}
+
+		for _, item := range table {
+			var nodesCreated []*model.Node
+			var nodesDeleted []string
+
+			c := &core.Core{
+				Log: logrus.New(),
+				DB: &fake_core.DB{
+					FindFn: func(out interface{}, where ...interface{}) error {
+						*(out.(*[]*model.Node)) = item.existingNodes
+						return nil
+					},
+					CreateFn: func(m model.Model) error {
+						node := m.(*model.Node)
+						nodesCreated = append(nodesCreated, &model.Node{
+							KubeName:   node.KubeName,
+							Name:       node.Name,
+							Size:       node.Size,
+							ProviderID: node.ProviderID,
+						})
+						return nil
+					},
+					DeleteFn: func(m model.Model) error {
+						nodesDeleted = append(nodesDeleted, m.(*model.Node).Name)
+						return nil
+					},
+				},
+				K8S: func(*model.Kube) kubernetes.ClientInterface {
+					return &fake_core.KubernetesClient{
+						ListNamespacesFn: func(query string) ([]*kubernetes.Namespace, error) {
+							return nil, item.mockNamespacesError
+						},
+						ListNodesFn: func(query string) ([]*kubernetes.Node, error) {
+							return item.k8sNodes, nil
+						},
+					}
+				},
+			}
+			c.Kubes = &core.Kubes{Collection: core.Collection{Core: c}}
+
+			provider := &existing.Provider{Core: c}
+
+			action := &core.Action{Status: new(model.ActionStatus)}
+			err := provider.CreateKube(item.kube, action)
+
+			So(err, ShouldResemble, item.err)
+			So(nodesCreated, ShouldResemble, item.nodesCreated)
+			So(nodesDeleted, ShouldResemble, item.nodesDeleted)
+
+			var nodes []string
+			for _, node := range item.kube.Nodes {
+				nodes = append(nodes, node.Name)
+			}
+			So(nodes, ShouldResemble, item.nodes)
+		}
+	})
+}
+
+func TestExistingProviderNodes(t *testing.T) {
+	Convey("Existing Provider does not create Nodes, and forgets deleted ones", t, func() {
+		provider := &existing.Provider{Core: new(core.Core)}
+		action := &core.Action{Status: new(model.ActionStatus)}
+
+		err := provider.CreateNode(&model.Node{KubeName: "test", Size: "m4.large"}, action)
+		So(err, ShouldResemble, errors.New("Nodes of existing Kubes are discovered from Kubernetes, and cannot be created"))
+
+		err = provider.DeleteNode(&model.Node{KubeName: "test", Name: "node-1"}, action)
+		So(err, ShouldBeNil)
+
+		err = provider.DeleteKube(&model.Kube{Name: "test"}, action)
+		So(err, ShouldBeNil)
+	})
+}
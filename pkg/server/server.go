@@ -1,11 +1,14 @@
 package server
 
 import (
+	"bufio"
 	"crypto/tls"
+	"errors"
 	"net"
 	"net/http"
 	"time"
 
+	"github.com/gorilla/mux"
 	"github.com/supergiant/supergiant/pkg/api"
 	"github.com/supergiant/supergiant/pkg/core"
 	"github.com/supergiant/supergiant/pkg/ui"
@@ -36,6 +39,9 @@ func New(c *core.Core) (server *Server, err error) {
 	}
 
 	server.primaryHandler = router
+	if c.Metrics != nil {
+		server.primaryHandler = &metricsHandler{c.Metrics, router}
+	}
 
 	if c.SSLEnabled() {
 		server.primaryListener, err = newTLSListener(c.HTTPSPort, c.SSLCertFile, c.SSLKeyFile)
@@ -81,6 +87,57 @@ func (s *Server) Stop() error {
 
 //------------------------------------------------------------------------------
 
+// metricsHandler records request metrics by route template (as opposed to
+// path) so that IDs don't create a series per resource.
+type metricsHandler struct {
+	metrics *core.Metrics
+	router  *mux.Router
+}
+
+func (h *metricsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+
+	route := "unmatched"
+	var match mux.RouteMatch
+	if h.router.Match(r, &match) {
+		if tpl, err := match.Route.GetPathTemplate(); err == nil {
+			route = tpl
+		}
+	}
+
+	rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+	h.router.ServeHTTP(rec, r)
+
+	h.metrics.ObserveHTTPRequest(route, r.Method, rec.status, start)
+}
+
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *statusRecorder) Flush() {
+	if flusher, ok := r.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+func (r *statusRecorder) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hijacker, ok := r.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, errors.New("ResponseWriter does not implement http.Hijacker")
+	}
+	r.status = http.StatusSwitchingProtocols
+	return hijacker.Hijack()
+}
+
+//------------------------------------------------------------------------------
+
 func newListener(port string) (ln net.Listener, err error) {
 	ln, err = net.Listen("tcp", ":"+port)
 	if err == nil {
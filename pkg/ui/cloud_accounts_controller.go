@@ -19,6 +19,11 @@ func NewCloudAccount(sg *client.Client, w http.ResponseWriter, r *http.Request)
 				"token": "",
 			},
 		}
+	case "existing":
+		m = map[string]interface{}{
+			"name":     "",
+			"provider": "existing",
+		}
 	default: // just default to AWS if option not provided, or mismatched
 		m = map[string]interface{}{
 			"name":     "",
@@ -77,6 +82,7 @@ func ListCloudAccounts(sg *client.Client, w http.ResponseWriter, r *http.Request
 		"newOptions": map[string]string{
 			"aws":          "AWS",
 			"digitalocean": "DigitalOcean",
+			"existing":     "Existing cluster",
 		},
 		"batchActionPaths": map[string]map[string]string{
 			"Delete": map[string]string{
@@ -0,0 +1,76 @@
+package ui
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// diffLine is a line of a diff. Op is "+" for an added line, "-" for a removed
+// line, and " " for an unchanged line.
+type diffLine struct {
+	Op   string
+	Text string
+}
+
+// diffJSON returns the line diff between a and b, indented, so that it can be
+// read. A nil a is empty.
+func diffJSON(a *json.RawMessage, b *json.RawMessage) []diffLine {
+	return diffLines(indentedJSONLines(a), indentedJSONLines(b))
+}
+
+// diffLines returns the diff between the lines a and b, from their longest
+// common subsequence.
+func diffLines(a []string, b []string) []diffLine {
+	// lcs[i][j] is the length of the longest common subsequence of a[i:] and b[j:]
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	var lines []diffLine
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			lines = append(lines, diffLine{" ", a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			lines = append(lines, diffLine{"-", a[i]})
+			i++
+		default:
+			lines = append(lines, diffLine{"+", b[j]})
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		lines = append(lines, diffLine{"-", a[i]})
+	}
+	for ; j < len(b); j++ {
+		lines = append(lines, diffLine{"+", b[j]})
+	}
+	return lines
+}
+
+func indentedJSONLines(raw *json.RawMessage) []string {
+	if raw == nil || len(*raw) == 0 {
+		return nil
+	}
+	var obj interface{}
+	if err := json.Unmarshal(*raw, &obj); err != nil {
+		return strings.Split(string(*raw), "\n")
+	}
+	indented, _ := json.MarshalIndent(obj, "", "  ")
+	return strings.Split(string(indented), "\n")
+}
@@ -1,8 +1,10 @@
 package ui
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/supergiant/supergiant/pkg/client"
 	"github.com/supergiant/supergiant/pkg/model"
@@ -130,7 +132,8 @@ func ListKubeResources(sg *client.Client, w http.ResponseWriter, r *http.Request
 			"other":   "Other",
 		},
 		"actionPaths": map[string]string{
-			"Edit": "/edit",
+			"Edit":      "/edit",
+			"Revisions": "/revisions",
 		},
 		"batchActionPaths": map[string]map[string]string{
 			"Delete": map[string]string{
@@ -203,3 +206,62 @@ func UpdateKubeResource(sg *client.Client, w http.ResponseWriter, r *http.Reques
 	http.Redirect(w, r, "/ui/kube_resources", http.StatusFound)
 	return nil
 }
+
+// GetKubeResourceRevisions shows the revisions of a KubeResource, newest first,
+// each with the diff of its Template from the previous revision.
+func GetKubeResourceRevisions(sg *client.Client, w http.ResponseWriter, r *http.Request) error {
+	id, err := parseID(r)
+	if err != nil {
+		return err
+	}
+	item := new(model.KubeResource)
+	if err := sg.KubeResources.Get(id, item); err != nil {
+		return err
+	}
+	list := new(model.KubeResourceRevisionList)
+	if err := sg.KubeResources.Revisions(id, list); err != nil {
+		return err
+	}
+
+	var revisions []map[string]interface{}
+	for i, revision := range list.Items {
+		var previous *model.KubeResourceRevision
+		if i > 0 {
+			previous = list.Items[i-1]
+		}
+		var previousTemplate *json.RawMessage
+		if previous != nil {
+			previousTemplate = previous.Template
+		}
+		revisions = append([]map[string]interface{}{{
+			"revision": revision,
+			"diff":     diffJSON(previousTemplate, revision.Template),
+			"current":  i == len(list.Items)-1,
+		}}, revisions...)
+	}
+
+	return renderTemplate(sg, w, "kube_resource_revisions", map[string]interface{}{
+		"title":      "Kube Resources",
+		"model":      item,
+		"revisions":  revisions,
+		"uiBasePath": fmt.Sprintf("/ui/kube_resources/%d", *id),
+	})
+}
+
+// RollbackKubeResource rolls a KubeResource back to the revision in the
+// revision query param.
+func RollbackKubeResource(sg *client.Client, w http.ResponseWriter, r *http.Request) error {
+	id, err := parseID(r)
+	if err != nil {
+		return err
+	}
+	revision, err := strconv.Atoi(r.URL.Query().Get("revision"))
+	if err != nil {
+		return err
+	}
+	if err := sg.KubeResources.Rollback(id, revision, new(model.KubeResource)); err != nil {
+		return err
+	}
+	http.Redirect(w, r, fmt.Sprintf("/ui/kube_resources/%d/revisions", *id), http.StatusFound)
+	return nil
+}
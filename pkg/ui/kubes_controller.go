@@ -36,6 +36,16 @@ func NewKube(sg *client.Client, w http.ResponseWriter, r *http.Request) error {
 				"ssh_key_fingerprint": "",
 			},
 		}
+	case "existing":
+		m = map[string]interface{}{
+			"cloud_account_name": "",
+			"name":               "",
+			"existing_config": map[string]interface{}{
+				"server":  "",
+				"ca_cert": "",
+			},
+			"bearer_token": "",
+		}
 	default: // just default to AWS if option not provided, or mismatched
 		m = map[string]interface{}{
 			"cloud_account_name": "",
@@ -104,6 +114,7 @@ func ListKubes(sg *client.Client, w http.ResponseWriter, r *http.Request) error
 		"newOptions": map[string]string{
 			"aws":          "AWS",
 			"digitalocean": "DigitalOcean",
+			"existing":     "Existing cluster",
 		},
 		"batchActionPaths": map[string]map[string]string{
 			"Reprovision": map[string]string{
@@ -116,6 +116,8 @@ func NewRouter(c *core.Core, baseRouter *mux.Router) *mux.Router {
 	r.HandleFunc("/kube_resources/{id}", restrictedHandler(c, GetKubeResource)).Methods("GET")
 	r.HandleFunc("/kube_resources/{id}/edit", restrictedHandler(c, EditKubeResource)).Methods("GET")
 	r.HandleFunc("/kube_resources/{id}", restrictedHandler(c, UpdateKubeResource)).Methods("POST")
+	r.HandleFunc("/kube_resources/{id}/revisions", restrictedHandler(c, GetKubeResourceRevisions)).Methods("GET")
+	r.HandleFunc("/kube_resources/{id}/rollback", restrictedHandler(c, RollbackKubeResource)).Methods("POST")
 
 	r.HandleFunc("/volumes/new", restrictedHandler(c, NewVolume)).Methods("GET")
 	r.HandleFunc("/volumes", restrictedHandler(c, CreateVolume)).Methods("POST")
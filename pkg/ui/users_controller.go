@@ -69,6 +69,10 @@ func ListUsers(sg *client.Client, w http.ResponseWriter, r *http.Request) error
 				"method":       "POST",
 				"relativePath": "/regenerate_api_token",
 			},
+			"Regenerate proxy token": map[string]string{
+				"method":       "POST",
+				"relativePath": "/regenerate_proxy_token",
+			},
 		},
 	})
 }
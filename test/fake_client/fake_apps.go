@@ -0,0 +1,23 @@
+package fake_client
+
+import "github.com/supergiant/supergiant/pkg/model"
+
+type Apps struct {
+	Collection
+	StartFn func(*int64, *model.App) error
+	StopFn  func(*int64, *model.App) error
+}
+
+func (c *Apps) Start(id *int64, m *model.App) error {
+	if c.StartFn == nil {
+		return nil
+	}
+	return c.StartFn(id, m)
+}
+
+func (c *Apps) Stop(id *int64, m *model.App) error {
+	if c.StopFn == nil {
+		return nil
+	}
+	return c.StopFn(id, m)
+}
@@ -1,11 +1,22 @@
 package fake_client
 
-import "github.com/supergiant/supergiant/pkg/model"
+import (
+	"io"
+
+	"github.com/gorilla/websocket"
+	"github.com/supergiant/supergiant/pkg/model"
+)
 
 type KubeResources struct {
 	Collection
-	StartFn func(*int64, *model.KubeResource) error
-	StopFn  func(*int64, *model.KubeResource) error
+	StartFn              func(*int64, *model.KubeResource) error
+	StopFn               func(*int64, *model.KubeResource) error
+	LogsFn               func(*int64, *model.PodLogQuery, io.Writer) error
+	ExecFn               func(*int64, *model.ExecQuery) (*websocket.Conn, error)
+	ImportFn             func(*model.KubeResourceImport) error
+	CreateFromManifestFn func(*model.KubeResourceManifest) error
+	RevisionsFn          func(*int64, *model.KubeResourceRevisionList) error
+	RollbackFn           func(*int64, int, *model.KubeResource) error
 }
 
 func (c *KubeResources) Start(id *int64, m *model.KubeResource) error {
@@ -21,3 +32,45 @@ func (c *KubeResources) Stop(id *int64, m *model.KubeResource) error {
 	}
 	return c.StopFn(id, m)
 }
+
+func (c *KubeResources) Logs(id *int64, query *model.PodLogQuery, w io.Writer) error {
+	if c.LogsFn == nil {
+		return nil
+	}
+	return c.LogsFn(id, query, w)
+}
+
+func (c *KubeResources) Exec(id *int64, query *model.ExecQuery) (*websocket.Conn, error) {
+	if c.ExecFn == nil {
+		return nil, nil
+	}
+	return c.ExecFn(id, query)
+}
+
+func (c *KubeResources) Import(m *model.KubeResourceImport) error {
+	if c.ImportFn == nil {
+		return nil
+	}
+	return c.ImportFn(m)
+}
+
+func (c *KubeResources) CreateFromManifest(m *model.KubeResourceManifest) error {
+	if c.CreateFromManifestFn == nil {
+		return nil
+	}
+	return c.CreateFromManifestFn(m)
+}
+
+func (c *KubeResources) Revisions(id *int64, list *model.KubeResourceRevisionList) error {
+	if c.RevisionsFn == nil {
+		return nil
+	}
+	return c.RevisionsFn(id, list)
+}
+
+func (c *KubeResources) Rollback(id *int64, revision int, m *model.KubeResource) error {
+	if c.RollbackFn == nil {
+		return nil
+	}
+	return c.RollbackFn(id, revision, m)
+}
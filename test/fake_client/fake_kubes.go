@@ -1,10 +1,15 @@
 package fake_client
 
-import "github.com/supergiant/supergiant/pkg/model"
+import (
+	"github.com/supergiant/supergiant/pkg/kubernetes"
+	"github.com/supergiant/supergiant/pkg/model"
+)
 
 type Kubes struct {
 	Collection
-	ProvisionFn func(*int64, *model.Kube) error
+	ProvisionFn       func(*int64, *model.Kube) error
+	KubeconfigFn      func(*int64) (*kubernetes.Kubeconfig, error)
+	ProxyKubeconfigFn func(*int64) (*kubernetes.Kubeconfig, error)
 }
 
 func (c *Kubes) Provision(id *int64, m *model.Kube) error {
@@ -13,3 +18,17 @@ func (c *Kubes) Provision(id *int64, m *model.Kube) error {
 	}
 	return c.ProvisionFn(id, m)
 }
+
+func (c *Kubes) Kubeconfig(id *int64) (*kubernetes.Kubeconfig, error) {
+	if c.KubeconfigFn == nil {
+		return nil, nil
+	}
+	return c.KubeconfigFn(id)
+}
+
+func (c *Kubes) ProxyKubeconfig(id *int64) (*kubernetes.Kubeconfig, error) {
+	if c.ProxyKubeconfigFn == nil {
+		return nil, nil
+	}
+	return c.ProxyKubeconfigFn(id)
+}
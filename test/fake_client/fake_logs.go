@@ -0,0 +1,22 @@
+package fake_client
+
+import "github.com/supergiant/supergiant/pkg/model"
+
+type Logs struct {
+	ListFn   func(*model.LogQuery, *model.LogEntryList) error
+	FollowFn func(*model.LogQuery, func(*model.LogEntry) error) error
+}
+
+func (c *Logs) List(query *model.LogQuery, list *model.LogEntryList) error {
+	if c.ListFn == nil {
+		return nil
+	}
+	return c.ListFn(query, list)
+}
+
+func (c *Logs) Follow(query *model.LogQuery, fn func(*model.LogEntry) error) error {
+	if c.FollowFn == nil {
+		return nil
+	}
+	return c.FollowFn(query, fn)
+}
@@ -4,7 +4,8 @@ import "github.com/supergiant/supergiant/pkg/model"
 
 type Users struct {
 	Collection
-	RegenerateAPITokenFn func(interface{}, *model.User) error
+	RegenerateAPITokenFn   func(interface{}, *model.User) error
+	RegenerateProxyTokenFn func(interface{}, *model.User) error
 }
 
 func (c *Users) RegenerateAPIToken(id interface{}, m *model.User) error {
@@ -13,3 +14,10 @@ func (c *Users) RegenerateAPIToken(id interface{}, m *model.User) error {
 	}
 	return c.RegenerateAPITokenFn(id, m)
 }
+
+func (c *Users) RegenerateProxyToken(id interface{}, m *model.User) error {
+	if c.RegenerateProxyTokenFn == nil {
+		return nil
+	}
+	return c.RegenerateProxyTokenFn(id, m)
+}
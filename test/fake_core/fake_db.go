@@ -16,8 +16,12 @@ type DB struct {
 	LimitFn   func(limit interface{}) core.DBInterface
 	OffsetFn  func(offset interface{}) core.DBInterface
 	ModelFn   func(value interface{}) core.DBInterface
+	SelectFn  func(query interface{}, args ...interface{}) core.DBInterface
+	GroupFn   func(query string) core.DBInterface
 	UpdateFn  func(attrs ...interface{}) error
 	CountFn   func(interface{}) error
+	ScanFn    func(dest interface{}) error
+	PingFn    func() error
 }
 
 func (db *DB) Create(m model.Model) error {
@@ -90,6 +94,20 @@ func (db *DB) Model(value interface{}) core.DBInterface {
 	return db.ModelFn(value)
 }
 
+func (db *DB) Select(query interface{}, args ...interface{}) core.DBInterface {
+	if db.SelectFn == nil {
+		return db // return db instead of nil, since these are chainable
+	}
+	return db.SelectFn(query, args...)
+}
+
+func (db *DB) Group(query string) core.DBInterface {
+	if db.GroupFn == nil {
+		return db // return db instead of nil, since these are chainable
+	}
+	return db.GroupFn(query)
+}
+
 func (db *DB) Update(attrs ...interface{}) error {
 	if db.UpdateFn == nil {
 		return nil
@@ -103,3 +121,17 @@ func (db *DB) Count(value interface{}) error {
 	}
 	return db.CountFn(value)
 }
+
+func (db *DB) Scan(dest interface{}) error {
+	if db.ScanFn == nil {
+		return nil
+	}
+	return db.ScanFn(dest)
+}
+
+func (db *DB) Ping() error {
+	if db.PingFn == nil {
+		return nil
+	}
+	return db.PingFn()
+}
@@ -1,19 +1,28 @@
 package fake_core
 
 import (
+	"io"
+
+	"github.com/gorilla/websocket"
 	"github.com/supergiant/supergiant/pkg/core"
 	"github.com/supergiant/supergiant/pkg/model"
 )
 
 type KubeResources struct {
-	CreateFn          func(*model.KubeResource) error
-	GetFn             func(*int64, model.Model) error
-	GetWithIncludesFn func(*int64, model.Model, []string) error
-	UpdateFn          func(*int64, *model.KubeResource, *model.KubeResource) error
-	DeleteFn          func(*int64, *model.KubeResource) core.ActionInterface
-	StartFn           func(*int64, *model.KubeResource) core.ActionInterface
-	StopFn            func(*int64, *model.KubeResource) core.ActionInterface
-	RefreshFn         func(*model.KubeResource) error
+	CreateFn             func(*model.KubeResource) error
+	GetFn                func(*int64, model.Model) error
+	GetWithIncludesFn    func(*int64, model.Model, []string) error
+	UpdateFn             func(*int64, *model.KubeResource, *model.KubeResource) error
+	DeleteFn             func(*int64, *model.KubeResource) core.ActionInterface
+	StartFn              func(*int64, *model.KubeResource) core.ActionInterface
+	StopFn               func(*int64, *model.KubeResource) core.ActionInterface
+	RefreshFn            func(*model.KubeResource) error
+	PodLogsFn            func(*int64, *model.PodLogQuery) (io.ReadCloser, error)
+	ExecPodFn            func(*int64, *model.ExecQuery) (*websocket.Conn, error)
+	ImportFn             func(*model.KubeResourceImport) error
+	CreateFromManifestFn func(*model.KubeResourceManifest) error
+	RevisionsFn          func(*int64, *model.KubeResourceRevisionList) error
+	RollbackFn           func(*int64, int, *model.KubeResource) error
 }
 
 func (c *KubeResources) Create(m *model.KubeResource) error {
@@ -47,3 +56,27 @@ func (c *KubeResources) Stop(id *int64, m *model.KubeResource) core.ActionInterf
 func (c *KubeResources) Refresh(m *model.KubeResource) error {
 	return c.RefreshFn(m)
 }
+
+func (c *KubeResources) PodLogs(id *int64, query *model.PodLogQuery) (io.ReadCloser, error) {
+	return c.PodLogsFn(id, query)
+}
+
+func (c *KubeResources) ExecPod(id *int64, query *model.ExecQuery) (*websocket.Conn, error) {
+	return c.ExecPodFn(id, query)
+}
+
+func (c *KubeResources) Import(m *model.KubeResourceImport) error {
+	return c.ImportFn(m)
+}
+
+func (c *KubeResources) CreateFromManifest(m *model.KubeResourceManifest) error {
+	return c.CreateFromManifestFn(m)
+}
+
+func (c *KubeResources) Revisions(id *int64, list *model.KubeResourceRevisionList) error {
+	return c.RevisionsFn(id, list)
+}
+
+func (c *KubeResources) Rollback(id *int64, revision int, m *model.KubeResource) error {
+	return c.RollbackFn(id, revision, m)
+}
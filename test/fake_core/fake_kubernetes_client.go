@@ -1,16 +1,29 @@
 package fake_core
 
 import (
+	"bytes"
 	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
 
+	"github.com/gorilla/websocket"
 	"github.com/supergiant/supergiant/pkg/kubernetes"
+	"github.com/supergiant/supergiant/pkg/model"
 )
 
 type KubernetesClient struct {
 	EnsureNamespaceFn                func(name string) error
-	GetResourceFn                    func(kind string, namespace string, name string, out *json.RawMessage) error
-	CreateResourceFn                 func(kind string, namespace string, objIn map[string]interface{}, out *json.RawMessage) error
-	DeleteResourceFn                 func(kind string, namespace string, name string) error
+	GetResourceFn                    func(apiVersion string, kind string, namespace string, name string, out *json.RawMessage) error
+	CreateResourceFn                 func(apiVersion string, kind string, namespace string, objIn map[string]interface{}, out *json.RawMessage) error
+	DeleteResourceFn                 func(apiVersion string, kind string, namespace string, name string) error
+	UpdateResourceFn                 func(apiVersion string, kind string, namespace string, name string, objIn map[string]interface{}, out *json.RawMessage) error
+	PatchResourceFn                  func(apiVersion string, kind string, namespace string, name string, patch map[string]interface{}, out *json.RawMessage) error
+	ListResourcesFn                  func(apiVersion string, kind string, namespace string) (*kubernetes.ResourceList, error)
+	WatchResourcesFn                 func(apiVersion string, kind string, namespace string, resourceVersion string, fn func(*kubernetes.WatchEvent) error) error
+	PodLogsFn                        func(namespace string, name string, query *model.PodLogQuery) (io.ReadCloser, error)
+	ExecPodFn                        func(namespace string, name string, query *model.ExecQuery) (*websocket.Conn, error)
+	ProxyRequestFn                   func(w http.ResponseWriter, r *http.Request, path string)
 	ListNamespacesFn                 func(query string) ([]*kubernetes.Namespace, error)
 	ListEventsFn                     func(query string) ([]*kubernetes.Event, error)
 	ListNodesFn                      func(query string) ([]*kubernetes.Node, error)
@@ -27,25 +40,74 @@ func (k *KubernetesClient) EnsureNamespace(name string) error {
 	return k.EnsureNamespaceFn(name)
 }
 
-func (k *KubernetesClient) GetResource(kind string, namespace string, name string, out *json.RawMessage) error {
+func (k *KubernetesClient) GetResource(apiVersion string, kind string, namespace string, name string, out *json.RawMessage) error {
 	if k.GetResourceFn == nil {
 		return nil
 	}
-	return k.GetResourceFn(kind, namespace, name, out)
+	return k.GetResourceFn(apiVersion, kind, namespace, name, out)
 }
 
-func (k *KubernetesClient) CreateResource(kind string, namespace string, objIn map[string]interface{}, out *json.RawMessage) error {
+func (k *KubernetesClient) CreateResource(apiVersion string, kind string, namespace string, objIn map[string]interface{}, out *json.RawMessage) error {
 	if k.CreateResourceFn == nil {
 		return nil
 	}
-	return k.CreateResourceFn(kind, namespace, objIn, out)
+	return k.CreateResourceFn(apiVersion, kind, namespace, objIn, out)
 }
 
-func (k *KubernetesClient) DeleteResource(kind string, namespace string, name string) error {
+func (k *KubernetesClient) DeleteResource(apiVersion string, kind string, namespace string, name string) error {
 	if k.DeleteResourceFn == nil {
 		return nil
 	}
-	return k.DeleteResourceFn(kind, namespace, name)
+	return k.DeleteResourceFn(apiVersion, kind, namespace, name)
+}
+
+func (k *KubernetesClient) UpdateResource(apiVersion string, kind string, namespace string, name string, objIn map[string]interface{}, out *json.RawMessage) error {
+	if k.UpdateResourceFn == nil {
+		return nil
+	}
+	return k.UpdateResourceFn(apiVersion, kind, namespace, name, objIn, out)
+}
+
+func (k *KubernetesClient) PatchResource(apiVersion string, kind string, namespace string, name string, patch map[string]interface{}, out *json.RawMessage) error {
+	if k.PatchResourceFn == nil {
+		return nil
+	}
+	return k.PatchResourceFn(apiVersion, kind, namespace, name, patch, out)
+}
+
+func (k *KubernetesClient) ListResources(apiVersion string, kind string, namespace string) (*kubernetes.ResourceList, error) {
+	if k.ListResourcesFn == nil {
+		return new(kubernetes.ResourceList), nil
+	}
+	return k.ListResourcesFn(apiVersion, kind, namespace)
+}
+
+func (k *KubernetesClient) WatchResources(apiVersion string, kind string, namespace string, resourceVersion string, fn func(*kubernetes.WatchEvent) error) error {
+	if k.WatchResourcesFn == nil {
+		return nil
+	}
+	return k.WatchResourcesFn(apiVersion, kind, namespace, resourceVersion, fn)
+}
+
+func (k *KubernetesClient) PodLogs(namespace string, name string, query *model.PodLogQuery) (io.ReadCloser, error) {
+	if k.PodLogsFn == nil {
+		return ioutil.NopCloser(new(bytes.Buffer)), nil
+	}
+	return k.PodLogsFn(namespace, name, query)
+}
+
+func (k *KubernetesClient) ExecPod(namespace string, name string, query *model.ExecQuery) (*websocket.Conn, error) {
+	if k.ExecPodFn == nil {
+		return nil, nil
+	}
+	return k.ExecPodFn(namespace, name, query)
+}
+
+func (k *KubernetesClient) ProxyRequest(w http.ResponseWriter, r *http.Request, path string) {
+	if k.ProxyRequestFn == nil {
+		return
+	}
+	k.ProxyRequestFn(w, r, path)
 }
 
 func (k *KubernetesClient) ListNamespaces(query string) ([]*kubernetes.Namespace, error) {
@@ -0,0 +1,186 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/supergiant/supergiant/pkg/core"
+	"github.com/supergiant/supergiant/pkg/kubernetes"
+	"github.com/supergiant/supergiant/pkg/model"
+	"github.com/supergiant/supergiant/test/fake_core"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestApps(t *testing.T) {
+	srv := newTestServer()
+	go srv.Start()
+	defer srv.Stop()
+
+	requestor := createAdmin(srv.Core)
+	sg := srv.Core.APIClient("token", requestor.APIToken)
+
+	srv.Core.AWSProvider = func(_ map[string]string) core.Provider {
+		return new(fake_core.Provider)
+	}
+	kube := createKube(sg)
+
+	provisioned := make(chan string, 10)
+	tornDown := make(chan string, 10)
+	provisioner := &fake_core.Provisioner{
+		ProvisionFn: func(m *model.KubeResource) error {
+			provisioned <- m.Kind + "/" + m.Name
+			return nil
+		},
+		TeardownFn: func(m *model.KubeResource) error {
+			tornDown <- m.Kind + "/" + m.Name
+			return nil
+		},
+	}
+	srv.Core.DefaultProvisioner = provisioner
+	srv.Core.PodProvisioner = provisioner
+	srv.Core.ServiceProvisioner = provisioner
+	srv.Core.K8S = func(_ *model.Kube) kubernetes.ClientInterface {
+		return &fake_core.KubernetesClient{
+			// Stopping deletes the resource
+			DeleteResourceFn: func(apiVersion string, kind string, namespace string, name string) error {
+				tornDown <- kind + "/" + name
+				return nil
+			},
+		}
+	}
+
+	Convey("Apps start, stop, and delete their KubeResources in order of their dependencies", t, func() {
+		app := &model.App{
+			KubeName:  kube.Name,
+			Namespace: "mongo",
+			Name:      "mongo",
+		}
+		So(sg.Apps.Create(app), ShouldBeNil)
+
+		// KubeResources are in the Kube and Namespace of the App, and are not
+		// started until it is.
+		config := &model.KubeResource{AppName: app.Name, Kind: "ConfigMap", Name: "config", Template: newRawMessage(`{}`)}
+		So(sg.KubeResources.Create(config), ShouldBeNil)
+		So(config.KubeName, ShouldEqual, kube.Name)
+		So(config.Namespace, ShouldEqual, "mongo")
+		So(config.Started, ShouldBeFalse)
+
+		service := &model.KubeResource{AppName: app.Name, Kind: "Service", Name: "db", Template: newRawMessage(`{}`)}
+		So(sg.KubeResources.Create(service), ShouldBeNil)
+
+		pod := &model.KubeResource{AppName: app.Name, Kind: "Pod", Name: "db", DependsOn: []string{"Service/db"}, Template: newRawMessage(`{}`)}
+		So(sg.KubeResources.Create(pod), ShouldBeNil)
+
+		err := sg.KubeResources.Update(config.ID, &model.KubeResource{DependsOn: []string{"Pod/db"}})
+		So(err, ShouldBeNil)
+
+		So(sg.Apps.Get(app.ID, app), ShouldBeNil)
+		So(app.KubeResources, ShouldHaveLength, 3)
+		So(app.PassiveStatus, ShouldEqual, "stopped")
+		So(provisioned, ShouldHaveLength, 0)
+
+		// Start, which responds with the App as it was
+		starting := new(model.App)
+		So(sg.Apps.Start(app.ID, starting), ShouldBeNil)
+		So(starting.Name, ShouldEqual, app.Name)
+		So(starting.KubeResources, ShouldHaveLength, 3)
+		So(starting.PassiveStatus, ShouldEqual, "stopped")
+		So(<-provisioned, ShouldEqual, "Service/db")
+		So(<-provisioned, ShouldEqual, "Pod/db")
+		So(<-provisioned, ShouldEqual, "ConfigMap/config")
+		waitFor("App to start", func() bool {
+			So(sg.Apps.Get(app.ID, app), ShouldBeNil)
+			return app.PassiveStatus == "started"
+		})
+		So(app.Started, ShouldBeTrue)
+
+		// Listed Apps have their KubeResources, and their status
+		other := &model.App{KubeName: kube.Name, Namespace: "other", Name: "other"}
+		So(sg.Apps.Create(other), ShouldBeNil)
+		list := new(model.AppList)
+		So(sg.Apps.List(list), ShouldBeNil)
+		So(list.Items, ShouldHaveLength, 2)
+		for _, item := range list.Items {
+			if item.Name == app.Name {
+				So(item.KubeResources, ShouldHaveLength, 3)
+				So(item.PassiveStatus, ShouldEqual, "started")
+			} else {
+				So(item.KubeResources, ShouldBeEmpty)
+				So(item.PassiveStatus, ShouldEqual, "empty")
+			}
+		}
+		So(srv.Core.DB.Delete(other), ShouldBeNil)
+
+		// Stop
+		So(sg.Apps.Stop(app.ID, app), ShouldBeNil)
+		So(<-tornDown, ShouldEqual, "ConfigMap/config")
+		So(<-tornDown, ShouldEqual, "Pod/db")
+		So(<-tornDown, ShouldEqual, "Service/db")
+		waitFor("App to stop", func() bool {
+			So(sg.Apps.Get(app.ID, app), ShouldBeNil)
+			return app.PassiveStatus == "stopped"
+		})
+		So(app.Started, ShouldBeFalse)
+
+		// Delete cascades to the KubeResources
+		So(sg.Apps.Delete(app.ID, app), ShouldBeNil)
+		So(<-tornDown, ShouldEqual, "ConfigMap/config")
+		So(<-tornDown, ShouldEqual, "Pod/db")
+		So(<-tornDown, ShouldEqual, "Service/db")
+		waitFor("App to be deleted", func() bool {
+			return sg.Apps.Get(app.ID, new(model.App)) != nil
+		})
+		var count int64
+		So(srv.Core.DB.Model(new(model.KubeResource)).Where("app_name = ?", app.Name).Count(&count), ShouldBeNil)
+		So(count, ShouldEqual, 0)
+	})
+
+	Convey("KubeResources of an App must be in its Kube and Namespace, and depend on others of the App without a cycle", t, func() {
+		app := &model.App{
+			KubeName:  kube.Name,
+			Namespace: "blog",
+			Name:      "blog",
+		}
+		So(sg.Apps.Create(app), ShouldBeNil)
+
+		service := &model.KubeResource{AppName: app.Name, Kind: "Service", Name: "web", Template: newRawMessage(`{}`)}
+		So(sg.KubeResources.Create(service), ShouldBeNil)
+		pod := &model.KubeResource{AppName: app.Name, Kind: "Pod", Name: "web", DependsOn: []string{"Service/web"}, Template: newRawMessage(`{}`)}
+		So(sg.KubeResources.Create(pod), ShouldBeNil)
+
+		table := []struct {
+			// Input
+			namespace string
+			dependsOn []string
+			// Expectations
+			err *model.Error
+		}{
+			{
+				namespace: "production",
+				err:       &model.Error{Status: 422, Message: "Validation failed: KubeResources of App blog must be in Kube test and Namespace blog"},
+			},
+			{
+				dependsOn: []string{"Service/db"},
+				err:       &model.Error{Status: 422, Message: "Validation failed: DependsOn: Service/db is not another KubeResource of App blog"},
+			},
+		}
+
+		for _, item := range table {
+			err := sg.KubeResources.Create(&model.KubeResource{
+				AppName:   app.Name,
+				Namespace: item.namespace,
+				Kind:      "ConfigMap",
+				Name:      "config",
+				DependsOn: item.dependsOn,
+				Template:  newRawMessage(`{}`),
+			})
+			So(err, ShouldResemble, item.err)
+		}
+
+		err := sg.KubeResources.Update(service.ID, &model.KubeResource{DependsOn: []string{"Pod/web"}})
+		So(err, ShouldResemble, &model.Error{Status: 422, Message: "Validation failed: DependsOn: cycle Service/web -> Pod/web -> Service/web"})
+
+		err = sg.KubeResources.Create(&model.KubeResource{AppName: "nope", Kind: "ConfigMap", Name: "config", Template: newRawMessage(`{}`)})
+		So(err, ShouldResemble, &model.Error{Status: 422, Message: "Parent does not exist, foreign key 'AppName' on KubeResource"})
+	})
+}
@@ -126,6 +126,16 @@ func TestCloudAccountsCreate(t *testing.T) {
 				err: &model.Error{Status: 422, Message: "Validation failed: Credentials: zero value"},
 			},
 
+			// No credentials for existing clusters
+			{
+				model: &model.CloudAccount{
+					Name:     "test",
+					Provider: "existing",
+				},
+				mockValidateAccountError: nil,
+				err: nil,
+			},
+
 			// On Provider ValidateAccount error
 			{
 				model: &model.CloudAccount{
@@ -149,6 +159,9 @@ func TestCloudAccountsCreate(t *testing.T) {
 					},
 				}
 			}
+			srv.Core.ExistingProvider = func(_ map[string]string) core.Provider {
+				return new(fake_core.Provider)
+			}
 
 			requestor := createAdmin(srv.Core)
 			sg := srv.Core.APIClient("token", requestor.APIToken)
@@ -4,6 +4,8 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/jinzhu/gorm"
+
 	"github.com/supergiant/supergiant/pkg/core"
 	"github.com/supergiant/supergiant/pkg/model"
 	"github.com/supergiant/supergiant/test/fake_core"
@@ -179,6 +181,36 @@ func TestEntrypointListenersCreate(t *testing.T) {
 				err: &model.Error{Status: 422, Message: "Parent does not exist, foreign key 'EntrypointName' on EntrypointListener"},
 			},
 
+			// No NodePort
+			{
+				parentCloudAccount: &model.CloudAccount{
+					Name:        "test",
+					Provider:    "aws",
+					Credentials: map[string]string{"test": "test"},
+				},
+				parentKube: &model.Kube{
+					CloudAccountName: "test",
+					Name:             "test",
+					MasterNodeSize:   "t2.micro",
+					NodeSizes:        []string{"t2.micro"},
+					AWSConfig: &model.AWSKubeConfig{
+						Region:           "us-east-1",
+						AvailabilityZone: "us-east-1a",
+					},
+				},
+				parentEntrypoint: &model.Entrypoint{
+					KubeName: "test",
+					Name:     "test",
+				},
+				model: &model.EntrypointListener{
+					EntrypointName: "test",
+					Name:           "port-test",
+					EntrypointPort: 80,
+				},
+				mockCreateEntrypointListenerError: nil,
+				err: &model.Error{Status: 422, Message: "Validation failed: NodePort: zero value"},
+			},
+
 			// On Provider CreateEntrypointListener error
 			{
 				parentCloudAccount: &model.CloudAccount{
@@ -703,3 +735,56 @@ func TestEntrypointListenersDelete(t *testing.T) {
 		}
 	})
 }
+
+// oldEntrypointListener is the schema of EntrypointListeners when they were
+// unique by port.
+type oldEntrypointListener struct {
+	ID             *int64 `gorm:"primary_key"`
+	EntrypointName string `gorm:"not null;index;unique_index:entrypoint_port"`
+	Name           string `gorm:"not null"`
+	EntrypointPort int64  `gorm:"not null;unique_index:entrypoint_port"`
+}
+
+func (oldEntrypointListener) TableName() string {
+	return "entrypoint_listeners"
+}
+
+func TestEntrypointListenersMigration(t *testing.T) {
+	Convey("EntrypointListeners are no longer unique by port after migrating", t, func() {
+		var oldSchemaHadIndex bool
+
+		srv := newConfiguredTestServer(func(c *core.Core) {
+			db, err := gorm.Open("sqlite3", c.SQLiteFile)
+			if err != nil {
+				panic(err)
+			}
+			defer db.Close()
+			db.DropTableIfExists(&oldEntrypointListener{})
+			db.AutoMigrate(&oldEntrypointListener{})
+			oldSchemaHadIndex = db.NewScope(&oldEntrypointListener{}).Dialect().HasIndex("entrypoint_listeners", "entrypoint_port")
+		})
+		go srv.Start()
+		defer srv.Stop()
+
+		So(oldSchemaHadIndex, ShouldBeTrue)
+
+		db, err := gorm.Open("sqlite3", srv.Core.SQLiteFile)
+		So(err, ShouldBeNil)
+		defer db.Close()
+		So(db.NewScope(&model.EntrypointListener{}).Dialect().HasIndex("entrypoint_listeners", "entrypoint_port"), ShouldBeFalse)
+
+		// The rules of an HTTP Entrypoint share its port
+		for _, path := range []string{"/a", "/b"} {
+			listener := &model.EntrypointListener{
+				EntrypointName:     "test",
+				Name:               "http" + path[1:],
+				EntrypointPort:     80,
+				EntrypointProtocol: "HTTP",
+				NodePort:           30303,
+				NodeProtocol:       "HTTP",
+				Path:               path,
+			}
+			So(db.Create(listener).Error, ShouldBeNil)
+		}
+	})
+}
@@ -1,10 +1,13 @@
 package api
 
 import (
+	"encoding/json"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/supergiant/supergiant/pkg/core"
+	"github.com/supergiant/supergiant/pkg/kubernetes"
 	"github.com/supergiant/supergiant/pkg/model"
 	"github.com/supergiant/supergiant/test/fake_core"
 
@@ -567,3 +570,212 @@ func TestEntrypointsDelete(t *testing.T) {
 		}
 	})
 }
+
+func TestHTTPEntrypoints(t *testing.T) {
+	srv := newTestServer()
+	go srv.Start()
+	defer srv.Stop()
+
+	requestor := createAdmin(srv.Core)
+	sg := srv.Core.APIClient("token", requestor.APIToken)
+
+	loadBalancerPorts := make(chan string, 10)
+	srv.Core.AWSProvider = func(_ map[string]string) core.Provider {
+		return &fake_core.Provider{
+			CreateEntrypointListenerFn: func(m *model.EntrypointListener, _ *core.Action) error {
+				loadBalancerPorts <- m.Name
+				return nil
+			},
+		}
+	}
+	kube := createKube(sg)
+
+	created := make(chan map[string]interface{}, 10)
+	deleted := make(chan string, 10)
+	srv.Core.K8S = func(_ *model.Kube) kubernetes.ClientInterface {
+		return &fake_core.KubernetesClient{
+			CreateResourceFn: func(apiVersion string, kind string, namespace string, objIn map[string]interface{}, out *json.RawMessage) error {
+				created <- objIn
+				return nil
+			},
+			GetResourceFn: func(apiVersion string, kind string, namespace string, name string, out *json.RawMessage) error {
+				*out = json.RawMessage(`{"spec": {"ports": [{"name": "http", "nodePort": 30080}, {"name": "https", "nodePort": 30443}]}}`)
+				return nil
+			},
+			DeleteResourceFn: func(apiVersion string, kind string, namespace string, name string) error {
+				deleted <- kind + "/" + name
+				return nil
+			},
+		}
+	}
+
+	Convey("HTTP Entrypoints route requests by host and path through an ingress controller", t, func() {
+		entrypoint := &model.Entrypoint{
+			KubeName: kube.Name,
+			Name:     "web",
+			Type:     "HTTP",
+		}
+		So(sg.Entrypoints.Create(entrypoint), ShouldBeNil)
+
+		// The ingress controller is created, and ports 80 and 443 of the load
+		// balancer map to it
+		var kinds []string
+		for i := 0; i < 4; i++ {
+			select {
+			case obj := <-created:
+				kinds = append(kinds, obj["kind"].(string)+"/"+obj["metadata"].(map[string]interface{})["name"].(string))
+			case <-time.After(5 * time.Second):
+			}
+		}
+		So(kinds, ShouldContain, "Deployment/nginx-ingress-controller")
+		So(kinds, ShouldContain, "Service/nginx-ingress-controller")
+
+		var ports []string
+		for i := 0; i < 2; i++ {
+			select {
+			case port := <-loadBalancerPorts:
+				ports = append(ports, port)
+			case <-time.After(5 * time.Second):
+			}
+		}
+		So(ports, ShouldContain, "http")
+		So(ports, ShouldContain, "https")
+
+		// A Kube has one HTTP Entrypoint
+		err := sg.Entrypoints.Create(&model.Entrypoint{KubeName: kube.Name, Name: "web2", Type: "HTTP"})
+		So(err, ShouldResemble, &model.Error{Status: 422, Message: "Validation failed: Kube test already has HTTP Entrypoint web"})
+
+		// Listeners are rules, provisioned as Ingresses, which share ports
+		listener := &model.EntrypointListener{
+			EntrypointName: entrypoint.Name,
+			Name:           "shop",
+			Host:           "shop.example.com",
+			Path:           "/",
+			Namespace:      "shop",
+			ServiceName:    "shop",
+			ServicePort:    8080,
+			TLSSecretName:  "shop-tls",
+		}
+		So(sg.EntrypointListeners.Create(listener), ShouldBeNil)
+		So(listener.EntrypointPort, ShouldEqual, 443)
+
+		var ingress map[string]interface{}
+		select {
+		case ingress = <-created:
+		case <-time.After(5 * time.Second):
+		}
+		ingressJSON, _ := json.Marshal(ingress)
+		So(string(ingressJSON), ShouldContainSubstring, `"kind":"Ingress"`)
+		So(string(ingressJSON), ShouldContainSubstring, `"rules":[{"host":"shop.example.com","http":{"paths":[{"backend":{"serviceName":"shop","servicePort":8080},"path":"/"}]}}]`)
+		So(string(ingressJSON), ShouldContainSubstring, `"tls":[{"hosts":["shop.example.com"],"secretName":"shop-tls"}]`)
+
+		blog := &model.EntrypointListener{
+			EntrypointName: entrypoint.Name,
+			Name:           "blog",
+			Host:           "blog.example.com",
+			Path:           "/",
+			Namespace:      "blog",
+			ServiceName:    "blog",
+			ServicePort:    80,
+			TLSSecretName:  "blog-tls",
+		}
+		So(sg.EntrypointListeners.Create(blog), ShouldBeNil)
+		So(blog.EntrypointPort, ShouldEqual, 443)
+		<-created
+
+		// Deleting a listener deletes its Ingress
+		So(sg.EntrypointListeners.Delete(blog.ID, blog), ShouldBeNil)
+		var deletedResource string
+		select {
+		case deletedResource = <-deleted:
+		case <-time.After(5 * time.Second):
+		}
+		So(deletedResource, ShouldEqual, "Ingress/blog")
+	})
+
+	Convey("Listeners of HTTP Entrypoints require a rule", t, func() {
+		table := []struct {
+			// Input
+			listener *model.EntrypointListener
+			// Expectations
+			err *model.Error
+		}{
+			{
+				listener: &model.EntrypointListener{EntrypointPort: 80, NodePort: 30303},
+				err:      &model.Error{Status: 422, Message: "Validation failed: Path: zero value"},
+			},
+			{
+				listener: &model.EntrypointListener{Path: "api", Namespace: "api", ServiceName: "api", ServicePort: 80},
+				err:      &model.Error{Status: 422, Message: "Validation failed: Path: must start with /"},
+			},
+			{
+				listener: &model.EntrypointListener{Path: "/api", Namespace: "api", ServicePort: 80},
+				err:      &model.Error{Status: 422, Message: "Validation failed: ServiceName: zero value"},
+			},
+		}
+
+		for _, item := range table {
+			item.listener.EntrypointName = "web"
+			item.listener.Name = "api"
+			err := sg.EntrypointListeners.Create(item.listener)
+			So(err, ShouldResemble, item.err)
+		}
+	})
+
+	Convey("On DigitalOcean, which has no load balancer, the ingress controller listens on ports 80 and 443 of every Node", t, func() {
+		srv.Core.DOProvider = func(_ map[string]string) core.Provider {
+			return &fake_core.Provider{
+				CreateEntrypointListenerFn: func(m *model.EntrypointListener, _ *core.Action) error {
+					loadBalancerPorts <- m.Name
+					return nil
+				},
+			}
+		}
+		cloudAccount := &model.CloudAccount{
+			Name:        "do",
+			Provider:    "digitalocean",
+			Credentials: map[string]string{"token": "test"},
+		}
+		So(sg.CloudAccounts.Create(cloudAccount), ShouldBeNil)
+		doKube := &model.Kube{
+			CloudAccountName: cloudAccount.Name,
+			Name:             "do",
+			MasterNodeSize:   "2gb",
+			NodeSizes:        []string{"2gb"},
+			DigitalOceanConfig: &model.DOKubeConfig{
+				Region:            "nyc1",
+				SSHKeyFingerprint: "test",
+			},
+		}
+		So(sg.Kubes.Create(doKube), ShouldBeNil)
+
+		entrypoint := &model.Entrypoint{
+			KubeName: doKube.Name,
+			Name:     "do-web",
+			Type:     "HTTP",
+		}
+		So(sg.Entrypoints.Create(entrypoint), ShouldBeNil)
+
+		var controller map[string]interface{}
+		for i := 0; i < 4; i++ {
+			select {
+			case obj := <-created:
+				if obj["metadata"].(map[string]interface{})["name"] == "nginx-ingress-controller" && obj["kind"] != "Service" {
+					controller = obj
+				}
+			case <-time.After(5 * time.Second):
+			}
+		}
+		So(controller, ShouldNotBeNil)
+		So(controller["kind"], ShouldEqual, "DaemonSet")
+		controllerJSON, _ := json.Marshal(controller)
+		So(string(controllerJSON), ShouldContainSubstring, `"ports":[{"containerPort":80,"hostPort":80,"name":"http"},{"containerPort":443,"hostPort":443,"name":"https"}]`)
+
+		// No listeners are created on the provider
+		select {
+		case port := <-loadBalancerPorts:
+			So(port, ShouldBeEmpty)
+		case <-time.After(500 * time.Millisecond):
+		}
+	})
+}
@@ -0,0 +1,118 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/supergiant/supergiant/pkg/core"
+	"github.com/supergiant/supergiant/pkg/model"
+	"github.com/supergiant/supergiant/test/fake_core"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// getOpen requests an unauthenticated path and decodes the body into out.
+func getOpen(c *core.Core, path string, out interface{}) (*http.Response, error) {
+	resp, err := testHTTPClient.Get(c.BaseURL() + path)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if out != nil {
+		err = json.NewDecoder(resp.Body).Decode(out)
+	}
+	return resp, err
+}
+
+func TestHealthz(t *testing.T) {
+	srv := newTestServer()
+	go srv.Start()
+	defer srv.Stop()
+
+	Convey("Healthz responds 200 without authentication", t, func() {
+		resp, err := getOpen(srv.Core, "/healthz", nil)
+		So(err, ShouldBeNil)
+		So(resp.StatusCode, ShouldEqual, 200)
+	})
+}
+
+func TestReadyz(t *testing.T) {
+	srv := newTestServer()
+	go srv.Start()
+	defer srv.Stop()
+
+	Convey("Given a server with a working DB", t, func() {
+		Convey("Readyz responds 200 with all checks healthy", func() {
+			readiness := new(model.Readiness)
+			resp, err := getOpen(srv.Core, "/readyz", readiness)
+			So(err, ShouldBeNil)
+			So(resp.StatusCode, ShouldEqual, 200)
+			So(readiness.Ready, ShouldBeTrue)
+			So(readiness.Checks, ShouldResemble, []*model.HealthCheck{
+				{Name: "db", Healthy: true},
+				{Name: "safe_maps", Healthy: true},
+			})
+		})
+	})
+
+	Convey("Given a server whose DB cannot be reached", t, func() {
+		realDB := srv.Core.DB
+		srv.Core.DB = &fake_core.DB{
+			PingFn: func() error {
+				return errors.New("connection refused")
+			},
+		}
+		defer func() { srv.Core.DB = realDB }()
+
+		Convey("Readyz responds 503 with the failing check", func() {
+			readiness := new(model.Readiness)
+			resp, err := getOpen(srv.Core, "/readyz", readiness)
+			So(err, ShouldBeNil)
+			So(resp.StatusCode, ShouldEqual, 503)
+			So(readiness.Ready, ShouldBeFalse)
+			So(readiness.Checks[0], ShouldResemble, &model.HealthCheck{Name: "db", Healthy: false, Error: "connection refused"})
+		})
+	})
+}
+
+func TestStatus(t *testing.T) {
+	srv := newTestServer()
+	go srv.Start()
+	defer srv.Stop()
+
+	srv.Core.AWSProvider = func(_ map[string]string) core.Provider {
+		return &fake_core.Provider{
+			CreateKubeFn: func(_ *model.Kube, _ *core.Action) error {
+				return errors.New("never ready")
+			},
+		}
+	}
+
+	requestor := createAdmin(srv.Core)
+	sg := srv.Core.APIClient("token", requestor.APIToken)
+	createKube(sg)
+
+	Convey("Status reports Kube reachability", t, func() {
+		status := new(model.Status)
+		resp, err := testHTTPClient.Do(authorizedRequest(srv.Core, requestor, "/api/v0/status"))
+		So(err, ShouldBeNil)
+		defer resp.Body.Close()
+		So(resp.StatusCode, ShouldEqual, 200)
+		json.NewDecoder(resp.Body).Decode(status)
+
+		So(status.Kubes, ShouldResemble, []*model.KubeStatus{
+			{
+				KubeName: "test",
+				Error:    "Kube is not ready",
+			},
+		})
+	})
+
+	Convey("Status requires authentication", t, func() {
+		resp, err := getOpen(srv.Core, "/api/v0/status", nil)
+		So(err, ShouldBeNil)
+		So(resp.StatusCode, ShouldEqual, 401)
+	})
+}
@@ -0,0 +1,442 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/supergiant/supergiant/pkg/core"
+	"github.com/supergiant/supergiant/pkg/kubernetes"
+	"github.com/supergiant/supergiant/pkg/model"
+	"github.com/supergiant/supergiant/test/fake_core"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func newWebTemplate() *model.KubeResourceTemplate {
+	return &model.KubeResourceTemplate{
+		Name: "web",
+		Kind: "Deployment",
+		Parameters: []*model.TemplateParameter{
+			{Name: "replicas", Type: "integer", Default: float64(1)},
+			{Name: "tag", Required: true},
+			{Name: "password", Secret: true, Required: true},
+			{Name: "debug", Type: "boolean"},
+		},
+		Template: newRawMessage(`{
+			"apiVersion": "extensions/v1beta1",
+			"spec": {
+				"replicas": "{{ replicas }}",
+				"template": {
+					"spec": {
+						"containers": [{
+							"name": "web",
+							"image": "nginx:{{ tag }}",
+							"env": [
+								{"name": "PASSWORD", "value": "{{ password }}"},
+								{"name": "DEBUG", "value": "debug={{ debug }}"}
+							]
+						}]
+					}
+				}
+			}
+		}`),
+	}
+}
+
+func TestKubeResourceTemplatesCreate(t *testing.T) {
+	srv := newTestServer()
+	go srv.Start()
+	defer srv.Stop()
+
+	requestor := createAdmin(srv.Core)
+	sg := srv.Core.APIClient("token", requestor.APIToken)
+
+	Convey("KubeResourceTemplates Create validates the parameters and placeholders", t, func() {
+		table := []struct {
+			// Input
+			parameters []*model.TemplateParameter
+			template   string
+			// Expectations
+			err *model.Error
+		}{
+			// A successful example
+			{
+				parameters: []*model.TemplateParameter{{Name: "tag", Required: true}},
+				template:   `{"image": "nginx:{{ tag }}"}`,
+			},
+			// An undeclared placeholder
+			{
+				parameters: []*model.TemplateParameter{{Name: "tag"}},
+				template:   `{"image": "{{ repository }}:{{ tag }}"}`,
+				err:        &model.Error{Status: 422, Message: "Validation failed: Template: {{ repository }} is not a parameter"},
+			},
+			// A default of the wrong type
+			{
+				parameters: []*model.TemplateParameter{{Name: "replicas", Type: "integer", Default: 1.5}},
+				template:   `{"replicas": "{{ replicas }}"}`,
+				err:        &model.Error{Status: 422, Message: "Validation failed: Parameters: default of replicas must be a integer"},
+			},
+			// A secret with a default
+			{
+				parameters: []*model.TemplateParameter{{Name: "password", Secret: true, Default: "hunter2"}},
+				template:   `{"password": "{{ password }}"}`,
+				err:        &model.Error{Status: 422, Message: "Validation failed: Parameters: secret password cannot have a default"},
+			},
+			// A parameter declared twice
+			{
+				parameters: []*model.TemplateParameter{{Name: "tag"}, {Name: "tag"}},
+				template:   `{}`,
+				err:        &model.Error{Status: 422, Message: "Validation failed: Parameters: tag is declared more than once"},
+			},
+		}
+
+		for _, item := range table {
+			template := &model.KubeResourceTemplate{
+				Name:       "nginx",
+				Kind:       "Pod",
+				Parameters: item.parameters,
+				Template:   newRawMessage(item.template),
+			}
+			err := sg.KubeResourceTemplates.Create(template)
+
+			if item.err == nil {
+				So(err, ShouldBeNil)
+				srv.Core.DB.Delete(template)
+			} else {
+				So(err, ShouldResemble, item.err)
+			}
+		}
+	})
+}
+
+func TestKubeResourcesWithTemplate(t *testing.T) {
+	srv := newTestServer()
+	go srv.Start()
+	defer srv.Stop()
+
+	requestor := createAdmin(srv.Core)
+	sg := srv.Core.APIClient("token", requestor.APIToken)
+
+	srv.Core.AWSProvider = func(_ map[string]string) core.Provider {
+		return new(fake_core.Provider)
+	}
+	kube := createKube(sg)
+
+	created := make(chan map[string]interface{}, 1)
+	patched := make(chan map[string]interface{}, 1)
+	srv.Core.K8S = func(_ *model.Kube) kubernetes.ClientInterface {
+		return &fake_core.KubernetesClient{
+			// As returned by Kubernetes, with the secret
+			GetResourceFn: func(apiVersion string, kind string, namespace string, name string, out *json.RawMessage) error {
+				*out = json.RawMessage(`{"metadata": {"annotations": {"encoded": "aHVudGVyMg=="}}, "spec": {"replicas": 1, "template": {"spec": {"containers": [{"env": [{"name": "PASSWORD", "value": "hunter2"}]}]}}}, "status": {"readyReplicas": 1}}`)
+				return nil
+			},
+			CreateResourceFn: func(apiVersion string, kind string, namespace string, objIn map[string]interface{}, out *json.RawMessage) error {
+				created <- objIn
+				*out, _ = json.Marshal(objIn)
+				return nil
+			},
+			PatchResourceFn: func(apiVersion string, kind string, namespace string, name string, patch map[string]interface{}, out *json.RawMessage) error {
+				patched <- patch
+				return nil
+			},
+		}
+	}
+
+	template := newWebTemplate()
+	if err := sg.KubeResourceTemplates.Create(template); err != nil {
+		panic(err)
+	}
+
+	Convey("KubeResources of a KubeResourceTemplate are rendered with their Parameters", t, func() {
+		kubeResource := &model.KubeResource{
+			KubeName:                 kube.Name,
+			Namespace:                "staging",
+			Name:                     "web",
+			KubeResourceTemplateName: template.Name,
+			Parameters: map[string]interface{}{
+				"tag":      "1.13",
+				"password": "hunter2",
+			},
+		}
+		err := sg.KubeResources.Create(kubeResource)
+		So(err, ShouldBeNil)
+		So(kubeResource.Kind, ShouldEqual, "Deployment")
+
+		rendered := compactJSON(kubeResource.Template)
+		So(rendered, ShouldContainSubstring, `"replicas":1,`)
+		So(rendered, ShouldContainSubstring, `"image":"nginx:1.13"`)
+		So(rendered, ShouldContainSubstring, `"value":"debug="`)
+		So(rendered, ShouldContainSubstring, `"value":"{{ password }}"`)
+
+		// The secret is only sent to Kubernetes
+		var obj map[string]interface{}
+		select {
+		case obj = <-created:
+		case <-time.After(5 * time.Second):
+		}
+		objJSON, _ := json.Marshal(obj)
+		So(string(objJSON), ShouldContainSubstring, `"value":"hunter2"`)
+
+		stored := new(model.KubeResource)
+		So(srv.Core.DB.First(stored, *kubeResource.ID), ShouldBeNil)
+		So(string(stored.TemplateJSON), ShouldNotContainSubstring, "hunter2")
+		So(stored.Parameters, ShouldResemble, map[string]interface{}{"tag": "1.13"})
+		So(stored.SecretParameters, ShouldBeNil)
+		So(stored.EncryptedSecretParameters, ShouldNotBeEmpty)
+		So(string(stored.EncryptedSecretParameters), ShouldNotContainSubstring, "hunter2")
+
+		// Nor are they stored in the clear in revisions
+		revision := new(model.KubeResourceRevision)
+		So(srv.Core.DB.First(revision, "kube_resource_id = ?", *kubeResource.ID), ShouldBeNil)
+		So(string(revision.ParametersJSON), ShouldNotContainSubstring, "hunter2")
+		So(revision.EncryptedSecretParameters, ShouldResemble, stored.EncryptedSecretParameters)
+
+		// Changing the Parameters renders and applies the template again
+		waitFor("KubeResource to start", func() bool {
+			So(srv.Core.DB.First(stored, *kubeResource.ID), ShouldBeNil)
+			return stored.Started
+		})
+
+		// Nor is it in the Artifact, as created or as refreshed
+		So(string(stored.ArtifactJSON), ShouldContainSubstring, `"value":"{{ password }}"`)
+		So(srv.Core.KubeResources.Refresh(stored), ShouldBeNil)
+		fetched := new(model.KubeResource)
+		So(sg.KubeResources.Get(kubeResource.ID, fetched), ShouldBeNil)
+		artifact := compactJSON(fetched.Artifact)
+		So(artifact, ShouldContainSubstring, `"value":"{{ password }}"`)
+		So(artifact, ShouldContainSubstring, `"encoded":"{{ password }}"`)
+		So(artifact, ShouldNotContainSubstring, "hunter2")
+		So(artifact, ShouldNotContainSubstring, "aHVudGVyMg==")
+		So(string(stored.ArtifactJSON), ShouldNotContainSubstring, "hunter2")
+
+		update := &model.KubeResource{
+			Parameters: map[string]interface{}{"replicas": float64(3)},
+		}
+		err = sg.KubeResources.Update(kubeResource.ID, update)
+		So(err, ShouldBeNil)
+		So(update.Parameters, ShouldResemble, map[string]interface{}{"tag": "1.13", "replicas": float64(3)})
+		So(compactJSON(update.Template), ShouldContainSubstring, `"replicas":3,`)
+
+		var patch map[string]interface{}
+		select {
+		case patch = <-patched:
+		case <-time.After(5 * time.Second):
+		}
+		patchJSON, _ := json.Marshal(patch)
+		So(string(patchJSON), ShouldContainSubstring, `"replicas":3,`)
+		So(string(patchJSON), ShouldContainSubstring, `"value":"hunter2"`)
+
+		// Changing only a secret parameter records a revision, and rolling back
+		// restores the secret parameters of the revision
+		revisions := new(model.KubeResourceRevisionList)
+		So(sg.KubeResources.Revisions(kubeResource.ID, revisions), ShouldBeNil)
+		revisionCount := len(revisions.Items)
+		err = sg.KubeResources.Update(kubeResource.ID, &model.KubeResource{
+			Parameters: map[string]interface{}{"password": "hunter3"},
+		})
+		So(err, ShouldBeNil)
+		So(sg.KubeResources.Revisions(kubeResource.ID, revisions), ShouldBeNil)
+		So(revisions.Items, ShouldHaveLength, revisionCount+1)
+		select {
+		case patch = <-patched:
+		case <-time.After(5 * time.Second):
+		}
+		patchJSON, _ = json.Marshal(patch)
+		So(string(patchJSON), ShouldContainSubstring, `"value":"hunter3"`)
+
+		err = sg.KubeResources.Rollback(kubeResource.ID, 1, new(model.KubeResource))
+		So(err, ShouldBeNil)
+		select {
+		case patch = <-patched:
+		case <-time.After(5 * time.Second):
+		}
+		patchJSON, _ = json.Marshal(patch)
+		So(string(patchJSON), ShouldContainSubstring, `"value":"hunter2"`)
+
+		// The KubeResourceTemplate can't be deleted while it has KubeResources
+		err = sg.KubeResourceTemplates.Delete(template.ID, new(model.KubeResourceTemplate))
+		So(err, ShouldResemble, &model.Error{Status: 422, Message: "Validation failed: Cannot delete KubeResourceTemplate that has KubeResources"})
+	})
+
+	Convey("KubeResources of a KubeResourceTemplate require valid Parameters", t, func() {
+		table := []struct {
+			// Input
+			kind       string
+			parameters map[string]interface{}
+			// Expectations
+			err *model.Error
+		}{
+			{
+				parameters: map[string]interface{}{"password": "hunter2"},
+				err:        &model.Error{Status: 422, Message: "Validation failed: Parameters: tag is required"},
+			},
+			{
+				parameters: map[string]interface{}{"tag": "1.13", "password": "hunter2", "replicas": "three"},
+				err:        &model.Error{Status: 422, Message: "Validation failed: Parameters: replicas must be a integer"},
+			},
+			{
+				parameters: map[string]interface{}{"tag": "1.13", "password": "hunter2", "image": "nginx"},
+				err:        &model.Error{Status: 422, Message: "Validation failed: Parameters: image is not a parameter of KubeResourceTemplate web"},
+			},
+			{
+				kind:       "Pod",
+				parameters: map[string]interface{}{"tag": "1.13", "password": "hunter2"},
+				err:        &model.Error{Status: 422, Message: "Validation failed: Kind: must be Deployment, the Kind of KubeResourceTemplate web"},
+			},
+		}
+
+		for _, item := range table {
+			err := sg.KubeResources.Create(&model.KubeResource{
+				KubeName:                 kube.Name,
+				Namespace:                "production",
+				Name:                     "web",
+				Kind:                     item.kind,
+				KubeResourceTemplateName: template.Name,
+				Parameters:               item.parameters,
+			})
+			So(err, ShouldResemble, item.err)
+		}
+
+		err := sg.KubeResources.Create(&model.KubeResource{
+			KubeName:                 kube.Name,
+			Namespace:                "production",
+			Name:                     "web",
+			KubeResourceTemplateName: "nope",
+		})
+		So(err, ShouldResemble, &model.Error{Status: 422, Message: "Parent does not exist, foreign key 'KubeResourceTemplateName' on KubeResource"})
+	})
+}
+
+func TestKubeResourcesWithHelmReleaseTemplate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "charts")
+	if err != nil {
+		panic(err)
+	}
+	defer os.RemoveAll(dir)
+	files := map[string]string{
+		"Chart.yaml": "name: app\nversion: 1.0.0\n",
+		"templates/secret.yaml": `apiVersion: v1
+kind: Secret
+metadata:
+  name: {{ .Release.Name }}
+data:
+  encoded: {{ .Values.password | b64enc }}
+stringData:
+  quoted: {{ .Values.password | quote }}
+`,
+	}
+	for name, data := range files {
+		path := filepath.Join(dir, "app", name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			panic(err)
+		}
+		if err := ioutil.WriteFile(path, []byte(data), 0644); err != nil {
+			panic(err)
+		}
+	}
+
+	srv := newConfiguredTestServer(func(c *core.Core) {
+		c.HelmChartDir = dir
+	})
+	go srv.Start()
+	defer srv.Stop()
+
+	requestor := createAdmin(srv.Core)
+	sg := srv.Core.APIClient("token", requestor.APIToken)
+
+	srv.Core.AWSProvider = func(_ map[string]string) core.Provider {
+		return new(fake_core.Provider)
+	}
+	kube := createKube(sg)
+
+	created := make(chan map[string]interface{}, 1)
+	srv.Core.K8S = func(_ *model.Kube) kubernetes.ClientInterface {
+		return &fake_core.KubernetesClient{
+			PatchResourceFn: func(apiVersion string, kind string, namespace string, name string, patch map[string]interface{}, out *json.RawMessage) error {
+				return &kubernetes.StatusError{Code: 404, Status: &kubernetes.Status{Code: 404}}
+			},
+			CreateResourceFn: func(apiVersion string, kind string, namespace string, objIn map[string]interface{}, out *json.RawMessage) error {
+				created <- objIn
+				return nil
+			},
+		}
+	}
+
+	template := &model.KubeResourceTemplate{
+		Name: "site",
+		Kind: model.HelmReleaseKind,
+		Parameters: []*model.TemplateParameter{
+			{Name: "password", Secret: true, Required: true},
+		},
+		Template: newRawMessage(`{"chart": "app", "values": {"password": "{{ password }}"}}`),
+	}
+	if err := sg.KubeResourceTemplates.Create(template); err != nil {
+		panic(err)
+	}
+
+	Convey("HelmReleases of a KubeResourceTemplate render secret parameters only in the resources sent to Kubernetes", t, func() {
+		kubeResource := &model.KubeResource{
+			KubeName:                 kube.Name,
+			Namespace:                "staging",
+			Name:                     "site",
+			KubeResourceTemplateName: template.Name,
+			Parameters:               map[string]interface{}{"password": "hunter2"},
+		}
+		So(sg.KubeResources.Create(kubeResource), ShouldBeNil)
+
+		var obj map[string]interface{}
+		select {
+		case obj = <-created:
+		case <-time.After(5 * time.Second):
+		}
+		objJSON, _ := json.Marshal(obj)
+		So(string(objJSON), ShouldContainSubstring, `"encoded":"aHVudGVyMg=="`)
+		So(string(objJSON), ShouldContainSubstring, `"quoted":"hunter2"`)
+
+		fetched := new(model.KubeResource)
+		waitFor("HelmRelease to be provisioned", func() bool {
+			So(sg.KubeResources.Get(kubeResource.ID, fetched), ShouldBeNil)
+			return fetched.Definition != nil && len(*fetched.Definition) > 0
+		})
+		definition := compactJSON(fetched.Definition)
+		So(definition, ShouldContainSubstring, `"quoted":"{{ password }}"`)
+		So(definition, ShouldNotContainSubstring, "hunter2")
+		So(definition, ShouldNotContainSubstring, "aHVudGVyMg==")
+
+		revisions := new(model.KubeResourceRevisionList)
+		waitFor("revision to record the Definition", func() bool {
+			So(sg.KubeResources.Revisions(kubeResource.ID, revisions), ShouldBeNil)
+			return len(revisions.Items) > 0 && revisions.Items[len(revisions.Items)-1].Definition != nil
+		})
+		revisionsJSON, _ := json.Marshal(revisions)
+		So(string(revisionsJSON), ShouldContainSubstring, `{{ password }}`)
+		So(string(revisionsJSON), ShouldNotContainSubstring, "hunter2")
+		So(string(revisionsJSON), ShouldNotContainSubstring, "aHVudGVyMg==")
+	})
+}
+
+// compactJSON returns raw without insignificant whitespace.
+func compactJSON(raw *json.RawMessage) string {
+	buf := new(bytes.Buffer)
+	if err := json.Compact(buf, *raw); err != nil {
+		panic(err)
+	}
+	return buf.String()
+}
+
+// waitFor polls fn until it returns true, for up to 5 seconds.
+func waitFor(desc string, fn func() bool) {
+	for i := 0; i < 50; i++ {
+		if fn() {
+			return
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	panic("Timed out waiting for " + desc)
+}
@@ -1,10 +1,19 @@
 package api
 
 import (
+	"bytes"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/gorilla/websocket"
 	"github.com/supergiant/supergiant/pkg/client"
 	"github.com/supergiant/supergiant/pkg/core"
 	"github.com/supergiant/supergiant/pkg/kubernetes"
@@ -147,6 +156,18 @@ func TestKubeResourcesCreate(t *testing.T) {
 				false,
 				&model.Error{Status: 422, Message: "Parent does not exist, foreign key 'KubeName' on KubeResource"},
 			},
+			// HelmRelease of a chart outside the chart directory
+			{
+				&model.KubeResource{
+					KubeName:  kube.Name,
+					Namespace: "test",
+					Name:      "release",
+					Kind:      model.HelmReleaseKind,
+					Template:  newRawMessage(`{"chart": "/etc"}`),
+				},
+				false,
+				&model.Error{Status: 422, Message: "Validation failed: No Helm chart directory configured; provide --helm-chart-dir at startup"},
+			},
 		}
 
 		for _, item := range table {
@@ -313,3 +334,958 @@ func TestKubeResourcesStart(t *testing.T) {
 		}
 	})
 }
+
+func TestKubeResourcesUpdate(t *testing.T) {
+	srv := newTestServer()
+	go srv.Start()
+	defer srv.Stop()
+
+	requestor := createAdmin(srv.Core)
+	sg := srv.Core.APIClient("token", requestor.APIToken)
+
+	srv.Core.AWSProvider = func(_ map[string]string) core.Provider {
+		return new(fake_core.Provider)
+	}
+	kube := createKube(sg)
+
+	Convey("KubeResources Update provisions the new Template only when the KubeResource is started", t, func() {
+		table := []struct {
+			// Input
+			started bool
+			// Expectations
+			templateProvisioned string
+		}{
+			{
+				started:             true,
+				templateProvisioned: `{"spec":{"updated":true}}`,
+			},
+			{
+				started:             false,
+				templateProvisioned: "",
+			},
+		}
+
+		for _, item := range table {
+			provisioned := make(chan string, 1)
+
+			srv.Core.DefaultProvisioner = &fake_core.Provisioner{
+				ProvisionFn: func(m *model.KubeResource) error {
+					if m.Started {
+						provisioned <- string(*m.Template)
+					}
+					return nil
+				},
+				IsRunningFn: func(_ *model.KubeResource) (bool, error) {
+					return true, nil
+				},
+			}
+			srv.Core.K8S = func(_ *model.Kube) kubernetes.ClientInterface {
+				return new(fake_core.KubernetesClient)
+			}
+
+			kubeResource := &model.KubeResource{
+				KubeName:  kube.Name,
+				Namespace: "test",
+				Name:      "test",
+				Kind:      "ConfigMap",
+				Template:  newRawMessage(`{}`),
+			}
+			srv.Core.DB.Create(kubeResource)
+			srv.Core.DB.Model(kubeResource).Update("started", item.started)
+
+			err := sg.KubeResources.Update(kubeResource.ID, &model.KubeResource{
+				Template: newRawMessage(`{"spec":{"updated":true}}`),
+			})
+			So(err, ShouldBeNil)
+
+			var templateProvisioned string
+			select {
+			case templateProvisioned = <-provisioned:
+			case <-time.After(time.Second):
+			}
+			So(templateProvisioned, ShouldEqual, item.templateProvisioned)
+
+			// Cleanup
+			srv.Core.DB.Delete(kubeResource)
+		}
+	})
+}
+
+func TestKubeResourcesRefreshDrift(t *testing.T) {
+	srv := newTestServer()
+	go srv.Start()
+	defer srv.Stop()
+
+	requestor := createAdmin(srv.Core)
+	sg := srv.Core.APIClient("token", requestor.APIToken)
+
+	srv.Core.AWSProvider = func(_ map[string]string) core.Provider {
+		return new(fake_core.Provider)
+	}
+	kube := createKube(sg)
+
+	Convey("KubeResources Refresh detects drift from the Definition, and reconciles it if the DriftPolicy is reconcile", t, func() {
+		table := []struct {
+			// Input
+			driftPolicy string
+			live        string
+			// Expectations
+			drift      *model.KubeResourceDrift
+			reconciled bool
+		}{
+			// Fields populated by Kubernetes are not drift
+			{
+				live:  `{"kind": "Deployment", "metadata": {"name": "test", "namespace": "test", "uid": "x"}, "spec": {"replicas": 2, "strategy": {}}, "status": {}}`,
+				drift: nil,
+			},
+			// Changed fields are reported
+			{
+				live:  `{"kind": "Deployment", "metadata": {"name": "test", "namespace": "test"}, "spec": {"replicas": 5}}`,
+				drift: &model.KubeResourceDrift{Fields: []string{"spec.replicas"}},
+			},
+			// and reconciled
+			{
+				driftPolicy: "reconcile",
+				live:        `{"kind": "Deployment", "metadata": {"name": "test", "namespace": "test"}, "spec": {"replicas": 5}}`,
+				drift:       &model.KubeResourceDrift{Fields: []string{"spec.replicas"}},
+				reconciled:  true,
+			},
+			// A deleted resource is reported
+			{
+				live:  "",
+				drift: &model.KubeResourceDrift{Missing: true},
+			},
+			// and created again
+			{
+				driftPolicy: "reconcile",
+				live:        "",
+				drift:       &model.KubeResourceDrift{Missing: true},
+				reconciled:  true,
+			},
+		}
+
+		for _, item := range table {
+			provisioned := make(chan bool, 1)
+
+			srv.Core.DefaultProvisioner = &fake_core.Provisioner{
+				ProvisionFn: func(_ *model.KubeResource) error {
+					provisioned <- true
+					return nil
+				},
+				IsRunningFn: func(_ *model.KubeResource) (bool, error) {
+					return item.live != "" || len(provisioned) > 0, nil
+				},
+			}
+			srv.Core.DeploymentProvisioner = srv.Core.DefaultProvisioner
+			srv.Core.K8S = func(_ *model.Kube) kubernetes.ClientInterface {
+				return &fake_core.KubernetesClient{
+					GetResourceFn: func(apiVersion string, kind string, namespace string, name string, out *json.RawMessage) error {
+						if item.live == "" {
+							return &kubernetes.StatusError{Code: http.StatusNotFound, Status: &kubernetes.Status{Message: "not found"}}
+						}
+						*out = json.RawMessage(item.live)
+						return nil
+					},
+				}
+			}
+
+			kubeResource := &model.KubeResource{
+				KubeName:    kube.Name,
+				Namespace:   "test",
+				Name:        "test",
+				Kind:        "Deployment",
+				DriftPolicy: item.driftPolicy,
+				Template:    newRawMessage(`{"spec": {"replicas": 2}}`),
+				Definition:  newRawMessage(`{"kind": "Deployment", "metadata": {"name": "test", "namespace": "test"}, "spec": {"replicas": 2}}`),
+			}
+			srv.Core.DB.Create(kubeResource)
+			srv.Core.DB.Model(kubeResource).Update("started", true)
+
+			refreshed := new(model.KubeResource)
+			srv.Core.DB.Preload("Kube").First(refreshed, *kubeResource.ID)
+			err := srv.Core.KubeResources.Refresh(refreshed)
+			So(err, ShouldBeNil)
+
+			var wasProvisioned bool
+			select {
+			case wasProvisioned = <-provisioned:
+			case <-time.After(time.Second):
+			}
+			So(wasProvisioned, ShouldEqual, item.reconciled)
+
+			saved := new(model.KubeResource)
+			srv.Core.DB.First(saved, *kubeResource.ID)
+			if item.drift == nil {
+				So(saved.Drift, ShouldBeNil)
+			} else {
+				So(saved.Drift.Missing, ShouldEqual, item.drift.Missing)
+				So(saved.Drift.Fields, ShouldResemble, item.drift.Fields)
+				So(saved.Drift.DetectedAt, ShouldNotBeNil)
+				So(saved.Drift.ReconciledAt != nil, ShouldEqual, item.reconciled)
+			}
+
+			// Cleanup
+			srv.Core.DB.Delete(kubeResource)
+		}
+	})
+}
+
+func TestKubeResourcesRefreshReadiness(t *testing.T) {
+	srv := newTestServer()
+	go srv.Start()
+	defer srv.Stop()
+
+	requestor := createAdmin(srv.Core)
+	sg := srv.Core.APIClient("token", requestor.APIToken)
+
+	srv.Core.AWSProvider = func(_ map[string]string) core.Provider {
+		return new(fake_core.Provider)
+	}
+	kube := createKube(sg)
+
+	Convey("KubeResources Refresh records the readiness of the resource, which is the passive status", t, func() {
+		job := `{"kind": "Job", "spec": {"completions": 1}, "status": {"active": 1}}`
+		srv.Core.K8S = func(_ *model.Kube) kubernetes.ClientInterface {
+			return &fake_core.KubernetesClient{
+				GetResourceFn: func(apiVersion string, kind string, namespace string, name string, out *json.RawMessage) error {
+					*out = json.RawMessage(job)
+					return nil
+				},
+			}
+		}
+
+		kubeResource := &model.KubeResource{
+			KubeName:  kube.Name,
+			Namespace: "test",
+			Name:      "migrate",
+			Kind:      "Job",
+			Template:  newRawMessage(`{"apiVersion": "batch/v1", "spec": {"template": {"spec": {"restartPolicy": "Never"}}}}`),
+		}
+		srv.Core.DB.Create(kubeResource)
+		defer srv.Core.DB.Delete(kubeResource)
+
+		refresh := func() *model.KubeResource {
+			refreshed := new(model.KubeResource)
+			So(srv.Core.DB.Preload("Kube").First(refreshed, *kubeResource.ID), ShouldBeNil)
+			So(srv.Core.KubeResources.Refresh(refreshed), ShouldBeNil)
+
+			fetched := new(model.KubeResource)
+			So(sg.KubeResources.Get(kubeResource.ID, fetched), ShouldBeNil)
+			return fetched
+		}
+
+		fetched := refresh()
+		So(fetched.Started, ShouldBeTrue)
+		So(fetched.Ready, ShouldBeFalse)
+		So(fetched.Readiness, ShouldEqual, "running")
+		So(fetched.PassiveStatus, ShouldEqual, "running")
+		So(fetched.PassiveStatusOkay, ShouldBeFalse)
+
+		// A failed Job is not an error of Refresh
+		job = `{"kind": "Job", "status": {"conditions": [{"type": "Failed", "status": "True", "reason": "BackoffLimitExceeded"}]}}`
+		fetched = refresh()
+		So(fetched.Started, ShouldBeTrue)
+		So(fetched.Ready, ShouldBeFalse)
+		So(fetched.PassiveStatus, ShouldEqual, "failed: BackoffLimitExceeded")
+
+		job = `{"kind": "Job", "spec": {"completions": 1}, "status": {"succeeded": 1}}`
+		fetched = refresh()
+		So(fetched.Started, ShouldBeTrue)
+		So(fetched.Ready, ShouldBeTrue)
+		So(fetched.PassiveStatus, ShouldEqual, "succeeded")
+		So(fetched.PassiveStatusOkay, ShouldBeTrue)
+
+		// Refreshing a copy loaded before another change doesn't undo it
+		stale := new(model.KubeResource)
+		So(srv.Core.DB.Preload("Kube").First(stale, *kubeResource.ID), ShouldBeNil)
+		So(srv.Core.DB.Model(&model.KubeResource{BaseModel: model.BaseModel{ID: kubeResource.ID}}).Update("drift_policy", "reconcile"), ShouldBeNil)
+		So(srv.Core.KubeResources.Refresh(stale), ShouldBeNil)
+
+		fetched = new(model.KubeResource)
+		So(sg.KubeResources.Get(kubeResource.ID, fetched), ShouldBeNil)
+		So(fetched.DriftPolicy, ShouldEqual, "reconcile")
+		So(fetched.Ready, ShouldBeTrue)
+	})
+}
+
+func TestKubeResourcesUnready(t *testing.T) {
+	srv := newTestServer()
+	go srv.Start()
+	defer srv.Stop()
+
+	requestor := createAdmin(srv.Core)
+	sg := srv.Core.APIClient("token", requestor.APIToken)
+
+	srv.Core.AWSProvider = func(_ map[string]string) core.Provider {
+		return new(fake_core.Provider)
+	}
+	kube := createKube(sg)
+
+	Convey("KubeResources whose resource exists but isn't ready can be updated and stopped", t, func() {
+		deployment := `{"kind": "Deployment", "metadata": {"generation": 1}, "spec": {"replicas": 1}, "status": {"observedGeneration": 1, "replicas": 1, "updatedReplicas": 1}}`
+		patched := make(chan string, 1)
+		deleted := make(chan bool, 1)
+		srv.Core.K8S = func(_ *model.Kube) kubernetes.ClientInterface {
+			return &fake_core.KubernetesClient{
+				GetResourceFn: func(apiVersion string, kind string, namespace string, name string, out *json.RawMessage) error {
+					*out = json.RawMessage(deployment)
+					return nil
+				},
+				PatchResourceFn: func(apiVersion string, kind string, namespace string, name string, patch map[string]interface{}, out *json.RawMessage) error {
+					data, _ := json.Marshal(patch)
+					patched <- string(data)
+					*out = json.RawMessage(deployment)
+					return nil
+				},
+				DeleteResourceFn: func(apiVersion string, kind string, namespace string, name string) error {
+					deleted <- true
+					return nil
+				},
+			}
+		}
+
+		kubeResource := &model.KubeResource{
+			KubeName:  kube.Name,
+			Namespace: "test",
+			Name:      "web",
+			Kind:      "Deployment",
+			Template:  newRawMessage(`{"apiVersion": "apps/v1", "spec": {"replicas": 1, "template": {"spec": {"containers": [{"name": "web", "image": "web:1"}]}}}}`),
+		}
+		srv.Core.DB.Create(kubeResource)
+		defer srv.Core.DB.Delete(kubeResource)
+
+		refreshed := new(model.KubeResource)
+		So(srv.Core.DB.Preload("Kube").First(refreshed, *kubeResource.ID), ShouldBeNil)
+		So(srv.Core.KubeResources.Refresh(refreshed), ShouldBeNil)
+
+		fetched := new(model.KubeResource)
+		So(sg.KubeResources.Get(kubeResource.ID, fetched), ShouldBeNil)
+		So(fetched.Started, ShouldBeTrue)
+		So(fetched.Ready, ShouldBeFalse)
+		So(fetched.PassiveStatus, ShouldEqual, "0 of 1 ready")
+		So(fetched.PassiveStatusOkay, ShouldBeFalse)
+
+		// Updating patches the existing resource
+		err := sg.KubeResources.Update(kubeResource.ID, &model.KubeResource{
+			Template: newRawMessage(`{"apiVersion": "apps/v1", "spec": {"replicas": 1, "template": {"spec": {"containers": [{"name": "web", "image": "web:2"}]}}}}`),
+		})
+		So(err, ShouldBeNil)
+		var patch string
+		select {
+		case patch = <-patched:
+		case <-time.After(5 * time.Second):
+		}
+		So(patch, ShouldContainSubstring, `"image":"web:2"`)
+
+		waitFor("KubeResource to be updated", func() bool {
+			return sg.KubeResources.Get(kubeResource.ID, fetched) == nil && fetched.Status == nil
+		})
+
+		// Fields removed from the Template are removed from the resource
+		err = sg.KubeResources.Update(kubeResource.ID, &model.KubeResource{
+			Template: newRawMessage(`{"apiVersion": "apps/v1", "spec": {"template": {"spec": {"containers": [{"name": "web", "image": "web:2"}]}}}}`),
+		})
+		So(err, ShouldBeNil)
+		select {
+		case patch = <-patched:
+		case <-time.After(5 * time.Second):
+		}
+		So(patch, ShouldContainSubstring, `"replicas":null`)
+
+		waitFor("KubeResource to be updated", func() bool {
+			return sg.KubeResources.Get(kubeResource.ID, fetched) == nil && fetched.Status == nil
+		})
+
+		// Stopping deletes it
+		So(sg.KubeResources.Stop(kubeResource.ID, kubeResource), ShouldBeNil)
+		var wasDeleted bool
+		select {
+		case wasDeleted = <-deleted:
+		case <-time.After(5 * time.Second):
+		}
+		So(wasDeleted, ShouldBeTrue)
+
+		waitFor("KubeResource to stop", func() bool {
+			return sg.KubeResources.Get(kubeResource.ID, fetched) == nil && !fetched.Started
+		})
+		So(fetched.Ready, ShouldBeFalse)
+		So(fetched.PassiveStatus, ShouldEqual, "stopped")
+	})
+}
+
+func TestKubeResourcesRevisions(t *testing.T) {
+	srv := newTestServer()
+	go srv.Start()
+	defer srv.Stop()
+
+	requestor := createAdmin(srv.Core)
+	sg := srv.Core.APIClient("token", requestor.APIToken)
+
+	srv.Core.AWSProvider = func(_ map[string]string) core.Provider {
+		return new(fake_core.Provider)
+	}
+	kube := createKube(sg)
+
+	provisioned := make(chan string, 10)
+	srv.Core.DefaultProvisioner = &fake_core.Provisioner{
+		ProvisionFn: func(m *model.KubeResource) error {
+			m.Definition = m.Template
+			provisioned <- string(*m.Template)
+			return srv.Core.DB.Save(m)
+		},
+		IsRunningFn: func(_ *model.KubeResource) (bool, error) {
+			return true, nil
+		},
+	}
+	srv.Core.K8S = func(_ *model.Kube) kubernetes.ClientInterface {
+		return new(fake_core.KubernetesClient)
+	}
+
+	Convey("KubeResources record a revision of each change, and can be rolled back to one", t, func() {
+		kubeResource := &model.KubeResource{
+			KubeName:  kube.Name,
+			Namespace: "test",
+			Name:      "test",
+			Kind:      "ConfigMap",
+			Template:  newRawMessage(`{"data":{"version":"1"}}`),
+		}
+		err := sg.KubeResources.Create(kubeResource)
+		So(err, ShouldBeNil)
+		<-provisioned
+		waitFor("KubeResource to start", func() bool {
+			So(sg.KubeResources.Get(kubeResource.ID, kubeResource), ShouldBeNil)
+			return kubeResource.Started
+		})
+
+		err = sg.KubeResources.Update(kubeResource.ID, &model.KubeResource{
+			Template: newRawMessage(`{"data":{"version":"2"}}`),
+		})
+		So(err, ShouldBeNil)
+		<-provisioned
+
+		// An update without changes is not a revision
+		err = sg.KubeResources.Update(kubeResource.ID, &model.KubeResource{
+			Template: newRawMessage(`{"data": {"version": "2"}}`),
+		})
+		So(err, ShouldBeNil)
+		<-provisioned
+
+		list := new(model.KubeResourceRevisionList)
+		err = sg.KubeResources.Revisions(kubeResource.ID, list)
+		So(err, ShouldBeNil)
+		So(list.Items, ShouldHaveLength, 2)
+		for i, revision := range list.Items {
+			So(revision.Revision, ShouldEqual, i+1)
+			So(revision.Author, ShouldEqual, requestor.Username)
+			So(*revision.KubeResourceID, ShouldEqual, *kubeResource.ID)
+		}
+		So(compactJSON(list.Items[0].Template), ShouldEqual, `{"data":{"version":"1"}}`)
+		So(compactJSON(list.Items[0].Definition), ShouldEqual, `{"data":{"version":"1"}}`)
+		So(compactJSON(list.Items[1].Template), ShouldEqual, `{"data":{"version":"2"}}`)
+
+		// Rolling back applies the old Template as a new revision
+		rolledBack := new(model.KubeResource)
+		err = sg.KubeResources.Rollback(kubeResource.ID, 1, rolledBack)
+		So(err, ShouldBeNil)
+		So(compactJSON(rolledBack.Template), ShouldEqual, `{"data":{"version":"1"}}`)
+		So(<-provisioned, ShouldEqual, `{"data":{"version":"1"}}`)
+
+		err = sg.KubeResources.Revisions(kubeResource.ID, list)
+		So(err, ShouldBeNil)
+		So(list.Items, ShouldHaveLength, 3)
+		So(list.Items[2].RollbackTo, ShouldEqual, 1)
+		So(compactJSON(list.Items[2].Template), ShouldEqual, `{"data":{"version":"1"}}`)
+
+		// Revisions which don't exist can't be rolled back to
+		err = sg.KubeResources.Rollback(kubeResource.ID, 9, new(model.KubeResource))
+		So(err, ShouldResemble, &model.Error{Status: 422, Message: "Validation failed: Revision 9 of KubeResource does not exist"})
+
+		req := authorizedRequest(srv.Core, requestor, fmt.Sprintf("/api/v0/kube_resources/%d/rollback?revision=latest", *kubeResource.ID))
+		req.Method = "POST"
+		resp, err := testHTTPClient.Do(req)
+		So(err, ShouldBeNil)
+		resp.Body.Close()
+		So(resp.StatusCode, ShouldEqual, 422)
+	})
+}
+
+func TestKubeResourcesLogsAndExec(t *testing.T) {
+	srv := newTestServer()
+	go srv.Start()
+	defer srv.Stop()
+
+	requestor := createAdmin(srv.Core)
+	sg := srv.Core.APIClient("token", requestor.APIToken)
+
+	srv.Core.AWSProvider = func(_ map[string]string) core.Provider {
+		return new(fake_core.Provider)
+	}
+	kube := createKube(sg)
+
+	// A Pod which echoes its stdin to stdout
+	upgrader := &websocket.Upgrader{Subprotocols: []string{kubernetes.ExecProtocol}}
+	pod := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		_, msg, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		conn.WriteMessage(websocket.BinaryMessage, append([]byte{kubernetes.ExecStdout}, msg[1:]...))
+		conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+	}))
+	defer pod.Close()
+
+	var logQuery *model.PodLogQuery
+	var execQuery *model.ExecQuery
+	srv.Core.K8S = func(_ *model.Kube) kubernetes.ClientInterface {
+		return &fake_core.KubernetesClient{
+			PodLogsFn: func(namespace string, name string, query *model.PodLogQuery) (io.ReadCloser, error) {
+				logQuery = query
+				return ioutil.NopCloser(strings.NewReader("line 1\nline 2\n")), nil
+			},
+			ExecPodFn: func(namespace string, name string, query *model.ExecQuery) (*websocket.Conn, error) {
+				execQuery = query
+				dialer := &websocket.Dialer{Subprotocols: []string{kubernetes.ExecProtocol}}
+				conn, _, err := dialer.Dial(strings.Replace(pod.URL, "http", "ws", 1), nil)
+				return conn, err
+			},
+		}
+	}
+
+	kubeResource := &model.KubeResource{
+		KubeName:  kube.Name,
+		Namespace: "test",
+		Name:      "test",
+		Kind:      "Pod",
+		Template:  newRawMessage(`{}`),
+	}
+	srv.Core.DB.Create(kubeResource)
+
+	configMap := &model.KubeResource{
+		KubeName:  kube.Name,
+		Namespace: "test",
+		Name:      "test",
+		Kind:      "ConfigMap",
+		Template:  newRawMessage(`{}`),
+	}
+	srv.Core.DB.Create(configMap)
+
+	Convey("KubeResources Logs streams the log of a Pod", t, func() {
+		out := new(bytes.Buffer)
+		err := sg.KubeResources.Logs(kubeResource.ID, &model.PodLogQuery{Container: "app", Tail: 2}, out)
+		So(err, ShouldBeNil)
+		So(out.String(), ShouldEqual, "line 1\nline 2\n")
+		So(logQuery, ShouldResemble, &model.PodLogQuery{Container: "app", Tail: 2})
+
+		resp, err := testHTTPClient.Do(authorizedRequest(srv.Core, requestor, fmt.Sprintf("/api/v0/kube_resources/%d/logs?tail=all", *kubeResource.ID)))
+		So(err, ShouldBeNil)
+		body := new(model.Error)
+		json.NewDecoder(resp.Body).Decode(body)
+		resp.Body.Close()
+		So(resp.StatusCode, ShouldEqual, 422)
+		So(body.Message, ShouldEqual, `Validation failed: tail: "all" is not an integer`)
+	})
+
+	Convey("KubeResources Exec connects to a command in a Pod", t, func() {
+		conn, err := sg.KubeResources.Exec(kubeResource.ID, &model.ExecQuery{
+			Command: []string{"cat"},
+			Stdin:   true,
+		})
+		So(err, ShouldBeNil)
+		defer conn.Close()
+		So(execQuery, ShouldResemble, &model.ExecQuery{Command: []string{"cat"}, Stdin: true})
+
+		So(conn.WriteMessage(websocket.BinaryMessage, append([]byte{kubernetes.ExecStdin}, "hello"...)), ShouldBeNil)
+		_, msg, err := conn.ReadMessage()
+		So(err, ShouldBeNil)
+		So(string(msg), ShouldEqual, "\x01hello")
+
+		_, _, err = conn.ReadMessage()
+		So(websocket.IsCloseError(err, websocket.CloseNormalClosure), ShouldBeTrue)
+	})
+
+	Convey("KubeResources Logs and Exec are only available for Pods", t, func() {
+		err := sg.KubeResources.Logs(configMap.ID, new(model.PodLogQuery), new(bytes.Buffer))
+		So(err, ShouldResemble, &model.Error{Status: 422, Message: "Validation failed: KubeResource is a ConfigMap; logs and exec are only available for Pods"})
+
+		_, err = sg.KubeResources.Exec(configMap.ID, &model.ExecQuery{Command: []string{"ls"}})
+		So(err, ShouldResemble, &model.Error{Status: 422, Message: "Validation failed: KubeResource is a ConfigMap; logs and exec are only available for Pods"})
+
+		_, err = sg.KubeResources.Exec(kubeResource.ID, new(model.ExecQuery))
+		So(err, ShouldResemble, &model.Error{Status: 422, Message: "Validation failed: Command is required"})
+	})
+}
+
+func TestKubeResourcesImport(t *testing.T) {
+	srv := newTestServer()
+	go srv.Start()
+	defer srv.Stop()
+
+	requestor := createAdmin(srv.Core)
+	sg := srv.Core.APIClient("token", requestor.APIToken)
+
+	srv.Core.AWSProvider = func(_ map[string]string) core.Provider {
+		return new(fake_core.Provider)
+	}
+	kube := createKube(sg)
+
+	items := map[string][]string{
+		"Service/default": {
+			`{"metadata": {"name": "web", "namespace": "default", "uid": "123", "resourceVersion": "5"}, "spec": {"ports": [{"port": 80}]}, "status": {"loadBalancer": {}}}`,
+			`{"metadata": {"name": "taken", "namespace": "default"}, "spec": {}}`,
+		},
+		"Service/other": {
+			`{"metadata": {"name": "a-name-too-long-for-supergiant", "namespace": "other"}, "spec": {}}`,
+		},
+		"Service/kube-system": {
+			`{"metadata": {"name": "kube-dns", "namespace": "kube-system"}, "spec": {}}`,
+		},
+		"Pod/other": {
+			`not json`,
+		},
+		"Pod/default": {
+			`{"metadata": {"name": "web-abc", "namespace": "default", "ownerReferences": [{"kind": "ReplicaSet", "name": "web-123"}]}, "spec": {}}`,
+			`{"metadata": {"name": "standalone", "namespace": "default"}, "spec": {}}`,
+		},
+	}
+
+	var resourceCreated bool
+
+	srv.Core.K8S = func(*model.Kube) kubernetes.ClientInterface {
+		return &fake_core.KubernetesClient{
+			ListNamespacesFn: func(query string) ([]*kubernetes.Namespace, error) {
+				return []*kubernetes.Namespace{
+					{Metadata: kubernetes.Metadata{Name: "default"}},
+					{Metadata: kubernetes.Metadata{Name: "other"}},
+					{Metadata: kubernetes.Metadata{Name: "kube-system"}},
+					{Metadata: kubernetes.Metadata{Name: "locked"}},
+				}, nil
+			},
+			ListResourcesFn: func(apiVersion string, kind string, namespace string) (*kubernetes.ResourceList, error) {
+				if namespace == "locked" {
+					return nil, errors.New("forbidden")
+				}
+				list := new(kubernetes.ResourceList)
+				for _, item := range items[kind+"/"+namespace] {
+					list.Items = append(list.Items, json.RawMessage(item))
+				}
+				return list, nil
+			},
+			CreateResourceFn: func(apiVersion string, kind string, namespace string, objIn map[string]interface{}, out *json.RawMessage) error {
+				resourceCreated = true
+				return nil
+			},
+		}
+	}
+
+	taken := &model.KubeResource{
+		KubeName:  kube.Name,
+		Namespace: "default",
+		Name:      "taken",
+		Kind:      "Service",
+		Template:  newRawMessage(`{}`),
+	}
+	srv.Core.DB.Create(taken)
+
+	Convey("KubeResources Import adopts existing resources without re-creating them", t, func() {
+		m := &model.KubeResourceImport{
+			KubeName: kube.Name,
+			Kinds:    []string{"Service", "Pod"},
+		}
+		err := sg.KubeResources.Import(m)
+		So(err, ShouldBeNil)
+		So(resourceCreated, ShouldBeFalse)
+
+		var imported []string
+		for _, kubeResource := range m.Imported {
+			imported = append(imported, kubeResource.Kind+" "+kubeResource.Namespace+"/"+kubeResource.Name)
+			So(kubeResource.Started, ShouldBeTrue)
+		}
+		So(imported, ShouldResemble, []string{"Service default/web", "Pod default/standalone"})
+
+		web := new(model.KubeResource)
+		So(srv.Core.DB.First(web, "name = ?", "web"), ShouldBeNil)
+		So(web.Started, ShouldBeTrue)
+		So(string(web.TemplateJSON), ShouldNotContainSubstring, "uid")
+		So(string(web.TemplateJSON), ShouldNotContainSubstring, "status")
+		So(string(web.ArtifactJSON), ShouldContainSubstring, `"uid":"123"`)
+
+		So(m.Skipped, ShouldResemble, []*model.SkippedKubeResource{
+			{Kind: "Service", Namespace: "default", Name: "taken", Reason: "already a KubeResource"},
+			{Kind: "Service", Namespace: "other", Name: "a-name-too-long-for-supergiant", Reason: "Validation failed: Name: greater than max"},
+			{Kind: "Pod", Namespace: "default", Name: "web-abc", Reason: "managed by ReplicaSet web-123"},
+		})
+
+		// The listing failures and the unreadable item don't stop the others
+		// from being imported.
+		So(m.Failed, ShouldHaveLength, 3)
+		So(m.Failed[0], ShouldResemble, &model.SkippedKubeResource{Kind: "Service", Namespace: "locked", Reason: "forbidden"})
+		So(m.Failed[1].Kind+" "+m.Failed[1].Namespace, ShouldEqual, "Pod other")
+		So(m.Failed[1].Reason, ShouldContainSubstring, "invalid character")
+		So(m.Failed[2], ShouldResemble, &model.SkippedKubeResource{Kind: "Pod", Namespace: "locked", Reason: "forbidden"})
+
+		Convey("Importing again skips everything", func() {
+			m := &model.KubeResourceImport{
+				KubeName:   kube.Name,
+				Kinds:      []string{"Service", "Pod"},
+				Namespaces: []string{"default"},
+			}
+			err := sg.KubeResources.Import(m)
+			So(err, ShouldBeNil)
+			So(m.Imported, ShouldBeEmpty)
+			So(m.Skipped, ShouldHaveLength, 4)
+		})
+	})
+
+	Convey("KubeResources Import only imports system namespaces if listed", t, func() {
+		So(srv.Core.DB.First(new(model.KubeResource), "name = ?", "kube-dns"), ShouldNotBeNil)
+
+		m := &model.KubeResourceImport{
+			KubeName:   kube.Name,
+			Kinds:      []string{"Service"},
+			Namespaces: []string{"kube-system"},
+		}
+		So(sg.KubeResources.Import(m), ShouldBeNil)
+		So(m.Imported, ShouldHaveLength, 1)
+		So(m.Imported[0].Name, ShouldEqual, "kube-dns")
+	})
+
+	Convey("KubeResources Import requires an existing Kube and kinds", t, func() {
+		err := sg.KubeResources.Import(&model.KubeResourceImport{KubeName: "nope", Kinds: []string{"Service"}})
+		So(err, ShouldResemble, &model.Error{Status: 422, Message: "Parent does not exist, foreign key 'KubeName' on KubeResourceImport"})
+
+		err = sg.KubeResources.Import(&model.KubeResourceImport{KubeName: kube.Name})
+		So(err, ShouldResemble, &model.Error{Status: 422, Message: "Validation failed: Kinds: less than min"})
+	})
+}
+
+func TestKubeResourcesCreateFromManifest(t *testing.T) {
+	srv := newTestServer()
+	go srv.Start()
+	defer srv.Stop()
+
+	var started []string
+
+	realKubeResources := srv.Core.KubeResources
+
+	srv.Core.KubeResources = &fake_core.KubeResources{
+		CreateFn:             realKubeResources.Create,
+		CreateFromManifestFn: realKubeResources.CreateFromManifest,
+		StartFn: func(id *int64, m *model.KubeResource) core.ActionInterface {
+			started = append(started, m.Kind+" "+m.Namespace+"/"+m.Name)
+			return &core.Action{
+				Status: &model.ActionStatus{
+					Description: "starting",
+				},
+				Core:       srv.Core,
+				Model:      m,
+				ResourceID: m.GetUUID(),
+				Fn: func(_ *core.Action) error {
+					return nil
+				},
+			}
+		},
+	}
+
+	requestor := createAdmin(srv.Core)
+	sg := srv.Core.APIClient("token", requestor.APIToken)
+
+	srv.Core.AWSProvider = func(_ map[string]string) core.Provider {
+		return new(fake_core.Provider)
+	}
+	kube := createKube(sg)
+
+	Convey("KubeResources CreateFromManifest creates a KubeResource for each resource, in order", t, func() {
+		m := &model.KubeResourceManifest{
+			KubeName:  kube.Name,
+			Namespace: "blog",
+			Manifest: `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: config
+data:
+  debug: "true"
+---
+# The Service and Deployment
+apiVersion: v1
+kind: List
+items:
+- apiVersion: v1
+  kind: Service
+  metadata:
+    name: web
+  spec:
+    ports:
+    - port: 80
+- apiVersion: extensions/v1beta1
+  kind: Deployment
+  metadata:
+    name: web
+    namespace: sites
+  spec:
+    replicas: 2
+---
+kind: Service
+metadata:
+  name: a-name-too-long-for-supergiant
+`,
+		}
+		err := sg.KubeResources.CreateFromManifest(m)
+		So(err, ShouldBeNil)
+		So(started, ShouldResemble, []string{"ConfigMap blog/config", "Service blog/web", "Deployment sites/web"})
+
+		var results []string
+		for _, result := range m.Results {
+			results = append(results, result.Kind+" "+result.Namespace+"/"+result.Name+" "+result.Error)
+			So(result.KubeResource == nil, ShouldEqual, result.Error != "")
+		}
+		So(results, ShouldResemble, []string{
+			"ConfigMap blog/config ",
+			"Service blog/web ",
+			"Deployment sites/web ",
+			"Service blog/a-name-too-long-for-supergiant Validation failed: Name: greater than max",
+		})
+
+		deployment := new(model.KubeResource)
+		So(srv.Core.DB.First(deployment, "kind = ?", "Deployment"), ShouldBeNil)
+		So(deployment.APIVersion(), ShouldEqual, "extensions/v1beta1")
+		So(string(deployment.TemplateJSON), ShouldContainSubstring, `"replicas":2`)
+
+		Convey("Resources which are already KubeResources are reported", func() {
+			started = nil
+			m := &model.KubeResourceManifest{
+				KubeName: kube.Name,
+				Manifest: `[{"kind": "ConfigMap", "metadata": {"name": "config", "namespace": "blog"}}, {"kind": "ConfigMap", "metadata": {"name": "other"}}]`,
+			}
+			err := sg.KubeResources.CreateFromManifest(m)
+			So(err, ShouldBeNil)
+			So(started, ShouldResemble, []string{"ConfigMap default/other"})
+			So(m.Results[0].Error, ShouldContainSubstring, "UNIQUE constraint failed")
+		})
+	})
+
+	Convey("KubeResources CreateFromManifest accepts a YAML body", t, func() {
+		started = nil
+		body := strings.NewReader(`kube_name: test
+manifest: |
+  kind: Secret
+  metadata:
+    name: password
+`)
+		req := authorizedRequest(srv.Core, requestor, "/api/v0/kube_resources/manifest")
+		req.Method = "POST"
+		req.Body = ioutil.NopCloser(body)
+		req.Header.Set("Content-Type", "application/yaml")
+
+		resp, err := testHTTPClient.Do(req)
+		So(err, ShouldBeNil)
+		defer resp.Body.Close()
+		So(resp.StatusCode, ShouldEqual, http.StatusCreated)
+		So(started, ShouldResemble, []string{"Secret default/password"})
+	})
+
+	Convey("KubeResources CreateFromManifest requires a valid manifest and an existing Kube", t, func() {
+		err := sg.KubeResources.CreateFromManifest(&model.KubeResourceManifest{KubeName: "nope", Manifest: "kind: Secret"})
+		So(err, ShouldResemble, &model.Error{Status: 422, Message: "Parent does not exist, foreign key 'KubeName' on KubeResourceManifest"})
+
+		err = sg.KubeResources.CreateFromManifest(&model.KubeResourceManifest{KubeName: kube.Name, Manifest: "metadata: {name: a}"})
+		So(err, ShouldResemble, &model.Error{Status: 422, Message: "Validation failed: document 1: resource has no kind"})
+	})
+}
+
+func TestKubeResourcesSchedule(t *testing.T) {
+	srv := newTestServer()
+	go srv.Start()
+	defer srv.Stop()
+
+	requestor := createAdmin(srv.Core)
+	sg := srv.Core.APIClient("token", requestor.APIToken)
+
+	srv.Core.AWSProvider = func(_ map[string]string) core.Provider {
+		return new(fake_core.Provider)
+	}
+	kube := createKube(sg)
+
+	deleted := make(chan string, 1)
+	srv.Core.K8S = func(_ *model.Kube) kubernetes.ClientInterface {
+		return &fake_core.KubernetesClient{
+			DeleteResourceFn: func(apiVersion string, kind string, namespace string, name string) error {
+				deleted <- kind + "/" + name
+				return nil
+			},
+		}
+	}
+
+	Convey("KubeResources and the Nodes of Kubes are started and stopped on their Schedules", t, func() {
+		kubeResource := &model.KubeResource{
+			KubeName:  kube.Name,
+			Namespace: "staging",
+			Kind:      "Pod",
+			Name:      "web",
+			Template:  newRawMessage(`{}`),
+			Schedule:  &model.Schedule{Start: "0 8 * * mon-fri", Stop: "* * * * *", TimeZone: "America/New_York"},
+		}
+		So(sg.KubeResources.Create(kubeResource), ShouldBeNil)
+		So(srv.Core.DB.Model(&model.KubeResource{BaseModel: model.BaseModel{ID: kubeResource.ID}}).Update("started", true), ShouldBeNil)
+
+		err := sg.Kubes.Update(kube.ID, &model.Kube{NodeSchedule: &model.Schedule{Stop: "* * * * *"}})
+		So(err, ShouldBeNil)
+
+		// Stop matches the minute since the last run
+		scheduler := &core.Scheduler{Core: srv.Core, LastRun: time.Now().Add(-time.Minute)}
+		So(scheduler.Perform(), ShouldBeNil)
+
+		var resource string
+		select {
+		case resource = <-deleted:
+		case <-time.After(5 * time.Second):
+		}
+		So(resource, ShouldEqual, "Pod/web")
+
+		waitFor("KubeResource to stop", func() bool {
+			So(sg.KubeResources.Get(kubeResource.ID, kubeResource), ShouldBeNil)
+			return !kubeResource.Started
+		})
+
+		So(sg.Kubes.Get(kube.ID, kube), ShouldBeNil)
+		So(kube.NodesStopped, ShouldBeTrue)
+	})
+
+	Convey("Schedules must be valid", t, func() {
+		table := []struct {
+			// Input
+			schedule *model.Schedule
+			// Expectations
+			err *model.Error
+		}{
+			{
+				schedule: &model.Schedule{Start: "60 * * * *"},
+				err:      &model.Error{Status: 422, Message: `Validation failed: Schedule.Start: invalid value "60" in minute field`},
+			},
+			{
+				schedule: &model.Schedule{Stop: "0 20 * *"},
+				err:      &model.Error{Status: 422, Message: `Validation failed: Schedule.Stop: cron expression "0 20 * *" must have 5 fields`},
+			},
+			{
+				schedule: &model.Schedule{Stop: "0 20 * * *", TimeZone: "Mars/Olympus_Mons"},
+				err:      &model.Error{Status: 422, Message: "Validation failed: Schedule.TimeZone: unknown time zone Mars/Olympus_Mons"},
+			},
+		}
+
+		for _, item := range table {
+			err := sg.KubeResources.Create(&model.KubeResource{
+				KubeName:  kube.Name,
+				Namespace: "staging",
+				Kind:      "Pod",
+				Name:      "invalid",
+				Template:  newRawMessage(`{}`),
+				Schedule:  item.schedule,
+			})
+			So(err, ShouldResemble, item.err)
+		}
+	})
+}
@@ -1,11 +1,23 @@
 package api
 
 import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
 	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/gorilla/websocket"
+
 	"github.com/supergiant/supergiant/pkg/core"
+	"github.com/supergiant/supergiant/pkg/kubernetes"
 	"github.com/supergiant/supergiant/pkg/model"
 	"github.com/supergiant/supergiant/test/fake_core"
 
@@ -391,6 +403,378 @@ func TestKubesGet(t *testing.T) {
 
 //------------------------------------------------------------------------------
 
+func TestKubesCreateExisting(t *testing.T) {
+	srv := newTestServer()
+	go srv.Start()
+	defer srv.Stop()
+
+	requestor := createAdmin(srv.Core)
+	sg := srv.Core.APIClient("token", requestor.APIToken)
+
+	srv.Core.ExistingProvider = func(_ map[string]string) core.Provider {
+		return new(fake_core.Provider)
+	}
+	sg.CloudAccounts.Create(&model.CloudAccount{Name: "existing", Provider: "existing"})
+
+	caCert, _, err := kubernetes.GenerateCA("kube-ca")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	Convey("Kubes Create reaches imported Kubes at the address of their server", t, func() {
+		table := []struct {
+			// Input
+			server   string
+			caCert   string
+			insecure bool
+			// Expectations
+			masterPublicIP string
+			err            *model.Error
+		}{
+			{server: "10.0.0.1:6443", caCert: caCert, masterPublicIP: "10.0.0.1:6443"},
+			{server: "https://10.0.0.1", caCert: caCert, masterPublicIP: "10.0.0.1"},
+			{server: "https://rancher.example.com/k8s/clusters/c-1/", insecure: true, masterPublicIP: "rancher.example.com/k8s/clusters/c-1"},
+			{
+				server: "http://10.0.0.1:8080",
+				caCert: caCert,
+				err:    &model.Error{Status: 422, Message: `Validation failed: ExistingConfig.Server: "http://10.0.0.1:8080" must be an https URL, or a host and port`},
+			},
+			// A CA is required, unless verification is skipped explicitly
+			{
+				server: "10.0.0.1:6443",
+				err:    &model.Error{Status: 422, Message: "Validation failed: " + kubernetes.ErrorNoCACert.Error()},
+			},
+		}
+
+		for i, item := range table {
+			kube := &model.Kube{
+				CloudAccountName:      "existing",
+				Name:                  fmt.Sprintf("existing%d", i),
+				ExistingConfig:        &model.ExistingKubeConfig{Server: item.server, CACert: item.caCert},
+				InsecureSkipTLSVerify: item.insecure,
+			}
+			err := sg.Kubes.Create(kube)
+
+			if item.err != nil {
+				So(err, ShouldResemble, item.err)
+				continue
+			}
+			So(err, ShouldBeNil)
+			So(kube.MasterPublicIP, ShouldEqual, item.masterPublicIP)
+		}
+	})
+}
+
+func TestKubesKubeconfig(t *testing.T) {
+	srv := newTestServer()
+	go srv.Start()
+	defer srv.Stop()
+
+	Convey("Kubes Kubeconfig works correctly", t, func() {
+		caCert, caKey, err := kubernetes.GenerateCA("kube-ca")
+		So(err, ShouldBeNil)
+
+		table := []struct {
+			// Input
+			caKey    string
+			username string
+			// Expectations
+			user  string
+			proxy bool
+		}{
+			// Without the CA key, the proxy, never the credentials of the Kube
+			{
+				caKey:    "",
+				username: "bossman",
+				user:     "supergiant-test-bossman",
+				proxy:    true,
+			},
+			// A certificate for an admin
+			{
+				caKey:    caKey,
+				username: "bossman",
+				user:     "supergiant-test-bossman",
+			},
+			// Users go through the proxy
+			{
+				caKey:    caKey,
+				username: "user",
+				user:     "supergiant-test-user",
+				proxy:    true,
+			},
+		}
+
+		for _, item := range table {
+
+			wipeAndInitialize(srv.Core)
+
+			srv.Core.AWSProvider = func(_ map[string]string) core.Provider {
+				return new(fake_core.Provider)
+			}
+
+			user, admin := createUserAndAdmin(srv.Core)
+			requestor := user
+			if item.username == admin.Username {
+				requestor = admin
+			}
+
+			kube := createKube(srv.Core.APIClient("token", admin.APIToken))
+			// The CA and credentials of the Kube are readonly
+			srv.Core.DB.Model(&model.Kube{BaseModel: model.BaseModel{ID: kube.ID}}).Update(map[string]interface{}{"ca_cert": caCert, "ca_key": item.caKey, "bearer_token": "kube-token"})
+			srv.Core.Kubes.Get(kube.ID, kube)
+
+			config, err := srv.Core.APIClient("token", requestor.APIToken).Kubes.Kubeconfig(kube.ID)
+			So(err, ShouldBeNil)
+
+			So(config.CurrentContext, ShouldEqual, "supergiant-test")
+			So(config.Contexts[0].Context.User, ShouldEqual, item.user)
+			So(config.Users[0].Name, ShouldEqual, item.user)
+
+			configUser := config.Users[0].User
+			So(configUser.Token, ShouldNotEqual, "kube-token")
+			So(configUser.Username, ShouldBeEmpty)
+			So(configUser.Password, ShouldBeEmpty)
+
+			if item.proxy {
+				So(config.Clusters[0].Cluster.Server, ShouldEqual, fmt.Sprintf("%s/api/v0/kubes/%d/proxy", srv.Core.BaseURL(), *kube.ID))
+				So(configUser, ShouldResemble, &kubernetes.KubeconfigUser{Token: requestor.ProxyToken})
+				continue
+			}
+
+			So(config.Clusters[0].Cluster.CertificateAuthorityData, ShouldResemble, []byte(caCert))
+
+			block, _ := pem.Decode(configUser.ClientCertificateData)
+			So(block, ShouldNotBeNil)
+			cert, err := x509.ParseCertificate(block.Bytes)
+			So(err, ShouldBeNil)
+			So(cert.Subject.CommonName, ShouldEqual, item.username)
+			So(cert.Subject.Organization, ShouldResemble, []string{"system:masters"})
+			So(cert.NotAfter.Before(time.Now().Add(25*time.Hour)), ShouldBeTrue)
+
+			_, err = tls.X509KeyPair(configUser.ClientCertificateData, configUser.ClientKeyData)
+			So(err, ShouldBeNil)
+		}
+	})
+}
+
+func TestKubesProxy(t *testing.T) {
+	srv := newTestServer()
+	go srv.Start()
+	defer srv.Stop()
+
+	srv.Core.AWSProvider = func(_ map[string]string) core.Provider {
+		return new(fake_core.Provider)
+	}
+	user, admin := createUserAndAdmin(srv.Core)
+	kube := createKube(srv.Core.APIClient("token", admin.APIToken))
+
+	// The Kube API, which echoes exec connections
+	var method, query, authorization string
+	upgrader := new(websocket.Upgrader)
+	k8s := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method = r.Method
+		query = r.URL.RawQuery
+		authorization = r.Header.Get("Authorization")
+
+		switch r.URL.Path {
+		case "/api/v1/namespaces":
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"kind":"NamespaceList"}`))
+		case "/api/v1/namespaces/ns/pods/a/exec":
+			conn, err := upgrader.Upgrade(w, r, nil)
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+			msgType, msg, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			conn.WriteMessage(msgType, msg)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer k8s.Close()
+
+	srv.Core.K8S = func(kube *model.Kube) kubernetes.ClientInterface {
+		kube.MasterPublicIP = k8s.Listener.Addr().String()
+		return &kubernetes.Client{Kube: kube, HTTPClient: k8s.Client()}
+	}
+
+	proxyURL := fmt.Sprintf("%s/api/v0/kubes/%d/proxy", srv.Core.BaseURL(), *kube.ID)
+	kubeAuthorization := "Basic " + base64.StdEncoding.EncodeToString([]byte(kube.Username+":"+kube.Password))
+
+	Convey("Kubes Proxy forwards requests to the Kube API with the credentials of the Kube", t, func() {
+		table := []struct {
+			// Input
+			method string
+			token  string
+			// Expectations
+			status    int
+			forwarded bool
+		}{
+			// Users can read
+			{"GET", user.ProxyToken, 200, true},
+			// Only admins can change things
+			{"POST", user.ProxyToken, 403, false},
+			{"POST", admin.ProxyToken, 200, true},
+			{"DELETE", admin.ProxyToken, 200, true},
+			// Unknown tokens, and API tokens, which kubeconfigs don't have
+			{"GET", "notarealtokennotarealtokennotrea", 401, false},
+			{"GET", user.APIToken, 401, false},
+		}
+
+		for _, item := range table {
+			method, query, authorization = "", "", ""
+
+			req, err := http.NewRequest(item.method, proxyURL+"/api/v1/namespaces?labelSelector=a%3Db", nil)
+			So(err, ShouldBeNil)
+			req.Header.Set("Authorization", "Bearer "+item.token)
+			resp, err := http.DefaultClient.Do(req)
+			So(err, ShouldBeNil)
+			body, _ := ioutil.ReadAll(resp.Body)
+			resp.Body.Close()
+
+			So(resp.StatusCode, ShouldEqual, item.status)
+			if !item.forwarded {
+				So(method, ShouldEqual, "")
+				continue
+			}
+			So(string(body), ShouldEqual, `{"kind":"NamespaceList"}`)
+			So(method, ShouldEqual, item.method)
+			So(query, ShouldEqual, "labelSelector=a%3Db")
+			So(authorization, ShouldEqual, kubeAuthorization)
+		}
+	})
+
+	Convey("Kubes Proxy only lets users read the allowed resources", t, func() {
+		table := []struct {
+			// Input
+			path  string
+			token string
+			// Expectations
+			forwarded bool
+		}{
+			{"/api", user.ProxyToken, true},
+			{"/apis/extensions/v1beta1", user.ProxyToken, true},
+			{"/api/v1/namespaces/ns", user.ProxyToken, true},
+			{"/api/v1/namespaces/ns/pods", user.ProxyToken, true},
+			{"/api/v1/namespaces/ns/pods/a/log", user.ProxyToken, true},
+			{"/apis/extensions/v1beta1/watch/namespaces/ns/deployments", user.ProxyToken, true},
+			{"/api/v1/nodes", user.ProxyToken, true},
+			// Secrets and tunnels into the Kube are for admins only
+			{"/api/v1/secrets", user.ProxyToken, false},
+			{"/api/v1/namespaces/ns/secrets", user.ProxyToken, false},
+			{"/api/v1/watch/namespaces/ns/secrets/a", user.ProxyToken, false},
+			{"/api/v1/namespaces/ns/pods/a/exec", user.ProxyToken, false},
+			{"/api/v1/namespaces/ns/pods/a/attach", user.ProxyToken, false},
+			{"/api/v1/namespaces/ns/pods/a/portforward", user.ProxyToken, false},
+			{"/api/v1/namespaces/ns/services/a/proxy/admin", user.ProxyToken, false},
+			{"/api/v1/nodes/a/proxy", user.ProxyToken, false},
+			{"/api/v1/proxy/namespaces/ns/services/a", user.ProxyToken, false},
+			{"/healthz", user.ProxyToken, false},
+			{"/api/v1/namespaces/ns/secrets", admin.ProxyToken, true},
+			{"/api/v1/nodes/a/proxy", admin.ProxyToken, true},
+		}
+
+		for _, item := range table {
+			method = ""
+
+			req, err := http.NewRequest("GET", proxyURL+item.path, nil)
+			So(err, ShouldBeNil)
+			req.Header.Set("Authorization", "Bearer "+item.token)
+			resp, err := http.DefaultClient.Do(req)
+			So(err, ShouldBeNil)
+			resp.Body.Close()
+
+			if item.forwarded {
+				So(method, ShouldEqual, "GET")
+			} else {
+				So(resp.StatusCode, ShouldEqual, 403)
+				So(method, ShouldEqual, "")
+			}
+		}
+	})
+
+	Convey("Kubes Proxy tunnels upgrade requests for admins", t, func() {
+		wsURL := "ws" + strings.TrimPrefix(proxyURL, "http") + "/api/v1/namespaces/ns/pods/a/exec?command=sh"
+
+		_, resp, err := websocket.DefaultDialer.Dial(wsURL, http.Header{"Authorization": []string{"Bearer " + user.ProxyToken}})
+		So(err, ShouldEqual, websocket.ErrBadHandshake)
+		So(resp.StatusCode, ShouldEqual, 403)
+
+		conn, _, err := websocket.DefaultDialer.Dial(wsURL, http.Header{"Authorization": []string{"Bearer " + admin.ProxyToken}})
+		So(err, ShouldBeNil)
+		defer conn.Close()
+		So(authorization, ShouldEqual, kubeAuthorization)
+
+		So(conn.WriteMessage(websocket.BinaryMessage, []byte("hello")), ShouldBeNil)
+		_, msg, err := conn.ReadMessage()
+		So(err, ShouldBeNil)
+		So(string(msg), ShouldEqual, "hello")
+	})
+
+	Convey("Kubes ProxyKubeconfig points at the proxy, with the proxy token of the user", t, func() {
+		config, err := srv.Core.APIClient("token", user.APIToken).Kubes.ProxyKubeconfig(kube.ID)
+		So(err, ShouldBeNil)
+
+		So(config.Clusters[0].Cluster, ShouldResemble, &kubernetes.KubeconfigCluster{Server: proxyURL})
+		So(config.Users[0].Name, ShouldEqual, "supergiant-test-user")
+		So(config.Users[0].User, ShouldResemble, &kubernetes.KubeconfigUser{Token: user.ProxyToken})
+	})
+
+	Convey("Proxy tokens only authenticate requests to the proxy, and are revoked by regenerating them", t, func() {
+		get := func(url string, auth string) int {
+			req, err := http.NewRequest("GET", url, nil)
+			So(err, ShouldBeNil)
+			req.Header.Set("Authorization", auth)
+			resp, err := http.DefaultClient.Do(req)
+			So(err, ShouldBeNil)
+			resp.Body.Close()
+			return resp.StatusCode
+		}
+		kubeURL := fmt.Sprintf("%s/api/v0/kubes/%d", srv.Core.BaseURL(), *kube.ID)
+
+		So(get(kubeURL, "Bearer "+user.ProxyToken), ShouldEqual, 401)
+		So(get(kubeURL, `SGAPI token="`+user.ProxyToken+`"`), ShouldEqual, 401)
+
+		oldToken := user.ProxyToken
+		regenerated := new(model.User)
+		So(srv.Core.APIClient("token", user.APIToken).Users.RegenerateProxyToken(user.ID, regenerated), ShouldBeNil)
+		So(regenerated.ProxyToken, ShouldNotEqual, oldToken)
+		So(get(proxyURL+"/api/v1/namespaces", "Bearer "+oldToken), ShouldEqual, 401)
+		So(get(proxyURL+"/api/v1/namespaces", "Bearer "+regenerated.ProxyToken), ShouldEqual, 200)
+		user.ProxyToken = regenerated.ProxyToken
+	})
+
+	Convey("Kubes ProxyKubeconfig generates a proxy token for users created before there were any", t, func() {
+		srv.Core.DB.Model(admin).Update("proxy_token", "")
+		srv.Core.DB.First(admin, admin.ID)
+		So(admin.ProxyToken, ShouldBeEmpty)
+
+		config, err := srv.Core.APIClient("token", admin.APIToken).Kubes.ProxyKubeconfig(kube.ID)
+		So(err, ShouldBeNil)
+		srv.Core.DB.First(admin, admin.ID)
+		So(admin.ProxyToken, ShouldNotBeEmpty)
+		So(config.Users[0].User.Token, ShouldEqual, admin.ProxyToken)
+	})
+
+	Convey("Kubes ProxyKubeconfig requires HTTPS unless Supergiant is published on loopback", t, func() {
+		srv.Core.PublishHost = "supergiant.example.com"
+		defer func() { srv.Core.PublishHost = "localhost" }()
+
+		_, err := srv.Core.Kubes.ProxyKubeconfig(kube.ID, user)
+		So(err, ShouldEqual, core.ErrorProxyKubeconfigRequiresSSL)
+
+		srv.Core.PublishHost = "127.0.0.1"
+		_, err = srv.Core.Kubes.ProxyKubeconfig(kube.ID, user)
+		So(err, ShouldBeNil)
+	})
+}
+
+//------------------------------------------------------------------------------
+
 func TestKubesUpdate(t *testing.T) {
 	srv := newTestServer()
 	go srv.Start()
@@ -913,6 +1297,13 @@ func TestKubeDelete(t *testing.T) {
 			}
 			So(kubeResourceNamesDeleted, ShouldResemble, item.kubeResourceNamesDeleted)
 
+			// Find KubeResourceRevisions of deleted KubeResources
+			for _, existingKubeResource := range item.existingKubeResources {
+				var remainingRevisions []*model.KubeResourceRevision
+				srv.Core.DB.Where("kube_resource_id = ?", *existingKubeResource.ID).Find(&remainingRevisions)
+				So(remainingRevisions, ShouldBeEmpty)
+			}
+
 			// Find Volumes deleted
 			for _, existingVolume := range item.existingVolumes {
 				volumeNamesDeleted = append(volumeNamesDeleted, existingVolume.Name)
@@ -0,0 +1,273 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/supergiant/supergiant/pkg/core"
+	"github.com/supergiant/supergiant/pkg/model"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+const testLogLines = `Legacy text line
+{"level":"info","msg":"Running step of Create Kube procedure: a","time":"2016-10-01T00:00:00Z","model":"Kube","model_id":1,"action_id":"abc"}
+{"level":"error","msg":"Failed to tag instance","time":"2016-10-02T00:00:00Z","model":"Kube","model_id":1,"action_id":"abc"}
+{"level":"warning","msg":"Deleting Node 2 which has no provider_id","time":"2016-10-03T00:00:00Z","model":"Node","model_id":2}
+{"level":"info","msg":"POST /api/v0/kubes 201","time":"2016-10-04T00:00:00Z","request_id":"xyz","user":"admin"}
+`
+
+func TestLogList(t *testing.T) {
+	file, _ := ioutil.TempFile(os.TempDir(), "supergiant-log")
+	defer os.Remove(file.Name())
+	file.WriteString(testLogLines)
+	file.Close()
+
+	srv := newConfiguredTestServer(func(c *core.Core) {
+		c.LogPath = file.Name()
+	})
+	go srv.Start()
+	defer srv.Stop()
+
+	requestor := createAdmin(srv.Core)
+	sg := srv.Core.APIClient("token", requestor.APIToken)
+
+	Convey("Log List works correctly", t, func() {
+		table := []struct {
+			// Input
+			query *model.LogQuery
+			// Expectations
+			messages []string
+			err      error
+		}{
+			// No filters returns everything
+			{
+				query: new(model.LogQuery),
+				messages: []string{
+					"Legacy text line",
+					"Running step of Create Kube procedure: a",
+					"Failed to tag instance",
+					"Deleting Node 2 which has no provider_id",
+					"POST /api/v0/kubes 201",
+				},
+			},
+			// Level is a minimum
+			{
+				query:    &model.LogQuery{Level: "warning"},
+				messages: []string{"Failed to tag instance", "Deleting Node 2 which has no provider_id"},
+			},
+			// Time range
+			{
+				query: &model.LogQuery{
+					Since: time.Date(2016, 10, 2, 0, 0, 0, 0, time.UTC),
+					Until: time.Date(2016, 10, 3, 0, 0, 0, 0, time.UTC),
+				},
+				messages: []string{"Failed to tag instance", "Deleting Node 2 which has no provider_id"},
+			},
+			// Model and ID
+			{
+				query:    &model.LogQuery{Model: "Kube", ModelID: "1"},
+				messages: []string{"Running step of Create Kube procedure: a", "Failed to tag instance"},
+			},
+			// Text is case-insensitive
+			{
+				query:    &model.LogQuery{Text: "node"},
+				messages: []string{"Deleting Node 2 which has no provider_id"},
+			},
+			// Limit returns the most recent
+			{
+				query:    &model.LogQuery{Limit: 2},
+				messages: []string{"Deleting Node 2 which has no provider_id", "POST /api/v0/kubes 201"},
+			},
+			// Invalid level
+			{
+				query: &model.LogQuery{Level: "loud"},
+				err:   errors.New(`[422] Validation failed: not a valid logrus Level: "loud"`),
+			},
+		}
+
+		for _, item := range table {
+			list := new(model.LogEntryList)
+			err := sg.Logs.List(item.query, list)
+
+			if item.err == nil {
+				So(err, ShouldBeNil)
+			} else {
+				So(err.Error(), ShouldEqual, item.err.Error())
+				continue
+			}
+
+			var messages []string
+			for _, entry := range list.Items {
+				messages = append(messages, entry.Message)
+			}
+			So(messages, ShouldResemble, item.messages)
+		}
+	})
+
+	Convey("Log List rejects invalid query params", t, func() {
+		table := []struct {
+			// Input
+			query string
+			// Expectations
+			message string
+		}{
+			{
+				query:   "since=yesterday",
+				message: `Validation failed: since: "yesterday" is not an RFC3339 time`,
+			},
+			{
+				query:   "limit=all",
+				message: `Validation failed: limit: "all" is not an integer`,
+			},
+		}
+
+		for _, item := range table {
+			resp, err := testHTTPClient.Do(authorizedRequest(srv.Core, requestor, "/api/v0/log?"+item.query))
+			So(err, ShouldBeNil)
+			body := new(model.Error)
+			json.NewDecoder(resp.Body).Decode(body)
+			resp.Body.Close()
+			So(resp.StatusCode, ShouldEqual, 422)
+			So(body.Message, ShouldEqual, item.message)
+		}
+	})
+
+	Convey("Log entries include their fields", t, func() {
+		list := new(model.LogEntryList)
+		err := sg.Logs.List(&model.LogQuery{Text: "tag instance"}, list)
+		So(err, ShouldBeNil)
+		So(list.Items, ShouldResemble, []*model.LogEntry{
+			{
+				Time:    time.Date(2016, 10, 2, 0, 0, 0, 0, time.UTC),
+				Level:   "error",
+				Message: "Failed to tag instance",
+				Fields: map[string]interface{}{
+					"model":     "Kube",
+					"model_id":  float64(1),
+					"action_id": "abc",
+				},
+			},
+		})
+	})
+}
+
+func TestLogListLargeFile(t *testing.T) {
+	file, _ := ioutil.TempFile(os.TempDir(), "supergiant-log")
+	defer os.Remove(file.Name())
+	// Many times larger than the chunks the log is read backwards in
+	for i := 0; i < 5000; i++ {
+		level := "info"
+		if i%1000 == 0 {
+			level = "error"
+		}
+		fmt.Fprintf(file, `{"level":"%s","msg":"Entry %d","time":"2016-10-01T00:00:00Z","padding":"%s"}`+"\n", level, i, strings.Repeat("x", 64))
+	}
+	// A line which is still being written is left out
+	file.WriteString(`{"level":"error","msg":"Partial`)
+	file.Close()
+
+	srv := newConfiguredTestServer(func(c *core.Core) {
+		c.LogPath = file.Name()
+	})
+	go srv.Start()
+	defer srv.Stop()
+
+	requestor := createAdmin(srv.Core)
+	sg := srv.Core.APIClient("token", requestor.APIToken)
+
+	Convey("Log List returns the most recent matching entries of a large log", t, func() {
+		table := []struct {
+			// Input
+			query *model.LogQuery
+			// Expectations
+			messages []string
+		}{
+			{
+				query:    &model.LogQuery{Limit: 3},
+				messages: []string{"Entry 4997", "Entry 4998", "Entry 4999"},
+			},
+			{
+				query:    &model.LogQuery{Level: "error", Limit: 10},
+				messages: []string{"Entry 0", "Entry 1000", "Entry 2000", "Entry 3000", "Entry 4000"},
+			},
+		}
+
+		for _, item := range table {
+			list := new(model.LogEntryList)
+			So(sg.Logs.List(item.query, list), ShouldBeNil)
+
+			var messages []string
+			for _, entry := range list.Items {
+				messages = append(messages, entry.Message)
+			}
+			So(messages, ShouldResemble, item.messages)
+		}
+	})
+}
+
+func TestLogFollow(t *testing.T) {
+	file, _ := ioutil.TempFile(os.TempDir(), "supergiant-log")
+	defer os.Remove(file.Name())
+	defer file.Close()
+	file.WriteString(testLogLines)
+
+	srv := newConfiguredTestServer(func(c *core.Core) {
+		c.LogPath = file.Name()
+		// Responses are streamed through the metrics handler
+		c.MetricsEnabled = true
+	})
+	go srv.Start()
+	defer srv.Stop()
+
+	requestor := createAdmin(srv.Core)
+	sg := srv.Core.APIClient("token", requestor.APIToken)
+
+	Convey("Log Follow streams existing and new matching entries", t, func() {
+		stop := errors.New("stop")
+		var messages []string
+
+		done := make(chan error)
+		go func() {
+			done <- sg.Logs.Follow(&model.LogQuery{Level: "error"}, func(entry *model.LogEntry) error {
+				messages = append(messages, entry.Message)
+				if len(messages) == 2 {
+					return stop
+				}
+				return nil
+			})
+		}()
+
+		time.Sleep(100 * time.Millisecond)
+		file.WriteString(`{"level":"info","msg":"Not an error","time":"2016-10-05T00:00:00Z"}` + "\n")
+		file.WriteString(`{"level":"error","msg":"A new error","time":"2016-10-05T00:00:00Z"}` + "\n")
+
+		select {
+		case err := <-done:
+			So(err, ShouldEqual, stop)
+		case <-time.After(5 * time.Second):
+			t.Fatal("Timed out following log")
+		}
+		So(messages, ShouldResemble, []string{"Failed to tag instance", "A new error"})
+	})
+}
+
+func TestLogNotConfigured(t *testing.T) {
+	srv := newTestServer()
+	go srv.Start()
+	defer srv.Stop()
+
+	requestor := createAdmin(srv.Core)
+	sg := srv.Core.APIClient("token", requestor.APIToken)
+
+	Convey("Log List responds 404 without a log file", t, func() {
+		err := sg.Logs.List(new(model.LogQuery), new(model.LogEntryList))
+		So(err.Error(), ShouldEqual, "[404] "+core.ErrorLogNotConfigured.Error())
+	})
+}
@@ -0,0 +1,137 @@
+package api
+
+import (
+	"testing"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"github.com/supergiant/supergiant/pkg/core"
+	"github.com/supergiant/supergiant/pkg/metrics"
+	"github.com/supergiant/supergiant/pkg/model"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestMetricsEndpoint(t *testing.T) {
+	srv := newConfiguredTestServer(func(c *core.Core) {
+		c.MetricsEnabled = true
+	})
+	go srv.Start()
+	defer srv.Stop()
+
+	Convey("Given a server with metrics enabled", t, func() {
+		requestor := createAdmin(srv.Core)
+		sg := srv.Core.APIClient("token", requestor.APIToken)
+
+		Convey("When an API request is made and /metrics is scraped", func() {
+			sg.Users.Get(requestor.ID, new(model.User))
+
+			resp, err := testHTTPClient.Get(srv.Core.BaseURL() + "/metrics")
+			So(err, ShouldBeNil)
+			defer resp.Body.Close()
+			families, parseErr := new(expfmt.TextParser).TextToMetricFamilies(resp.Body)
+
+			Convey("It should render request, DB and status metrics", func() {
+				So(resp.StatusCode, ShouldEqual, 200)
+				So(resp.Header.Get("Content-Type"), ShouldEqual, metrics.ContentType)
+				So(parseErr, ShouldBeNil)
+
+				requests := families["supergiant_http_requests_total"]
+				So(requests, ShouldNotBeNil)
+				var userGets float64
+				for _, metric := range requests.Metric {
+					labels := make(map[string]string)
+					for _, pair := range metric.Label {
+						labels[pair.GetName()] = pair.GetValue()
+					}
+					if labels["route"] == "/api/v0/users/{id}" && labels["method"] == "GET" && labels["code"] == "200" {
+						userGets += metric.Counter.GetValue()
+					}
+				}
+				So(userGets, ShouldEqual, 1)
+
+				So(families, ShouldContainKey, "supergiant_db_query_duration_seconds")
+				So(families["supergiant_db_query_duration_seconds"].GetType().String(), ShouldEqual, "HISTOGRAM")
+			})
+		})
+	})
+}
+
+func TestMetricsStatusGauges(t *testing.T) {
+	srv := newConfiguredTestServer(func(c *core.Core) {
+		c.MetricsEnabled = true
+	})
+	go srv.Start()
+	defer srv.Stop()
+
+	Convey("Given a server with metrics enabled, Kubes, Nodes and KubeResources", t, func() {
+		srv.Core.DB.Create(&model.CloudAccount{Name: "test", Provider: "aws", Credentials: map[string]string{"thanks": "for being great"}})
+		srv.Core.DB.Create(&model.Kube{
+			CloudAccountName: "test",
+			Name:             "test",
+			MasterNodeSize:   "m4.large",
+			NodeSizes:        []string{"m4.large"},
+			Username:         "user",
+			Password:         "password",
+			AWSConfig: &model.AWSKubeConfig{
+				Region:           "us-east-1",
+				AvailabilityZone: "us-east-1a",
+			},
+		})
+		for _, node := range []*model.Node{
+			{KubeName: "test", Size: "m4.large"},
+			{KubeName: "test", Size: "m4.large", ProviderID: "i-1"},
+			{KubeName: "test", Size: "m4.large", ProviderID: "i-2"},
+			{KubeName: "test", Size: "m4.large", ProviderID: "i-3", OutOfDisk: true},
+		} {
+			srv.Core.DB.Create(node)
+		}
+		for _, kubeResource := range []*model.KubeResource{
+			{KubeName: "test", Kind: "Pod", Namespace: "default", Name: "stopped", Template: newRawMessage(`{}`)},
+			{KubeName: "test", Kind: "Pod", Namespace: "default", Name: "started", Template: newRawMessage(`{}`), Started: true, Ready: true},
+			{KubeName: "test", Kind: "Pod", Namespace: "default", Name: "drifted", Template: newRawMessage(`{}`), Started: true, Ready: true, Drift: &model.KubeResourceDrift{Fields: []string{"spec"}}},
+		} {
+			srv.Core.DB.Create(kubeResource)
+		}
+
+		Convey("When /metrics is scraped", func() {
+			resp, err := testHTTPClient.Get(srv.Core.BaseURL() + "/metrics")
+			So(err, ShouldBeNil)
+			defer resp.Body.Close()
+			families, parseErr := new(expfmt.TextParser).TextToMetricFamilies(resp.Body)
+			So(parseErr, ShouldBeNil)
+
+			Convey("It should count each status", func() {
+				So(statusGauges(families["supergiant_kubes"]), ShouldResemble, map[string]float64{"not ready": 1})
+				So(statusGauges(families["supergiant_nodes"]), ShouldResemble, map[string]float64{"provisioning": 1, "running": 2, "out of disk": 1})
+				So(statusGauges(families["supergiant_kube_resources"]), ShouldResemble, map[string]float64{"stopped": 1, "started": 1, "drifted": 1})
+			})
+		})
+	})
+}
+
+func statusGauges(family *dto.MetricFamily) map[string]float64 {
+	values := make(map[string]float64)
+	if family == nil {
+		return values
+	}
+	for _, metric := range family.Metric {
+		values[metric.Label[0].GetValue()] = metric.Gauge.GetValue()
+	}
+	return values
+}
+
+func TestMetricsEndpointDisabled(t *testing.T) {
+	srv := newTestServer()
+	go srv.Start()
+	defer srv.Stop()
+
+	Convey("Given a server with metrics disabled", t, func() {
+		Convey("/metrics should not be found", func() {
+			resp, err := testHTTPClient.Get(srv.Core.BaseURL() + "/metrics")
+			So(err, ShouldBeNil)
+			resp.Body.Close()
+			So(resp.StatusCode, ShouldEqual, 404)
+		})
+	})
+}
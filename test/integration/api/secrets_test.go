@@ -0,0 +1,176 @@
+package api
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/supergiant/supergiant/pkg/core"
+	"github.com/supergiant/supergiant/pkg/kubernetes"
+	"github.com/supergiant/supergiant/pkg/model"
+	"github.com/supergiant/supergiant/test/fake_core"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestSecrets(t *testing.T) {
+	srv := newTestServer()
+	go srv.Start()
+	defer srv.Stop()
+
+	requestor := createAdmin(srv.Core)
+	sg := srv.Core.APIClient("token", requestor.APIToken)
+
+	srv.Core.AWSProvider = func(_ map[string]string) core.Provider {
+		return new(fake_core.Provider)
+	}
+	kube := createKube(sg)
+
+	applied := make(chan map[string]interface{}, 10)
+	patched := make(chan string, 10)
+	srv.Core.K8S = func(_ *model.Kube) kubernetes.ClientInterface {
+		return &fake_core.KubernetesClient{
+			UpdateResourceFn: func(apiVersion string, kind string, namespace string, name string, objIn map[string]interface{}, out *json.RawMessage) error {
+				applied <- objIn
+				return nil
+			},
+			PatchResourceFn: func(apiVersion string, kind string, namespace string, name string, patch map[string]interface{}, out *json.RawMessage) error {
+				patchJSON, _ := json.Marshal(patch)
+				patched <- kind + "/" + name + " " + string(patchJSON)
+				return nil
+			},
+		}
+	}
+
+	Convey("Secrets are stored encrypted, provisioned in their Kube, and restart their consumers when rotated", t, func() {
+		secret := &model.Secret{
+			KubeName:  kube.Name,
+			Namespace: "mongo",
+			Name:      "mongo",
+			Data:      map[string]string{"password": "hunter2", "user": "admin"},
+		}
+		So(sg.Secrets.Create(secret), ShouldBeNil)
+		So(secret.Kind, ShouldEqual, "Secret")
+		So(secret.Keys, ShouldResemble, []string{"password", "user"})
+		So(secret.Version, ShouldEqual, 1)
+
+		stored := new(model.Secret)
+		So(srv.Core.DB.First(stored, *secret.ID), ShouldBeNil)
+		So(stored.EncryptedData, ShouldNotBeEmpty)
+		So(string(stored.EncryptedData), ShouldNotContainSubstring, "hunter2")
+
+		var obj map[string]interface{}
+		select {
+		case obj = <-applied:
+		case <-time.After(5 * time.Second):
+		}
+		So(obj["kind"], ShouldEqual, "Secret")
+		So(obj["data"], ShouldResemble, map[string]string{
+			"password": base64.StdEncoding.EncodeToString([]byte("hunter2")),
+			"user":     base64.StdEncoding.EncodeToString([]byte("admin")),
+		})
+
+		waitFor("Secret to be ready", func() bool {
+			So(sg.Secrets.Get(secret.ID, secret), ShouldBeNil)
+			return secret.Ready
+		})
+
+		// Data is never returned
+		fetched := new(model.Secret)
+		So(sg.Secrets.Get(secret.ID, fetched), ShouldBeNil)
+		So(fetched.Data, ShouldBeNil)
+
+		// A started Deployment which uses the Secret
+		consumer := &model.KubeResource{
+			KubeName:  kube.Name,
+			Namespace: "mongo",
+			Kind:      "Deployment",
+			Name:      "mongo",
+			Started:   true,
+			Template: newRawMessage(`{
+				"spec": {"template": {"spec": {"containers": [{
+					"name": "mongo",
+					"env": [{"name": "PASSWORD", "valueFrom": {"secretKeyRef": {"name": "mongo", "key": "password"}}}]
+				}]}}}
+			}`),
+		}
+		So(srv.Core.DB.Create(consumer), ShouldBeNil)
+
+		consumers, err := srv.Core.Secrets.Consumers(secret)
+		So(err, ShouldBeNil)
+		So(consumers, ShouldHaveLength, 1)
+
+		// Rotating the Secret applies the new Data, and restarts the Deployment
+		err = sg.Secrets.Update(secret.ID, &model.Secret{Data: map[string]string{"password": "hunter3", "user": "admin"}})
+		So(err, ShouldBeNil)
+
+		select {
+		case obj = <-applied:
+		case <-time.After(5 * time.Second):
+		}
+		So(obj["data"].(map[string]string)["password"], ShouldEqual, base64.StdEncoding.EncodeToString([]byte("hunter3")))
+
+		var patch string
+		select {
+		case patch = <-patched:
+		case <-time.After(5 * time.Second):
+		}
+		So(patch, ShouldEqual, `Deployment/mongo {"spec":{"template":{"metadata":{"annotations":{"supergiant.io/secret.mongo":"2"}}}}}`)
+
+		waitFor("Secret to be ready", func() bool {
+			So(sg.Secrets.Get(secret.ID, secret), ShouldBeNil)
+			return secret.Ready
+		})
+		So(secret.Version, ShouldEqual, 2)
+		So(secret.RotatedAt, ShouldNotBeNil)
+	})
+
+	Convey("Secrets require valid Data", t, func() {
+		table := []struct {
+			// Input
+			data map[string]string
+			// Expectations
+			err *model.Error
+		}{
+			{
+				data: nil,
+				err:  &model.Error{Status: 422, Message: "Validation failed: Data: must have at least one key"},
+			},
+			{
+				data: map[string]string{"pass word": "hunter2"},
+				err:  &model.Error{Status: 422, Message: `Validation failed: Data: invalid key "pass word"`},
+			},
+		}
+
+		for _, item := range table {
+			err := sg.Secrets.Create(&model.Secret{
+				KubeName:  kube.Name,
+				Namespace: "mongo",
+				Name:      "invalid",
+				Data:      item.data,
+			})
+			So(err, ShouldResemble, item.err)
+		}
+	})
+
+	Convey("Secrets are unique by Kube, Namespace, Kind and Name", t, func() {
+		newSecret := func(kind string) *model.Secret {
+			return &model.Secret{
+				KubeName:  kube.Name,
+				Namespace: "unique",
+				Kind:      kind,
+				Name:      "creds",
+				Data:      map[string]string{"password": "hunter2"},
+			}
+		}
+		So(sg.Secrets.Create(newSecret("Secret")), ShouldBeNil)
+
+		err := sg.Secrets.Create(newSecret("Secret"))
+		So(err, ShouldNotBeNil)
+		So(err.Error(), ShouldContainSubstring, "UNIQUE constraint failed")
+
+		// A ConfigMap may share the name of a Secret
+		So(sg.Secrets.Create(newSecret("ConfigMap")), ShouldBeNil)
+	})
+}
@@ -2,6 +2,7 @@ package api
 
 import (
 	"encoding/json"
+	"net/http"
 
 	"github.com/supergiant/supergiant/pkg/core"
 	"github.com/supergiant/supergiant/pkg/model"
@@ -9,11 +10,18 @@ import (
 )
 
 func newTestServer() *server.Server {
+	return newConfiguredTestServer(func(*core.Core) {})
+}
+
+// newConfiguredTestServer allows setting extra Settings before initializing.
+func newConfiguredTestServer(configure func(*core.Core)) *server.Server {
 	c := new(core.Core)
 	c.LogLevel = "fatal"
 	c.PublishHost = "localhost"
 	c.HTTPPort = "9999"
 	c.SQLiteFile = "../../../tmp/test.db"
+	c.EncryptionKeyFile = "../../../tmp/test.key"
+	configure(c)
 
 	wipeAndInitialize(c)
 
@@ -36,6 +44,10 @@ func wipeDatabase(c *core.Core) {
 	c.DB.Delete(&model.User{})
 	c.DB.Delete(&model.Kube{})
 	c.DB.Delete(&model.KubeResource{})
+	c.DB.Delete(&model.KubeResourceTemplate{})
+	c.DB.Delete(&model.KubeResourceRevision{})
+	c.DB.Delete(&model.App{})
+	c.DB.Delete(&model.Secret{})
 	c.DB.Delete(&model.CloudAccount{})
 	c.DB.Delete(&model.Volume{})
 	c.DB.Delete(&model.Entrypoint{})
@@ -78,3 +90,13 @@ func newRawMessage(str string) *json.RawMessage {
 	rawmsg := json.RawMessage([]byte(str))
 	return &rawmsg
 }
+
+// testHTTPClient is used for requests not made through the API client. It does
+// not reuse connections, since each test starts a new server on the same port.
+var testHTTPClient = &http.Client{Transport: &http.Transport{DisableKeepAlives: true}}
+
+func authorizedRequest(c *core.Core, user *model.User, path string) *http.Request {
+	req, _ := http.NewRequest("GET", c.BaseURL()+path, nil)
+	req.Header.Set("Authorization", `SGAPI token="`+user.APIToken+`"`)
+	return req
+}
@@ -272,6 +272,37 @@ func TestUsersRegenerateAPIToken(t *testing.T) {
 	})
 }
 
+func TestUsersRegenerateProxyToken(t *testing.T) {
+	srv := newTestServer()
+	go srv.Start()
+	defer srv.Stop()
+
+	user, admin := createUserAndAdmin(srv.Core)
+
+	Convey("Given a user and an admin", t, func() {
+
+		Convey("When the user calls RegenerateProxyToken on another User", func() {
+			sg := srv.Core.APIClient("token", user.APIToken)
+			err := sg.Users.RegenerateProxyToken(admin.ID, new(model.User))
+
+			Convey("They should receive a 403 Forbidden error", func() {
+				So(err.(*model.Error).Status, ShouldEqual, 403)
+			})
+		})
+
+		Convey("When the user calls RegenerateProxyToken on themself", func() {
+			sg := srv.Core.APIClient("token", user.APIToken)
+			reloadedUser := new(model.User)
+			err := sg.Users.RegenerateProxyToken(user.ID, reloadedUser)
+
+			Convey("The token should be regenerated", func() {
+				So(err, ShouldBeNil)
+				So(reloadedUser.ProxyToken, ShouldNotEqual, user.ProxyToken)
+			})
+		})
+	})
+}
+
 func TestUsersDelete(t *testing.T) {
 	Convey("Given a user and an admin", t, func() {
 
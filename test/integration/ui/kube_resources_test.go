@@ -1,7 +1,9 @@
 package ui
 
 import (
+	"encoding/json"
 	"errors"
+	"io/ioutil"
 	"net/http"
 	"net/url"
 	"strings"
@@ -621,3 +623,105 @@ func TestKubeResourcesUpdate(t *testing.T) {
 		}
 	})
 }
+
+func TestKubeResourcesRevisions(t *testing.T) {
+	srv := newTestServer()
+	go srv.Start()
+	defer srv.Stop()
+
+	Convey("UI KubeResources Revisions shows the diff between revisions", t, func() {
+
+		table := []struct {
+			// Mocks
+			mockAuthenticated bool
+			mock404           bool
+			// Expectations
+			responseStatusCode int
+			responseURL        string
+			responseContains   []string
+		}{
+			// A successful example
+			{
+				mockAuthenticated:  true,
+				responseStatusCode: 200,
+				responseURL:        "http://localhost:10000/ui/kube_resources/1/revisions",
+				responseContains: []string{
+					`diff_removed">-   &#34;image&#34;: &#34;nginx:1.12&#34;`,
+					`diff_added">&#43;   &#34;image&#34;: &#34;nginx:1.13&#34;`,
+					`action="/ui/kube_resources/1/rollback?revision=1"`,
+				},
+			},
+			// Unauthenticated
+			{
+				mockAuthenticated:  false,
+				responseStatusCode: 200,
+				responseURL:        "http://localhost:10000/ui/sessions/new",
+			},
+			// 404
+			{
+				mockAuthenticated:  true,
+				mock404:            true,
+				responseStatusCode: 404,
+				responseURL:        "http://localhost:10000/ui/kube_resources/1/revisions",
+			},
+		}
+
+		for _, item := range table {
+
+			// For unauthenticated Session-based routes
+			srv.Core.APIClient = func(authType string, authToken string) *client.Client {
+				return new(client.Client)
+			}
+
+			srv.Core.Sessions = &fake_core.Sessions{
+				ClientFn: func(sessionID string) *client.Client {
+					if item.mockAuthenticated {
+						return &client.Client{
+							KubeResources: &fake_client.KubeResources{
+								Collection: fake_client.Collection{
+									GetFn: func(id interface{}, m model.Model) error {
+										if item.mock404 {
+											return errors.New("404")
+										}
+										return nil
+									},
+								},
+								RevisionsFn: func(id *int64, list *model.KubeResourceRevisionList) error {
+									v1 := json.RawMessage(`{"image": "nginx:1.12"}`)
+									v2 := json.RawMessage(`{"image": "nginx:1.13"}`)
+									list.Items = []*model.KubeResourceRevision{
+										{Revision: 1, Template: &v1},
+										{Revision: 2, Author: "bossman", Template: &v2},
+									}
+									return nil
+								},
+							},
+						}
+					}
+					return nil
+				},
+			}
+
+			req, _ := http.NewRequest("GET", "http://localhost:10000/ui/kube_resources/1/revisions", nil)
+
+			// As long as we have a cookie with the right name, it will trigger the
+			// use of our fake_core.Sessions above.
+			cookie := &http.Cookie{
+				Name:  core.SessionCookieName,
+				Value: "fake-session-id",
+				Path:  "/",
+			}
+			req.AddCookie(cookie)
+
+			resp, _ := http.DefaultClient.Do(req)
+			body, _ := ioutil.ReadAll(resp.Body)
+			resp.Body.Close()
+
+			So(resp.StatusCode, ShouldEqual, item.responseStatusCode)
+			So(resp.Request.URL.String(), ShouldEqual, item.responseURL)
+			for _, s := range item.responseContains {
+				So(string(body), ShouldContainSubstring, s)
+			}
+		}
+	})
+}
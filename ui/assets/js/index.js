@@ -534,15 +534,37 @@ $(function() {
           xhr.setRequestHeader('Authorization', 'SGAPI session="' + getCookie('supergiant_session') + '"');
         },
         url: "/api/v0/log",
+        dataType: "json",
         success: function(data) {
 
-          data = data.replace(/[\x00-\x7F]\[\d+mINFO[\x00-\x7F]\[0m/g, "<span class='text-info'>INFO</span> ")
-          data = data.replace(/[\x00-\x7F]\[\d+mWARN[\x00-\x7F]\[0m/g, "<span class='text-warning'>WARN</span> ")
-          data = data.replace(/[\x00-\x7F]\[\d+mERRO[\x00-\x7F]\[0m/g, "<span class='text-danger'>ERRO</span> ")
-          data = data.replace(/[\x00-\x7F]\[\d+mDEBU[\x00-\x7F]\[0m/g, "<span class='text-muted'>DEBU</span> ")
-
-          logDiv.html(data);
+          var levelClasses = {
+            "info": "text-info",
+            "warning": "text-warning",
+            "error": "text-danger",
+            "fatal": "text-danger",
+            "panic": "text-danger",
+            "debug": "text-muted"
+          };
+
+          var lines = $.map(data.items, function(entry) {
+            var line = $('<div>').text(entry.msg).html();
+            if (entry.level) {
+              var level = entry.level.substring(0, 4).toUpperCase();
+              line = entry.time + " <span class='" + levelClasses[entry.level] + "'>" + level + "</span> " + line;
+            }
+            $.each(entry.fields || {}, function(key, val) {
+              line += " " + $('<div>').text(key + "=" + val).html();
+            });
+            return line;
+          });
+
+          logDiv.html(lines.join("\n"));
 
+        },
+        error: function(xhr) {
+          if (xhr.responseJSON) {
+            logDiv.text(xhr.responseJSON.message);
+          }
         }
       });
     };